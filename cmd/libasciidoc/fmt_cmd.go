@@ -0,0 +1,71 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+
+	"github.com/bytesparadise/libasciidoc"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+// NewFmtCmd returns the `fmt` command, which formats Asciidoc files
+func NewFmtCmd() *cobra.Command {
+
+	var write bool
+
+	fmtCmd := &cobra.Command{
+		Use:   "fmt [flags] FILE...",
+		Short: "Format Asciidoc files",
+		Long: `Format Asciidoc files in a normalized style (consistent list markers and heading style, one sentence per line, etc.)
+By default, the formatted content is written on STDOUT. If no file is given, the content is read from STDIN.`,
+		Args: cobra.ArbitraryArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				if write {
+					return errors.New("cannot use -w with standard input")
+				}
+				return libasciidoc.ConvertToAsciidoc(context.Background(), os.Stdin, cmd.OutOrStdout())
+			}
+			for _, source := range args {
+				log.Debugf("formatting file %v", source)
+				if err := formatFile(cmd, source, write); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+	flags := fmtCmd.Flags()
+	flags.BoolVarP(&write, "write", "w", false, "write result to (source) file instead of STDOUT")
+	return fmtCmd
+}
+
+func formatFile(cmd *cobra.Command, source string, write bool) error {
+	content, err := ioutil.ReadFile(source)
+	if err != nil {
+		return errors.Wrapf(err, "unable to format file '%s'", source)
+	}
+	result := bytes.NewBuffer(nil)
+	err = libasciidoc.ConvertToAsciidoc(context.Background(), bytes.NewReader(content), result)
+	if err != nil {
+		return errors.Wrapf(err, "unable to format file '%s'", source)
+	}
+	if !write {
+		_, err = cmd.OutOrStdout().Write(result.Bytes())
+		return err
+	}
+	if bytes.Equal(content, result.Bytes()) {
+		// no need to rewrite the file
+		return nil
+	}
+	info, err := os.Stat(source)
+	if err != nil {
+		return errors.Wrapf(err, "unable to format file '%s'", source)
+	}
+	return ioutil.WriteFile(source, result.Bytes(), info.Mode())
+}
@@ -0,0 +1,62 @@
+package main_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+
+	main "github.com/bytesparadise/libasciidoc/cmd/libasciidoc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("fmt cmd", func() {
+
+	It("format with STDOUT output", func() {
+		// given
+		fmtCmd := main.NewFmtCmd()
+		buf := new(bytes.Buffer)
+		fmtCmd.SetOutput(buf)
+		fmtCmd.SetArgs([]string{"test/test.adoc"})
+		// when
+		err := fmtCmd.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).ToNot(BeEmpty())
+	})
+
+	It("format and write the source file", func() {
+		// given
+		f, err := ioutil.TempFile("", "libasciidoc-fmt-*.adoc")
+		Expect(err).ToNot(HaveOccurred())
+		defer os.Remove(f.Name())
+		_, err = f.WriteString("- item 1\n- item 2")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(f.Close()).To(Succeed())
+		fmtCmd := main.NewFmtCmd()
+		buf := new(bytes.Buffer)
+		fmtCmd.SetOutput(buf)
+		fmtCmd.SetArgs([]string{"-w", f.Name()})
+		// when
+		err = fmtCmd.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(BeEmpty())
+		content, err := ioutil.ReadFile(f.Name())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(content)).To(Equal("* item 1\n* item 2\n"))
+	})
+
+	It("fail to format missing file", func() {
+		// given
+		fmtCmd := main.NewFmtCmd()
+		buf := new(bytes.Buffer)
+		fmtCmd.SetOutput(buf)
+		fmtCmd.SetArgs([]string{"test/doesnotexist.adoc"})
+		// when
+		err := fmtCmd.Execute()
+		// then
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -13,6 +13,8 @@ func main() {
 	rootCmd := NewRootCmd()
 	versionCmd := NewVersionCmd()
 	rootCmd.AddCommand(versionCmd)
+	fmtCmd := NewFmtCmd()
+	rootCmd.AddCommand(fmtCmd)
 	rootCmd.SetHelpCommand(helpCommand)
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Println(err)
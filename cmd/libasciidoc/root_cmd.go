@@ -12,6 +12,7 @@ import (
 	"github.com/bytesparadise/libasciidoc"
 	logsupport "github.com/bytesparadise/libasciidoc/pkg/log"
 	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -23,10 +24,14 @@ func NewRootCmd() *cobra.Command {
 	var noHeaderFooter bool
 	var outputName string
 	var logLevel string
+	var backend string
+	var attributes []string
+	var safeMode string
+	var imageDimensions bool
 
 	rootCmd := &cobra.Command{
 		Use:   "libasciidoc [flags] FILE",
-		Short: `libasciidoc is a tool to convert from Asciidoc to HTML`,
+		Short: `libasciidoc is a tool to convert from Asciidoc to HTML (or Markdown)`,
 		Args:  cobra.ArbitraryArgs,
 		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
 			lvl, err := log.ParseLevel(logLevel)
@@ -43,13 +48,31 @@ func NewRootCmd() *cobra.Command {
 			if len(args) == 0 {
 				return helpCommand.RunE(cmd, args)
 			}
+			var ext string
+			switch backend {
+			case "html5":
+				ext = ".html"
+			case "markdown":
+				ext = ".md"
+			default:
+				return fmt.Errorf("unsupported backend '%s'", backend)
+			}
+			mode, err := types.NewSafeMode(safeMode)
+			if err != nil {
+				return err
+			}
 			for _, source := range args {
-				out, close := getOut(cmd, source, outputName)
+				out, close := getOut(cmd, source, outputName, ext)
 				if out != nil {
 					defer close()
 					path, _ := filepath.Abs(source)
 					log.Debugf("Starting to process file %v", path)
-					_, err := libasciidoc.ConvertFileToHTML(context.Background(), source, out, renderer.IncludeHeaderFooter(!noHeaderFooter)) //renderer.IncludeHeaderFooter(true)
+					attrs := renderer.Attributes(parseAttributes(attributes))
+					if backend == "markdown" {
+						err = libasciidoc.ConvertFileToMarkdown(context.Background(), source, out, attrs, renderer.SafeMode(mode), renderer.ImageDimensions(imageDimensions))
+					} else {
+						_, err = libasciidoc.ConvertFileToHTML(context.Background(), source, out, renderer.IncludeHeaderFooter(!noHeaderFooter), attrs, renderer.SafeMode(mode), renderer.ImageDimensions(imageDimensions)) //renderer.IncludeHeaderFooter(true)
+					}
 					if err != nil {
 						return err
 					}
@@ -61,10 +84,28 @@ func NewRootCmd() *cobra.Command {
 	flags := rootCmd.Flags()
 	flags.BoolVarP(&noHeaderFooter, "no-header-footer", "s", false, "do not render header/footer (default: false)")
 	flags.StringVarP(&outputName, "out-file", "o", "", "output file (default: based on path of input file); use - to output to STDOUT")
+	flags.StringVarP(&backend, "backend", "b", "html5", "backend to format the file [html5|markdown]")
+	flags.StringArrayVarP(&attributes, "attribute", "a", []string{}, "a document attribute to set in the form of name, name=value or name=value@ (soft, overridable in the document), or name! to unset it")
+	flags.StringVarP(&safeMode, "safe-mode", "S", "unsafe", "safe mode to restrict the file inclusions and the raw content [unsafe|safe|server|secure]")
+	flags.BoolVar(&imageDimensions, "image-dimensions", false, "read the width and height of the local images which have no dimensions in the document (default: false)")
 	rootCmd.PersistentFlags().StringVar(&logLevel, "log", "warning", "log level to set [debug|info|warning|error|fatal|panic]")
 	return rootCmd
 }
 
+// parseAttributes converts the `name=value` attributes set in the command line into a map
+func parseAttributes(attributes []string) map[string]string {
+	result := make(map[string]string, len(attributes))
+	for _, attr := range attributes {
+		parts := strings.SplitN(attr, "=", 2)
+		if len(parts) == 2 {
+			result[parts[0]] = parts[1]
+		} else {
+			result[parts[0]] = ""
+		}
+	}
+	return result
+}
+
 type closeFunc func() error
 
 func defaultCloseFunc() closeFunc {
@@ -77,7 +118,7 @@ func newCloseFileFunc(c io.Closer) closeFunc {
 	}
 }
 
-func getOut(cmd *cobra.Command, source, outputName string) (io.Writer, closeFunc) {
+func getOut(cmd *cobra.Command, source, outputName, ext string) (io.Writer, closeFunc) {
 	if outputName == "-" {
 		// outfile is STDOUT
 		return cmd.OutOrStdout(), defaultCloseFunc()
@@ -91,7 +132,7 @@ func getOut(cmd *cobra.Command, source, outputName string) (io.Writer, closeFunc
 	} else if source != "" {
 		// outfile is based on source
 		path, _ := filepath.Abs(source)
-		outname := strings.TrimSuffix(path, filepath.Ext(path)) + ".html"
+		outname := strings.TrimSuffix(path, filepath.Ext(path)) + ext
 		outfile, err := os.Create(outname)
 		if err != nil {
 			log.Warnf("Cannot create output file - %v, skipping", outname)
@@ -68,6 +68,111 @@ var _ = Describe("root cmd", func() {
 		Expect(buf.String()).ToNot(ContainSubstring(`<div id="footer">`))
 	})
 
+	It("render in Markdown with STDOUT output", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-b", "markdown", "-o", "-", "test/admonition.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(ContainSubstring("> [!NOTE]"))
+	})
+
+	It("render with attributes", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-s", "-o", "-", "-a", "product=Acme", "-a", "edition=Pro@", "-a", "version=1.0", "test/attributes.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(ContainSubstring("<p>Acme Community 1.0</p>"))
+	})
+
+	It("render in unsafe mode", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-s", "-o", "-", "test/safe_mode.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(ContainSubstring("<p>content</p>"))
+		Expect(buf.String()).To(ContainSubstring("<p><del>raw</del></p>"))
+	})
+
+	It("render in safe mode", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-s", "-o", "-", "--safe-mode", "safe", "test/safe_mode.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(ContainSubstring("Unresolved directive in test/safe_mode.adoc - include::../../test/includes/chapter-a.adoc[]"))
+		Expect(buf.String()).To(ContainSubstring("<p><del>raw</del></p>"))
+	})
+
+	It("render in server mode", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-s", "-o", "-", "-S", "server", "test/safe_mode.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(ContainSubstring("Unresolved directive in test/safe_mode.adoc - include::../../test/includes/chapter-a.adoc[]"))
+		Expect(buf.String()).To(ContainSubstring("<p>&lt;del&gt;raw&lt;/del&gt;</p>"))
+	})
+
+	It("render with image dimensions", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-s", "-o", "-", "--image-dimensions", "test/image_dimensions.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(ContainSubstring(`<img src="circle.svg" alt="circle" width="100" height="100">`))
+	})
+
+	It("fail to render with unknown safe mode", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-S", "paranoid", "-o", "-", "test/safe_mode.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("fail to render with unknown backend", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-b", "pdf", "-o", "-", "test/test.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).To(HaveOccurred())
+	})
+
 	It("render multiple files", func() {
 		// given
 		root := main.NewRootCmd()
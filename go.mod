@@ -1,6 +1,6 @@
 module github.com/bytesparadise/libasciidoc
 
-go 1.11
+go 1.16
 
 require (
 	github.com/davecgh/go-spew v1.1.1
@@ -19,7 +19,7 @@ require (
 	golang.org/x/crypto v0.0.0-20190909091759-094676da4a83 // indirect
 	golang.org/x/net v0.0.0-20190909003024-a7b16738d86b // indirect
 	golang.org/x/sys v0.0.0-20190910064555-bbd175535a8b // indirect
-	golang.org/x/text v0.3.2 // indirect
+	golang.org/x/text v0.3.2
 	golang.org/x/tools v0.0.0-20190911225940-c7d52e45e2f2 // indirect
 	gopkg.in/yaml.v2 v2.2.1
 )
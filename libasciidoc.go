@@ -6,11 +6,14 @@ import (
 	"context"
 	"io"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/bytesparadise/libasciidoc/pkg/parser"
 	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	asciidocrenderer "github.com/bytesparadise/libasciidoc/pkg/renderer/asciidoc"
 	htmlrenderer "github.com/bytesparadise/libasciidoc/pkg/renderer/html5"
+	markdownrenderer "github.com/bytesparadise/libasciidoc/pkg/renderer/markdown"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 
 	"github.com/pkg/errors"
@@ -30,30 +33,31 @@ var (
 // The conversion result is written in the given writer `output`, whereas the document metadata (title, etc.) (or an error if a problem occurred) is returned
 // as the result of the function call.
 func ConvertFileToHTML(ctx context.Context, filename string, output io.Writer, options ...renderer.Option) (map[string]interface{}, error) {
-	file, err := os.Open(filename)
+	file, err := openFile(filename, options)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error opening %s", filename)
 	}
 	defer file.Close()
-	return ConvertToHTML(ctx, file, output, options...)
+	return convertToHTML(ctx, filename, file, output, options...)
 }
 
 // ConvertToHTML converts the content of the given reader `r` into a full HTML document, written in the given writer `output`.
 // Returns an error if a problem occurred
 func ConvertToHTML(ctx context.Context, r io.Reader, output io.Writer, options ...renderer.Option) (map[string]interface{}, error) {
+	return convertToHTML(ctx, "", r, output, options...)
+}
+
+func convertToHTML(ctx context.Context, filename string, r io.Reader, output io.Writer, options ...renderer.Option) (map[string]interface{}, error) {
+	start := time.Now()
+	rendererCtx := renderer.Wrap(ctx, types.Document{}, intrinsicOptions(filename, "html5", options)...)
 	log.Debugf("parsing the asciidoc source...")
-	doc, err := parser.ParseDocument("", r)
+	doc, err := parser.ParseDocument(filename, r, rendererCtx.ParserOptions()...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error while parsing the document")
 	}
-	return convertToHTML(ctx, doc, output, options...)
-}
-
-func convertToHTML(ctx context.Context, doc types.Document, output io.Writer, options ...renderer.Option) (map[string]interface{}, error) {
-	start := time.Now()
-	rendererCtx := renderer.Wrap(ctx, doc, options...)
+	rendererCtx.Document = doc
 	// insert tables of contents, preamble and process file inclusions
-	err := renderer.Prerender(rendererCtx)
+	err = renderer.Prerender(rendererCtx)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error while rendering the document")
 	}
@@ -66,3 +70,107 @@ func convertToHTML(ctx context.Context, doc types.Document, output io.Writer, op
 	log.Debugf("rendered the HTML output in %v", duration)
 	return metadata, nil
 }
+
+// intrinsicOptions returns the options to set the document filename, the backend and the version
+// in the renderer context (from which the intrinsic document attributes are derived), followed by the given options
+func intrinsicOptions(filename, backend string, options []renderer.Option) []renderer.Option {
+	version := BuildTag
+	if version == "" {
+		version = BuildCommit
+	}
+	return append([]renderer.Option{
+		renderer.Filename(filename),
+		renderer.Backend(backend),
+		renderer.Version(version),
+	}, options...)
+}
+
+// openFile opens the document file with the given name, from the filesystem set in the options if any,
+// or from the OS filesystem otherwise
+func openFile(filename string, options []renderer.Option) (io.ReadCloser, error) {
+	if fsys, found := renderer.Wrap(context.Background(), types.Document{}, options...).FileSystem(); found {
+		return fsys.Open(strings.TrimPrefix(filename, "/"))
+	}
+	return os.Open(filename)
+}
+
+// FileDependencies returns the locations of all the files included in the document with the given filename, directly or not,
+// in the order in which they are included and without duplicates (eg: to generate Makefile rules or to invalidate a cache).
+// The locations are absolute paths, paths in the filesystem set in the options, or URIs.
+func FileDependencies(ctx context.Context, filename string, options ...renderer.Option) ([]string, error) {
+	file, err := openFile(filename, options)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error opening %s", filename)
+	}
+	defer file.Close()
+	rendererCtx := renderer.Wrap(ctx, types.Document{}, intrinsicOptions(filename, "html5", options)...)
+	deps, err := parser.ParseDependencies(filename, file, rendererCtx.ParserOptions()...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while parsing the document")
+	}
+	return deps, nil
+}
+
+// ConvertFileToAsciidoc formats the content of the given filename into a normalized Asciidoc document.
+// The file inclusions are not processed, but retained as-is in the output.
+// The conversion result is written in the given writer `output`. Returns an error if a problem occurred
+func ConvertFileToAsciidoc(ctx context.Context, filename string, output io.Writer, options ...renderer.Option) error {
+	file, err := openFile(filename, options)
+	if err != nil {
+		return errors.Wrapf(err, "error opening %s", filename)
+	}
+	defer file.Close()
+	return ConvertToAsciidoc(ctx, file, output, options...)
+}
+
+// ConvertToAsciidoc formats the content of the given reader `r` into a normalized Asciidoc document, written in the given writer `output`.
+// The file inclusions are not processed, but retained as-is in the output.
+// Returns an error if a problem occurred
+func ConvertToAsciidoc(ctx context.Context, r io.Reader, output io.Writer, options ...renderer.Option) error {
+	log.Debugf("parsing the asciidoc source...")
+	doc, err := parser.ParseDocumentWithoutInclusions("", r)
+	if err != nil {
+		return errors.Wrapf(err, "error while parsing the document")
+	}
+	rendererCtx := renderer.Wrap(ctx, doc, options...)
+	// no pre-rendering here: the table of contents and preamble must not be inserted in the output
+	if err := asciidocrenderer.Render(rendererCtx, output); err != nil {
+		return errors.Wrapf(err, "error while rendering the document")
+	}
+	return nil
+}
+
+// ConvertFileToMarkdown converts the content of the given filename into a GitHub-flavored Markdown document.
+// The conversion result is written in the given writer `output`. Returns an error if a problem occurred
+func ConvertFileToMarkdown(ctx context.Context, filename string, output io.Writer, options ...renderer.Option) error {
+	file, err := openFile(filename, options)
+	if err != nil {
+		return errors.Wrapf(err, "error opening %s", filename)
+	}
+	defer file.Close()
+	return convertToMarkdown(ctx, filename, file, output, options...)
+}
+
+// ConvertToMarkdown converts the content of the given reader `r` into a GitHub-flavored Markdown document, written in the given writer `output`.
+// The elements which have no equivalent in Markdown are rendered in raw HTML, or dropped with a warning.
+// Returns an error if a problem occurred
+func ConvertToMarkdown(ctx context.Context, r io.Reader, output io.Writer, options ...renderer.Option) error {
+	return convertToMarkdown(ctx, "", r, output, options...)
+}
+
+func convertToMarkdown(ctx context.Context, filename string, r io.Reader, output io.Writer, options ...renderer.Option) error {
+	rendererCtx := renderer.Wrap(ctx, types.Document{}, intrinsicOptions(filename, "markdown", options)...)
+	log.Debugf("parsing the asciidoc source...")
+	doc, err := parser.ParseDocument(filename, r, rendererCtx.ParserOptions()...)
+	if err != nil {
+		return errors.Wrapf(err, "error while parsing the document")
+	}
+	rendererCtx.Document = doc
+	renderer.IncludeAttributeOverrides(rendererCtx)
+	renderer.IncludeIntrinsicAttributes(rendererCtx)
+	renderer.IncludeDefaultAttributes(rendererCtx)
+	if err := markdownrenderer.Render(rendererCtx, output); err != nil {
+		return errors.Wrapf(err, "error while rendering the document")
+	}
+	return nil
+}
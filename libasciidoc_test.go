@@ -1,6 +1,15 @@
 package libasciidoc_test
 
 import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing/fstest"
+	"time"
+
+	"github.com/bytesparadise/libasciidoc"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
 	. "github.com/bytesparadise/libasciidoc/testsupport"
 
 	. "github.com/onsi/ginkgo"
@@ -188,4 +197,104 @@ Last updated {{.LastUpdated}}
 		})
 	})
 
+	Context("virtual filesystem", func() {
+
+		fsys := fstest.MapFS{
+			"docs/index.adoc": &fstest.MapFile{
+				Data: []byte(`= Title
+
+include::chapters/chapter.adoc[]
+
+include::/shared/footer.adoc[]
+
+docfile: {docfile}, docdir: {docdir}, docname: {docname}, docyear: {docyear}`),
+				ModTime: time.Date(2020, 5, 1, 10, 0, 0, 0, time.UTC),
+			},
+			"docs/chapters/chapter.adoc": &fstest.MapFile{
+				Data: []byte("== Chapter\n\ninclude::../../shared/snippet.adoc[]"),
+			},
+			"shared/snippet.adoc": &fstest.MapFile{
+				Data: []byte("snippet content"),
+			},
+			"shared/footer.adoc": &fstest.MapFile{
+				Data: []byte("footer content"),
+			},
+		}
+
+		It("should convert file from virtual filesystem", func() {
+			expected := `<div class="sect1">
+<h2 id="_chapter">Chapter</h2>
+<div class="sectionbody">
+<div class="paragraph">
+<p>snippet content</p>
+</div>
+<div class="paragraph">
+<p>footer content</p>
+</div>
+<div class="paragraph">
+<p>docfile: /docs/index.adoc, docdir: /docs, docname: index, docyear: 2020</p>
+</div>
+</div>
+</div>`
+			output := &strings.Builder{}
+			metadata, err := libasciidoc.ConvertFileToHTML(context.Background(), "docs/index.adoc", output, renderer.FileSystem(fsys))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(output.String()).To(Equal(expected))
+			Expect(metadata[types.AttrTitle]).To(Equal("Title"))
+		})
+
+		It("should not include file outside of virtual filesystem", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			fsys := fstest.MapFS{
+				"index.adoc": &fstest.MapFile{
+					Data: []byte("include::../secret.adoc[]"),
+				},
+			}
+			expected := `<div class="paragraph">
+<p>Unresolved directive in index.adoc - include::../secret.adoc[]</p>
+</div>`
+			output := &strings.Builder{}
+			_, err := libasciidoc.ConvertFileToHTML(context.Background(), "index.adoc", output, renderer.FileSystem(fsys))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(output.String()).To(Equal(expected))
+			Expect(console).To(ContainMessageWithLevel(log.ErrorLevel, "failed to include '../secret.adoc'"))
+		})
+
+		It("should fail to convert missing file from virtual filesystem", func() {
+			_, err := libasciidoc.ConvertFileToHTML(context.Background(), "docs/unknown.adoc", &strings.Builder{}, renderer.FileSystem(fsys))
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should list dependencies of file from virtual filesystem", func() {
+			deps, err := libasciidoc.FileDependencies(context.Background(), "docs/index.adoc", renderer.FileSystem(fsys))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deps).To(Equal([]string{
+				"docs/chapters/chapter.adoc",
+				"shared/snippet.adoc",
+				"shared/footer.adoc",
+			}))
+		})
+	})
+
+	Context("file dependencies", func() {
+
+		It("should list dependencies of file", func() {
+			// file inclusions in the document are resolved relatively to the current working directory
+			deps, err := libasciidoc.FileDependencies(context.Background(), "test/includes/dependencies.adoc")
+			Expect(err).ToNot(HaveOccurred())
+			expected := []string{}
+			for _, name := range []string{"parent-include.adoc", "child-include.adoc", "grandchild-include.adoc", "cycle-a.adoc", "cycle-b.adoc"} {
+				dep, err := filepath.Abs(filepath.Join("test", "includes", name))
+				Expect(err).ToNot(HaveOccurred())
+				expected = append(expected, dep)
+			}
+			Expect(deps).To(Equal(expected))
+		})
+
+		It("should fail to list dependencies of missing file", func() {
+			_, err := libasciidoc.FileDependencies(context.Background(), "test/includes/unknown.adoc")
+			Expect(err).To(HaveOccurred())
+		})
+	})
 })
@@ -0,0 +1,134 @@
+package parser_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("thematic breaks - preflight", func() {
+
+	DescribeTable("thematic breaks",
+		func(source string) {
+			Expect(source).To(EqualDocumentBlock(types.ThematicBreak{}))
+		},
+		Entry("single quotes", "'''"),
+		Entry("asterisks", "***"),
+		Entry("spaced asterisks", "* * *"),
+		Entry("hyphens", "---"),
+		Entry("spaced hyphens", "- - -"),
+		Entry("underscores", "___"),
+		Entry("spaced underscores", "_ _ _"),
+		Entry("with trailing spaces", "***   "),
+	)
+
+	It("thematic break between 2 paragraphs", func() {
+		source := `first paragraph
+
+***
+
+second paragraph`
+		expected := types.PreflightDocument{
+			Blocks: []interface{}{
+				types.Paragraph{
+					Attributes: types.ElementAttributes{},
+					Lines: []types.InlineElements{
+						{
+							types.StringElement{Content: "first paragraph"},
+						},
+					},
+				},
+				types.BlankLine{},
+				types.ThematicBreak{},
+				types.BlankLine{},
+				types.Paragraph{
+					Attributes: types.ElementAttributes{},
+					Lines: []types.InlineElements{
+						{
+							types.StringElement{Content: "second paragraph"},
+						},
+					},
+				},
+			},
+		}
+		Expect(source).To(BecomePreflightDocument(expected))
+	})
+
+	It("not a thematic break when followed by content", func() {
+		source := `*** item`
+		expected := types.PreflightDocument{
+			Blocks: []interface{}{
+				types.UnorderedListItem{
+					Attributes:  types.ElementAttributes{},
+					Level:       3,
+					BulletStyle: types.ThreeAsterisks,
+					CheckStyle:  types.NoCheck,
+					Elements: []interface{}{
+						types.Paragraph{
+							Attributes: types.ElementAttributes{},
+							Lines: []types.InlineElements{
+								{
+									types.StringElement{Content: "item"},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		Expect(source).To(BecomePreflightDocument(expected))
+	})
+})
+
+var _ = Describe("page breaks - preflight", func() {
+
+	It("page break between 2 paragraphs", func() {
+		source := `first paragraph
+
+<<<
+second paragraph`
+		expected := types.PreflightDocument{
+			Blocks: []interface{}{
+				types.Paragraph{
+					Attributes: types.ElementAttributes{},
+					Lines: []types.InlineElements{
+						{
+							types.StringElement{Content: "first paragraph"},
+						},
+					},
+				},
+				types.BlankLine{},
+				types.PageBreak{},
+				types.Paragraph{
+					Attributes: types.ElementAttributes{},
+					Lines: []types.InlineElements{
+						{
+							types.StringElement{Content: "second paragraph"},
+						},
+					},
+				},
+			},
+		}
+		Expect(source).To(BecomePreflightDocument(expected))
+	})
+
+	It("not a page break when followed by content", func() {
+		source := `<<< foo`
+		expected := types.PreflightDocument{
+			Blocks: []interface{}{
+				types.Paragraph{
+					Attributes: types.ElementAttributes{},
+					Lines: []types.InlineElements{
+						{
+							types.StringElement{Content: "<<< foo"},
+						},
+					},
+				},
+			},
+		}
+		Expect(source).To(BecomePreflightDocument(expected))
+	})
+})
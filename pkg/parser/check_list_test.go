@@ -24,7 +24,8 @@ var _ = Describe("checked lists - document", func() {
 			Elements: []interface{}{
 				types.UnorderedList{
 					Attributes: types.ElementAttributes{
-						types.AttrTitle: "Checklist",
+						types.AttrTitle:         "Checklist",
+						types.AttrTitlePosition: types.Position{Line: 1, Column: 1},
 					},
 					Items: []types.UnorderedListItem{
 						{
@@ -127,7 +128,8 @@ var _ = Describe("checked lists - document", func() {
 			Elements: []interface{}{
 				types.UnorderedList{
 					Attributes: types.ElementAttributes{
-						types.AttrTitle: "Checklist",
+						types.AttrTitle:         "Checklist",
+						types.AttrTitlePosition: types.Position{Line: 1, Column: 1},
 					},
 					Items: []types.UnorderedListItem{
 						{
@@ -254,7 +256,8 @@ var _ = Describe("checked lists - document", func() {
 			Elements: []interface{}{
 				types.UnorderedList{
 					Attributes: types.ElementAttributes{
-						types.AttrTitle: "Checklist",
+						types.AttrTitle:         "Checklist",
+						types.AttrTitlePosition: types.Position{Line: 1, Column: 1},
 					},
 					Items: []types.UnorderedListItem{
 						{
@@ -1065,6 +1065,99 @@ foo
 		})
 	})
 
+	Context("Markdown-style quote blocks", func() {
+
+		It("single-line quote", func() {
+			source := `> some *quote*`
+			expected := types.DelimitedBlock{
+				Attributes: types.ElementAttributes{},
+				Kind:       types.Quote,
+				Elements: []interface{}{
+					types.Paragraph{
+						Attributes: types.ElementAttributes{},
+						Lines: []types.InlineElements{
+							{
+								types.StringElement{Content: "some "},
+								types.QuotedText{
+									Kind: types.Bold,
+									Elements: types.InlineElements{
+										types.StringElement{Content: "quote"},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(source).To(EqualDocumentBlock(expected))
+		})
+
+		It("multi-line quote with author and title", func() {
+			source := `> some
+> quote
+>
+> another paragraph
+> -- john doe, quote title`
+			expected := types.DelimitedBlock{
+				Attributes: types.ElementAttributes{
+					types.AttrKind:        types.Quote,
+					types.AttrQuoteAuthor: "john doe",
+					types.AttrQuoteTitle:  "quote title",
+				},
+				Kind: types.Quote,
+				Elements: []interface{}{
+					types.Paragraph{
+						Attributes: types.ElementAttributes{},
+						Lines: []types.InlineElements{
+							{
+								types.StringElement{Content: "some"},
+							},
+							{
+								types.StringElement{Content: "quote"},
+							},
+						},
+					},
+					types.BlankLine{},
+					types.Paragraph{
+						Attributes: types.ElementAttributes{},
+						Lines: []types.InlineElements{
+							{
+								types.StringElement{Content: "another paragraph"},
+							},
+						},
+					},
+				},
+			}
+			Expect(source).To(EqualDocumentBlock(expected))
+		})
+
+		It("quote with author only and custom ID", func() {
+			source := `[#quote]
+> some quote
+> -- john doe`
+			expected := types.DelimitedBlock{
+				Attributes: types.ElementAttributes{
+					types.AttrID:          "quote",
+					types.AttrCustomID:    true,
+					types.AttrKind:        types.Quote,
+					types.AttrQuoteAuthor: "john doe",
+				},
+				Kind: types.Quote,
+				Elements: []interface{}{
+					types.Paragraph{
+						Attributes: types.ElementAttributes{},
+						Lines: []types.InlineElements{
+							{
+								types.StringElement{Content: "some quote"},
+							},
+						},
+					},
+				},
+			}
+			Expect(source).To(EqualDocumentBlock(expected))
+		})
+	})
+
 	Context("verse blocks", func() {
 
 		It("single line verse with author and title", func() {
@@ -1456,6 +1549,310 @@ end
 		})
 	})
 
+	Context("source blocks with substitutions", func() {
+
+		It("with language and substitutions", func() {
+			source := `[source,go,subs="+quotes"]
+----
+*package* main
+----`
+			expected := types.DelimitedBlock{
+				Attributes: types.ElementAttributes{
+					types.AttrKind:          types.Source,
+					types.AttrLanguage:      "go",
+					types.AttrSubstitutions: "+quotes",
+				},
+				Kind: types.Source,
+				Elements: []interface{}{
+					types.Paragraph{
+						Attributes: types.ElementAttributes{},
+						Lines: []types.InlineElements{
+							{
+								types.StringElement{
+									Content: "*package* main",
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(source).To(EqualDocumentBlock(expected))
+		})
+
+		It("with substitutions but no language", func() {
+			source := `[source,subs=none]
+----
+*package* main
+----`
+			expected := types.DelimitedBlock{
+				Attributes: types.ElementAttributes{
+					types.AttrKind:          types.Source,
+					types.AttrSubstitutions: "none",
+				},
+				Kind: types.Source,
+				Elements: []interface{}{
+					types.Paragraph{
+						Attributes: types.ElementAttributes{},
+						Lines: []types.InlineElements{
+							{
+								types.StringElement{
+									Content: "*package* main",
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(source).To(EqualDocumentBlock(expected))
+		})
+	})
+
+	Context("open blocks", func() {
+
+		It("open block with paragraph and nested block", func() {
+			source := `--
+some *bold* content
+
+----
+foo
+----
+--`
+			expected := types.DelimitedBlock{
+				Attributes: types.ElementAttributes{},
+				Kind:       types.Open,
+				Elements: []interface{}{
+					types.Paragraph{
+						Attributes: types.ElementAttributes{},
+						Lines: []types.InlineElements{
+							{
+								types.StringElement{Content: "some "},
+								types.QuotedText{
+									Kind: types.Bold,
+									Elements: types.InlineElements{
+										types.StringElement{Content: "bold"},
+									},
+								},
+								types.StringElement{Content: " content"},
+							},
+						},
+					},
+					types.BlankLine{},
+					types.DelimitedBlock{
+						Attributes: types.ElementAttributes{},
+						Kind:       types.Listing,
+						Elements: []interface{}{
+							types.Paragraph{
+								Attributes: types.ElementAttributes{},
+								Lines: []types.InlineElements{
+									{
+										types.StringElement{Content: "foo"},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(source).To(EqualDocumentBlock(expected))
+		})
+
+		It("open block masquerading as a sidebar", func() {
+			source := `[sidebar]
+.a title
+--
+some content
+--`
+			expected := types.DelimitedBlock{
+				Attributes: types.ElementAttributes{
+					types.AttrKind:  types.Sidebar,
+					types.AttrTitle: "a title",
+				},
+				Kind: types.Sidebar,
+				Elements: []interface{}{
+					types.Paragraph{
+						Attributes: types.ElementAttributes{},
+						Lines: []types.InlineElements{
+							{
+								types.StringElement{Content: "some content"},
+							},
+						},
+					},
+				},
+			}
+			Expect(source).To(EqualDocumentBlock(expected))
+		})
+
+		It("open block masquerading as an admonition", func() {
+			source := `[NOTE]
+--
+some content
+--`
+			expected := types.DelimitedBlock{
+				Attributes: types.ElementAttributes{
+					types.AttrAdmonitionKind: types.Note,
+				},
+				Kind: types.Open,
+				Elements: []interface{}{
+					types.Paragraph{
+						Attributes: types.ElementAttributes{},
+						Lines: []types.InlineElements{
+							{
+								types.StringElement{Content: "some content"},
+							},
+						},
+					},
+				},
+			}
+			Expect(source).To(EqualDocumentBlock(expected))
+		})
+
+		It("open block masquerading as a source block", func() {
+			source := `[source,go]
+--
+func *foo*() {
+
+}
+--`
+			expected := types.DelimitedBlock{
+				Attributes: types.ElementAttributes{
+					types.AttrKind:     types.Source,
+					types.AttrLanguage: "go",
+				},
+				Kind: types.Source,
+				Elements: []interface{}{
+					types.Paragraph{
+						Attributes: types.ElementAttributes{},
+						Lines: []types.InlineElements{
+							{
+								types.StringElement{Content: "func *foo*() {"},
+							},
+							{},
+							{
+								types.StringElement{Content: "}"},
+							},
+						},
+					},
+				},
+			}
+			Expect(source).To(EqualDocumentBlock(expected))
+		})
+
+		It("open block masquerading as a listing block", func() {
+			source := `[listing]
+--
+some *content*
+--`
+			expected := types.DelimitedBlock{
+				Attributes: types.ElementAttributes{
+					types.AttrKind: types.Listing,
+				},
+				Kind: types.Listing,
+				Elements: []interface{}{
+					types.Paragraph{
+						Attributes: types.ElementAttributes{},
+						Lines: []types.InlineElements{
+							{
+								types.StringElement{Content: "some *content*"},
+							},
+						},
+					},
+				},
+			}
+			Expect(source).To(EqualDocumentBlock(expected))
+		})
+
+		It("open block masquerading as a literal block", func() {
+			source := `[literal]
+--
+  some *content*
+--`
+			expected := types.LiteralBlock{
+				Attributes: types.ElementAttributes{
+					types.AttrKind:             types.Literal,
+					types.AttrLiteralBlockType: types.LiteralBlockWithDelimiter,
+				},
+				Lines: []string{
+					"  some *content*",
+				},
+			}
+			Expect(source).To(EqualDocumentBlock(expected))
+		})
+
+		It("open block masquerading as an abstract", func() {
+			source := `[abstract]
+--
+some content
+--`
+			expected := types.DelimitedBlock{
+				Attributes: types.ElementAttributes{
+					types.AttrKind: types.Abstract,
+				},
+				Kind: types.Abstract,
+				Elements: []interface{}{
+					types.Paragraph{
+						Attributes: types.ElementAttributes{},
+						Lines: []types.InlineElements{
+							{
+								types.StringElement{Content: "some content"},
+							},
+						},
+					},
+				},
+			}
+			Expect(source).To(EqualDocumentBlock(expected))
+		})
+
+		It("paragraph masquerading as a listing block", func() {
+			source := `[listing]
+some content`
+			expected := types.Paragraph{
+				Attributes: types.ElementAttributes{
+					types.AttrKind: types.Listing,
+				},
+				Lines: []types.InlineElements{
+					{
+						types.StringElement{Content: "some content"},
+					},
+				},
+			}
+			Expect(source).To(EqualDocumentBlock(expected))
+		})
+
+		It("paragraph ending before an open block delimiter", func() {
+			source := `some content
+--
+other content
+--`
+			expected := types.PreflightDocument{
+				Blocks: []interface{}{
+					types.Paragraph{
+						Attributes: types.ElementAttributes{},
+						Lines: []types.InlineElements{
+							{
+								types.StringElement{Content: "some content"},
+							},
+						},
+					},
+					types.DelimitedBlock{
+						Attributes: types.ElementAttributes{},
+						Kind:       types.Open,
+						Elements: []interface{}{
+							types.Paragraph{
+								Attributes: types.ElementAttributes{},
+								Lines: []types.InlineElements{
+									{
+										types.StringElement{Content: "other content"},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(source).To(BecomePreflightDocument(expected))
+		})
+	})
+
 	Context("sidebar blocks", func() {
 
 		It("sidebar block with paragraph", func() {
@@ -2275,7 +2672,8 @@ foo
 				Elements: []interface{}{
 					types.DelimitedBlock{
 						Attributes: types.ElementAttributes{
-							types.AttrTitle: "example block title",
+							types.AttrTitle:         "example block title",
+							types.AttrTitlePosition: types.Position{Line: 1, Column: 1},
 						},
 						Kind: types.Example,
 						Elements: []interface{}{
@@ -3175,9 +3573,10 @@ end
 				Elements: []interface{}{
 					types.DelimitedBlock{
 						Attributes: types.ElementAttributes{
-							types.AttrKind:     types.Source,
-							types.AttrLanguage: "ruby",
-							types.AttrTitle:    "Source block title",
+							types.AttrKind:          types.Source,
+							types.AttrLanguage:      "ruby",
+							types.AttrTitle:         "Source block title",
+							types.AttrTitlePosition: types.Position{Line: 2, Column: 1},
 						},
 						Kind: types.Source,
 						Elements: []interface{}{
@@ -3233,11 +3632,12 @@ end
 				Elements: []interface{}{
 					types.DelimitedBlock{
 						Attributes: types.ElementAttributes{
-							types.AttrKind:     types.Source,
-							types.AttrLanguage: "ruby",
-							types.AttrID:       "id-for-source-block",
-							types.AttrCustomID: true,
-							types.AttrTitle:    "app.rb",
+							types.AttrKind:          types.Source,
+							types.AttrLanguage:      "ruby",
+							types.AttrID:            "id-for-source-block",
+							types.AttrCustomID:      true,
+							types.AttrTitle:         "app.rb",
+							types.AttrTitlePosition: types.Position{Line: 3, Column: 1},
 						},
 						Kind: types.Source,
 						Elements: []interface{}{
@@ -3336,7 +3736,8 @@ bar
 				Elements: []interface{}{
 					types.DelimitedBlock{
 						Attributes: types.ElementAttributes{
-							types.AttrTitle: "a title",
+							types.AttrTitle:         "a title",
+							types.AttrTitlePosition: types.Position{Line: 1, Column: 1},
 						},
 						Kind: types.Sidebar,
 						Elements: []interface{}{
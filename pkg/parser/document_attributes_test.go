@@ -907,7 +907,13 @@ a paragraph written by {author}.`
 							Lines: []types.InlineElements{
 								{
 									types.StringElement{Content: "a paragraph written by "},
-									types.DocumentAttributeSubstitution{Name: "author"},
+									types.DocumentAttributeSubstitution{
+										Name: "author",
+										Position: types.Position{
+											Line:   3,
+											Column: 24,
+										},
+									},
 									types.StringElement{Content: "."},
 								},
 							},
@@ -937,7 +943,13 @@ a paragraph written by {author}.`
 							Lines: []types.InlineElements{
 								{
 									types.StringElement{Content: "a paragraph written by "},
-									types.DocumentAttributeSubstitution{Name: "author"},
+									types.DocumentAttributeSubstitution{
+										Name: "author",
+										Position: types.Position{
+											Line:   5,
+											Column: 24,
+										},
+									},
 									types.StringElement{Content: "."},
 								},
 							},
@@ -1076,6 +1088,75 @@ a paragraph with *bold content*`
 		})
 	})
 
+	Context("counters", func() {
+
+		It("paragraph with counters", func() {
+			source := `{counter:foo} {counter2:bar} {counter:baz:A} {counter2:qux:10}`
+			expected := types.Paragraph{
+				Attributes: types.ElementAttributes{},
+				Lines: []types.InlineElements{
+					{
+						types.CounterSubstitution{Name: "foo"},
+						types.StringElement{Content: " "},
+						types.CounterSubstitution{Name: "bar", Hidden: true},
+						types.StringElement{Content: " "},
+						types.CounterSubstitution{Name: "baz", Value: 'A'},
+						types.StringElement{Content: " "},
+						types.CounterSubstitution{Name: "qux", Hidden: true, Value: 10},
+					},
+				},
+			}
+			Expect(source).To(EqualDocumentBlock(expected))
+		})
+
+		It("invalid counter", func() {
+			source := `{counter:foo:AB}`
+			expected := types.Paragraph{
+				Attributes: types.ElementAttributes{},
+				Lines: []types.InlineElements{
+					{
+						types.StringElement{Content: "{counter:foo:AB}"},
+					},
+				},
+			}
+			Expect(source).To(EqualDocumentBlock(expected))
+		})
+	})
+
+	Context("inline attribute entries", func() {
+
+		It("paragraph with inline attribute entries", func() {
+			source := `{set:foo:bar}{set:baz}{set:qux!}content`
+			expected := types.Paragraph{
+				Attributes: types.ElementAttributes{},
+				Lines: []types.InlineElements{
+					{
+						types.InlineAttributeEntry{Name: "foo", Value: "bar"},
+						types.InlineAttributeEntry{Name: "baz"},
+						types.InlineAttributeEntry{Name: "qux", Unset: true},
+						types.StringElement{Content: "content"},
+					},
+				},
+			}
+			Expect(source).To(EqualDocumentBlock(expected))
+		})
+
+		It("paragraph with inline attribute entry followed by substitution", func() {
+			source := `{set:foo:bar} and {foo}`
+			expected := types.Paragraph{
+				Attributes: types.ElementAttributes{},
+				Lines: []types.InlineElements{
+					{
+						types.InlineAttributeEntry{Name: "foo", Value: "bar"},
+						types.StringElement{Content: " and "},
+						types.DocumentAttributeSubstitution{Name: "foo"},
+					},
+				},
+			}
+			Expect(source).To(EqualDocumentBlock(expected))
+		})
+	})
+
 	Context("invalid document attributes", func() {
 
 		It("paragraph without blank line before attribute declarations", func() {
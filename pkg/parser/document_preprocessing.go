@@ -2,7 +2,6 @@ package parser
 
 import (
 	"io"
-	"strconv"
 
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 
@@ -16,20 +15,80 @@ type ContextKey string
 // LevelOffset the key for the level offset of the file to include
 const LevelOffset ContextKey = "leveloffset"
 
-// ParsePreflightDocument parses a document's content and applies the preprocessing directives (file inclusions)
+// filenameKey the key for the name of the file being parsed in the parser's global store
+const filenameKey string = "filename"
+
+// positionOf returns the position of the current element in the document being parsed,
+// or an unknown position if the content being parsed is not a document (or a file to include)
+func positionOf(c *current) types.Position {
+	filename, ok := c.globalStore[filenameKey].(string)
+	if !ok {
+		return types.Position{}
+	}
+	return types.Position{
+		Filename: filename,
+		Line:     c.pos.line,
+		Column:   c.pos.col,
+	}
+}
+
+// attributeOverridesKey the key for the document attribute overrides in the parser's global store
+const attributeOverridesKey string = "attributeOverrides"
+
+// AttributeOverrides option to set the document attributes which were set (or unset) from the API or the command line,
+// and which are used in the file inclusions
+func AttributeOverrides(overrides types.DocumentAttributeOverrides) Option {
+	return GlobalStore(attributeOverridesKey, overrides)
+}
+
+// attributeOverrides returns the document attribute overrides in the given options, if any
+func attributeOverrides(opts ...Option) types.DocumentAttributeOverrides {
+	p := newParser("", nil, opts...)
+	if overrides, ok := p.cur.globalStore[attributeOverridesKey].(types.DocumentAttributeOverrides); ok {
+		return overrides
+	}
+	return types.DocumentAttributeOverrides{}
+}
+
+// ParsePreflightDocument parses a document's content and applies the preprocessing directives (file inclusions).
+// The intrinsic attributes derived from the filename (eg: `docdir`) can be used in the file inclusions.
 func ParsePreflightDocument(filename string, r io.Reader, opts ...Option) (types.PreflightDocument, error) {
 	opts = append(opts, Entrypoint("PreflightDocument"))
-	return parsePreflightDocument(filename, r, "", opts...)
+	var attrs types.DocumentAttributes
+	if _, ok := fileSystem(opts...); ok {
+		attrs = types.NewVirtualFileAttributes(filename)
+		if safeMode(opts...) >= types.SafeModeSafe {
+			// the file inclusions are restricted to the directory of the document (unless another one was set)
+			opts = append(opts, BaseDir(fsBaseDir(filename, opts...)))
+		}
+	} else {
+		attrs = types.NewFileAttributes(filename)
+		if safeMode(opts...) >= types.SafeModeSafe {
+			// resolve the base directory once for all, before the current working directory changes during the file inclusions
+			dir, err := baseDir(filename, opts...)
+			if err != nil {
+				return types.PreflightDocument{}, errors.Wrap(err, "unable to resolve the base directory")
+			}
+			opts = append(opts, BaseDir(dir))
+		}
+	}
+	// track the files being included, to detect the circular file inclusions and limit their depth
+	opts = append(opts, GlobalStore(includeChainKey, []string{rootLocation(filename, opts...)}))
+	overrides := attributeOverrides(opts...)
+	overrides.ApplyTo(attrs)
+	return parsePreflightDocument(filename, r, attrs, overrides, 0, opts...)
 }
 
-func parsePreflightDocument(filename string, r io.Reader, levelOffset string, opts ...Option) (types.PreflightDocument, error) {
-	d, err := ParseReader(filename, r, opts...)
+// parsePreflightDocument parses the content of the document or of a file to include.
+// The given attributes are shared with the included files, so that the attributes declared in
+// a document can be used in the files that it includes (and vice-versa)
+func parsePreflightDocument(filename string, r io.Reader, attrs types.DocumentAttributes, overrides types.DocumentAttributeOverrides, levelOffset int, opts ...Option) (types.PreflightDocument, error) {
+	d, err := ParseReader(filename, r, append(opts, GlobalStore(filenameKey, filename))...)
 	if err != nil {
 		return types.PreflightDocument{}, err
 	}
 	doc := d.(types.PreflightDocument)
-	attrs := types.DocumentAttributes{}
-	blocks, err := parseElements(filename, doc.Blocks, attrs, levelOffset, opts...)
+	blocks, err := parseElements(filename, doc.Blocks, attrs, overrides, levelOffset, opts...)
 	if err != nil {
 		return types.PreflightDocument{}, err
 	}
@@ -38,41 +97,55 @@ func parsePreflightDocument(filename string, r io.Reader, levelOffset string, op
 }
 
 // parseElements resolves the file inclusions if any is found in the given elements
-func parseElements(filename string, elements []interface{}, attrs types.DocumentAttributes, levelOffset string, opts ...Option) ([]interface{}, error) {
+func parseElements(filename string, elements []interface{}, attrs types.DocumentAttributes, overrides types.DocumentAttributeOverrides, levelOffset int, opts ...Option) ([]interface{}, error) {
 	result := []interface{}{}
 	for _, e := range elements {
 		switch e := e.(type) {
 		case types.DocumentAttributeDeclaration:
-			attrs[e.Name] = e.Value
+			if !overrides.Locked(e.Name) {
+				attrs[e.Name] = e.Value
+			}
+			result = append(result, e)
+		case types.DocumentAttributeReset:
+			if !overrides.Locked(e.Name) {
+				delete(attrs, e.Name)
+			}
 			result = append(result, e)
 		case types.FileInclusion:
 			// read the file and include its content
-			embedded, err := parseFileToInclude(filename, e, attrs, opts...)
+			embedded, err := parseFileToInclude(filename, e, attrs, overrides, levelOffset, opts...)
 			if err != nil {
 				// do not fail, but instead report the error in the console
 				log.Errorf("failed to include file '%s': %v", e.Location, err)
 			}
 			result = append(result, embedded.Blocks...)
 		case types.DelimitedBlock:
-			elmts, err := parseElements(filename, e.Elements, attrs, levelOffset,
-				// use a new var to avoid overridding the current one which needs to stay as-is for the rest of the doc parsing
-				append(opts, Entrypoint("PreflightDocumentWithinDelimitedBlock"))...)
+			// use a new var to avoid overridding the current one which needs to stay as-is for the rest of the doc parsing
+			blockOpts := append(opts, Entrypoint("PreflightDocumentWithinDelimitedBlock"))
+			if isVerbatim(e.Kind) {
+				blockOpts = append(blockOpts, withinVerbatimBlock(e.Attributes))
+			}
+			elmts, err := parseElements(filename, e.Elements, attrs, overrides, levelOffset, blockOpts...)
 			if err != nil {
 				return nil, err
 			}
+			if isVerbatim(e.Kind) {
+				elmts = mergeParagraphs(elmts)
+			}
 			result = append(result, types.DelimitedBlock{
 				Attributes: e.Attributes,
 				Kind:       e.Kind,
 				Elements:   elmts,
 			})
+		case types.LiteralBlock:
+			if e.Attributes.GetAsString(types.AttrLiteralBlockType) == types.LiteralBlockWithDelimiter {
+				e = parseLiteralBlock(filename, e, attrs, overrides, levelOffset, opts...)
+			}
+			result = append(result, e)
 		case types.Section:
-			if levelOffset != "" {
-				log.Debugf("applying level offset '%s'", levelOffset)
-				offset, err := strconv.Atoi(levelOffset)
-				if err != nil {
-					return nil, errors.Wrapf(err, "failed to preparse '%s'", filename)
-				}
-				e.Level += offset
+			if levelOffset != 0 {
+				log.Debugf("applying level offset '%d'", levelOffset)
+				e.Level += levelOffset
 			}
 			result = append(result, e)
 		default:
@@ -18,6 +18,21 @@ func ParseDocument(filename string, r io.Reader, opts ...Option) (types.Document
 	if err != nil {
 		return types.Document{}, err
 	}
+	return parseDocument(preflightDoc)
+}
+
+// ParseDocumentWithoutInclusions parses the content of the reader identitied by the filename,
+// but keeps the file inclusions as-is instead of replacing them with the content of the files to include
+func ParseDocumentWithoutInclusions(filename string, r io.Reader, opts ...Option) (types.Document, error) {
+	opts = append(opts, Entrypoint("PreflightDocument"))
+	d, err := ParseReader(filename, r, opts...)
+	if err != nil {
+		return types.Document{}, err
+	}
+	return parseDocument(d.(types.PreflightDocument))
+}
+
+func parseDocument(preflightDoc types.PreflightDocument) (types.Document, error) {
 	if log.IsLevelEnabled(log.DebugLevel) {
 		log.Debug("preflight document")
 		spew.Dump(preflightDoc)
@@ -311,11 +326,16 @@ func rearrangeSections(blocks []interface{}) (types.Document, error) {
 	elementRefs := types.ElementReferences{}
 	footnotes := types.Footnotes{}
 	footnoteRefs := types.FootnoteReferences{}
-	var previous *types.Section // the current "parent" section
+	indexTerms := types.NewIndexTermsCollector(ParseBlockTitle)
+	counters := types.DocumentAttributes{} // the counters in the titles of the sections, incremented in the document order
+	var previous *types.Section            // the current "parent" section
 	for _, element := range blocks {
 		if e, ok := element.(types.Section); ok {
+			if err := applyCounters(e.Attributes, e.Title, counters); err != nil {
+				return types.Document{}, err
+			}
 			// avoid duplicate IDs in sections
-			referenceSection(e, elementRefs)
+			referenceElement(e.Attributes, e.Title, elementRefs)
 			if previous == nil { // set first parent
 				log.Debugf("setting section with title %v as a top-level element", e.Title)
 				sections = append(sections, e)
@@ -341,6 +361,18 @@ func rearrangeSections(blocks []interface{}) (types.Document, error) {
 			}
 			previous = &e // pointer to new current parent
 		} else {
+			switch e := element.(type) {
+			case types.DiscreteHeading:
+				if err := applyCounters(e.Attributes, e.Title, counters); err != nil {
+					return types.Document{}, err
+				}
+				// a discrete heading can be referenced, but it does not open a new section
+				referenceElement(e.Attributes, e.Title, elementRefs)
+			case types.DocumentAttributeDeclaration:
+				counters.AddDeclaration(e)
+			case types.DocumentAttributeReset:
+				counters.Reset(e)
+			}
 			if previous == nil {
 				log.Debugf("adding element of type %T as a top-level element", element)
 				tle = append(tle, element)
@@ -362,6 +394,10 @@ func rearrangeSections(blocks []interface{}) (types.Document, error) {
 				footnoteRefs[k] = v
 			}
 		}
+		// and index terms
+		if err := indexTerms.CollectIndexTerms(element); err != nil {
+			return types.Document{}, errors.Wrap(err, "unable to collect index terms in document")
+		}
 	}
 	// process the remaining sections
 	sections = pruneSections(sections, 1)
@@ -375,11 +411,27 @@ func rearrangeSections(blocks []interface{}) (types.Document, error) {
 		ElementReferences:  elementRefs,
 		Footnotes:          footnotes,
 		FootnoteReferences: footnoteRefs,
+		IndexTerms:         indexTerms.IndexTerms,
 	}, nil
 }
 
-func referenceSection(e types.Section, elementRefs types.ElementReferences) {
-	id := e.Attributes.GetAsString(types.AttrID)
+// applyCounters increments the counters in the title of the given section or discrete heading, and
+// regenerates its default ID with the values of these counters (eg: `_part_1` for `== Part {counter:p}`)
+func applyCounters(attrs types.ElementAttributes, title types.InlineElements, counters types.DocumentAttributes) error {
+	id, err := types.ReplaceNonAlphanumericsWithCounters(title, "_", counters)
+	if err != nil {
+		return errors.Wrap(err, "unable to generate default ID of section")
+	}
+	if !attrs.GetAsBool(types.AttrCustomID) {
+		attrs[types.AttrID] = id
+	}
+	return nil
+}
+
+// referenceElement registers the title of the given section or discrete heading with its ID, which is
+// suffixed with a number if another element of the document already has the same ID
+func referenceElement(attrs types.ElementAttributes, title types.InlineElements, elementRefs types.ElementReferences) {
+	id := attrs.GetAsString(types.AttrID)
 	for i := 1; ; i++ {
 		var key string
 		if i == 1 {
@@ -388,13 +440,13 @@ func referenceSection(e types.Section, elementRefs types.ElementReferences) {
 			key = id + "_" + strconv.Itoa(i)
 		}
 		if _, found := elementRefs[key]; !found {
-			elementRefs[key] = e.Title
+			elementRefs[key] = title
 			// override the element id
-			e.Attributes[types.AttrID] = key
+			attrs[types.AttrID] = key
 			break
 		}
 	}
-	elementRefs[e.Attributes.GetAsString(types.AttrID)] = e.Title
+	elementRefs[attrs.GetAsString(types.AttrID)] = title
 }
 
 func pruneSections(sections []types.Section, level int) []types.Section {
@@ -282,6 +282,25 @@ a paragraph`
 				Expect(source).To(EqualDocumentBlock(expected))
 			})
 
+			It("attribute with quoted value", func() {
+				source := `[subs="+attributes,-callouts", foo = "bar" ]
+a paragraph`
+				expected := types.Paragraph{
+					Attributes: types.ElementAttributes{
+						types.AttrSubstitutions: "+attributes,-callouts",
+						"foo":                   "bar",
+					},
+					Lines: []types.InlineElements{
+						{
+							types.StringElement{
+								Content: "a paragraph",
+							},
+						},
+					},
+				}
+				Expect(source).To(EqualDocumentBlock(expected))
+			})
+
 			It("full role syntax", func() {
 				source := `[role=a role]
 a paragraph`
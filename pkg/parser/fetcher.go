@@ -0,0 +1,106 @@
+package parser
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Fetcher the interface to retrieve the content of the remote files to include (eg: `include::https://example.com/README.adoc[]`)
+type Fetcher interface {
+	// Fetch returns the content at the given URI. The caller is responsible for closing it.
+	Fetch(uri string) (io.ReadCloser, error)
+}
+
+// FetcherFunc an adapter to use an ordinary function as a Fetcher
+type FetcherFunc func(uri string) (io.ReadCloser, error)
+
+// Fetch calls f(uri)
+func (f FetcherFunc) Fetch(uri string) (io.ReadCloser, error) {
+	return f(uri)
+}
+
+// HTTPFetcher a Fetcher which retrieves the remote files with an HTTP client
+type HTTPFetcher struct {
+	client *http.Client
+}
+
+// DefaultFetchTimeout the timeout of the requests sent by the default HTTP fetcher
+const DefaultFetchTimeout = 30 * time.Second
+
+// NewHTTPFetcher returns a new Fetcher which uses the given client,
+// or a client with a `DefaultFetchTimeout` timeout if the given client is `nil`
+func NewHTTPFetcher(client *http.Client) HTTPFetcher {
+	if client == nil {
+		client = &http.Client{
+			Timeout: DefaultFetchTimeout,
+		}
+	}
+	return HTTPFetcher{
+		client: client,
+	}
+}
+
+// Fetch sends a GET request to the given URI and returns the response body,
+// or an error if the response status is not `200 OK`
+func (f HTTPFetcher) Fetch(uri string) (io.ReadCloser, error) {
+	resp, err := f.client.Get(uri)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to fetch '%s'", uri)
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, errors.Errorf("unable to fetch '%s': %s", uri, resp.Status)
+	}
+	return resp.Body, nil
+}
+
+// fetcherKey the key for the fetcher of the remote files to include in the parser's global store
+const fetcherKey string = "fetcher"
+
+// URIFetcher option to set the Fetcher used to retrieve the remote files to include.
+// Remote files are only included when the `allow-uri-read` attribute is set from the API or the command line.
+func URIFetcher(f Fetcher) Option {
+	return GlobalStore(fetcherKey, f)
+}
+
+// uriFetcher returns the fetcher in the given options, or an HTTP fetcher with the default timeout
+func uriFetcher(opts ...Option) Fetcher {
+	p := newParser("", nil, opts...)
+	if f, ok := p.cur.globalStore[fetcherKey].(Fetcher); ok && f != nil {
+		return f
+	}
+	return NewHTTPFetcher(nil)
+}
+
+// remoteLocation returns the URI of the file to include and `true` if the given path is a URI, or
+// if the including document is remote, in which case the path is resolved against the URI of this document
+// (eg: `/other.adoc` becomes `https://example.com/other.adoc`), so that a remote document never reads a local file.
+// Otherwise, returns the given path and `false`.
+func remoteLocation(filename, path string) (string, bool) {
+	if isURI(path) {
+		return path, true
+	}
+	if !isURI(filename) {
+		return path, false
+	}
+	base, err := url.Parse(filename)
+	if err != nil {
+		return path, false
+	}
+	ref, err := url.Parse(path)
+	if err != nil {
+		// do not fall back to a local file
+		return base.Scheme + "://" + base.Host + "/" + strings.TrimPrefix(path, "/"), true
+	}
+	return base.ResolveReference(ref).String(), true
+}
+
+// isURI returns true if the given location starts with `http://` or `https://`
+func isURI(location string) bool {
+	return strings.HasPrefix(location, "http://") || strings.HasPrefix(location, "https://")
+}
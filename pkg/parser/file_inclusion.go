@@ -3,14 +3,19 @@ package parser
 import (
 	"bufio"
 	"bytes"
+	"io"
+	"io/fs"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 	"text/template"
 
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 	"github.com/pkg/errors"
-
 	log "github.com/sirupsen/logrus"
+	"golang.org/x/text/encoding/htmlindex"
+	"golang.org/x/text/transform"
 )
 
 var invalidFileTmpl *template.Template
@@ -23,16 +28,107 @@ func init() {
 	}
 }
 
-func parseFileToInclude(filename string, incl types.FileInclusion, attrs types.DocumentAttributes, opts ...Option) (types.PreflightDocument, error) {
+func parseFileToInclude(filename string, incl types.FileInclusion, attrs types.DocumentAttributes, overrides types.DocumentAttributeOverrides, levelOffset int, opts ...Option) (types.PreflightDocument, error) {
 	path := incl.Location.Resolve(attrs)
+	if uri, ok := remoteLocation(filename, path); ok {
+		return parseRemoteFileToInclude(filename, uri, incl, attrs, overrides, levelOffset, opts...)
+	}
+	if mode := safeMode(opts...); mode >= types.SafeModeSecure {
+		log.Warnf("cannot include file '%s' in %s mode", path, mode)
+		return linkToInclude(path)
+	}
+	if fsys, ok := fileSystem(opts...); ok {
+		if safeMode(opts...) >= types.SafeModeSafe {
+			p, err := fsPath(filename, path)
+			if err != nil {
+				return invalidFileErrMsg(filename, path, incl.RawText, err)
+			}
+			if err := checkWithinFSBaseDir(p, fsBaseDir(filename, opts...)); err != nil {
+				return invalidFileErrMsg(filename, path, incl.RawText, err)
+			}
+		}
+		return parseFSFileToInclude(fsys, filename, path, incl, attrs, overrides, levelOffset, opts...)
+	}
+	if safeMode(opts...) >= types.SafeModeSafe {
+		dir, err := baseDir(filename, opts...)
+		if err != nil {
+			return invalidFileErrMsg(filename, path, incl.RawText, err)
+		}
+		if err := checkWithinBaseDir(path, dir); err != nil {
+			return invalidFileErrMsg(filename, path, incl.RawText, err)
+		}
+	}
 	log.Debugf("parsing '%s'...", path)
 	f, absPath, done, err := open(path)
 	defer done()
+	if err != nil {
+		return missingFileToInclude(filename, path, incl, err)
+	}
+	return parseContentToInclude(filename, path, absPath, f, incl, attrs, overrides, levelOffset, opts...)
+}
+
+// parseFSFileToInclude opens and parses the file at the given path in the given filesystem
+func parseFSFileToInclude(fsys fs.FS, filename, path string, incl types.FileInclusion, attrs types.DocumentAttributes, overrides types.DocumentAttributeOverrides, levelOffset int, opts ...Option) (types.PreflightDocument, error) {
+	p, err := fsPath(filename, path)
+	if err != nil {
+		return invalidFileErrMsg(filename, path, incl.RawText, err)
+	}
+	log.Debugf("parsing '%s' in filesystem...", p)
+	f, err := fsys.Open(p)
+	if err != nil {
+		return missingFileToInclude(filename, path, incl, err)
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.WithError(err).Errorf("failed to close file '%s'", p)
+		}
+	}()
+	return parseContentToInclude(filename, path, p, f, incl, attrs, overrides, levelOffset, opts...)
+}
+
+// parseRemoteFileToInclude fetches and parses the remote file at the given URI if the `allow-uri-read` attribute
+// was set from the API or the command line, and unless the safe mode is `server` or `secure`.
+// Otherwise, the file inclusion is replaced with a link to the URI.
+func parseRemoteFileToInclude(filename, uri string, incl types.FileInclusion, attrs types.DocumentAttributes, overrides types.DocumentAttributeOverrides, levelOffset int, opts ...Option) (types.PreflightDocument, error) {
+	if mode := safeMode(opts...); mode >= types.SafeModeServer {
+		log.Warnf("cannot include remote file '%s' in %s mode", uri, mode)
+		return linkToInclude(uri)
+	}
+	if !overrides.IsSet(types.AttrAllowURIRead) {
+		log.Warnf("cannot include remote file '%s' unless the '%s' attribute is set from the API or the command line", uri, types.AttrAllowURIRead)
+		return linkToInclude(uri)
+	}
+	log.Debugf("fetching '%s'...", uri)
+	r, err := uriFetcher(opts...).Fetch(uri)
+	if err != nil {
+		return missingFileToInclude(filename, uri, incl, err)
+	}
+	defer func() {
+		if err := r.Close(); err != nil {
+			log.WithError(err).Errorf("failed to close remote file '%s'", uri)
+		}
+	}()
+	return parseContentToInclude(filename, uri, uri, r, incl, attrs, overrides, levelOffset, opts...)
+}
+
+// parseContentToInclude reads the given content (limited to the lines or tags specified in the file inclusion)
+// and parses it. The `path` is the location of the content as specified in the document, and `location` is its
+// absolute path (or URI), which is used to resolve the file inclusions in the content. The `levelOffset` is the
+// level offset of the including document, to which the `leveloffset` attribute of the file inclusion applies.
+func parseContentToInclude(filename, path, location string, r io.Reader, incl types.FileInclusion, attrs types.DocumentAttributes, overrides types.DocumentAttributeOverrides, levelOffset int, opts ...Option) (types.PreflightDocument, error) {
+	if err := checkIncludeDepth(attrs, opts...); err != nil {
+		return invalidFileErrMsg(filename, path, incl.RawText, err)
+	}
+	if err := checkIncludeCycle(location, opts...); err != nil {
+		return invalidFileErrMsg(filename, path, incl.RawText, err)
+	}
+	addDependency(location, opts...)
+	r, err := decode(r, incl)
 	if err != nil {
 		return invalidFileErrMsg(filename, path, incl.RawText, err)
 	}
 	content := bytes.NewBuffer(nil)
-	scanner := bufio.NewScanner(bufio.NewReader(f))
+	scanner := bufio.NewScanner(bufio.NewReader(r))
 	if lineRanges, ok := incl.LineRanges(); ok {
 		if err := readWithinLines(scanner, content, lineRanges); err != nil {
 			return invalidFileErrMsg(filename, path, incl.RawText, err)
@@ -53,9 +149,126 @@ func parseFileToInclude(filename string, incl types.FileInclusion, attrs types.D
 		}
 		return msg, errors.Wrap(err, "unable to read file to include")
 	}
+	raw, isRaw := enclosingVerbatimBlock(opts...)
+	if size := tabSize(raw, attrs); isRaw && size > 0 {
+		content = expandTabs(content, size)
+	}
+	if value := incl.Attributes.GetAsString(types.AttrIncludeIndent); value != "" {
+		indent, err := strconv.Atoi(value)
+		if err != nil || indent < 0 {
+			log.Warnf("invalid value for the '%s' attribute of '%s': '%s'", types.AttrIncludeIndent, incl.RawText, value)
+		} else {
+			content = reindent(content, indent)
+		}
+	}
+	nestedOpts := append([]Option{}, opts...)
+	if depth, ok := nestedMaxIncludeDepth(incl, opts...); ok {
+		nestedOpts = append(nestedOpts, depth)
+	}
+	nestedOpts = append(nestedOpts, withIncludedLocation(location, opts...))
+	if isRaw {
+		// the content of the files is included as-is in verbatim blocks, but the file inclusions
+		// in AsciiDoc files are still resolved
+		if types.IsAsciidoc(path) && content.Len() > 0 {
+			lines := includeRawLines(location, strings.Split(strings.TrimSuffix(content.String(), "\n"), "\n"), attrs, overrides, levelOffset, nestedOpts...)
+			content = bytes.NewBufferString(strings.Join(lines, "\n") + "\n")
+		}
+		return rawLines(content), nil
+	}
 	// parse the content, and returns the corresponding elements
-	levelOffset := incl.Attributes.GetAsString(types.AttrLevelOffset)
-	return parsePreflightDocument(absPath, content, levelOffset, opts...)
+	return parsePreflightDocument(location, content, attrs, overrides, includedLevelOffset(incl, levelOffset), nestedOpts...)
+}
+
+// includedLevelOffset returns the level offset of the file to include, given the level offset of the including document:
+// a relative `leveloffset` attribute (eg: `+1` or `-1`) is added to it, whereas an absolute one (eg: `1`) replaces it.
+func includedLevelOffset(incl types.FileInclusion, levelOffset int) int {
+	value := incl.Attributes.GetAsString(types.AttrLevelOffset)
+	if value == "" {
+		return levelOffset
+	}
+	offset, err := strconv.Atoi(value)
+	if err != nil {
+		log.Warnf("invalid value for the '%s' attribute of '%s': '%s'", types.AttrLevelOffset, incl.RawText, value)
+		return levelOffset
+	}
+	if strings.HasPrefix(value, "+") || strings.HasPrefix(value, "-") {
+		return levelOffset + offset
+	}
+	return offset
+}
+
+// decode returns a reader which decodes the given content from the encoding set in the `encoding`
+// attribute of the file inclusion (eg: `encoding=iso-8859-1`), or the given reader if the content is UTF-8 encoded
+func decode(r io.Reader, incl types.FileInclusion) (io.Reader, error) {
+	name := incl.Attributes.GetAsString(types.AttrIncludeEncoding)
+	if name == "" || strings.EqualFold(name, "utf-8") || strings.EqualFold(name, "utf8") {
+		return r, nil
+	}
+	enc, err := htmlindex.Get(name)
+	if err != nil {
+		return nil, errors.Errorf("unsupported encoding: '%s'", name)
+	}
+	return transform.NewReader(r, enc.NewDecoder()), nil
+}
+
+// reindent removes the common indentation of the non-blank lines of the given content,
+// and indents them with the given number of spaces instead. Blank lines are emptied.
+func reindent(content *bytes.Buffer, indent int) *bytes.Buffer {
+	lines := strings.Split(strings.TrimSuffix(content.String(), "\n"), "\n")
+	common := -1
+	for _, l := range lines {
+		if strings.TrimSpace(l) == "" {
+			continue
+		}
+		if i := len(l) - len(strings.TrimLeft(l, " \t")); common == -1 || i < common {
+			common = i
+		}
+	}
+	result := bytes.NewBuffer(nil)
+	for _, l := range lines {
+		if strings.TrimSpace(l) != "" {
+			result.WriteString(strings.Repeat(" ", indent))
+			result.WriteString(l[common:])
+		}
+		result.WriteString("\n")
+	}
+	return result
+}
+
+// missingFileToInclude returns an empty document if the file inclusion has the `optional` option,
+// or an error message in place of the file inclusion otherwise
+func missingFileToInclude(filename, path string, incl types.FileInclusion, err error) (types.PreflightDocument, error) {
+	if incl.IsOptional() {
+		log.WithError(err).Infof("skipping optional file to include '%s'", path)
+		return types.PreflightDocument{
+			Blocks: []interface{}{},
+		}, nil
+	}
+	return invalidFileErrMsg(filename, path, incl.RawText, err)
+}
+
+// linkToInclude returns a paragraph with a link to the given URI, in place of the file inclusion
+func linkToInclude(uri string) (types.PreflightDocument, error) {
+	link, err := types.NewInlineLink(types.Location{
+		types.StringElement{
+			Content: uri,
+		},
+	}, nil)
+	if err != nil {
+		return types.PreflightDocument{}, err
+	}
+	return types.PreflightDocument{
+		Blocks: []interface{}{
+			types.Paragraph{
+				Attributes: types.ElementAttributes{},
+				Lines: []types.InlineElements{
+					{
+						link,
+					},
+				},
+			},
+		},
+	}, nil
 }
 
 func invalidFileErrMsg(filename, path, rawText string, err error) (types.PreflightDocument, error) {
@@ -123,7 +336,9 @@ func readWithinLines(scanner *bufio.Scanner, content *bytes.Buffer, lineRanges t
 
 func readWithinTags(path string, scanner *bufio.Scanner, content *bytes.Buffer, expectedRanges types.TagRanges) error {
 	log.Debugf("limiting to tag ranges: %v", expectedRanges)
+	selection := expectedRanges.Selection()
 	actualRanges := make(map[string]*types.TagRange, len(expectedRanges)) // ensure capacity
+	openTags := []string{}                                                // the tags enclosing the current line, from the outermost to the innermost
 	lineNumber := 0
 	for scanner.Scan() {
 		lineNumber++
@@ -143,11 +358,17 @@ func readWithinTags(path string, scanner *bufio.Scanner, content *bytes.Buffer,
 				StartLine: lineNumber,
 				EndLine:   -1,
 			}
+			openTags = append(openTags, startTag.Value)
 		}
 		if endTag, ok := fl.GetEndTag(); ok {
-			actualRanges[endTag.Value].EndLine = lineNumber
+			if tr, found := actualRanges[endTag.Value]; found && tr.EndLine == -1 {
+				tr.EndLine = lineNumber
+				openTags = closeTag(openTags, endTag.Value)
+			} else {
+				log.Warnf("unexpected end tag '%s' at line %d of include file: %s", endTag.Value, lineNumber, path)
+			}
 		}
-		if expectedRanges.Match(lineNumber, actualRanges) && !fl.HasTag() {
+		if selection.Match(openTags) && !fl.HasTag() {
 			_, err := content.Write(scanner.Bytes())
 			if err != nil {
 				return err
@@ -159,7 +380,7 @@ func readWithinTags(path string, scanner *bufio.Scanner, content *bytes.Buffer,
 		}
 	}
 	// after the file has been processed, let's check if all tags were "found"
-	for _, tag := range expectedRanges {
+	for _, tag := range expectedRanges.Names() {
 		log.Debugf("checking if tag '%s' was found...", tag)
 		tr, found := actualRanges[tag]
 		if !found {
@@ -171,6 +392,16 @@ func readWithinTags(path string, scanner *bufio.Scanner, content *bytes.Buffer,
 	return nil
 }
 
+// closeTag removes the innermost occurrence of the given tag from the open tags
+func closeTag(openTags []string, tag string) []string {
+	for i := len(openTags) - 1; i >= 0; i-- {
+		if openTags[i] == tag {
+			return append(openTags[:i], openTags[i+1:]...)
+		}
+	}
+	return openTags
+}
+
 func readAll(scanner *bufio.Scanner, content *bytes.Buffer) error {
 	for scanner.Scan() {
 		// parse the line in search for the `tag::<tag>[]` or `end:<tag>[]` macros
@@ -1,7 +1,14 @@
 package parser_test
 
 import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
 	"strings"
+	"testing/fstest"
 
 	"github.com/bytesparadise/libasciidoc/pkg/parser"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
@@ -80,6 +87,22 @@ var _ = Describe("file location", func() {
 
 var _ = Describe("file inclusions - preflight with preprocessing", func() {
 
+	verbatimLines := func(lines ...string) []types.InlineElements {
+		result := []types.InlineElements{}
+		for _, l := range lines {
+			if l == "" {
+				result = append(result, types.InlineElements{})
+				continue
+			}
+			result = append(result, types.InlineElements{
+				types.VerbatimLine{
+					Content: l,
+				},
+			})
+		}
+		return result
+	}
+
 	It("should include adoc file without leveloffset", func() {
 		console, reset := ConfigureLogger()
 		defer reset()
@@ -169,24 +192,7 @@ var _ = Describe("file inclusions - preflight with preprocessing", func() {
 						Elements: []interface{}{
 							types.Paragraph{
 								Attributes: types.ElementAttributes{},
-								Lines: []types.InlineElements{
-									{
-										types.StringElement{
-											Content: "= Chapter A",
-										},
-									},
-								},
-							},
-							types.BlankLine{},
-							types.Paragraph{
-								Attributes: types.ElementAttributes{},
-								Lines: []types.InlineElements{
-									{
-										types.StringElement{
-											Content: "content",
-										},
-									},
-								},
+								Lines:      verbatimLines("= Chapter A", "", "content"),
 							},
 						},
 					},
@@ -207,24 +213,7 @@ include::../../test/includes/chapter-a.adoc[]
 						Elements: []interface{}{
 							types.Paragraph{
 								Attributes: types.ElementAttributes{},
-								Lines: []types.InlineElements{
-									{
-										types.StringElement{
-											Content: "= Chapter A",
-										},
-									},
-								},
-							},
-							types.BlankLine{},
-							types.Paragraph{
-								Attributes: types.ElementAttributes{},
-								Lines: []types.InlineElements{
-									{
-										types.StringElement{
-											Content: "content",
-										},
-									},
-								},
+								Lines:      verbatimLines("= Chapter A", "", "content"),
 							},
 						},
 					},
@@ -1056,23 +1045,35 @@ include::{includedir}/grandchild-include.adoc[]
 						Elements: []interface{}{
 							types.Paragraph{
 								Attributes: types.ElementAttributes{},
-								Lines: []types.InlineElements{
-									{
-										types.StringElement{
-											Content: "first line of grandchild",
-										},
-									},
+								Lines:      verbatimLines("first line of grandchild", "", "last line of grandchild"),
+							},
+						},
+					},
+				},
+			}
+			Expect(source).To(BecomePreflightDocument(expected))
+		})
+		It("should resolve path with the docdir intrinsic attribute", func() {
+			source := `include::{docdir}/../../test/includes/grandchild-include.adoc[]`
+			expected := types.PreflightDocument{
+				Blocks: []interface{}{
+					types.Paragraph{
+						Attributes: types.ElementAttributes{},
+						Lines: []types.InlineElements{
+							{
+								types.StringElement{
+									Content: "first line of grandchild",
 								},
 							},
-							types.BlankLine{},
-							types.Paragraph{
-								Attributes: types.ElementAttributes{},
-								Lines: []types.InlineElements{
-									{
-										types.StringElement{
-											Content: "last line of grandchild",
-										},
-									},
+						},
+					},
+					types.BlankLine{},
+					types.Paragraph{
+						Attributes: types.ElementAttributes{},
+						Lines: []types.InlineElements{
+							{
+								types.StringElement{
+									Content: "last line of grandchild",
 								},
 							},
 						},
@@ -1098,45 +1099,7 @@ include::../../test/includes/hello_world.go.txt[]
 						Elements: []interface{}{
 							types.Paragraph{
 								Attributes: types.ElementAttributes{},
-								Lines: []types.InlineElements{
-									{
-										types.StringElement{
-											Content: `package includes`,
-										},
-									},
-								},
-							},
-							types.BlankLine{},
-							types.Paragraph{
-								Attributes: types.ElementAttributes{},
-								Lines: []types.InlineElements{
-									{
-										types.StringElement{
-											Content: `import "fmt"`,
-										},
-									},
-								},
-							},
-							types.BlankLine{},
-							types.Paragraph{
-								Attributes: types.ElementAttributes{},
-								Lines: []types.InlineElements{
-									{
-										types.StringElement{
-											Content: `func helloworld() {`,
-										},
-									},
-									{
-										types.StringElement{
-											Content: `	fmt.Println("hello, world!")`,
-										},
-									},
-									{
-										types.StringElement{
-											Content: `}`,
-										},
-									},
-								},
+								Lines:      verbatimLines("package includes", "", "import \"fmt\"", "", "func helloworld() {", "\tfmt.Println(\"hello, world!\")", "}"),
 							},
 						},
 					},
@@ -1158,13 +1121,7 @@ include::../../test/includes/hello_world.go.txt[lines=1]
 						Elements: []interface{}{
 							types.Paragraph{
 								Attributes: types.ElementAttributes{},
-								Lines: []types.InlineElements{
-									{
-										types.StringElement{
-											Content: `package includes`,
-										},
-									},
-								},
+								Lines:      verbatimLines("package includes"),
 							},
 						},
 					},
@@ -1173,196 +1130,1308 @@ include::../../test/includes/hello_world.go.txt[lines=1]
 			Expect(source).To(BecomePreflightDocument(expected))
 		})
 	})
-})
 
-var _ = Describe("file inclusions - preflight without preprocessing", func() {
+	Context("inclusion of non-asciidoc file in verbatim blocks", func() {
 
-	It("should include adoc file with leveloffset attribute", func() {
-		source := "include::../../test/includes/chapter-a.adoc[leveloffset=+1]"
-		expected := types.PreflightDocument{
-			Blocks: []interface{}{
-				types.FileInclusion{
-					Attributes: types.ElementAttributes{
-						types.AttrLevelOffset: "+1",
-					},
-					Location: types.Location{
-						types.StringElement{
-							Content: "../../test/includes/chapter-a.adoc",
-						},
-					},
-					RawText: `include::../../test/includes/chapter-a.adoc[leveloffset=+1]`,
-				},
+		fsys := fstest.MapFS{
+			"sample.go": &fstest.MapFile{
+				Data: []byte("package main\n\n// a comment\n* not a list\n\tfmt.Println(\"<b>\")  \n  == not a section"),
+			},
+			"sample.adoc": &fstest.MapFile{
+				Data: []byte("== Section"),
+			},
+			"list.adoc": &fstest.MapFile{
+				Data: []byte("* item\n* <b>other</b>\ninclude::sample.adoc[]"),
 			},
 		}
-		Expect(source).To(BecomePreflightDocumentWithoutPreprocessing(expected))
-	})
-
-	Context("file inclusions in delimited blocks", func() {
 
-		It("should include adoc file within fenced block", func() {
-			source := "```\n" +
-				"include::../../test/includes/chapter-a.adoc[]\n" +
-				"```"
+		It("should include raw lines in listing block", func() {
+			source := "----\nbefore\ninclude::sample.go[]\nafter\n----"
 			expected := types.PreflightDocument{
 				Blocks: []interface{}{
 					types.DelimitedBlock{
+						Kind:       types.Listing,
 						Attributes: types.ElementAttributes{},
-						Kind:       types.Fenced,
 						Elements: []interface{}{
-							types.FileInclusion{
+							types.Paragraph{
 								Attributes: types.ElementAttributes{},
-								Location: types.Location{
+								Lines: append(append([]types.InlineElements{
+									{
+										types.StringElement{
+											Content: "before",
+										},
+									},
+								}, verbatimLines(
+									"package main",
+									"",
+									"// a comment",
+									"* not a list",
+									"\tfmt.Println(\"<b>\")  ",
+									"  == not a section",
+								)...), types.InlineElements{
 									types.StringElement{
-										Content: "../../test/includes/chapter-a.adoc",
+										Content: "after",
 									},
-								},
-								RawText: `include::../../test/includes/chapter-a.adoc[]`,
+								}),
 							},
 						},
 					},
 				},
 			}
-			Expect(source).To(BecomePreflightDocumentWithoutPreprocessing(expected))
+			Expect(source).To(BecomePreflightDocument(expected, parser.FileSystem(fsys)))
 		})
 
-		It("should include adoc file within listing block", func() {
-			source := `----
-include::../../test/includes/chapter-a.adoc[]
-----`
+		It("should include raw lines in listing block with tabsize block attribute", func() {
+			source := "[tabsize=4]\n----\ninclude::sample.go[lines=5]\n----"
 			expected := types.PreflightDocument{
 				Blocks: []interface{}{
 					types.DelimitedBlock{
-						Attributes: types.ElementAttributes{},
-						Kind:       types.Listing,
+						Kind: types.Listing,
+						Attributes: types.ElementAttributes{
+							types.AttrTabSize: "4",
+						},
 						Elements: []interface{}{
-							types.FileInclusion{
+							types.Paragraph{
 								Attributes: types.ElementAttributes{},
-								Location: types.Location{
-									types.StringElement{
-										Content: "../../test/includes/chapter-a.adoc",
-									},
-								},
-								RawText: `include::../../test/includes/chapter-a.adoc[]`,
+								Lines:      verbatimLines("    fmt.Println(\"<b>\")  "),
 							},
 						},
 					},
 				},
 			}
-			Expect(source).To(BecomePreflightDocumentWithoutPreprocessing(expected))
+			Expect(source).To(BecomePreflightDocument(expected, parser.FileSystem(fsys)))
 		})
 
-		It("should include adoc file within example block", func() {
-			source := `====
-include::../../test/includes/chapter-a.adoc[]
-====`
+		It("should include raw lines in source block with tabsize document attribute and indent", func() {
+			source := ":tabsize: 2\n\n[source,go]\n----\ninclude::sample.go[lines=5..6,indent=0]\n----"
 			expected := types.PreflightDocument{
 				Blocks: []interface{}{
+					types.DocumentAttributeDeclaration{
+						Name:  types.AttrTabSize,
+						Value: "2",
+					},
+					types.BlankLine{},
 					types.DelimitedBlock{
-						Attributes: types.ElementAttributes{},
-						Kind:       types.Example,
+						Kind: types.Source,
+						Attributes: types.ElementAttributes{
+							types.AttrKind:     types.Source,
+							types.AttrLanguage: "go",
+						},
 						Elements: []interface{}{
-							types.FileInclusion{
+							types.Paragraph{
 								Attributes: types.ElementAttributes{},
-								Location: types.Location{
-									types.StringElement{
-										Content: "../../test/includes/chapter-a.adoc",
-									},
-								},
-								RawText: `include::../../test/includes/chapter-a.adoc[]`,
+								Lines: verbatimLines(
+									"fmt.Println(\"<b>\")  ",
+									"== not a section",
+								),
 							},
 						},
 					},
 				},
 			}
-			Expect(source).To(BecomePreflightDocumentWithoutPreprocessing(expected))
+			Expect(source).To(BecomePreflightDocument(expected, parser.FileSystem(fsys)))
 		})
 
-		It("should include adoc file within quote block", func() {
-			source := `____
-include::../../test/includes/chapter-a.adoc[]
-____`
+		It("should include raw lines in literal block", func() {
+			source := "....\nbefore\ninclude::sample.adoc[]\ninclude::sample.go[lines=3..4]\n...."
 			expected := types.PreflightDocument{
 				Blocks: []interface{}{
-					types.DelimitedBlock{
-						Attributes: types.ElementAttributes{},
-						Kind:       types.Quote,
-						Elements: []interface{}{
-							types.FileInclusion{
-								Attributes: types.ElementAttributes{},
-								Location: types.Location{
-									types.StringElement{
-										Content: "../../test/includes/chapter-a.adoc",
-									},
-								},
-								RawText: `include::../../test/includes/chapter-a.adoc[]`,
-							},
+					types.LiteralBlock{
+						Attributes: types.ElementAttributes{
+							types.AttrKind:             types.Literal,
+							types.AttrLiteralBlockType: types.LiteralBlockWithDelimiter,
+						},
+						Lines: []string{
+							"before",
+							"== Section",
+							"// a comment",
+							"* not a list",
 						},
 					},
 				},
 			}
-			Expect(source).To(BecomePreflightDocumentWithoutPreprocessing(expected))
+			Expect(source).To(BecomePreflightDocument(expected, parser.FileSystem(fsys)))
 		})
 
-		It("should include adoc file within verse block", func() {
-			source := `[verse]
-____
-include::../../test/includes/chapter-a.adoc[]
-____`
+		It("should replace missing file with message in literal block", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			source := "....\ninclude::unknown.go[]\n...."
 			expected := types.PreflightDocument{
 				Blocks: []interface{}{
-					types.DelimitedBlock{
+					types.LiteralBlock{
 						Attributes: types.ElementAttributes{
-							types.AttrKind: types.Verse,
+							types.AttrKind:             types.Literal,
+							types.AttrLiteralBlockType: types.LiteralBlockWithDelimiter,
 						},
-						Kind: types.Verse,
-						Elements: []interface{}{
-							types.FileInclusion{
-								Attributes: types.ElementAttributes{},
-								Location: types.Location{
-									types.StringElement{
-										Content: "../../test/includes/chapter-a.adoc",
-									},
-								},
-								RawText: `include::../../test/includes/chapter-a.adoc[]`,
-							},
+						Lines: []string{
+							"Unresolved directive in test.adoc - include::unknown.go[]",
 						},
 					},
 				},
 			}
-			Expect(source).To(BecomePreflightDocumentWithoutPreprocessing(expected))
+			Expect(source).To(BecomePreflightDocument(expected, parser.FileSystem(fsys)))
+			Expect(console).To(ContainMessageWithLevel(log.ErrorLevel, "failed to include 'unknown.go'"))
 		})
 
-		It("should include adoc file within sidebar block", func() {
-			source := `****
-include::../../test/includes/chapter-a.adoc[]
-****`
+		It("should include asciidoc file in listing block", func() {
+			source := "----\ninclude::sample.adoc[]\n----"
 			expected := types.PreflightDocument{
 				Blocks: []interface{}{
 					types.DelimitedBlock{
+						Kind:       types.Listing,
 						Attributes: types.ElementAttributes{},
-						Kind:       types.Sidebar,
 						Elements: []interface{}{
-							types.FileInclusion{
+							types.Paragraph{
 								Attributes: types.ElementAttributes{},
-								Location: types.Location{
-									types.StringElement{
-										Content: "../../test/includes/chapter-a.adoc",
-									},
-								},
-								RawText: `include::../../test/includes/chapter-a.adoc[]`,
+								Lines:      verbatimLines("== Section"),
 							},
 						},
 					},
 				},
 			}
-			Expect(source).To(BecomePreflightDocumentWithoutPreprocessing(expected))
+			Expect(source).To(BecomePreflightDocument(expected, parser.FileSystem(fsys)))
 		})
 
-		It("should include adoc file within passthrough block", func() {
-			Skip("missing support for passthrough blocks")
-			source := `++++
-include::../../test/includes/chapter-a.adoc[]
-++++`
+		It("should include asciidoc file with a list and a nested file inclusion in listing block", func() {
+			source := "----\ninclude::list.adoc[]\n----"
+			expected := types.PreflightDocument{
+				Blocks: []interface{}{
+					types.DelimitedBlock{
+						Kind:       types.Listing,
+						Attributes: types.ElementAttributes{},
+						Elements: []interface{}{
+							types.Paragraph{
+								Attributes: types.ElementAttributes{},
+								Lines:      verbatimLines("* item", "* <b>other</b>", "== Section"),
+							},
+						},
+					},
+				},
+			}
+			Expect(source).To(BecomePreflightDocument(expected, parser.FileSystem(fsys)))
+		})
+	})
+
+	Context("file inclusions from filesystem", func() {
+
+		fsys := fstest.MapFS{
+			"includes/chapter.adoc": &fstest.MapFile{
+				Data: []byte("include::snippet.adoc[]\n\ninclude::/other.adoc[]"),
+			},
+			"includes/snippet.adoc": &fstest.MapFile{
+				Data: []byte("snippet"),
+			},
+			"other.adoc": &fstest.MapFile{
+				Data: []byte("other"),
+			},
+		}
+
+		It("should include file and its relative and absolute inclusions", func() {
+			source := "include::includes/chapter.adoc[]"
+			expected := types.PreflightDocument{
+				Blocks: []interface{}{
+					types.Paragraph{
+						Attributes: types.ElementAttributes{},
+						Lines: []types.InlineElements{
+							{
+								types.StringElement{
+									Content: "snippet",
+								},
+							},
+						},
+					},
+					types.BlankLine{},
+					types.Paragraph{
+						Attributes: types.ElementAttributes{},
+						Lines: []types.InlineElements{
+							{
+								types.StringElement{
+									Content: "other",
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(source).To(BecomePreflightDocument(expected, parser.FileSystem(fsys)))
+		})
+
+		It("should include file relative to docdir", func() {
+			source := "include::{docdir}/other.adoc[]"
+			expected := types.PreflightDocument{
+				Blocks: []interface{}{
+					types.Paragraph{
+						Attributes: types.ElementAttributes{},
+						Lines: []types.InlineElements{
+							{
+								types.StringElement{
+									Content: "other",
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(source).To(BecomePreflightDocument(expected, parser.FileSystem(fsys)))
+		})
+
+		It("should not include missing file", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			source := "include::../../test/includes/chapter-a.adoc[]"
+			expected := types.PreflightDocument{
+				Blocks: []interface{}{
+					types.Paragraph{
+						Attributes: types.ElementAttributes{},
+						Lines: []types.InlineElements{
+							{
+								types.StringElement{
+									Content: "Unresolved directive in test.adoc - include::../../test/includes/chapter-a.adoc[]",
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(source).To(BecomePreflightDocument(expected, parser.FileSystem(fsys)))
+			Expect(console).To(ContainMessageWithLevel(log.ErrorLevel, "failed to include '../../test/includes/chapter-a.adoc'"))
+		})
+
+		Context("in safe mode", func() {
+
+			fsys := fstest.MapFS{
+				"docs/chapter.adoc": &fstest.MapFile{
+					Data: []byte("include::../outside.adoc[]"),
+				},
+				"outside.adoc": &fstest.MapFile{
+					Data: []byte("outside"),
+				},
+			}
+
+			unresolved := func(filename, rawText string) types.Paragraph {
+				return types.Paragraph{
+					Attributes: types.ElementAttributes{},
+					Lines: []types.InlineElements{
+						{
+							types.StringElement{
+								Content: "Unresolved directive in " + filename + " - " + rawText,
+							},
+						},
+					},
+				}
+			}
+
+			It("should not include file outside of the directory of the document", func() {
+				console, reset := ConfigureLogger()
+				defer reset()
+				source := "include::../outside.adoc[]"
+				doc, err := parser.ParsePreflightDocument("docs/index.adoc", strings.NewReader(source), parser.FileSystem(fsys), parser.SafeMode(types.SafeModeSafe))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(doc.Blocks).To(Equal([]interface{}{unresolved("docs/index.adoc", source)}))
+				Expect(console).To(ContainMessageWithLevel(log.ErrorLevel, "failed to include '../outside.adoc'"))
+			})
+
+			It("should not include file outside of the directory of the document from an included file", func() {
+				console, reset := ConfigureLogger()
+				defer reset()
+				source := "include::chapter.adoc[]"
+				doc, err := parser.ParsePreflightDocument("docs/index.adoc", strings.NewReader(source), parser.FileSystem(fsys), parser.SafeMode(types.SafeModeSafe))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(doc.Blocks).To(Equal([]interface{}{unresolved("docs/chapter.adoc", "include::../outside.adoc[]")}))
+				Expect(console).To(ContainMessageWithLevel(log.ErrorLevel, "failed to include '../outside.adoc'"))
+			})
+
+			It("should include file within the base directory", func() {
+				source := "include::../outside.adoc[]"
+				doc, err := parser.ParsePreflightDocument("docs/index.adoc", strings.NewReader(source), parser.FileSystem(fsys), parser.SafeMode(types.SafeModeSafe), parser.BaseDir("/"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(doc.Blocks).To(HaveLen(1))
+				Expect(doc.Blocks[0]).To(BeAssignableToTypeOf(types.Paragraph{}))
+				Expect(doc.Blocks[0].(types.Paragraph).Lines).To(Equal([]types.InlineElements{{types.StringElement{Content: "outside"}}}))
+			})
+
+			It("should include file outside of the directory of the document in unsafe mode", func() {
+				source := "include::../outside.adoc[]"
+				doc, err := parser.ParsePreflightDocument("docs/index.adoc", strings.NewReader(source), parser.FileSystem(fsys))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(doc.Blocks).To(HaveLen(1))
+				Expect(doc.Blocks[0].(types.Paragraph).Lines).To(Equal([]types.InlineElements{{types.StringElement{Content: "outside"}}}))
+			})
+		})
+	})
+
+	Context("safe modes", func() {
+
+		chapterA := []interface{}{
+			types.Section{
+				Attributes: types.ElementAttributes{
+					types.AttrID:       "chapter_a",
+					types.AttrCustomID: false,
+				},
+				Level: 0,
+				Title: types.InlineElements{
+					types.StringElement{
+						Content: "Chapter A",
+					},
+				},
+				Elements: []interface{}{},
+			},
+			types.BlankLine{},
+			types.Paragraph{
+				Attributes: types.ElementAttributes{},
+				Lines: []types.InlineElements{
+					{
+						types.StringElement{
+							Content: "content",
+						},
+					},
+				},
+			},
+		}
+
+		unresolved := func(rawText string) types.PreflightDocument {
+			return types.PreflightDocument{
+				Blocks: []interface{}{
+					types.Paragraph{
+						Attributes: types.ElementAttributes{},
+						Lines: []types.InlineElements{
+							{
+								types.StringElement{
+									Content: "Unresolved directive in test.adoc - " + rawText,
+								},
+							},
+						},
+					},
+				},
+			}
+		}
+
+		It("should include file within base directory in safe mode", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			source := "include::../../test/includes/chapter-a.adoc[]"
+			expected := types.PreflightDocument{
+				Blocks: chapterA,
+			}
+			Expect(source).To(BecomePreflightDocument(expected, parser.SafeMode(types.SafeModeSafe), parser.BaseDir("../../test")))
+			Expect(console).ToNot(ContainAnyMessageWithLevels(log.ErrorLevel, log.WarnLevel))
+		})
+
+		It("should include file within base directory in safe mode from an included file", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			source := "include::../../test/includes/parent-include.adoc[]"
+			doc, err := parser.ParsePreflightDocument("test.adoc", strings.NewReader(source), parser.SafeMode(types.SafeModeSafe), parser.BaseDir("../../test/includes"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(doc.Blocks).To(HaveLen(11)) // lines and blank lines of parent, child and grandchild
+			Expect(console).ToNot(ContainAnyMessageWithLevels(log.ErrorLevel, log.WarnLevel))
+		})
+
+		It("should not include file outside of default base directory in safe mode", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			source := "include::../../test/includes/chapter-a.adoc[]"
+			expected := unresolved(source)
+			Expect(source).To(BecomePreflightDocument(expected, parser.SafeMode(types.SafeModeSafe)))
+			Expect(console).To(ContainMessageWithLevel(log.ErrorLevel, "failed to include '../../test/includes/chapter-a.adoc'"))
+		})
+
+		It("should not include file with absolute path outside of base directory in safe mode", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			source := "include::/etc/passwd[]"
+			expected := unresolved(source)
+			Expect(source).To(BecomePreflightDocument(expected, parser.SafeMode(types.SafeModeServer), parser.BaseDir("../../test")))
+			Expect(console).To(ContainMessageWithLevel(log.ErrorLevel, "failed to include '/etc/passwd'"))
+		})
+
+		It("should include file outside of base directory in unsafe mode", func() {
+			source := "include::../../test/includes/chapter-a.adoc[]"
+			expected := types.PreflightDocument{
+				Blocks: chapterA,
+			}
+			Expect(source).To(BecomePreflightDocument(expected, parser.SafeMode(types.SafeModeUnsafe), parser.BaseDir("../../test/includes/unknown")))
+		})
+
+		It("should replace file inclusion with a link in secure mode", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			source := "include::../../test/includes/chapter-a.adoc[]"
+			expected := types.PreflightDocument{
+				Blocks: []interface{}{
+					types.Paragraph{
+						Attributes: types.ElementAttributes{},
+						Lines: []types.InlineElements{
+							{
+								types.InlineLink{
+									Attributes: types.ElementAttributes{},
+									Location: types.Location{
+										types.StringElement{
+											Content: "../../test/includes/chapter-a.adoc",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(source).To(BecomePreflightDocument(expected, parser.SafeMode(types.SafeModeSecure)))
+			Expect(console).To(ContainMessageWithLevel(log.WarnLevel, "cannot include file '../../test/includes/chapter-a.adoc' in secure mode"))
+		})
+
+		It("should replace remote file inclusion with a link in server mode", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			source := "include::https://example.com/docs/other.adoc[]"
+			expected := types.PreflightDocument{
+				Blocks: []interface{}{
+					types.Paragraph{
+						Attributes: types.ElementAttributes{},
+						Lines: []types.InlineElements{
+							{
+								types.InlineLink{
+									Attributes: types.ElementAttributes{},
+									Location: types.Location{
+										types.StringElement{
+											Content: "https://example.com/docs/other.adoc",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(source).To(BecomePreflightDocument(expected,
+				parser.SafeMode(types.SafeModeServer),
+				parser.AttributeOverrides(types.NewDocumentAttributeOverrides(map[string]string{
+					types.AttrAllowURIRead: "",
+				})),
+				parser.URIFetcher(parser.FetcherFunc(func(uri string) (io.ReadCloser, error) {
+					return ioutil.NopCloser(strings.NewReader("remote content")), nil
+				}))))
+			Expect(console).To(ContainMessageWithLevel(log.WarnLevel, "cannot include remote file 'https://example.com/docs/other.adoc' in server mode"))
+		})
+	})
+
+	Context("remote file inclusions", func() {
+
+		// an in-memory fetcher of remote files
+		files := map[string]string{
+			"https://example.com/docs/chapter.adoc":  "== Chapter\n\ncontent\n\ninclude::other.adoc[lines=2]",
+			"https://example.com/docs/other.adoc":    "first line\nsecond line",
+			"https://example.com/docs/absolute.adoc": "include::/etc/hostname[]",
+			"https://example.com/etc/hostname":       "remote host",
+		}
+		fetcher := parser.FetcherFunc(func(uri string) (io.ReadCloser, error) {
+			content, found := files[uri]
+			if !found {
+				return nil, fmt.Errorf("not found: %s", uri)
+			}
+			return ioutil.NopCloser(strings.NewReader(content)), nil
+		})
+
+		It("should include remote file and resolve its relative inclusions", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			source := "include::https://example.com/docs/chapter.adoc[leveloffset=+1]"
+			expected := types.PreflightDocument{
+				Blocks: []interface{}{
+					types.Section{
+						Attributes: types.ElementAttributes{
+							types.AttrID:       "chapter",
+							types.AttrCustomID: false,
+						},
+						Level: 2,
+						Title: types.InlineElements{
+							types.StringElement{
+								Content: "Chapter",
+							},
+						},
+						Elements: []interface{}{},
+					},
+					types.BlankLine{},
+					types.Paragraph{
+						Attributes: types.ElementAttributes{},
+						Lines: []types.InlineElements{
+							{
+								types.StringElement{
+									Content: "content",
+								},
+							},
+						},
+					},
+					types.BlankLine{},
+					types.Paragraph{
+						Attributes: types.ElementAttributes{},
+						Lines: []types.InlineElements{
+							{
+								types.StringElement{
+									Content: "second line",
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(source).To(BecomePreflightDocument(expected,
+				parser.AttributeOverrides(types.NewDocumentAttributeOverrides(map[string]string{
+					types.AttrAllowURIRead: "",
+				})),
+				parser.URIFetcher(fetcher)))
+			Expect(console).ToNot(ContainAnyMessageWithLevels(log.ErrorLevel, log.WarnLevel))
+		})
+
+		It("should resolve absolute inclusions of remote file against its URI", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			source := "include::https://example.com/docs/absolute.adoc[]"
+			expected := types.PreflightDocument{
+				Blocks: []interface{}{
+					types.Paragraph{
+						Attributes: types.ElementAttributes{},
+						Lines: []types.InlineElements{
+							{
+								types.StringElement{
+									Content: "remote host",
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(source).To(BecomePreflightDocument(expected,
+				parser.AttributeOverrides(types.NewDocumentAttributeOverrides(map[string]string{
+					types.AttrAllowURIRead: "",
+				})),
+				parser.URIFetcher(fetcher)))
+			Expect(console).ToNot(ContainAnyMessageWithLevels(log.ErrorLevel, log.WarnLevel))
+		})
+
+		It("should replace remote file inclusion with a link when allow-uri-read is not set", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			source := `:allow-uri-read:
+
+include::https://example.com/docs/other.adoc[]`
+			expected := types.PreflightDocument{
+				Blocks: []interface{}{
+					types.DocumentAttributeDeclaration{
+						Name:  types.AttrAllowURIRead,
+						Value: "",
+					},
+					types.BlankLine{},
+					types.Paragraph{
+						Attributes: types.ElementAttributes{},
+						Lines: []types.InlineElements{
+							{
+								types.InlineLink{
+									Attributes: types.ElementAttributes{},
+									Location: types.Location{
+										types.StringElement{
+											Content: "https://example.com/docs/other.adoc",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(source).To(BecomePreflightDocument(expected, parser.URIFetcher(fetcher)))
+			Expect(console).To(ContainMessageWithLevel(log.WarnLevel, "cannot include remote file 'https://example.com/docs/other.adoc' unless the 'allow-uri-read' attribute is set from the API or the command line"))
+		})
+
+		It("should not include missing remote file", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			source := "include::https://example.com/docs/unknown.adoc[]"
+			expected := types.PreflightDocument{
+				Blocks: []interface{}{
+					types.Paragraph{
+						Attributes: types.ElementAttributes{},
+						Lines: []types.InlineElements{
+							{
+								types.StringElement{
+									Content: "Unresolved directive in test.adoc - include::https://example.com/docs/unknown.adoc[]",
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(source).To(BecomePreflightDocument(expected,
+				parser.AttributeOverrides(types.NewDocumentAttributeOverrides(map[string]string{
+					types.AttrAllowURIRead: "",
+				})),
+				parser.URIFetcher(fetcher)))
+			Expect(console).To(ContainMessageWithLevel(log.ErrorLevel, "failed to include 'https://example.com/docs/unknown.adoc'"))
+		})
+
+		It("should include remote file with the default HTTP fetcher", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/other.adoc" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				fmt.Fprint(w, "remote content")
+			}))
+			defer server.Close()
+			source := fmt.Sprintf("include::%s/other.adoc[]\n\ninclude::%s/unknown.adoc[]", server.URL, server.URL)
+			expected := types.PreflightDocument{
+				Blocks: []interface{}{
+					types.Paragraph{
+						Attributes: types.ElementAttributes{},
+						Lines: []types.InlineElements{
+							{
+								types.StringElement{
+									Content: "remote content",
+								},
+							},
+						},
+					},
+					types.BlankLine{},
+					types.Paragraph{
+						Attributes: types.ElementAttributes{},
+						Lines: []types.InlineElements{
+							{
+								types.StringElement{
+									Content: fmt.Sprintf("Unresolved directive in test.adoc - include::%s/unknown.adoc[]", server.URL),
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(source).To(BecomePreflightDocument(expected,
+				parser.AttributeOverrides(types.NewDocumentAttributeOverrides(map[string]string{
+					types.AttrAllowURIRead: "",
+				}))))
+			Expect(console).To(ContainMessageWithLevel(log.ErrorLevel, fmt.Sprintf("failed to include '%s/unknown.adoc'", server.URL)))
+		})
+	})
+
+	Context("file inclusions with options", func() {
+
+		paragraph := func(lines ...string) types.Paragraph {
+			result := types.Paragraph{
+				Attributes: types.ElementAttributes{},
+				Lines:      []types.InlineElements{},
+			}
+			for _, l := range lines {
+				result.Lines = append(result.Lines, types.InlineElements{
+					types.StringElement{
+						Content: l,
+					},
+				})
+			}
+			return result
+		}
+
+		section := func(id, title string, level int) types.Section {
+			return types.Section{
+				Attributes: types.ElementAttributes{
+					types.AttrID:       id,
+					types.AttrCustomID: false,
+				},
+				Level: level,
+				Title: types.InlineElements{
+					types.StringElement{
+						Content: title,
+					},
+				},
+				Elements: []interface{}{},
+			}
+		}
+
+		fsys := fstest.MapFS{
+			"tags.adoc": &fstest.MapFile{
+				Data: []byte(`untagged
+// tag::a[]
+in a
+// tag::debug[]
+debug in a
+// end::debug[]
+// end::a[]
+// tag::b[]
+in b
+// end::b[]
+end`),
+			},
+			"code.adoc": &fstest.MapFile{
+				Data: []byte("    if ok {\n        return\n\n    }"),
+			},
+			"latin1.adoc": &fstest.MapFile{
+				Data: []byte{'c', 'a', 'f', 0xe9},
+			},
+			"parent.adoc": &fstest.MapFile{
+				Data: []byte("== Parent\n\ninclude::child.adoc[leveloffset=+1]\n\ninclude::child.adoc[leveloffset=1]"),
+			},
+			"child.adoc": &fstest.MapFile{
+				Data: []byte("== Child"),
+			},
+		}
+
+		DescribeTable("tag wildcards and negations",
+			func(tags string, lines ...string) {
+				console, reset := ConfigureLogger()
+				defer reset()
+				source := fmt.Sprintf("include::tags.adoc[tags=%s]", tags)
+				expected := types.PreflightDocument{
+					Blocks: []interface{}{
+						paragraph(lines...),
+					},
+				}
+				Expect(source).To(BecomePreflightDocument(expected, parser.FileSystem(fsys)))
+				Expect(console).ToNot(ContainAnyMessageWithLevels(log.ErrorLevel, log.WarnLevel))
+			},
+			Entry("all lines", "**", "untagged", "in a", "debug in a", "in b", "end"),
+			Entry("all lines except a tag", "**;!debug", "untagged", "in a", "in b", "end"),
+			Entry("all tagged lines", "*", "in a", "debug in a", "in b"),
+			Entry("all tagged lines except a tag", "*;!debug", "in a", "in b"),
+			Entry("all lines except a negated tag", "!debug", "untagged", "in a", "in b", "end"),
+			Entry("all untagged lines", "!*", "untagged", "end"),
+			Entry("tag except a nested tag", "a;!debug", "in a"),
+			Entry("nested tag", "debug", "debug in a"),
+			Entry("multiple tags", "debug;b", "debug in a", "in b"),
+		)
+
+		It("should skip missing optional file", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			source := "before\n\ninclude::../../test/includes/unknown.adoc[opts=optional]\n\nafter"
+			expected := types.PreflightDocument{
+				Blocks: []interface{}{
+					paragraph("before"),
+					types.BlankLine{},
+					types.BlankLine{},
+					paragraph("after"),
+				},
+			}
+			Expect(source).To(BecomePreflightDocument(expected))
+			Expect(console).ToNot(ContainAnyMessageWithLevels(log.ErrorLevel, log.WarnLevel))
+		})
+
+		It("should skip missing optional file from filesystem", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			source := `include::unknown.adoc[leveloffset=+1,options="optional"]`
+			expected := types.PreflightDocument{
+				Blocks: []interface{}{},
+			}
+			Expect(source).To(BecomePreflightDocument(expected, parser.FileSystem(fsys)))
+			Expect(console).ToNot(ContainAnyMessageWithLevels(log.ErrorLevel, log.WarnLevel))
+		})
+
+		It("should include existing optional file", func() {
+			source := "include::child.adoc[opts=optional]"
+			expected := types.PreflightDocument{
+				Blocks: []interface{}{
+					section("child", "Child", 1),
+				},
+			}
+			Expect(source).To(BecomePreflightDocument(expected, parser.FileSystem(fsys)))
+		})
+
+		It("should remove indentation of included lines", func() {
+			source := "----\ninclude::code.adoc[indent=0]\n----"
+			expected := types.PreflightDocument{
+				Blocks: []interface{}{
+					types.DelimitedBlock{
+						Kind:       types.Listing,
+						Attributes: types.ElementAttributes{},
+						Elements: []interface{}{
+							types.Paragraph{
+								Attributes: types.ElementAttributes{},
+								Lines:      verbatimLines("if ok {", "    return", "", "}"),
+							},
+						},
+					},
+				},
+			}
+			Expect(source).To(BecomePreflightDocument(expected, parser.FileSystem(fsys)))
+		})
+
+		It("should re-indent included lines", func() {
+			source := "----\ninclude::code.adoc[indent=2]\n----"
+			expected := types.PreflightDocument{
+				Blocks: []interface{}{
+					types.DelimitedBlock{
+						Kind:       types.Listing,
+						Attributes: types.ElementAttributes{},
+						Elements: []interface{}{
+							types.Paragraph{
+								Attributes: types.ElementAttributes{},
+								Lines:      verbatimLines("  if ok {", "      return", "", "  }"),
+							},
+						},
+					},
+				},
+			}
+			Expect(source).To(BecomePreflightDocument(expected, parser.FileSystem(fsys)))
+		})
+
+		It("should include file with encoding", func() {
+			source := "include::latin1.adoc[encoding=iso-8859-1]"
+			expected := types.PreflightDocument{
+				Blocks: []interface{}{
+					paragraph("café"),
+				},
+			}
+			Expect(source).To(BecomePreflightDocument(expected, parser.FileSystem(fsys)))
+		})
+
+		It("should not include file with unknown encoding", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			source := "include::latin1.adoc[encoding=unknown]"
+			expected := types.PreflightDocument{
+				Blocks: []interface{}{
+					paragraph("Unresolved directive in test.adoc - include::latin1.adoc[encoding=unknown]"),
+				},
+			}
+			Expect(source).To(BecomePreflightDocument(expected, parser.FileSystem(fsys)))
+			Expect(console).To(ContainMessageWithLevelAndError(log.ErrorLevel, "failed to include 'latin1.adoc'", "unsupported encoding: 'unknown'"))
+		})
+
+		It("should apply relative and absolute level offsets on nested file inclusions", func() {
+			source := "include::parent.adoc[leveloffset=+1]"
+			expected := types.PreflightDocument{
+				Blocks: []interface{}{
+					section("parent", "Parent", 2),
+					types.BlankLine{},
+					section("child", "Child", 3),
+					types.BlankLine{},
+					section("child", "Child", 2),
+				},
+			}
+			Expect(source).To(BecomePreflightDocument(expected, parser.FileSystem(fsys)))
+		})
+
+		It("should ignore invalid level offset", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			source := "include::child.adoc[leveloffset=one]"
+			expected := types.PreflightDocument{
+				Blocks: []interface{}{
+					section("child", "Child", 1),
+				},
+			}
+			Expect(source).To(BecomePreflightDocument(expected, parser.FileSystem(fsys)))
+			Expect(console).To(ContainMessageWithLevel(log.WarnLevel, "invalid value for the 'leveloffset' attribute of 'include::child.adoc[leveloffset=one]': 'one'"))
+		})
+	})
+
+	Context("circular and nested file inclusions", func() {
+
+		paragraph := func(content string) types.Paragraph {
+			return types.Paragraph{
+				Attributes: types.ElementAttributes{},
+				Lines: []types.InlineElements{
+					{
+						types.StringElement{
+							Content: content,
+						},
+					},
+				},
+			}
+		}
+
+		fsys := fstest.MapFS{
+			"test.adoc": &fstest.MapFile{
+				Data: []byte("include::test.adoc[]"),
+			},
+			"cycle-a.adoc": &fstest.MapFile{
+				Data: []byte("cycle a\n\ninclude::cycle-b.adoc[]"),
+			},
+			"cycle-b.adoc": &fstest.MapFile{
+				Data: []byte("cycle b\n\ninclude::cycle-a.adoc[]"),
+			},
+			"level-1.adoc": &fstest.MapFile{
+				Data: []byte("level 1\n\ninclude::level-2.adoc[]"),
+			},
+			"level-2.adoc": &fstest.MapFile{
+				Data: []byte("level 2\n\ninclude::level-3.adoc[]"),
+			},
+			"level-3.adoc": &fstest.MapFile{
+				Data: []byte("level 3"),
+			},
+		}
+
+		It("should not include document in itself", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			source := "include::test.adoc[]"
+			expected := types.PreflightDocument{
+				Blocks: []interface{}{
+					paragraph("Unresolved directive in test.adoc - include::test.adoc[]"),
+				},
+			}
+			Expect(source).To(BecomePreflightDocument(expected, parser.FileSystem(fsys)))
+			Expect(console).To(ContainMessageWithLevelAndError(log.ErrorLevel, "failed to include 'test.adoc'", "circular file inclusion: test.adoc -> test.adoc"))
+		})
+
+		It("should not include files in a cycle from filesystem", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			source := "include::cycle-a.adoc[]"
+			expected := types.PreflightDocument{
+				Blocks: []interface{}{
+					paragraph("cycle a"),
+					types.BlankLine{},
+					paragraph("cycle b"),
+					types.BlankLine{},
+					paragraph("Unresolved directive in cycle-b.adoc - include::cycle-a.adoc[]"),
+				},
+			}
+			Expect(source).To(BecomePreflightDocument(expected, parser.FileSystem(fsys)))
+			Expect(console).To(ContainMessageWithLevelAndError(log.ErrorLevel, "failed to include 'cycle-a.adoc'", "circular file inclusion: test.adoc -> cycle-a.adoc -> cycle-b.adoc -> cycle-a.adoc"))
+		})
+
+		It("should not include files in a cycle from OS filesystem", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			source := "include::../../test/includes/cycle-a.adoc[]"
+			cycleA, err := filepath.Abs("../../test/includes/cycle-a.adoc")
+			Expect(err).ToNot(HaveOccurred())
+			cycleB, err := filepath.Abs("../../test/includes/cycle-b.adoc")
+			Expect(err).ToNot(HaveOccurred())
+			expected := types.PreflightDocument{
+				Blocks: []interface{}{
+					paragraph("first line of cycle a"),
+					types.BlankLine{},
+					paragraph("first line of cycle b"),
+					types.BlankLine{},
+					paragraph(fmt.Sprintf("Unresolved directive in %s - include::cycle-a.adoc[]", cycleB)),
+				},
+			}
+			Expect(source).To(BecomePreflightDocument(expected))
+			root, err := filepath.Abs("test.adoc")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(console).To(ContainMessageWithLevelAndError(log.ErrorLevel, "failed to include 'cycle-a.adoc'",
+				fmt.Sprintf("circular file inclusion: %s -> %s -> %s -> %s", root, cycleA, cycleB, cycleA)))
+		})
+
+		It("should include same file twice", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			source := "include::level-3.adoc[]\n\ninclude::level-3.adoc[]"
+			expected := types.PreflightDocument{
+				Blocks: []interface{}{
+					paragraph("level 3"),
+					types.BlankLine{},
+					paragraph("level 3"),
+				},
+			}
+			Expect(source).To(BecomePreflightDocument(expected, parser.FileSystem(fsys)))
+			Expect(console).ToNot(ContainAnyMessageWithLevels(log.ErrorLevel, log.WarnLevel))
+		})
+
+		It("should include nested files within default max include depth", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			source := "include::level-1.adoc[]"
+			expected := types.PreflightDocument{
+				Blocks: []interface{}{
+					paragraph("level 1"),
+					types.BlankLine{},
+					paragraph("level 2"),
+					types.BlankLine{},
+					paragraph("level 3"),
+				},
+			}
+			Expect(source).To(BecomePreflightDocument(expected, parser.FileSystem(fsys)))
+			Expect(console).ToNot(ContainAnyMessageWithLevels(log.ErrorLevel, log.WarnLevel))
+		})
+
+		It("should not include nested files beyond max-include-depth attribute", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			source := ":max-include-depth: 1\n\ninclude::level-1.adoc[]"
+			expected := types.PreflightDocument{
+				Blocks: []interface{}{
+					types.DocumentAttributeDeclaration{
+						Name:  types.AttrMaxIncludeDepth,
+						Value: "1",
+					},
+					types.BlankLine{},
+					paragraph("level 1"),
+					types.BlankLine{},
+					paragraph("Unresolved directive in level-1.adoc - include::level-2.adoc[]"),
+				},
+			}
+			Expect(source).To(BecomePreflightDocument(expected, parser.FileSystem(fsys)))
+			Expect(console).To(ContainMessageWithLevelAndError(log.ErrorLevel, "failed to include 'level-2.adoc'", "maximum include depth of 1 exceeded"))
+		})
+
+		It("should not include any file with max-include-depth attribute set to 0 from the API", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			source := "include::level-1.adoc[]"
+			expected := types.PreflightDocument{
+				Blocks: []interface{}{
+					paragraph("Unresolved directive in test.adoc - include::level-1.adoc[]"),
+				},
+			}
+			Expect(source).To(BecomePreflightDocument(expected,
+				parser.FileSystem(fsys),
+				parser.AttributeOverrides(types.NewDocumentAttributeOverrides(map[string]string{
+					types.AttrMaxIncludeDepth: "0",
+				}))))
+			Expect(console).To(ContainMessageWithLevelAndError(log.ErrorLevel, "failed to include 'level-1.adoc'", "maximum include depth of 0 exceeded"))
+		})
+
+		It("should use default max include depth when max-include-depth attribute is invalid", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			source := ":max-include-depth: many\n\ninclude::level-3.adoc[]"
+			expected := types.PreflightDocument{
+				Blocks: []interface{}{
+					types.DocumentAttributeDeclaration{
+						Name:  types.AttrMaxIncludeDepth,
+						Value: "many",
+					},
+					types.BlankLine{},
+					paragraph("level 3"),
+				},
+			}
+			Expect(source).To(BecomePreflightDocument(expected, parser.FileSystem(fsys)))
+			Expect(console).To(ContainMessageWithLevel(log.WarnLevel, "invalid value for the 'max-include-depth' attribute: 'many'"))
+		})
+
+		It("should not include nested files beyond depth of include directive", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			source := "include::level-1.adoc[depth=2]"
+			expected := types.PreflightDocument{
+				Blocks: []interface{}{
+					paragraph("level 1"),
+					types.BlankLine{},
+					paragraph("level 2"),
+					types.BlankLine{},
+					paragraph("Unresolved directive in level-2.adoc - include::level-3.adoc[]"),
+				},
+			}
+			Expect(source).To(BecomePreflightDocument(expected, parser.FileSystem(fsys)))
+			Expect(console).To(ContainMessageWithLevelAndError(log.ErrorLevel, "failed to include 'level-3.adoc'", "maximum include depth of 2 exceeded"))
+		})
+
+		It("should include nested files beyond max-include-depth attribute with depth of include directive", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			source := ":max-include-depth: 1\n\ninclude::level-1.adoc[depth=3]"
+			expected := types.PreflightDocument{
+				Blocks: []interface{}{
+					types.DocumentAttributeDeclaration{
+						Name:  types.AttrMaxIncludeDepth,
+						Value: "1",
+					},
+					types.BlankLine{},
+					paragraph("level 1"),
+					types.BlankLine{},
+					paragraph("level 2"),
+					types.BlankLine{},
+					paragraph("level 3"),
+				},
+			}
+			Expect(source).To(BecomePreflightDocument(expected, parser.FileSystem(fsys)))
+			Expect(console).ToNot(ContainAnyMessageWithLevels(log.ErrorLevel, log.WarnLevel))
+		})
+	})
+})
+
+var _ = Describe("file inclusions - preflight without preprocessing", func() {
+
+	It("should include adoc file with leveloffset attribute", func() {
+		source := "include::../../test/includes/chapter-a.adoc[leveloffset=+1]"
+		expected := types.PreflightDocument{
+			Blocks: []interface{}{
+				types.FileInclusion{
+					Attributes: types.ElementAttributes{
+						types.AttrLevelOffset: "+1",
+					},
+					Location: types.Location{
+						types.StringElement{
+							Content: "../../test/includes/chapter-a.adoc",
+						},
+					},
+					RawText: `include::../../test/includes/chapter-a.adoc[leveloffset=+1]`,
+				},
+			},
+		}
+		Expect(source).To(BecomePreflightDocumentWithoutPreprocessing(expected))
+	})
+
+	Context("file inclusions in delimited blocks", func() {
+
+		It("should include adoc file within fenced block", func() {
+			source := "```\n" +
+				"include::../../test/includes/chapter-a.adoc[]\n" +
+				"```"
+			expected := types.PreflightDocument{
+				Blocks: []interface{}{
+					types.DelimitedBlock{
+						Attributes: types.ElementAttributes{},
+						Kind:       types.Fenced,
+						Elements: []interface{}{
+							types.FileInclusion{
+								Attributes: types.ElementAttributes{},
+								Location: types.Location{
+									types.StringElement{
+										Content: "../../test/includes/chapter-a.adoc",
+									},
+								},
+								RawText: `include::../../test/includes/chapter-a.adoc[]`,
+							},
+						},
+					},
+				},
+			}
+			Expect(source).To(BecomePreflightDocumentWithoutPreprocessing(expected))
+		})
+
+		It("should include adoc file within listing block", func() {
+			source := `----
+include::../../test/includes/chapter-a.adoc[]
+----`
+			expected := types.PreflightDocument{
+				Blocks: []interface{}{
+					types.DelimitedBlock{
+						Attributes: types.ElementAttributes{},
+						Kind:       types.Listing,
+						Elements: []interface{}{
+							types.FileInclusion{
+								Attributes: types.ElementAttributes{},
+								Location: types.Location{
+									types.StringElement{
+										Content: "../../test/includes/chapter-a.adoc",
+									},
+								},
+								RawText: `include::../../test/includes/chapter-a.adoc[]`,
+							},
+						},
+					},
+				},
+			}
+			Expect(source).To(BecomePreflightDocumentWithoutPreprocessing(expected))
+		})
+
+		It("should include adoc file within example block", func() {
+			source := `====
+include::../../test/includes/chapter-a.adoc[]
+====`
+			expected := types.PreflightDocument{
+				Blocks: []interface{}{
+					types.DelimitedBlock{
+						Attributes: types.ElementAttributes{},
+						Kind:       types.Example,
+						Elements: []interface{}{
+							types.FileInclusion{
+								Attributes: types.ElementAttributes{},
+								Location: types.Location{
+									types.StringElement{
+										Content: "../../test/includes/chapter-a.adoc",
+									},
+								},
+								RawText: `include::../../test/includes/chapter-a.adoc[]`,
+							},
+						},
+					},
+				},
+			}
+			Expect(source).To(BecomePreflightDocumentWithoutPreprocessing(expected))
+		})
+
+		It("should include adoc file within quote block", func() {
+			source := `____
+include::../../test/includes/chapter-a.adoc[]
+____`
+			expected := types.PreflightDocument{
+				Blocks: []interface{}{
+					types.DelimitedBlock{
+						Attributes: types.ElementAttributes{},
+						Kind:       types.Quote,
+						Elements: []interface{}{
+							types.FileInclusion{
+								Attributes: types.ElementAttributes{},
+								Location: types.Location{
+									types.StringElement{
+										Content: "../../test/includes/chapter-a.adoc",
+									},
+								},
+								RawText: `include::../../test/includes/chapter-a.adoc[]`,
+							},
+						},
+					},
+				},
+			}
+			Expect(source).To(BecomePreflightDocumentWithoutPreprocessing(expected))
+		})
+
+		It("should include adoc file within verse block", func() {
+			source := `[verse]
+____
+include::../../test/includes/chapter-a.adoc[]
+____`
+			expected := types.PreflightDocument{
+				Blocks: []interface{}{
+					types.DelimitedBlock{
+						Attributes: types.ElementAttributes{
+							types.AttrKind: types.Verse,
+						},
+						Kind: types.Verse,
+						Elements: []interface{}{
+							types.FileInclusion{
+								Attributes: types.ElementAttributes{},
+								Location: types.Location{
+									types.StringElement{
+										Content: "../../test/includes/chapter-a.adoc",
+									},
+								},
+								RawText: `include::../../test/includes/chapter-a.adoc[]`,
+							},
+						},
+					},
+				},
+			}
+			Expect(source).To(BecomePreflightDocumentWithoutPreprocessing(expected))
+		})
+
+		It("should include adoc file within sidebar block", func() {
+			source := `****
+include::../../test/includes/chapter-a.adoc[]
+****`
+			expected := types.PreflightDocument{
+				Blocks: []interface{}{
+					types.DelimitedBlock{
+						Attributes: types.ElementAttributes{},
+						Kind:       types.Sidebar,
+						Elements: []interface{}{
+							types.FileInclusion{
+								Attributes: types.ElementAttributes{},
+								Location: types.Location{
+									types.StringElement{
+										Content: "../../test/includes/chapter-a.adoc",
+									},
+								},
+								RawText: `include::../../test/includes/chapter-a.adoc[]`,
+							},
+						},
+					},
+				},
+			}
+			Expect(source).To(BecomePreflightDocumentWithoutPreprocessing(expected))
+		})
+
+		It("should include adoc file within passthrough block", func() {
+			Skip("missing support for passthrough blocks")
+			source := `++++
+include::../../test/includes/chapter-a.adoc[]
+++++`
 			expected := types.PreflightDocument{
 				Blocks: []interface{}{
 					types.DelimitedBlock{
@@ -1686,3 +2755,59 @@ include::../../test/includes/chapter-a.adoc[]
 		})
 	})
 })
+
+var _ = Describe("file dependencies", func() {
+
+	It("should list dependencies from filesystem", func() {
+		fsys := fstest.MapFS{
+			"docs/chapter.adoc": &fstest.MapFile{
+				Data: []byte("include::snippet.adoc[]\n\ninclude::/other.adoc[]\n\ninclude::unknown.adoc[]"),
+			},
+			"docs/snippet.adoc": &fstest.MapFile{
+				Data: []byte("include::chapter.adoc[]"),
+			},
+			"other.adoc": &fstest.MapFile{
+				Data: []byte("other"),
+			},
+		}
+		source := "include::chapter.adoc[]\n\ninclude::/other.adoc[]"
+		deps, err := parser.ParseDependencies("docs/index.adoc", strings.NewReader(source), parser.FileSystem(fsys))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(deps).To(Equal([]string{
+			"docs/chapter.adoc",
+			"docs/snippet.adoc",
+			"other.adoc",
+		}))
+	})
+
+	It("should list remote dependencies", func() {
+		files := map[string]string{
+			"https://example.com/docs/chapter.adoc": "include::other.adoc[]",
+			"https://example.com/docs/other.adoc":   "other",
+		}
+		fetcher := parser.FetcherFunc(func(uri string) (io.ReadCloser, error) {
+			content, found := files[uri]
+			if !found {
+				return nil, fmt.Errorf("not found: %s", uri)
+			}
+			return ioutil.NopCloser(strings.NewReader(content)), nil
+		})
+		source := "include::https://example.com/docs/chapter.adoc[]"
+		deps, err := parser.ParseDependencies("test.adoc", strings.NewReader(source),
+			parser.AttributeOverrides(types.NewDocumentAttributeOverrides(map[string]string{
+				types.AttrAllowURIRead: "",
+			})),
+			parser.URIFetcher(fetcher))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(deps).To(Equal([]string{
+			"https://example.com/docs/chapter.adoc",
+			"https://example.com/docs/other.adoc",
+		}))
+	})
+
+	It("should list no dependency", func() {
+		deps, err := parser.ParseDependencies("", strings.NewReader("content"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(deps).To(BeEmpty())
+	})
+})
@@ -0,0 +1,43 @@
+package parser
+
+import (
+	"io/fs"
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// fileSystemKey the key for the filesystem of the files to include in the parser's global store
+const fileSystemKey string = "fileSystem"
+
+// FileSystem option to read the files to include from the given filesystem (eg: an `embed.FS`,
+// a zip archive or an in-memory tree) instead of the OS filesystem. In this case, the name of the document
+// is its path in the filesystem, the relative paths of the files to include are resolved against the directory
+// of the including document, and the absolute paths (eg: `/includes/chapter.adoc`) against the root of the filesystem.
+func FileSystem(fsys fs.FS) Option {
+	return GlobalStore(fileSystemKey, fsys)
+}
+
+// fileSystem returns the filesystem in the given options, if any
+func fileSystem(opts ...Option) (fs.FS, bool) {
+	p := newParser("", nil, opts...)
+	fsys, ok := p.cur.globalStore[fileSystemKey].(fs.FS)
+	return fsys, ok && fsys != nil
+}
+
+// fsPath returns the path in the filesystem of the file at the given location,
+// relative to the directory of the given document, or to the root of the filesystem
+// if the location starts with `/`. Returns an error if the location is outside of the filesystem.
+func fsPath(filename, location string) (string, error) {
+	var p string
+	if strings.HasPrefix(location, "/") {
+		p = strings.TrimPrefix(path.Clean(location), "/")
+	} else {
+		p = path.Join(path.Dir(strings.TrimPrefix(filename, "/")), location)
+	}
+	if !fs.ValidPath(p) {
+		return "", errors.Errorf("invalid path in filesystem: '%s'", location)
+	}
+	return p, nil
+}
@@ -105,6 +105,20 @@ image::images/foo.png[the foo.png image, 600, 400]`
 				Expect(source).To(EqualDocumentBlock(expected))
 			})
 
+			It("block image with options", func() {
+				source := `image::images/foo.svg[foo, opts="inline,interactive", float=left]`
+				expected := types.ImageBlock{
+					Attributes: types.ElementAttributes{
+						types.AttrImageAlt:         "foo",
+						types.AttrImageInline:      nil,
+						types.AttrImageInteractive: nil,
+						types.AttrImageFloat:       "left",
+					},
+					Path: "images/foo.svg",
+				}
+				Expect(source).To(EqualDocumentBlock(expected))
+			})
+
 			It("2 block images", func() {
 				source := `image::app.png[]
 image::appa.png[]`
@@ -115,12 +129,22 @@ image::appa.png[]`
 								types.AttrImageAlt: "app",
 							},
 							Path: "app.png",
+							Position: types.Position{
+								Filename: "test.adoc",
+								Line:     1,
+								Column:   1,
+							},
 						},
 						types.ImageBlock{
 							Attributes: types.ElementAttributes{
 								types.AttrImageAlt: "appa",
 							},
 							Path: "appa.png",
+							Position: types.Position{
+								Filename: "test.adoc",
+								Line:     2,
+								Column:   1,
+							},
 						},
 					},
 				}
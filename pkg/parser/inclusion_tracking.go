@@ -0,0 +1,166 @@
+package parser
+
+import (
+	"io"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// DefaultMaxIncludeDepth the default maximum depth of nested file inclusions, unless the
+// `max-include-depth` attribute is set
+const DefaultMaxIncludeDepth = 64
+
+// includeChainKey the key for the locations of the document and the files being included, in the parser's global store
+const includeChainKey string = "includeChain"
+
+// maxIncludeDepthKey the key for the maximum depth of nested file inclusions set with the `depth` attribute
+// of an include directive, in the parser's global store
+const maxIncludeDepthKey string = "maxIncludeDepth"
+
+// dependenciesKey the key for the dependencies collector in the parser's global store
+const dependenciesKey string = "dependencies"
+
+// includeChain returns the locations of the document and of the files being included, in the given options
+func includeChain(opts ...Option) []string {
+	p := newParser("", nil, opts...)
+	if chain, ok := p.cur.globalStore[includeChainKey].([]string); ok {
+		return chain
+	}
+	return []string{}
+}
+
+// withIncludedLocation returns an option with the include chain extended with the given location
+func withIncludedLocation(location string, opts ...Option) Option {
+	chain := includeChain(opts...)
+	// copy the chain to avoid sharing the underlying array between sibling inclusions
+	result := make([]string, len(chain), len(chain)+1)
+	copy(result, chain)
+	return GlobalStore(includeChainKey, append(result, location))
+}
+
+// rootLocation returns the location of the document in the include chain, or an empty string if it has no filename
+func rootLocation(filename string, opts ...Option) string {
+	if filename == "" {
+		return ""
+	}
+	if _, ok := fileSystem(opts...); ok {
+		return strings.TrimPrefix(path.Clean("/"+filename), "/")
+	}
+	if isURI(filename) {
+		return filename
+	}
+	absPath, err := filepath.Abs(filename)
+	if err != nil {
+		return filename
+	}
+	return absPath
+}
+
+// maxIncludeDepth returns the maximum depth of nested file inclusions, as set by the `depth` attribute of
+// an enclosing include directive, or by the `max-include-depth` attribute, or the `DefaultMaxIncludeDepth`
+func maxIncludeDepth(attrs types.DocumentAttributes, opts ...Option) int {
+	p := newParser("", nil, opts...)
+	if depth, ok := p.cur.globalStore[maxIncludeDepthKey].(int); ok {
+		return depth
+	}
+	if value, ok := attrs.GetAsString(types.AttrMaxIncludeDepth); ok {
+		depth, err := strconv.Atoi(value)
+		if err == nil && depth >= 0 {
+			return depth
+		}
+		log.Warnf("invalid value for the '%s' attribute: '%s'", types.AttrMaxIncludeDepth, value)
+	}
+	return DefaultMaxIncludeDepth
+}
+
+// includeDepth returns the current depth of nested file inclusions
+func includeDepth(opts ...Option) int {
+	if chain := includeChain(opts...); len(chain) > 0 {
+		// the first location is the document's
+		return len(chain) - 1
+	}
+	return 0
+}
+
+// checkIncludeDepth returns an error if including a file would exceed the maximum depth of nested file inclusions
+func checkIncludeDepth(attrs types.DocumentAttributes, opts ...Option) error {
+	if max := maxIncludeDepth(attrs, opts...); includeDepth(opts...)+1 > max {
+		return errors.Errorf("maximum include depth of %d exceeded", max)
+	}
+	return nil
+}
+
+// nestedMaxIncludeDepth returns an option with the maximum depth of the file inclusions within the file to include,
+// if its include directive has a `depth` attribute (eg: `include::chapter.adoc[depth=1]`)
+func nestedMaxIncludeDepth(incl types.FileInclusion, opts ...Option) (Option, bool) {
+	value := incl.Attributes.GetAsString(types.AttrIncludeDepth)
+	if value == "" {
+		return nil, false
+	}
+	depth, err := strconv.Atoi(value)
+	if err != nil || depth < 0 {
+		log.Warnf("invalid value for the '%s' attribute of '%s': '%s'", types.AttrIncludeDepth, incl.RawText, value)
+		return nil, false
+	}
+	// the depth is relative to the file to include
+	return GlobalStore(maxIncludeDepthKey, includeDepth(opts...)+depth), true
+}
+
+// checkIncludeCycle returns an error with the include chain if the file at the given location is already being included
+func checkIncludeCycle(location string, opts ...Option) error {
+	chain := includeChain(opts...)
+	for _, l := range chain {
+		if l == location {
+			locations := []string{}
+			for _, l := range append(chain, location) {
+				if l != "" { // the document may have no filename
+					locations = append(locations, l)
+				}
+			}
+			return errors.Errorf("circular file inclusion: %s", strings.Join(locations, " -> "))
+		}
+	}
+	return nil
+}
+
+// dependencies the locations of the files included in a document, directly or not
+type dependencies struct {
+	locations []string
+	found     map[string]bool
+}
+
+func (d *dependencies) add(location string) {
+	if !d.found[location] {
+		d.found[location] = true
+		d.locations = append(d.locations, location)
+	}
+}
+
+// addDependency records the location of an included file in the dependencies collector in the given options, if any
+func addDependency(location string, opts ...Option) {
+	p := newParser("", nil, opts...)
+	if deps, ok := p.cur.globalStore[dependenciesKey].(*dependencies); ok {
+		deps.add(location)
+	}
+}
+
+// ParseDependencies returns the locations of all the files included in the given document, directly or not,
+// in the order in which they are included and without duplicates. The locations are absolute paths, paths in
+// the filesystem set with the `FileSystem` option, or URIs. The files which could not be included are ignored.
+func ParseDependencies(filename string, r io.Reader, opts ...Option) ([]string, error) {
+	deps := &dependencies{
+		locations: []string{},
+		found:     map[string]bool{},
+	}
+	if _, err := ParsePreflightDocument(filename, r, append(opts, GlobalStore(dependenciesKey, deps))...); err != nil {
+		return nil, err
+	}
+	return deps.locations, nil
+}
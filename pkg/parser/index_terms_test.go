@@ -0,0 +1,262 @@
+package parser_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("index terms", func() {
+
+	BeforeEach(func() {
+		types.ResetIndexTermSequence()
+	})
+
+	Context("visible index terms", func() {
+
+		It("index term with double parentheses", func() {
+			source := "some ((big cats)) here"
+			expected := types.Paragraph{
+				Attributes: types.ElementAttributes{},
+				Lines: []types.InlineElements{
+					{
+						types.StringElement{Content: "some "},
+						types.IndexTerm{
+							ID:      0,
+							Terms:   []string{"big cats"},
+							Visible: true,
+						},
+						types.StringElement{Content: " here"},
+					},
+				},
+			}
+			Expect(source).To(EqualDocumentBlock(expected))
+		})
+
+		It("index term with macro", func() {
+			source := "some indexterm2:[ lions ] here"
+			expected := types.Paragraph{
+				Attributes: types.ElementAttributes{},
+				Lines: []types.InlineElements{
+					{
+						types.StringElement{Content: "some "},
+						types.IndexTerm{
+							ID:      0,
+							Terms:   []string{"lions"},
+							Visible: true,
+						},
+						types.StringElement{Content: " here"},
+					},
+				},
+			}
+			Expect(source).To(EqualDocumentBlock(expected))
+		})
+	})
+
+	Context("concealed index terms", func() {
+
+		It("index terms with triple parentheses", func() {
+			source := "some text(((big cats, wild, lions)))"
+			expected := types.Paragraph{
+				Attributes: types.ElementAttributes{},
+				Lines: []types.InlineElements{
+					{
+						types.StringElement{Content: "some text"},
+						types.IndexTerm{
+							ID:    0,
+							Terms: []string{"big cats", "wild", "lions"},
+						},
+					},
+				},
+			}
+			Expect(source).To(EqualDocumentBlock(expected))
+		})
+
+		It("index terms with macro", func() {
+			source := "indexterm:[tigers,big cats] some text"
+			expected := types.Paragraph{
+				Attributes: types.ElementAttributes{},
+				Lines: []types.InlineElements{
+					{
+						types.IndexTerm{
+							ID:    0,
+							Terms: []string{"tigers", "big cats"},
+						},
+						types.StringElement{Content: " some text"},
+					},
+				},
+			}
+			Expect(source).To(EqualDocumentBlock(expected))
+		})
+
+		It("single parentheses are not index terms", func() {
+			source := "some (text) here"
+			expected := types.Paragraph{
+				Attributes: types.ElementAttributes{},
+				Lines: []types.InlineElements{
+					{
+						types.StringElement{Content: "some (text) here"},
+					},
+				},
+			}
+			Expect(source).To(EqualDocumentBlock(expected))
+		})
+
+		DescribeTable("blank index terms are not index terms",
+			func(source string) {
+				expected := types.Paragraph{
+					Attributes: types.ElementAttributes{},
+					Lines: []types.InlineElements{
+						{
+							types.StringElement{Content: source},
+						},
+					},
+				}
+				Expect(source).To(EqualDocumentBlock(expected))
+			},
+			Entry("comma in triple parentheses", "some (((,))) here"),
+			Entry("spaces and comma in triple parentheses", "some ((( , ))) here"),
+			Entry("empty triple parentheses", "some ((())) here"),
+			Entry("comma in macro", "some indexterm:[,] here"),
+			Entry("spaces in double parentheses", "some (( )) here"),
+			Entry("spaces in visible macro", "some indexterm2:[ ] here"),
+		)
+	})
+
+	Context("index terms collection", func() {
+
+		It("index terms in paragraphs, lists and sections", func() {
+			source := `== Cats
+
+some ((big cats))
+
+* ((lions))
+
+[index]
+== Index`
+			bigCats := types.IndexTerm{
+				ID:      0,
+				Terms:   []string{"big cats"},
+				Visible: true,
+			}
+			lions := types.IndexTerm{
+				ID:      1,
+				Terms:   []string{"lions"},
+				Visible: true,
+			}
+			cats := types.Section{
+				Level: 1,
+				Attributes: types.ElementAttributes{
+					types.AttrID:       "cats",
+					types.AttrCustomID: false,
+				},
+				Title: types.InlineElements{
+					types.StringElement{Content: "Cats"},
+				},
+				Elements: []interface{}{
+					types.Paragraph{
+						Attributes: types.ElementAttributes{},
+						Lines: []types.InlineElements{
+							{
+								types.StringElement{Content: "some "},
+								bigCats,
+							},
+						},
+					},
+					types.UnorderedList{
+						Attributes: types.ElementAttributes{},
+						Items: []types.UnorderedListItem{
+							{
+								Level:       1,
+								BulletStyle: types.OneAsterisk,
+								CheckStyle:  types.NoCheck,
+								Attributes:  types.ElementAttributes{},
+								Elements: []interface{}{
+									types.Paragraph{
+										Attributes: types.ElementAttributes{},
+										Lines: []types.InlineElements{
+											{
+												lions,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			index := types.Section{
+				Level: 1,
+				Attributes: types.ElementAttributes{
+					types.AttrIndexSection: nil,
+					types.AttrID:           "index",
+					types.AttrCustomID:     false,
+				},
+				Title: types.InlineElements{
+					types.StringElement{Content: "Index"},
+				},
+				Elements: []interface{}{},
+			}
+			expected := types.Document{
+				Attributes: types.DocumentAttributes{},
+				ElementReferences: types.ElementReferences{
+					"cats":  cats.Title,
+					"index": index.Title,
+				},
+				Footnotes:          types.Footnotes{},
+				FootnoteReferences: types.FootnoteReferences{},
+				IndexTerms:         types.IndexTerms{bigCats, lions},
+				Elements: []interface{}{
+					cats,
+					index,
+				},
+			}
+			Expect(source).To(EqualDocument(expected))
+		})
+
+		It("index terms in section titles", func() {
+			source := `== The ((Cats)) chapter(((felines)))`
+			cats := types.IndexTerm{
+				ID:      0,
+				Terms:   []string{"Cats"},
+				Visible: true,
+			}
+			felines := types.IndexTerm{
+				ID:      1,
+				Terms:   []string{"felines"},
+				Visible: false,
+			}
+			section := types.Section{
+				Level: 1,
+				Attributes: types.ElementAttributes{
+					types.AttrID:       "the_cats_chapter",
+					types.AttrCustomID: false,
+				},
+				Title: types.InlineElements{
+					types.StringElement{Content: "The "},
+					cats,
+					types.StringElement{Content: " chapter"},
+					felines,
+				},
+				Elements: []interface{}{},
+			}
+			expected := types.Document{
+				Attributes: types.DocumentAttributes{},
+				ElementReferences: types.ElementReferences{
+					"the_cats_chapter": section.Title,
+				},
+				Footnotes:          types.Footnotes{},
+				FootnoteReferences: types.FootnoteReferences{},
+				IndexTerms:         types.IndexTerms{cats, felines},
+				Elements: []interface{}{
+					section,
+				},
+			}
+			Expect(source).To(EqualDocument(expected))
+		})
+	})
+})
@@ -509,7 +509,8 @@ second term:: definition of the second term`
 			Blocks: []interface{}{
 				types.LabeledListItem{
 					Attributes: types.ElementAttributes{
-						types.AttrTitle: "Labeled, single-line",
+						types.AttrTitle:         "Labeled, single-line",
+						types.AttrTitlePosition: types.Position{Filename: "test.adoc", Line: 1, Column: 1},
 					},
 					Level: 1,
 					Term:  "first term",
@@ -558,7 +559,8 @@ level 1:: description 1`
 			Blocks: []interface{}{
 				types.LabeledListItem{
 					Attributes: types.ElementAttributes{
-						types.AttrTitle: "Labeled, max nesting",
+						types.AttrTitle:         "Labeled, max nesting",
+						types.AttrTitlePosition: types.Position{Filename: "test.adoc", Line: 1, Column: 1},
 					},
 					Level: 1,
 					Term:  "level 1",
@@ -641,7 +643,8 @@ level 2::: description 2`
 			Blocks: []interface{}{
 				types.LabeledListItem{
 					Attributes: types.ElementAttributes{
-						types.AttrTitle: "Labeled, max nesting",
+						types.AttrTitle:         "Labeled, max nesting",
+						types.AttrTitlePosition: types.Position{Filename: "test.adoc", Line: 1, Column: 1},
 					},
 					Level: 1,
 					Term:  "level 1",
@@ -1339,7 +1342,8 @@ second term:: definition of the second term`
 			Elements: []interface{}{
 				types.LabeledList{
 					Attributes: types.ElementAttributes{
-						types.AttrTitle: "Labeled, single-line",
+						types.AttrTitle:         "Labeled, single-line",
+						types.AttrTitlePosition: types.Position{Line: 1, Column: 1},
 					},
 					Items: []types.LabeledListItem{
 						{
@@ -1397,7 +1401,8 @@ level 1:: description 1`
 			Elements: []interface{}{
 				types.LabeledList{
 					Attributes: types.ElementAttributes{
-						types.AttrTitle: "Labeled, max nesting",
+						types.AttrTitle:         "Labeled, max nesting",
+						types.AttrTitlePosition: types.Position{Line: 1, Column: 1},
 					},
 					Items: []types.LabeledListItem{
 						{
@@ -1499,7 +1504,8 @@ level 2::: description 2`
 			Elements: []interface{}{
 				types.LabeledList{
 					Attributes: types.ElementAttributes{
-						types.AttrTitle: "Labeled, max nesting",
+						types.AttrTitle:         "Labeled, max nesting",
+						types.AttrTitlePosition: types.Position{Line: 1, Column: 1},
 					},
 					Items: []types.LabeledListItem{
 						{
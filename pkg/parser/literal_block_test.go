@@ -59,6 +59,7 @@ a normal paragraph.`
 							types.AttrID:               "ID",
 							types.AttrCustomID:         true,
 							types.AttrTitle:            "title",
+							types.AttrTitlePosition:    types.Position{Filename: "test.adoc", Line: 1, Column: 1},
 						},
 						Lines: []string{
 							"  some literal content",
@@ -116,6 +117,7 @@ a normal paragraph.`
 							types.AttrID:               "ID",
 							types.AttrCustomID:         true,
 							types.AttrTitle:            "title",
+							types.AttrTitlePosition:    types.Position{Filename: "test.adoc", Line: 2, Column: 1},
 						},
 						Lines: []string{
 							"some literal content",
@@ -183,6 +185,7 @@ a normal paragraph.`
 							types.AttrID:               "ID",
 							types.AttrCustomID:         true,
 							types.AttrTitle:            "title",
+							types.AttrTitlePosition:    types.Position{Filename: "test.adoc", Line: 3, Column: 1},
 							types.AttrLiteralBlockType: types.LiteralBlockWithAttribute,
 						},
 						Lines: []string{
@@ -0,0 +1,93 @@
+package parser_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("videos and audios", func() {
+
+	Context("video blocks", func() {
+
+		It("video with empty attributes", func() {
+			source := "video::videos/demo.mp4[]"
+			expected := types.VideoBlock{
+				Attributes: types.ElementAttributes{},
+				Path:       "videos/demo.mp4",
+			}
+			Expect(source).To(EqualDocumentBlock(expected))
+		})
+
+		It("video with named attributes and options", func() {
+			source := `video::demo.mp4[width=640,start=10,end=30,options="autoplay,loop"]`
+			expected := types.VideoBlock{
+				Attributes: types.ElementAttributes{
+					types.AttrVideoWidth:    "640",
+					types.AttrMediaStart:    "10",
+					types.AttrMediaEnd:      "30",
+					types.AttrMediaAutoplay: nil,
+					types.AttrMediaLoop:     nil,
+				},
+				Path: "demo.mp4",
+			}
+			Expect(source).To(EqualDocumentBlock(expected))
+		})
+
+		It("youtube video with positional attributes", func() {
+			source := "video::rPQoq7ThGAU[youtube,640,360]"
+			expected := types.VideoBlock{
+				Attributes: types.ElementAttributes{
+					types.AttrVideoPoster: "youtube",
+					types.AttrVideoWidth:  "640",
+					types.AttrVideoHeight: "360",
+				},
+				Path: "rPQoq7ThGAU",
+			}
+			Expect(source).To(EqualDocumentBlock(expected))
+		})
+
+		It("video with id, title and role", func() {
+			source := `[#demo]
+[.product]
+.Product demo
+video::https://vimeo.com/67480300[]`
+			expected := types.VideoBlock{
+				Attributes: types.ElementAttributes{
+					types.AttrID:       "demo",
+					types.AttrCustomID: true,
+					types.AttrRole:     "product",
+					types.AttrTitle:    "Product demo",
+				},
+				Path: "https://vimeo.com/67480300",
+			}
+			Expect(source).To(EqualDocumentBlock(expected))
+		})
+	})
+
+	Context("audio blocks", func() {
+
+		It("audio with empty attributes", func() {
+			source := "audio::clip.mp3[]"
+			expected := types.AudioBlock{
+				Attributes: types.ElementAttributes{},
+				Path:       "clip.mp3",
+			}
+			Expect(source).To(EqualDocumentBlock(expected))
+		})
+
+		It("audio with attributes and options", func() {
+			source := "audio::clip.mp3[start=5,opts=nocontrols]"
+			expected := types.AudioBlock{
+				Attributes: types.ElementAttributes{
+					types.AttrMediaStart:      "5",
+					types.AttrMediaNoControls: nil,
+				},
+				Path: "clip.mp3",
+			}
+			Expect(source).To(EqualDocumentBlock(expected))
+		})
+	})
+})
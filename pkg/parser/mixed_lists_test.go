@@ -793,7 +793,8 @@ Operating Systems::
 				Elements: []interface{}{
 					types.LabeledList{
 						Attributes: types.ElementAttributes{
-							types.AttrTitle: "Mixed",
+							types.AttrTitle:         "Mixed",
+							types.AttrTitlePosition: types.Position{Line: 1, Column: 1},
 						},
 						Items: []types.LabeledListItem{
 							{
@@ -886,7 +887,8 @@ a paragraph
 				Elements: []interface{}{
 					types.LabeledList{
 						Attributes: types.ElementAttributes{
-							types.AttrTitle: "Mixed",
+							types.AttrTitle:         "Mixed",
+							types.AttrTitlePosition: types.Position{Line: 1, Column: 1},
 						},
 						Items: []types.LabeledListItem{
 							{
@@ -1470,7 +1472,8 @@ a paragraph
 				Elements: []interface{}{
 					types.UnorderedList{
 						Attributes: types.ElementAttributes{
-							types.AttrTitle: "Checklist",
+							types.AttrTitle:         "Checklist",
+							types.AttrTitlePosition: types.Position{Line: 1, Column: 1},
 						},
 						Items: []types.UnorderedListItem{
 							{
@@ -1515,7 +1518,8 @@ a paragraph
 					},
 					types.OrderedList{
 						Attributes: types.ElementAttributes{
-							types.AttrTitle: "Ordered, basic",
+							types.AttrTitle:         "Ordered, basic",
+							types.AttrTitlePosition: types.Position{Line: 5, Column: 1},
 						},
 						Items: []types.OrderedListItem{
 							{
@@ -190,7 +190,8 @@ var _ = Describe("ordered lists - preflight", func() {
 						Level:          1,
 						NumberingStyle: types.Arabic,
 						Attributes: types.ElementAttributes{
-							types.AttrTitle: "Ordered, max nesting",
+							types.AttrTitle:         "Ordered, max nesting",
+							types.AttrTitlePosition: types.Position{Filename: "test.adoc", Line: 1, Column: 1},
 						},
 						Elements: []interface{}{
 							types.Paragraph{
@@ -309,7 +310,8 @@ var _ = Describe("ordered lists - preflight", func() {
 						Level:          1,
 						NumberingStyle: types.Arabic,
 						Attributes: types.ElementAttributes{
-							types.AttrTitle: "Ordered, max nesting",
+							types.AttrTitle:         "Ordered, max nesting",
+							types.AttrTitlePosition: types.Position{Filename: "test.adoc", Line: 1, Column: 1},
 						},
 						Elements: []interface{}{
 							types.Paragraph{
@@ -1202,7 +1204,8 @@ var _ = Describe("ordered lists - document", func() {
 				Elements: []interface{}{
 					types.OrderedList{
 						Attributes: types.ElementAttributes{
-							types.AttrTitle: "Ordered, max nesting",
+							types.AttrTitle:         "Ordered, max nesting",
+							types.AttrTitlePosition: types.Position{Line: 1, Column: 1},
 						},
 						Items: []types.OrderedListItem{
 							{
@@ -1350,7 +1353,8 @@ var _ = Describe("ordered lists - document", func() {
 				Elements: []interface{}{
 					types.OrderedList{
 						Attributes: types.ElementAttributes{
-							types.AttrTitle: "Ordered, max nesting",
+							types.AttrTitle:         "Ordered, max nesting",
+							types.AttrTitlePosition: types.Position{Line: 1, Column: 1},
 						},
 						Items: []types.OrderedListItem{
 							{
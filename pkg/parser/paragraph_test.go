@@ -151,6 +151,46 @@ baz`
 			Expect(source).To(EqualDocumentBlock(expected))
 		})
 
+		It("with paragraph options", func() {
+			source := `[options="hardbreaks"]
+foo
+bar`
+			expected := types.Paragraph{
+				Attributes: types.ElementAttributes{
+					types.AttrHardBreaks: nil,
+				},
+				Lines: []types.InlineElements{
+					{
+						types.StringElement{Content: "foo"},
+					},
+					{
+						types.StringElement{Content: "bar"},
+					},
+				},
+			}
+			Expect(source).To(EqualDocumentBlock(expected))
+		})
+
+		It("with paragraph opts", func() {
+			source := `[opts=hardbreaks]
+foo
+bar`
+			expected := types.Paragraph{
+				Attributes: types.ElementAttributes{
+					types.AttrHardBreaks: nil,
+				},
+				Lines: []types.InlineElements{
+					{
+						types.StringElement{Content: "foo"},
+					},
+					{
+						types.StringElement{Content: "bar"},
+					},
+				},
+			}
+			Expect(source).To(EqualDocumentBlock(expected))
+		})
+
 		It("with paragraph attribute", func() {
 			source := `[%hardbreaks]
 foo
@@ -640,4 +680,119 @@ image::foo.png[]`
 			Expect(source).To(EqualDocumentBlock(expected)) //, parser.Debug(true))
 		})
 	})
+
+	Context("paragraphs with custom substitutions", func() {
+
+		It("paragraph with substitutions attribute", func() {
+			source := `[subs="+attributes,-quotes"]
+a *paragraph* with {foo} +
+// a comment
+on 2 lines`
+			expected := types.Paragraph{
+				Attributes: types.ElementAttributes{
+					types.AttrSubstitutions: "+attributes,-quotes",
+				},
+				Lines: []types.InlineElements{
+					{
+						types.StringElement{Content: "a *paragraph* with {foo} +"},
+					},
+					{
+						types.SingleLineComment{Content: " a comment"},
+					},
+					{
+						types.StringElement{Content: "on 2 lines"},
+					},
+				},
+			}
+			Expect(source).To(EqualDocumentBlock(expected))
+		})
+
+		It("source paragraph with substitutions attribute", func() {
+			source := `[source,go,subs="quotes"]
+*package* main`
+			expected := types.Paragraph{
+				Attributes: types.ElementAttributes{
+					types.AttrKind:          types.Source,
+					types.AttrLanguage:      "go",
+					types.AttrSubstitutions: "quotes",
+				},
+				Lines: []types.InlineElements{
+					{
+						types.StringElement{Content: "*package* main"},
+					},
+				},
+			}
+			Expect(source).To(EqualDocumentBlock(expected))
+		})
+
+		It("literal paragraph with substitutions attribute", func() {
+			source := `[subs=quotes]
+  some *literal* content`
+			expected := types.LiteralBlock{
+				Attributes: types.ElementAttributes{
+					types.AttrKind:             types.Literal,
+					types.AttrLiteralBlockType: types.LiteralBlockWithSpacesOnFirstLine,
+					types.AttrSubstitutions:    "quotes",
+				},
+				Lines: []string{
+					"  some *literal* content",
+				},
+			}
+			Expect(source).To(EqualDocumentBlock(expected))
+		})
+
+		It("admonition paragraph with substitutions attribute", func() {
+			source := `[subs=quotes]
+NOTE: a *note* with {foo}`
+			expected := types.Paragraph{
+				Attributes: types.ElementAttributes{
+					types.AttrAdmonitionKind: types.Note,
+					types.AttrSubstitutions:  "quotes",
+				},
+				Lines: []types.InlineElements{
+					{
+						types.StringElement{Content: "a *note* with {foo}"},
+					},
+				},
+			}
+			Expect(source).To(EqualDocumentBlock(expected))
+		})
+
+		It("list item with substitutions attribute", func() {
+			source := `[subs=normal]
+* an item`
+			expected := types.UnorderedListItem{
+				Attributes: types.ElementAttributes{
+					types.AttrSubstitutions: "normal",
+				},
+				Level:       1,
+				BulletStyle: types.OneAsterisk,
+				CheckStyle:  types.NoCheck,
+				Elements: []interface{}{
+					types.Paragraph{
+						Attributes: types.ElementAttributes{},
+						Lines: []types.InlineElements{
+							{
+								types.StringElement{Content: "an item"},
+							},
+						},
+					},
+				},
+			}
+			Expect(source).To(EqualDocumentBlock(expected))
+		})
+
+		It("image block with substitutions attribute", func() {
+			source := `[subs=quotes]
+image::cat.png[]`
+			expected := types.ImageBlock{
+				Attributes: types.ElementAttributes{
+					types.AttrImageAlt:      "cat",
+					types.AttrSubstitutions: "quotes",
+				},
+				Path: "cat.png",
+			}
+			Expect(source).To(EqualDocumentBlock(expected))
+		})
+	})
 })
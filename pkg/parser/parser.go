@@ -23,34 +23,34 @@ var g = &grammar{
 	rules: []*rule{
 		{
 			name: "PreflightDocument",
-			pos:  position{line: 17, col: 1, offset: 290},
+			pos:  position{line: 18, col: 1, offset: 304},
 			expr: &actionExpr{
-				pos: position{line: 17, col: 22, offset: 311},
+				pos: position{line: 18, col: 22, offset: 325},
 				run: (*parser).callonPreflightDocument1,
 				expr: &seqExpr{
-					pos: position{line: 17, col: 22, offset: 311},
+					pos: position{line: 18, col: 22, offset: 325},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 17, col: 22, offset: 311},
+							pos:   position{line: 18, col: 22, offset: 325},
 							label: "frontmatter",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 17, col: 35, offset: 324},
+								pos: position{line: 18, col: 35, offset: 338},
 								expr: &ruleRefExpr{
-									pos:  position{line: 17, col: 35, offset: 324},
+									pos:  position{line: 18, col: 35, offset: 338},
 									name: "FrontMatter",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 17, col: 49, offset: 338},
+							pos:   position{line: 18, col: 49, offset: 352},
 							label: "blocks",
 							expr: &ruleRefExpr{
-								pos:  position{line: 17, col: 57, offset: 346},
+								pos:  position{line: 18, col: 57, offset: 360},
 								name: "DocumentBlocks",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 17, col: 73, offset: 362},
+							pos:  position{line: 18, col: 73, offset: 376},
 							name: "EOF",
 						},
 					},
@@ -59,34 +59,34 @@ var g = &grammar{
 		},
 		{
 			name: "PreflightDocumentWithinDelimitedBlock",
-			pos:  position{line: 21, col: 1, offset: 446},
+			pos:  position{line: 22, col: 1, offset: 460},
 			expr: &actionExpr{
-				pos: position{line: 21, col: 42, offset: 487},
+				pos: position{line: 22, col: 42, offset: 501},
 				run: (*parser).callonPreflightDocumentWithinDelimitedBlock1,
 				expr: &seqExpr{
-					pos: position{line: 21, col: 42, offset: 487},
+					pos: position{line: 22, col: 42, offset: 501},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 21, col: 42, offset: 487},
+							pos:   position{line: 22, col: 42, offset: 501},
 							label: "frontmatter",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 21, col: 55, offset: 500},
+								pos: position{line: 22, col: 55, offset: 514},
 								expr: &ruleRefExpr{
-									pos:  position{line: 21, col: 55, offset: 500},
+									pos:  position{line: 22, col: 55, offset: 514},
 									name: "FrontMatter",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 21, col: 69, offset: 514},
+							pos:   position{line: 22, col: 69, offset: 528},
 							label: "blocks",
 							expr: &ruleRefExpr{
-								pos:  position{line: 21, col: 77, offset: 522},
+								pos:  position{line: 22, col: 77, offset: 536},
 								name: "DocumentBlocksWithinDelimitedBlock",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 21, col: 113, offset: 558},
+							pos:  position{line: 22, col: 113, offset: 572},
 							name: "EOF",
 						},
 					},
@@ -95,31 +95,31 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentBlocks",
-			pos:  position{line: 28, col: 1, offset: 753},
+			pos:  position{line: 29, col: 1, offset: 767},
 			expr: &actionExpr{
-				pos: position{line: 28, col: 19, offset: 771},
+				pos: position{line: 29, col: 19, offset: 785},
 				run: (*parser).callonDocumentBlocks1,
 				expr: &seqExpr{
-					pos: position{line: 28, col: 19, offset: 771},
+					pos: position{line: 29, col: 19, offset: 785},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 28, col: 19, offset: 771},
+							pos:   position{line: 29, col: 19, offset: 785},
 							label: "header",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 28, col: 27, offset: 779},
+								pos: position{line: 29, col: 27, offset: 793},
 								expr: &ruleRefExpr{
-									pos:  position{line: 28, col: 27, offset: 779},
+									pos:  position{line: 29, col: 27, offset: 793},
 									name: "DocumentHeader",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 28, col: 44, offset: 796},
+							pos:   position{line: 29, col: 44, offset: 810},
 							label: "others",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 28, col: 52, offset: 804},
+								pos: position{line: 29, col: 52, offset: 818},
 								expr: &ruleRefExpr{
-									pos:  position{line: 28, col: 52, offset: 804},
+									pos:  position{line: 29, col: 52, offset: 818},
 									name: "DocumentBlock",
 								},
 							},
@@ -130,80 +130,104 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentBlock",
-			pos:  position{line: 37, col: 1, offset: 1053},
+			pos:  position{line: 38, col: 1, offset: 1067},
 			expr: &actionExpr{
-				pos: position{line: 37, col: 18, offset: 1070},
+				pos: position{line: 38, col: 18, offset: 1084},
 				run: (*parser).callonDocumentBlock1,
 				expr: &seqExpr{
-					pos: position{line: 37, col: 18, offset: 1070},
+					pos: position{line: 38, col: 18, offset: 1084},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 37, col: 18, offset: 1070},
+							pos: position{line: 38, col: 18, offset: 1084},
 							expr: &ruleRefExpr{
-								pos:  position{line: 37, col: 19, offset: 1071},
+								pos:  position{line: 38, col: 19, offset: 1085},
 								name: "EOF",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 38, col: 5, offset: 1080},
+							pos:   position{line: 39, col: 5, offset: 1094},
 							label: "block",
 							expr: &choiceExpr{
-								pos: position{line: 38, col: 12, offset: 1087},
+								pos: position{line: 39, col: 12, offset: 1101},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 38, col: 12, offset: 1087},
+										pos:  position{line: 39, col: 12, offset: 1101},
+										name: "ParagraphWithSubstitutions",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 40, col: 11, offset: 1172},
 										name: "SimpleParagraph",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 39, col: 11, offset: 1113},
+										pos:  position{line: 41, col: 11, offset: 1198},
+										name: "DiscreteHeading",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 42, col: 11, offset: 1250},
 										name: "Section",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 40, col: 11, offset: 1132},
+										pos:  position{line: 43, col: 11, offset: 1269},
+										name: "ThematicBreak",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 44, col: 11, offset: 1339},
+										name: "PageBreak",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 45, col: 11, offset: 1359},
 										name: "DelimitedBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 41, col: 11, offset: 1157},
+										pos:  position{line: 46, col: 11, offset: 1384},
 										name: "FileInclusion",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 42, col: 11, offset: 1181},
+										pos:  position{line: 47, col: 11, offset: 1408},
 										name: "VerseParagraph",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 43, col: 11, offset: 1235},
+										pos:  position{line: 48, col: 11, offset: 1462},
 										name: "ImageBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 44, col: 11, offset: 1257},
+										pos:  position{line: 49, col: 11, offset: 1484},
+										name: "VideoBlock",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 50, col: 11, offset: 1505},
+										name: "AudioBlock",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 51, col: 11, offset: 1526},
 										name: "ListItem",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 45, col: 11, offset: 1276},
+										pos:  position{line: 52, col: 11, offset: 1545},
 										name: "BlankLine",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 46, col: 11, offset: 1327},
+										pos:  position{line: 53, col: 11, offset: 1596},
 										name: "LiteralBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 47, col: 11, offset: 1351},
+										pos:  position{line: 54, col: 11, offset: 1620},
 										name: "DocumentAttributeDeclaration",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 48, col: 11, offset: 1391},
+										pos:  position{line: 55, col: 11, offset: 1660},
 										name: "DocumentAttributeReset",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 49, col: 11, offset: 1425},
+										pos:  position{line: 56, col: 11, offset: 1694},
 										name: "TableOfContentsMacro",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 50, col: 11, offset: 1456},
+										pos:  position{line: 57, col: 11, offset: 1725},
 										name: "UserMacroBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 51, col: 11, offset: 1481},
+										pos:  position{line: 58, col: 11, offset: 1750},
 										name: "Paragraph",
 									},
 								},
@@ -215,14 +239,14 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentBlocksWithinDelimitedBlock",
-			pos:  position{line: 55, col: 1, offset: 1519},
+			pos:  position{line: 62, col: 1, offset: 1788},
 			expr: &labeledExpr{
-				pos:   position{line: 55, col: 39, offset: 1557},
+				pos:   position{line: 62, col: 39, offset: 1826},
 				label: "blocks",
 				expr: &zeroOrMoreExpr{
-					pos: position{line: 55, col: 46, offset: 1564},
+					pos: position{line: 62, col: 46, offset: 1833},
 					expr: &ruleRefExpr{
-						pos:  position{line: 55, col: 47, offset: 1565},
+						pos:  position{line: 62, col: 47, offset: 1834},
 						name: "DocumentBlockWithinDelimitedBlock",
 					},
 				},
@@ -230,72 +254,96 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentBlockWithinDelimitedBlock",
-			pos:  position{line: 57, col: 1, offset: 1602},
+			pos:  position{line: 64, col: 1, offset: 1871},
 			expr: &actionExpr{
-				pos: position{line: 57, col: 38, offset: 1639},
+				pos: position{line: 64, col: 38, offset: 1908},
 				run: (*parser).callonDocumentBlockWithinDelimitedBlock1,
 				expr: &seqExpr{
-					pos: position{line: 57, col: 38, offset: 1639},
+					pos: position{line: 64, col: 38, offset: 1908},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 57, col: 38, offset: 1639},
+							pos: position{line: 64, col: 38, offset: 1908},
 							expr: &ruleRefExpr{
-								pos:  position{line: 57, col: 39, offset: 1640},
+								pos:  position{line: 64, col: 39, offset: 1909},
 								name: "EOF",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 58, col: 5, offset: 1649},
+							pos:   position{line: 65, col: 5, offset: 1918},
 							label: "block",
 							expr: &choiceExpr{
-								pos: position{line: 58, col: 12, offset: 1656},
+								pos: position{line: 65, col: 12, offset: 1925},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 58, col: 12, offset: 1656},
+										pos:  position{line: 65, col: 12, offset: 1925},
+										name: "DiscreteHeading",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 66, col: 11, offset: 1951},
+										name: "ThematicBreak",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 67, col: 11, offset: 2021},
+										name: "PageBreak",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 68, col: 11, offset: 2041},
 										name: "DelimitedBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 59, col: 11, offset: 1681},
+										pos:  position{line: 69, col: 11, offset: 2066},
 										name: "FileInclusion",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 60, col: 11, offset: 1705},
+										pos:  position{line: 70, col: 11, offset: 2090},
+										name: "ParagraphWithSubstitutions",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 71, col: 11, offset: 2160},
 										name: "VerseParagraph",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 61, col: 11, offset: 1730},
+										pos:  position{line: 72, col: 11, offset: 2185},
 										name: "ImageBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 62, col: 11, offset: 1752},
+										pos:  position{line: 73, col: 11, offset: 2207},
+										name: "VideoBlock",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 74, col: 11, offset: 2228},
+										name: "AudioBlock",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 75, col: 11, offset: 2249},
 										name: "ListItem",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 63, col: 11, offset: 1771},
+										pos:  position{line: 76, col: 11, offset: 2268},
 										name: "BlankLine",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 64, col: 11, offset: 1822},
+										pos:  position{line: 77, col: 11, offset: 2319},
 										name: "LiteralBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 65, col: 11, offset: 1846},
+										pos:  position{line: 78, col: 11, offset: 2343},
 										name: "DocumentAttributeDeclaration",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 66, col: 11, offset: 1886},
+										pos:  position{line: 79, col: 11, offset: 2383},
 										name: "DocumentAttributeReset",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 67, col: 11, offset: 1920},
+										pos:  position{line: 80, col: 11, offset: 2417},
 										name: "TableOfContentsMacro",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 68, col: 11, offset: 1951},
+										pos:  position{line: 81, col: 11, offset: 2448},
 										name: "UserMacroBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 69, col: 11, offset: 1976},
+										pos:  position{line: 82, col: 11, offset: 2473},
 										name: "Paragraph",
 									},
 								},
@@ -307,38 +355,38 @@ var g = &grammar{
 		},
 		{
 			name: "FrontMatter",
-			pos:  position{line: 76, col: 1, offset: 2122},
+			pos:  position{line: 89, col: 1, offset: 2619},
 			expr: &ruleRefExpr{
-				pos:  position{line: 76, col: 16, offset: 2137},
+				pos:  position{line: 89, col: 16, offset: 2634},
 				name: "YamlFrontMatter",
 			},
 		},
 		{
 			name: "YamlFrontMatter",
-			pos:  position{line: 78, col: 1, offset: 2155},
+			pos:  position{line: 91, col: 1, offset: 2652},
 			expr: &actionExpr{
-				pos: position{line: 78, col: 20, offset: 2174},
+				pos: position{line: 91, col: 20, offset: 2671},
 				run: (*parser).callonYamlFrontMatter1,
 				expr: &seqExpr{
-					pos: position{line: 78, col: 20, offset: 2174},
+					pos: position{line: 91, col: 20, offset: 2671},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 78, col: 20, offset: 2174},
+							pos:  position{line: 91, col: 20, offset: 2671},
 							name: "YamlFrontMatterToken",
 						},
 						&labeledExpr{
-							pos:   position{line: 78, col: 41, offset: 2195},
+							pos:   position{line: 91, col: 41, offset: 2692},
 							label: "content",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 78, col: 49, offset: 2203},
+								pos: position{line: 91, col: 49, offset: 2700},
 								expr: &ruleRefExpr{
-									pos:  position{line: 78, col: 50, offset: 2204},
+									pos:  position{line: 91, col: 50, offset: 2701},
 									name: "YamlFrontMatterContent",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 78, col: 75, offset: 2229},
+							pos:  position{line: 91, col: 75, offset: 2726},
 							name: "YamlFrontMatterToken",
 						},
 					},
@@ -347,17 +395,17 @@ var g = &grammar{
 		},
 		{
 			name: "YamlFrontMatterToken",
-			pos:  position{line: 82, col: 1, offset: 2309},
+			pos:  position{line: 95, col: 1, offset: 2806},
 			expr: &seqExpr{
-				pos: position{line: 82, col: 26, offset: 2334},
+				pos: position{line: 95, col: 26, offset: 2831},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 82, col: 26, offset: 2334},
+						pos:        position{line: 95, col: 26, offset: 2831},
 						val:        "---",
 						ignoreCase: false,
 					},
 					&ruleRefExpr{
-						pos:  position{line: 82, col: 32, offset: 2340},
+						pos:  position{line: 95, col: 32, offset: 2837},
 						name: "EOLS",
 					},
 				},
@@ -365,26 +413,26 @@ var g = &grammar{
 		},
 		{
 			name: "YamlFrontMatterContent",
-			pos:  position{line: 84, col: 1, offset: 2346},
+			pos:  position{line: 97, col: 1, offset: 2843},
 			expr: &actionExpr{
-				pos: position{line: 84, col: 27, offset: 2372},
+				pos: position{line: 97, col: 27, offset: 2869},
 				run: (*parser).callonYamlFrontMatterContent1,
 				expr: &zeroOrMoreExpr{
-					pos: position{line: 84, col: 27, offset: 2372},
+					pos: position{line: 97, col: 27, offset: 2869},
 					expr: &oneOrMoreExpr{
-						pos: position{line: 84, col: 28, offset: 2373},
+						pos: position{line: 97, col: 28, offset: 2870},
 						expr: &seqExpr{
-							pos: position{line: 84, col: 29, offset: 2374},
+							pos: position{line: 97, col: 29, offset: 2871},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 84, col: 29, offset: 2374},
+									pos: position{line: 97, col: 29, offset: 2871},
 									expr: &ruleRefExpr{
-										pos:  position{line: 84, col: 30, offset: 2375},
+										pos:  position{line: 97, col: 30, offset: 2872},
 										name: "YamlFrontMatterToken",
 									},
 								},
 								&anyMatcher{
-									line: 84, col: 51, offset: 2396,
+									line: 97, col: 51, offset: 2893,
 								},
 							},
 						},
@@ -394,66 +442,76 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentHeader",
-			pos:  position{line: 91, col: 1, offset: 2562},
+			pos:  position{line: 104, col: 1, offset: 3059},
 			expr: &actionExpr{
-				pos: position{line: 91, col: 19, offset: 2580},
+				pos: position{line: 104, col: 19, offset: 3077},
 				run: (*parser).callonDocumentHeader1,
 				expr: &seqExpr{
-					pos: position{line: 91, col: 19, offset: 2580},
+					pos: position{line: 104, col: 19, offset: 3077},
 					exprs: []interface{}{
-						&litMatcher{
-							pos:        position{line: 91, col: 19, offset: 2580},
-							val:        "=",
-							ignoreCase: false,
+						&choiceExpr{
+							pos: position{line: 104, col: 20, offset: 3078},
+							alternatives: []interface{}{
+								&litMatcher{
+									pos:        position{line: 104, col: 20, offset: 3078},
+									val:        "=",
+									ignoreCase: false,
+								},
+								&litMatcher{
+									pos:        position{line: 104, col: 26, offset: 3084},
+									val:        "#",
+									ignoreCase: false,
+								},
+							},
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 91, col: 23, offset: 2584},
+							pos: position{line: 104, col: 31, offset: 3089},
 							expr: &ruleRefExpr{
-								pos:  position{line: 91, col: 23, offset: 2584},
+								pos:  position{line: 104, col: 31, offset: 3089},
 								name: "WS",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 91, col: 27, offset: 2588},
+							pos:   position{line: 104, col: 35, offset: 3093},
 							label: "title",
 							expr: &ruleRefExpr{
-								pos:  position{line: 91, col: 34, offset: 2595},
+								pos:  position{line: 104, col: 42, offset: 3100},
 								name: "TitleElements",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 91, col: 49, offset: 2610},
+							pos:   position{line: 104, col: 57, offset: 3115},
 							label: "id",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 91, col: 53, offset: 2614},
+								pos: position{line: 104, col: 61, offset: 3119},
 								expr: &ruleRefExpr{
-									pos:  position{line: 91, col: 53, offset: 2614},
+									pos:  position{line: 104, col: 61, offset: 3119},
 									name: "InlineElementID",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 91, col: 71, offset: 2632},
+							pos:  position{line: 104, col: 79, offset: 3137},
 							name: "EOL",
 						},
 						&labeledExpr{
-							pos:   position{line: 92, col: 9, offset: 2644},
+							pos:   position{line: 105, col: 9, offset: 3149},
 							label: "authors",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 92, col: 18, offset: 2653},
+								pos: position{line: 105, col: 18, offset: 3158},
 								expr: &ruleRefExpr{
-									pos:  position{line: 92, col: 18, offset: 2653},
+									pos:  position{line: 105, col: 18, offset: 3158},
 									name: "DocumentAuthors",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 93, col: 9, offset: 2680},
+							pos:   position{line: 106, col: 9, offset: 3185},
 							label: "revision",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 93, col: 19, offset: 2690},
+								pos: position{line: 106, col: 19, offset: 3195},
 								expr: &ruleRefExpr{
-									pos:  position{line: 93, col: 19, offset: 2690},
+									pos:  position{line: 106, col: 19, offset: 3195},
 									name: "DocumentRevision",
 								},
 							},
@@ -464,16 +522,16 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAuthors",
-			pos:  position{line: 98, col: 1, offset: 2799},
+			pos:  position{line: 111, col: 1, offset: 3304},
 			expr: &choiceExpr{
-				pos: position{line: 98, col: 20, offset: 2818},
+				pos: position{line: 111, col: 20, offset: 3323},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 98, col: 20, offset: 2818},
+						pos:  position{line: 111, col: 20, offset: 3323},
 						name: "DocumentAuthorsInlineForm",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 98, col: 48, offset: 2846},
+						pos:  position{line: 111, col: 48, offset: 3351},
 						name: "DocumentAuthorsAttributeForm",
 					},
 				},
@@ -481,41 +539,41 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAuthorsInlineForm",
-			pos:  position{line: 100, col: 1, offset: 2876},
+			pos:  position{line: 113, col: 1, offset: 3381},
 			expr: &actionExpr{
-				pos: position{line: 100, col: 30, offset: 2905},
+				pos: position{line: 113, col: 30, offset: 3410},
 				run: (*parser).callonDocumentAuthorsInlineForm1,
 				expr: &seqExpr{
-					pos: position{line: 100, col: 30, offset: 2905},
+					pos: position{line: 113, col: 30, offset: 3410},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 100, col: 30, offset: 2905},
+							pos: position{line: 113, col: 30, offset: 3410},
 							expr: &ruleRefExpr{
-								pos:  position{line: 100, col: 30, offset: 2905},
+								pos:  position{line: 113, col: 30, offset: 3410},
 								name: "WS",
 							},
 						},
 						&notExpr{
-							pos: position{line: 100, col: 34, offset: 2909},
+							pos: position{line: 113, col: 34, offset: 3414},
 							expr: &litMatcher{
-								pos:        position{line: 100, col: 35, offset: 2910},
+								pos:        position{line: 113, col: 35, offset: 3415},
 								val:        ":",
 								ignoreCase: false,
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 100, col: 39, offset: 2914},
+							pos:   position{line: 113, col: 39, offset: 3419},
 							label: "authors",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 100, col: 48, offset: 2923},
+								pos: position{line: 113, col: 48, offset: 3428},
 								expr: &ruleRefExpr{
-									pos:  position{line: 100, col: 48, offset: 2923},
+									pos:  position{line: 113, col: 48, offset: 3428},
 									name: "DocumentAuthor",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 100, col: 65, offset: 2940},
+							pos:  position{line: 113, col: 65, offset: 3445},
 							name: "EOL",
 						},
 					},
@@ -524,35 +582,35 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAuthorsAttributeForm",
-			pos:  position{line: 104, col: 1, offset: 3010},
+			pos:  position{line: 117, col: 1, offset: 3515},
 			expr: &actionExpr{
-				pos: position{line: 104, col: 33, offset: 3042},
+				pos: position{line: 117, col: 33, offset: 3547},
 				run: (*parser).callonDocumentAuthorsAttributeForm1,
 				expr: &seqExpr{
-					pos: position{line: 104, col: 33, offset: 3042},
+					pos: position{line: 117, col: 33, offset: 3547},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 104, col: 33, offset: 3042},
+							pos: position{line: 117, col: 33, offset: 3547},
 							expr: &ruleRefExpr{
-								pos:  position{line: 104, col: 33, offset: 3042},
+								pos:  position{line: 117, col: 33, offset: 3547},
 								name: "WS",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 104, col: 37, offset: 3046},
+							pos:        position{line: 117, col: 37, offset: 3551},
 							val:        ":author:",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 104, col: 48, offset: 3057},
+							pos:   position{line: 117, col: 48, offset: 3562},
 							label: "author",
 							expr: &ruleRefExpr{
-								pos:  position{line: 104, col: 56, offset: 3065},
+								pos:  position{line: 117, col: 56, offset: 3570},
 								name: "DocumentAuthor",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 104, col: 72, offset: 3081},
+							pos:  position{line: 117, col: 72, offset: 3586},
 							name: "EOL",
 						},
 					},
@@ -561,58 +619,58 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAuthor",
-			pos:  position{line: 108, col: 1, offset: 3160},
+			pos:  position{line: 121, col: 1, offset: 3665},
 			expr: &actionExpr{
-				pos: position{line: 108, col: 19, offset: 3178},
+				pos: position{line: 121, col: 19, offset: 3683},
 				run: (*parser).callonDocumentAuthor1,
 				expr: &seqExpr{
-					pos: position{line: 108, col: 19, offset: 3178},
+					pos: position{line: 121, col: 19, offset: 3683},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 108, col: 19, offset: 3178},
+							pos: position{line: 121, col: 19, offset: 3683},
 							expr: &ruleRefExpr{
-								pos:  position{line: 108, col: 19, offset: 3178},
+								pos:  position{line: 121, col: 19, offset: 3683},
 								name: "WS",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 108, col: 23, offset: 3182},
+							pos:   position{line: 121, col: 23, offset: 3687},
 							label: "fullname",
 							expr: &ruleRefExpr{
-								pos:  position{line: 108, col: 33, offset: 3192},
+								pos:  position{line: 121, col: 33, offset: 3697},
 								name: "DocumentAuthorName",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 108, col: 53, offset: 3212},
+							pos:   position{line: 121, col: 53, offset: 3717},
 							label: "email",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 108, col: 59, offset: 3218},
+								pos: position{line: 121, col: 59, offset: 3723},
 								expr: &ruleRefExpr{
-									pos:  position{line: 108, col: 60, offset: 3219},
+									pos:  position{line: 121, col: 60, offset: 3724},
 									name: "DocumentAuthorEmail",
 								},
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 108, col: 82, offset: 3241},
+							pos: position{line: 121, col: 82, offset: 3746},
 							expr: &ruleRefExpr{
-								pos:  position{line: 108, col: 82, offset: 3241},
+								pos:  position{line: 121, col: 82, offset: 3746},
 								name: "WS",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 108, col: 86, offset: 3245},
+							pos: position{line: 121, col: 86, offset: 3750},
 							expr: &litMatcher{
-								pos:        position{line: 108, col: 86, offset: 3245},
+								pos:        position{line: 121, col: 86, offset: 3750},
 								val:        ";",
 								ignoreCase: false,
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 108, col: 91, offset: 3250},
+							pos: position{line: 121, col: 91, offset: 3755},
 							expr: &ruleRefExpr{
-								pos:  position{line: 108, col: 91, offset: 3250},
+								pos:  position{line: 121, col: 91, offset: 3755},
 								name: "WS",
 							},
 						},
@@ -622,47 +680,47 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAuthorName",
-			pos:  position{line: 113, col: 1, offset: 3392},
+			pos:  position{line: 126, col: 1, offset: 3897},
 			expr: &actionExpr{
-				pos: position{line: 113, col: 23, offset: 3414},
+				pos: position{line: 126, col: 23, offset: 3919},
 				run: (*parser).callonDocumentAuthorName1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 113, col: 23, offset: 3414},
+					pos: position{line: 126, col: 23, offset: 3919},
 					expr: &choiceExpr{
-						pos: position{line: 113, col: 24, offset: 3415},
+						pos: position{line: 126, col: 24, offset: 3920},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 113, col: 24, offset: 3415},
+								pos:  position{line: 126, col: 24, offset: 3920},
 								name: "Alphanums",
 							},
 							&seqExpr{
-								pos: position{line: 113, col: 37, offset: 3428},
+								pos: position{line: 126, col: 37, offset: 3933},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 113, col: 37, offset: 3428},
+										pos: position{line: 126, col: 37, offset: 3933},
 										expr: &litMatcher{
-											pos:        position{line: 113, col: 38, offset: 3429},
+											pos:        position{line: 126, col: 38, offset: 3934},
 											val:        "<",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 113, col: 42, offset: 3433},
+										pos: position{line: 126, col: 42, offset: 3938},
 										expr: &litMatcher{
-											pos:        position{line: 113, col: 43, offset: 3434},
+											pos:        position{line: 126, col: 43, offset: 3939},
 											val:        ";",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 113, col: 47, offset: 3438},
+										pos: position{line: 126, col: 47, offset: 3943},
 										expr: &ruleRefExpr{
-											pos:  position{line: 113, col: 48, offset: 3439},
+											pos:  position{line: 126, col: 48, offset: 3944},
 											name: "NEWLINE",
 										},
 									},
 									&anyMatcher{
-										line: 113, col: 56, offset: 3447,
+										line: 126, col: 56, offset: 3952,
 									},
 								},
 							},
@@ -673,53 +731,53 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAuthorEmail",
-			pos:  position{line: 117, col: 1, offset: 3488},
+			pos:  position{line: 130, col: 1, offset: 3993},
 			expr: &actionExpr{
-				pos: position{line: 117, col: 24, offset: 3511},
+				pos: position{line: 130, col: 24, offset: 4016},
 				run: (*parser).callonDocumentAuthorEmail1,
 				expr: &seqExpr{
-					pos: position{line: 117, col: 24, offset: 3511},
+					pos: position{line: 130, col: 24, offset: 4016},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 117, col: 24, offset: 3511},
+							pos:        position{line: 130, col: 24, offset: 4016},
 							val:        "<",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 117, col: 28, offset: 3515},
+							pos:   position{line: 130, col: 28, offset: 4020},
 							label: "email",
 							expr: &actionExpr{
-								pos: position{line: 117, col: 35, offset: 3522},
+								pos: position{line: 130, col: 35, offset: 4027},
 								run: (*parser).callonDocumentAuthorEmail5,
 								expr: &oneOrMoreExpr{
-									pos: position{line: 117, col: 35, offset: 3522},
+									pos: position{line: 130, col: 35, offset: 4027},
 									expr: &choiceExpr{
-										pos: position{line: 117, col: 36, offset: 3523},
+										pos: position{line: 130, col: 36, offset: 4028},
 										alternatives: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 117, col: 36, offset: 3523},
+												pos:  position{line: 130, col: 36, offset: 4028},
 												name: "Alphanums",
 											},
 											&seqExpr{
-												pos: position{line: 117, col: 49, offset: 3536},
+												pos: position{line: 130, col: 49, offset: 4041},
 												exprs: []interface{}{
 													&notExpr{
-														pos: position{line: 117, col: 49, offset: 3536},
+														pos: position{line: 130, col: 49, offset: 4041},
 														expr: &litMatcher{
-															pos:        position{line: 117, col: 50, offset: 3537},
+															pos:        position{line: 130, col: 50, offset: 4042},
 															val:        ">",
 															ignoreCase: false,
 														},
 													},
 													&notExpr{
-														pos: position{line: 117, col: 54, offset: 3541},
+														pos: position{line: 130, col: 54, offset: 4046},
 														expr: &ruleRefExpr{
-															pos:  position{line: 117, col: 55, offset: 3542},
+															pos:  position{line: 130, col: 55, offset: 4047},
 															name: "EOL",
 														},
 													},
 													&anyMatcher{
-														line: 117, col: 60, offset: 3547,
+														line: 130, col: 60, offset: 4052,
 													},
 												},
 											},
@@ -729,7 +787,7 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 119, col: 4, offset: 3588},
+							pos:        position{line: 132, col: 4, offset: 4093},
 							val:        ">",
 							ignoreCase: false,
 						},
@@ -739,82 +797,82 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentRevision",
-			pos:  position{line: 125, col: 1, offset: 3749},
+			pos:  position{line: 138, col: 1, offset: 4254},
 			expr: &actionExpr{
-				pos: position{line: 125, col: 21, offset: 3769},
+				pos: position{line: 138, col: 21, offset: 4274},
 				run: (*parser).callonDocumentRevision1,
 				expr: &seqExpr{
-					pos: position{line: 125, col: 21, offset: 3769},
+					pos: position{line: 138, col: 21, offset: 4274},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 125, col: 21, offset: 3769},
+							pos: position{line: 138, col: 21, offset: 4274},
 							expr: &ruleRefExpr{
-								pos:  position{line: 125, col: 21, offset: 3769},
+								pos:  position{line: 138, col: 21, offset: 4274},
 								name: "WS",
 							},
 						},
 						&notExpr{
-							pos: position{line: 125, col: 25, offset: 3773},
+							pos: position{line: 138, col: 25, offset: 4278},
 							expr: &litMatcher{
-								pos:        position{line: 125, col: 26, offset: 3774},
+								pos:        position{line: 138, col: 26, offset: 4279},
 								val:        ":",
 								ignoreCase: false,
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 125, col: 30, offset: 3778},
+							pos:   position{line: 138, col: 30, offset: 4283},
 							label: "revision",
 							expr: &choiceExpr{
-								pos: position{line: 126, col: 9, offset: 3797},
+								pos: position{line: 139, col: 9, offset: 4302},
 								alternatives: []interface{}{
 									&actionExpr{
-										pos: position{line: 126, col: 10, offset: 3798},
+										pos: position{line: 139, col: 10, offset: 4303},
 										run: (*parser).callonDocumentRevision9,
 										expr: &seqExpr{
-											pos: position{line: 126, col: 10, offset: 3798},
+											pos: position{line: 139, col: 10, offset: 4303},
 											exprs: []interface{}{
 												&labeledExpr{
-													pos:   position{line: 126, col: 10, offset: 3798},
+													pos:   position{line: 139, col: 10, offset: 4303},
 													label: "revnumber",
 													expr: &ruleRefExpr{
-														pos:  position{line: 126, col: 21, offset: 3809},
+														pos:  position{line: 139, col: 21, offset: 4314},
 														name: "DocumentRevisionNumber",
 													},
 												},
 												&zeroOrOneExpr{
-													pos: position{line: 126, col: 45, offset: 3833},
+													pos: position{line: 139, col: 45, offset: 4338},
 													expr: &litMatcher{
-														pos:        position{line: 126, col: 45, offset: 3833},
+														pos:        position{line: 139, col: 45, offset: 4338},
 														val:        ",",
 														ignoreCase: false,
 													},
 												},
 												&labeledExpr{
-													pos:   position{line: 126, col: 50, offset: 3838},
+													pos:   position{line: 139, col: 50, offset: 4343},
 													label: "revdate",
 													expr: &zeroOrOneExpr{
-														pos: position{line: 126, col: 58, offset: 3846},
+														pos: position{line: 139, col: 58, offset: 4351},
 														expr: &ruleRefExpr{
-															pos:  position{line: 126, col: 59, offset: 3847},
+															pos:  position{line: 139, col: 59, offset: 4352},
 															name: "DocumentRevisionDate",
 														},
 													},
 												},
 												&zeroOrOneExpr{
-													pos: position{line: 126, col: 82, offset: 3870},
+													pos: position{line: 139, col: 82, offset: 4375},
 													expr: &litMatcher{
-														pos:        position{line: 126, col: 82, offset: 3870},
+														pos:        position{line: 139, col: 82, offset: 4375},
 														val:        ":",
 														ignoreCase: false,
 													},
 												},
 												&labeledExpr{
-													pos:   position{line: 126, col: 87, offset: 3875},
+													pos:   position{line: 139, col: 87, offset: 4380},
 													label: "revremark",
 													expr: &zeroOrOneExpr{
-														pos: position{line: 126, col: 97, offset: 3885},
+														pos: position{line: 139, col: 97, offset: 4390},
 														expr: &ruleRefExpr{
-															pos:  position{line: 126, col: 98, offset: 3886},
+															pos:  position{line: 139, col: 98, offset: 4391},
 															name: "DocumentRevisionRemark",
 														},
 													},
@@ -823,34 +881,34 @@ var g = &grammar{
 										},
 									},
 									&actionExpr{
-										pos: position{line: 128, col: 15, offset: 4003},
+										pos: position{line: 141, col: 15, offset: 4508},
 										run: (*parser).callonDocumentRevision23,
 										expr: &seqExpr{
-											pos: position{line: 128, col: 15, offset: 4003},
+											pos: position{line: 141, col: 15, offset: 4508},
 											exprs: []interface{}{
 												&labeledExpr{
-													pos:   position{line: 128, col: 15, offset: 4003},
+													pos:   position{line: 141, col: 15, offset: 4508},
 													label: "revdate",
 													expr: &ruleRefExpr{
-														pos:  position{line: 128, col: 24, offset: 4012},
+														pos:  position{line: 141, col: 24, offset: 4517},
 														name: "DocumentRevisionDate",
 													},
 												},
 												&zeroOrOneExpr{
-													pos: position{line: 128, col: 46, offset: 4034},
+													pos: position{line: 141, col: 46, offset: 4539},
 													expr: &litMatcher{
-														pos:        position{line: 128, col: 46, offset: 4034},
+														pos:        position{line: 141, col: 46, offset: 4539},
 														val:        ":",
 														ignoreCase: false,
 													},
 												},
 												&labeledExpr{
-													pos:   position{line: 128, col: 51, offset: 4039},
+													pos:   position{line: 141, col: 51, offset: 4544},
 													label: "revremark",
 													expr: &zeroOrOneExpr{
-														pos: position{line: 128, col: 61, offset: 4049},
+														pos: position{line: 141, col: 61, offset: 4554},
 														expr: &ruleRefExpr{
-															pos:  position{line: 128, col: 62, offset: 4050},
+															pos:  position{line: 141, col: 62, offset: 4555},
 															name: "DocumentRevisionRemark",
 														},
 													},
@@ -862,7 +920,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 130, col: 13, offset: 4159},
+							pos:  position{line: 143, col: 13, offset: 4664},
 							name: "EOL",
 						},
 					},
@@ -871,66 +929,66 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentRevisionNumber",
-			pos:  position{line: 135, col: 1, offset: 4289},
+			pos:  position{line: 148, col: 1, offset: 4794},
 			expr: &choiceExpr{
-				pos: position{line: 135, col: 27, offset: 4315},
+				pos: position{line: 148, col: 27, offset: 4820},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 135, col: 27, offset: 4315},
+						pos: position{line: 148, col: 27, offset: 4820},
 						run: (*parser).callonDocumentRevisionNumber2,
 						expr: &seqExpr{
-							pos: position{line: 135, col: 27, offset: 4315},
+							pos: position{line: 148, col: 27, offset: 4820},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 135, col: 27, offset: 4315},
+									pos:        position{line: 148, col: 27, offset: 4820},
 									val:        "v",
 									ignoreCase: true,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 135, col: 32, offset: 4320},
+									pos:  position{line: 148, col: 32, offset: 4825},
 									name: "DIGIT",
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 135, col: 39, offset: 4327},
+									pos: position{line: 148, col: 39, offset: 4832},
 									expr: &choiceExpr{
-										pos: position{line: 135, col: 40, offset: 4328},
+										pos: position{line: 148, col: 40, offset: 4833},
 										alternatives: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 135, col: 40, offset: 4328},
+												pos:  position{line: 148, col: 40, offset: 4833},
 												name: "Alphanums",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 135, col: 52, offset: 4340},
+												pos:  position{line: 148, col: 52, offset: 4845},
 												name: "Spaces",
 											},
 											&seqExpr{
-												pos: position{line: 135, col: 62, offset: 4350},
+												pos: position{line: 148, col: 62, offset: 4855},
 												exprs: []interface{}{
 													&notExpr{
-														pos: position{line: 135, col: 62, offset: 4350},
+														pos: position{line: 148, col: 62, offset: 4855},
 														expr: &ruleRefExpr{
-															pos:  position{line: 135, col: 63, offset: 4351},
+															pos:  position{line: 148, col: 63, offset: 4856},
 															name: "EOL",
 														},
 													},
 													&notExpr{
-														pos: position{line: 135, col: 67, offset: 4355},
+														pos: position{line: 148, col: 67, offset: 4860},
 														expr: &litMatcher{
-															pos:        position{line: 135, col: 68, offset: 4356},
+															pos:        position{line: 148, col: 68, offset: 4861},
 															val:        ",",
 															ignoreCase: false,
 														},
 													},
 													&notExpr{
-														pos: position{line: 135, col: 72, offset: 4360},
+														pos: position{line: 148, col: 72, offset: 4865},
 														expr: &litMatcher{
-															pos:        position{line: 135, col: 73, offset: 4361},
+															pos:        position{line: 148, col: 73, offset: 4866},
 															val:        ":",
 															ignoreCase: false,
 														},
 													},
 													&anyMatcher{
-														line: 135, col: 78, offset: 4366,
+														line: 148, col: 78, offset: 4871,
 													},
 												},
 											},
@@ -941,64 +999,64 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 137, col: 5, offset: 4408},
+						pos: position{line: 150, col: 5, offset: 4913},
 						run: (*parser).callonDocumentRevisionNumber18,
 						expr: &seqExpr{
-							pos: position{line: 137, col: 5, offset: 4408},
+							pos: position{line: 150, col: 5, offset: 4913},
 							exprs: []interface{}{
 								&zeroOrOneExpr{
-									pos: position{line: 137, col: 5, offset: 4408},
+									pos: position{line: 150, col: 5, offset: 4913},
 									expr: &litMatcher{
-										pos:        position{line: 137, col: 5, offset: 4408},
+										pos:        position{line: 150, col: 5, offset: 4913},
 										val:        "v",
 										ignoreCase: true,
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 137, col: 11, offset: 4414},
+									pos:  position{line: 150, col: 11, offset: 4919},
 									name: "DIGIT",
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 137, col: 18, offset: 4421},
+									pos: position{line: 150, col: 18, offset: 4926},
 									expr: &choiceExpr{
-										pos: position{line: 137, col: 19, offset: 4422},
+										pos: position{line: 150, col: 19, offset: 4927},
 										alternatives: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 137, col: 19, offset: 4422},
+												pos:  position{line: 150, col: 19, offset: 4927},
 												name: "Alphanums",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 137, col: 31, offset: 4434},
+												pos:  position{line: 150, col: 31, offset: 4939},
 												name: "Spaces",
 											},
 											&seqExpr{
-												pos: position{line: 137, col: 41, offset: 4444},
+												pos: position{line: 150, col: 41, offset: 4949},
 												exprs: []interface{}{
 													&notExpr{
-														pos: position{line: 137, col: 41, offset: 4444},
+														pos: position{line: 150, col: 41, offset: 4949},
 														expr: &ruleRefExpr{
-															pos:  position{line: 137, col: 42, offset: 4445},
+															pos:  position{line: 150, col: 42, offset: 4950},
 															name: "EOL",
 														},
 													},
 													&notExpr{
-														pos: position{line: 137, col: 46, offset: 4449},
+														pos: position{line: 150, col: 46, offset: 4954},
 														expr: &litMatcher{
-															pos:        position{line: 137, col: 47, offset: 4450},
+															pos:        position{line: 150, col: 47, offset: 4955},
 															val:        ",",
 															ignoreCase: false,
 														},
 													},
 													&notExpr{
-														pos: position{line: 137, col: 51, offset: 4454},
+														pos: position{line: 150, col: 51, offset: 4959},
 														expr: &litMatcher{
-															pos:        position{line: 137, col: 52, offset: 4455},
+															pos:        position{line: 150, col: 52, offset: 4960},
 															val:        ":",
 															ignoreCase: false,
 														},
 													},
 													&anyMatcher{
-														line: 137, col: 57, offset: 4460,
+														line: 150, col: 57, offset: 4965,
 													},
 												},
 											},
@@ -1006,16 +1064,16 @@ var g = &grammar{
 									},
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 137, col: 62, offset: 4465},
+									pos: position{line: 150, col: 62, offset: 4970},
 									expr: &ruleRefExpr{
-										pos:  position{line: 137, col: 62, offset: 4465},
+										pos:  position{line: 150, col: 62, offset: 4970},
 										name: "WS",
 									},
 								},
 								&andExpr{
-									pos: position{line: 137, col: 66, offset: 4469},
+									pos: position{line: 150, col: 66, offset: 4974},
 									expr: &litMatcher{
-										pos:        position{line: 137, col: 67, offset: 4470},
+										pos:        position{line: 150, col: 67, offset: 4975},
 										val:        ",",
 										ignoreCase: false,
 									},
@@ -1028,43 +1086,43 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentRevisionDate",
-			pos:  position{line: 141, col: 1, offset: 4510},
+			pos:  position{line: 154, col: 1, offset: 5015},
 			expr: &actionExpr{
-				pos: position{line: 141, col: 25, offset: 4534},
+				pos: position{line: 154, col: 25, offset: 5039},
 				run: (*parser).callonDocumentRevisionDate1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 141, col: 25, offset: 4534},
+					pos: position{line: 154, col: 25, offset: 5039},
 					expr: &choiceExpr{
-						pos: position{line: 141, col: 26, offset: 4535},
+						pos: position{line: 154, col: 26, offset: 5040},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 141, col: 26, offset: 4535},
+								pos:  position{line: 154, col: 26, offset: 5040},
 								name: "Alphanums",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 141, col: 38, offset: 4547},
+								pos:  position{line: 154, col: 38, offset: 5052},
 								name: "Spaces",
 							},
 							&seqExpr{
-								pos: position{line: 141, col: 48, offset: 4557},
+								pos: position{line: 154, col: 48, offset: 5062},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 141, col: 48, offset: 4557},
+										pos: position{line: 154, col: 48, offset: 5062},
 										expr: &ruleRefExpr{
-											pos:  position{line: 141, col: 49, offset: 4558},
+											pos:  position{line: 154, col: 49, offset: 5063},
 											name: "EOL",
 										},
 									},
 									&notExpr{
-										pos: position{line: 141, col: 53, offset: 4562},
+										pos: position{line: 154, col: 53, offset: 5067},
 										expr: &litMatcher{
-											pos:        position{line: 141, col: 54, offset: 4563},
+											pos:        position{line: 154, col: 54, offset: 5068},
 											val:        ":",
 											ignoreCase: false,
 										},
 									},
 									&anyMatcher{
-										line: 141, col: 59, offset: 4568,
+										line: 154, col: 59, offset: 5073,
 									},
 								},
 							},
@@ -1075,35 +1133,35 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentRevisionRemark",
-			pos:  position{line: 145, col: 1, offset: 4609},
+			pos:  position{line: 158, col: 1, offset: 5114},
 			expr: &actionExpr{
-				pos: position{line: 145, col: 27, offset: 4635},
+				pos: position{line: 158, col: 27, offset: 5140},
 				run: (*parser).callonDocumentRevisionRemark1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 145, col: 27, offset: 4635},
+					pos: position{line: 158, col: 27, offset: 5140},
 					expr: &choiceExpr{
-						pos: position{line: 145, col: 28, offset: 4636},
+						pos: position{line: 158, col: 28, offset: 5141},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 145, col: 28, offset: 4636},
+								pos:  position{line: 158, col: 28, offset: 5141},
 								name: "Alphanums",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 145, col: 40, offset: 4648},
+								pos:  position{line: 158, col: 40, offset: 5153},
 								name: "Spaces",
 							},
 							&seqExpr{
-								pos: position{line: 145, col: 50, offset: 4658},
+								pos: position{line: 158, col: 50, offset: 5163},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 145, col: 50, offset: 4658},
+										pos: position{line: 158, col: 50, offset: 5163},
 										expr: &ruleRefExpr{
-											pos:  position{line: 145, col: 51, offset: 4659},
+											pos:  position{line: 158, col: 51, offset: 5164},
 											name: "EOL",
 										},
 									},
 									&anyMatcher{
-										line: 145, col: 56, offset: 4664,
+										line: 158, col: 56, offset: 5169,
 									},
 								},
 							},
@@ -1114,54 +1172,54 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAttributeDeclaration",
-			pos:  position{line: 152, col: 1, offset: 4820},
+			pos:  position{line: 165, col: 1, offset: 5325},
 			expr: &actionExpr{
-				pos: position{line: 152, col: 33, offset: 4852},
+				pos: position{line: 165, col: 33, offset: 5357},
 				run: (*parser).callonDocumentAttributeDeclaration1,
 				expr: &seqExpr{
-					pos: position{line: 152, col: 33, offset: 4852},
+					pos: position{line: 165, col: 33, offset: 5357},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 152, col: 33, offset: 4852},
+							pos:        position{line: 165, col: 33, offset: 5357},
 							val:        ":",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 152, col: 37, offset: 4856},
+							pos:   position{line: 165, col: 37, offset: 5361},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 152, col: 43, offset: 4862},
+								pos:  position{line: 165, col: 43, offset: 5367},
 								name: "DocumentAttributeName",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 152, col: 66, offset: 4885},
+							pos:        position{line: 165, col: 66, offset: 5390},
 							val:        ":",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 152, col: 70, offset: 4889},
+							pos:   position{line: 165, col: 70, offset: 5394},
 							label: "value",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 152, col: 76, offset: 4895},
+								pos: position{line: 165, col: 76, offset: 5400},
 								expr: &actionExpr{
-									pos: position{line: 152, col: 77, offset: 4896},
+									pos: position{line: 165, col: 77, offset: 5401},
 									run: (*parser).callonDocumentAttributeDeclaration9,
 									expr: &seqExpr{
-										pos: position{line: 152, col: 78, offset: 4897},
+										pos: position{line: 165, col: 78, offset: 5402},
 										exprs: []interface{}{
 											&oneOrMoreExpr{
-												pos: position{line: 152, col: 78, offset: 4897},
+												pos: position{line: 165, col: 78, offset: 5402},
 												expr: &ruleRefExpr{
-													pos:  position{line: 152, col: 78, offset: 4897},
+													pos:  position{line: 165, col: 78, offset: 5402},
 													name: "WS",
 												},
 											},
 											&labeledExpr{
-												pos:   position{line: 152, col: 82, offset: 4901},
+												pos:   position{line: 165, col: 82, offset: 5406},
 												label: "value",
 												expr: &ruleRefExpr{
-													pos:  position{line: 152, col: 89, offset: 4908},
+													pos:  position{line: 165, col: 89, offset: 5413},
 													name: "DocumentAttributeValue",
 												},
 											},
@@ -1171,7 +1229,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 152, col: 138, offset: 4957},
+							pos:  position{line: 165, col: 138, offset: 5462},
 							name: "EOLS",
 						},
 					},
@@ -1180,72 +1238,72 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAttributeName",
-			pos:  position{line: 159, col: 1, offset: 5206},
+			pos:  position{line: 172, col: 1, offset: 5711},
 			expr: &actionExpr{
-				pos: position{line: 159, col: 26, offset: 5231},
+				pos: position{line: 172, col: 26, offset: 5736},
 				run: (*parser).callonDocumentAttributeName1,
 				expr: &seqExpr{
-					pos: position{line: 159, col: 26, offset: 5231},
+					pos: position{line: 172, col: 26, offset: 5736},
 					exprs: []interface{}{
 						&choiceExpr{
-							pos: position{line: 159, col: 27, offset: 5232},
+							pos: position{line: 172, col: 27, offset: 5737},
 							alternatives: []interface{}{
 								&charClassMatcher{
-									pos:        position{line: 159, col: 27, offset: 5232},
+									pos:        position{line: 172, col: 27, offset: 5737},
 									val:        "[A-Z]",
 									ranges:     []rune{'A', 'Z'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&charClassMatcher{
-									pos:        position{line: 159, col: 35, offset: 5240},
+									pos:        position{line: 172, col: 35, offset: 5745},
 									val:        "[a-z]",
 									ranges:     []rune{'a', 'z'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&charClassMatcher{
-									pos:        position{line: 159, col: 43, offset: 5248},
+									pos:        position{line: 172, col: 43, offset: 5753},
 									val:        "[0-9]",
 									ranges:     []rune{'0', '9'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&litMatcher{
-									pos:        position{line: 159, col: 51, offset: 5256},
+									pos:        position{line: 172, col: 51, offset: 5761},
 									val:        "_",
 									ignoreCase: false,
 								},
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 159, col: 56, offset: 5261},
+							pos: position{line: 172, col: 56, offset: 5766},
 							expr: &choiceExpr{
-								pos: position{line: 159, col: 57, offset: 5262},
+								pos: position{line: 172, col: 57, offset: 5767},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 159, col: 57, offset: 5262},
+										pos:        position{line: 172, col: 57, offset: 5767},
 										val:        "[A-Z]",
 										ranges:     []rune{'A', 'Z'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&charClassMatcher{
-										pos:        position{line: 159, col: 65, offset: 5270},
+										pos:        position{line: 172, col: 65, offset: 5775},
 										val:        "[a-z]",
 										ranges:     []rune{'a', 'z'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&charClassMatcher{
-										pos:        position{line: 159, col: 73, offset: 5278},
+										pos:        position{line: 172, col: 73, offset: 5783},
 										val:        "[0-9]",
 										ranges:     []rune{'0', '9'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&litMatcher{
-										pos:        position{line: 159, col: 81, offset: 5286},
+										pos:        position{line: 172, col: 81, offset: 5791},
 										val:        "-",
 										ignoreCase: false,
 									},
@@ -1258,24 +1316,24 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAttributeValue",
-			pos:  position{line: 163, col: 1, offset: 5328},
+			pos:  position{line: 176, col: 1, offset: 5833},
 			expr: &actionExpr{
-				pos: position{line: 163, col: 27, offset: 5354},
+				pos: position{line: 176, col: 27, offset: 5859},
 				run: (*parser).callonDocumentAttributeValue1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 163, col: 27, offset: 5354},
+					pos: position{line: 176, col: 27, offset: 5859},
 					expr: &seqExpr{
-						pos: position{line: 163, col: 28, offset: 5355},
+						pos: position{line: 176, col: 28, offset: 5860},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 163, col: 28, offset: 5355},
+								pos: position{line: 176, col: 28, offset: 5860},
 								expr: &ruleRefExpr{
-									pos:  position{line: 163, col: 29, offset: 5356},
+									pos:  position{line: 176, col: 29, offset: 5861},
 									name: "NEWLINE",
 								},
 							},
 							&anyMatcher{
-								line: 163, col: 37, offset: 5364,
+								line: 176, col: 37, offset: 5869,
 							},
 						},
 					},
@@ -1284,67 +1342,67 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAttributeReset",
-			pos:  position{line: 167, col: 1, offset: 5404},
+			pos:  position{line: 180, col: 1, offset: 5909},
 			expr: &choiceExpr{
-				pos: position{line: 167, col: 27, offset: 5430},
+				pos: position{line: 180, col: 27, offset: 5935},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 167, col: 27, offset: 5430},
+						pos: position{line: 180, col: 27, offset: 5935},
 						run: (*parser).callonDocumentAttributeReset2,
 						expr: &seqExpr{
-							pos: position{line: 167, col: 27, offset: 5430},
+							pos: position{line: 180, col: 27, offset: 5935},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 167, col: 27, offset: 5430},
+									pos:        position{line: 180, col: 27, offset: 5935},
 									val:        ":!",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 167, col: 32, offset: 5435},
+									pos:   position{line: 180, col: 32, offset: 5940},
 									label: "name",
 									expr: &ruleRefExpr{
-										pos:  position{line: 167, col: 38, offset: 5441},
+										pos:  position{line: 180, col: 38, offset: 5946},
 										name: "DocumentAttributeName",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 167, col: 61, offset: 5464},
+									pos:        position{line: 180, col: 61, offset: 5969},
 									val:        ":",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 167, col: 65, offset: 5468},
+									pos:  position{line: 180, col: 65, offset: 5973},
 									name: "EOLS",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 169, col: 5, offset: 5537},
+						pos: position{line: 182, col: 5, offset: 6042},
 						run: (*parser).callonDocumentAttributeReset9,
 						expr: &seqExpr{
-							pos: position{line: 169, col: 5, offset: 5537},
+							pos: position{line: 182, col: 5, offset: 6042},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 169, col: 5, offset: 5537},
+									pos:        position{line: 182, col: 5, offset: 6042},
 									val:        ":",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 169, col: 9, offset: 5541},
+									pos:   position{line: 182, col: 9, offset: 6046},
 									label: "name",
 									expr: &ruleRefExpr{
-										pos:  position{line: 169, col: 15, offset: 5547},
+										pos:  position{line: 182, col: 15, offset: 6052},
 										name: "DocumentAttributeName",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 169, col: 38, offset: 5570},
+									pos:        position{line: 182, col: 38, offset: 6075},
 									val:        "!:",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 169, col: 43, offset: 5575},
+									pos:  position{line: 182, col: 43, offset: 6080},
 									name: "EOLS",
 								},
 							},
@@ -1355,48 +1413,316 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAttributeSubstitution",
-			pos:  position{line: 173, col: 1, offset: 5643},
+			pos:  position{line: 186, col: 1, offset: 6148},
+			expr: &choiceExpr{
+				pos: position{line: 186, col: 34, offset: 6181},
+				alternatives: []interface{}{
+					&ruleRefExpr{
+						pos:  position{line: 186, col: 34, offset: 6181},
+						name: "CounterSubstitution",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 186, col: 56, offset: 6203},
+						name: "InlineAttributeEntry",
+					},
+					&actionExpr{
+						pos: position{line: 186, col: 79, offset: 6226},
+						run: (*parser).callonDocumentAttributeSubstitution4,
+						expr: &seqExpr{
+							pos: position{line: 186, col: 79, offset: 6226},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 186, col: 79, offset: 6226},
+									val:        "{",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 186, col: 83, offset: 6230},
+									label: "name",
+									expr: &ruleRefExpr{
+										pos:  position{line: 186, col: 89, offset: 6236},
+										name: "DocumentAttributeName",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 186, col: 112, offset: 6259},
+									val:        "}",
+									ignoreCase: false,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "InlineAttributeEntry",
+			pos:  position{line: 190, col: 1, offset: 6348},
+			expr: &choiceExpr{
+				pos: position{line: 190, col: 25, offset: 6372},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 190, col: 25, offset: 6372},
+						run: (*parser).callonInlineAttributeEntry2,
+						expr: &seqExpr{
+							pos: position{line: 190, col: 25, offset: 6372},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 190, col: 25, offset: 6372},
+									val:        "{set:",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 190, col: 33, offset: 6380},
+									label: "name",
+									expr: &ruleRefExpr{
+										pos:  position{line: 190, col: 39, offset: 6386},
+										name: "DocumentAttributeName",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 190, col: 62, offset: 6409},
+									val:        "!}",
+									ignoreCase: false,
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 192, col: 5, offset: 6487},
+						run: (*parser).callonInlineAttributeEntry8,
+						expr: &seqExpr{
+							pos: position{line: 192, col: 5, offset: 6487},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 192, col: 5, offset: 6487},
+									val:        "{set:",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 192, col: 13, offset: 6495},
+									label: "name",
+									expr: &ruleRefExpr{
+										pos:  position{line: 192, col: 19, offset: 6501},
+										name: "DocumentAttributeName",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 192, col: 42, offset: 6524},
+									label: "value",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 192, col: 48, offset: 6530},
+										expr: &actionExpr{
+											pos: position{line: 192, col: 49, offset: 6531},
+											run: (*parser).callonInlineAttributeEntry15,
+											expr: &seqExpr{
+												pos: position{line: 192, col: 49, offset: 6531},
+												exprs: []interface{}{
+													&litMatcher{
+														pos:        position{line: 192, col: 49, offset: 6531},
+														val:        ":",
+														ignoreCase: false,
+													},
+													&labeledExpr{
+														pos:   position{line: 192, col: 53, offset: 6535},
+														label: "value",
+														expr: &actionExpr{
+															pos: position{line: 192, col: 60, offset: 6542},
+															run: (*parser).callonInlineAttributeEntry19,
+															expr: &zeroOrMoreExpr{
+																pos: position{line: 192, col: 60, offset: 6542},
+																expr: &seqExpr{
+																	pos: position{line: 192, col: 61, offset: 6543},
+																	exprs: []interface{}{
+																		&notExpr{
+																			pos: position{line: 192, col: 61, offset: 6543},
+																			expr: &litMatcher{
+																				pos:        position{line: 192, col: 62, offset: 6544},
+																				val:        "}",
+																				ignoreCase: false,
+																			},
+																		},
+																		&notExpr{
+																			pos: position{line: 192, col: 66, offset: 6548},
+																			expr: &ruleRefExpr{
+																				pos:  position{line: 192, col: 67, offset: 6549},
+																				name: "EOL",
+																			},
+																		},
+																		&anyMatcher{
+																			line: 192, col: 71, offset: 6553,
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 196, col: 5, offset: 6621},
+									val:        "}",
+									ignoreCase: false,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "CounterSubstitution",
+			pos:  position{line: 200, col: 1, offset: 6700},
+			expr: &choiceExpr{
+				pos: position{line: 200, col: 24, offset: 6723},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 200, col: 24, offset: 6723},
+						run: (*parser).callonCounterSubstitution2,
+						expr: &seqExpr{
+							pos: position{line: 200, col: 24, offset: 6723},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 200, col: 24, offset: 6723},
+									val:        "{counter:",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 200, col: 36, offset: 6735},
+									label: "name",
+									expr: &ruleRefExpr{
+										pos:  position{line: 200, col: 42, offset: 6741},
+										name: "DocumentAttributeName",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 200, col: 65, offset: 6764},
+									label: "start",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 200, col: 71, offset: 6770},
+										expr: &ruleRefExpr{
+											pos:  position{line: 200, col: 72, offset: 6771},
+											name: "CounterStart",
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 200, col: 87, offset: 6786},
+									val:        "}",
+									ignoreCase: false,
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 202, col: 5, offset: 6865},
+						run: (*parser).callonCounterSubstitution11,
+						expr: &seqExpr{
+							pos: position{line: 202, col: 5, offset: 6865},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 202, col: 5, offset: 6865},
+									val:        "{counter2:",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 202, col: 18, offset: 6878},
+									label: "name",
+									expr: &ruleRefExpr{
+										pos:  position{line: 202, col: 24, offset: 6884},
+										name: "DocumentAttributeName",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 202, col: 47, offset: 6907},
+									label: "start",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 202, col: 53, offset: 6913},
+										expr: &ruleRefExpr{
+											pos:  position{line: 202, col: 54, offset: 6914},
+											name: "CounterStart",
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 202, col: 69, offset: 6929},
+									val:        "}",
+									ignoreCase: false,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "CounterStart",
+			pos:  position{line: 206, col: 1, offset: 7006},
 			expr: &actionExpr{
-				pos: position{line: 173, col: 34, offset: 5676},
-				run: (*parser).callonDocumentAttributeSubstitution1,
+				pos: position{line: 206, col: 17, offset: 7022},
+				run: (*parser).callonCounterStart1,
 				expr: &seqExpr{
-					pos: position{line: 173, col: 34, offset: 5676},
+					pos: position{line: 206, col: 17, offset: 7022},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 173, col: 34, offset: 5676},
-							val:        "{",
+							pos:        position{line: 206, col: 17, offset: 7022},
+							val:        ":",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 173, col: 38, offset: 5680},
-							label: "name",
-							expr: &ruleRefExpr{
-								pos:  position{line: 173, col: 44, offset: 5686},
-								name: "DocumentAttributeName",
+							pos:   position{line: 206, col: 21, offset: 7026},
+							label: "start",
+							expr: &choiceExpr{
+								pos: position{line: 206, col: 28, offset: 7033},
+								alternatives: []interface{}{
+									&actionExpr{
+										pos: position{line: 206, col: 28, offset: 7033},
+										run: (*parser).callonCounterStart6,
+										expr: &charClassMatcher{
+											pos:        position{line: 206, col: 28, offset: 7033},
+											val:        "[A-Za-z]",
+											ranges:     []rune{'A', 'Z', 'a', 'z'},
+											ignoreCase: false,
+											inverted:   false,
+										},
+									},
+									&actionExpr{
+										pos: position{line: 208, col: 5, offset: 7080},
+										run: (*parser).callonCounterStart8,
+										expr: &oneOrMoreExpr{
+											pos: position{line: 208, col: 5, offset: 7080},
+											expr: &charClassMatcher{
+												pos:        position{line: 208, col: 5, offset: 7080},
+												val:        "[0-9]",
+												ranges:     []rune{'0', '9'},
+												ignoreCase: false,
+												inverted:   false,
+											},
+										},
+									},
+								},
 							},
 						},
-						&litMatcher{
-							pos:        position{line: 173, col: 67, offset: 5709},
-							val:        "}",
-							ignoreCase: false,
-						},
 					},
 				},
 			},
 		},
 		{
 			name: "ElementAttributes",
-			pos:  position{line: 180, col: 1, offset: 5897},
+			pos:  position{line: 217, col: 1, offset: 7273},
 			expr: &actionExpr{
-				pos: position{line: 180, col: 22, offset: 5918},
+				pos: position{line: 217, col: 22, offset: 7294},
 				run: (*parser).callonElementAttributes1,
 				expr: &labeledExpr{
-					pos:   position{line: 180, col: 22, offset: 5918},
+					pos:   position{line: 217, col: 22, offset: 7294},
 					label: "attrs",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 180, col: 28, offset: 5924},
+						pos: position{line: 217, col: 28, offset: 7300},
 						expr: &ruleRefExpr{
-							pos:  position{line: 180, col: 29, offset: 5925},
+							pos:  position{line: 217, col: 29, offset: 7301},
 							name: "ElementAttribute",
 						},
 					},
@@ -1405,30 +1731,30 @@ var g = &grammar{
 		},
 		{
 			name: "ElementAttribute",
-			pos:  position{line: 184, col: 1, offset: 6015},
+			pos:  position{line: 221, col: 1, offset: 7391},
 			expr: &actionExpr{
-				pos: position{line: 184, col: 21, offset: 6035},
+				pos: position{line: 221, col: 21, offset: 7411},
 				run: (*parser).callonElementAttribute1,
 				expr: &seqExpr{
-					pos: position{line: 184, col: 21, offset: 6035},
+					pos: position{line: 221, col: 21, offset: 7411},
 					exprs: []interface{}{
 						&andExpr{
-							pos: position{line: 184, col: 21, offset: 6035},
+							pos: position{line: 221, col: 21, offset: 7411},
 							expr: &choiceExpr{
-								pos: position{line: 184, col: 23, offset: 6037},
+								pos: position{line: 221, col: 23, offset: 7413},
 								alternatives: []interface{}{
 									&litMatcher{
-										pos:        position{line: 184, col: 23, offset: 6037},
+										pos:        position{line: 221, col: 23, offset: 7413},
 										val:        "[",
 										ignoreCase: false,
 									},
 									&litMatcher{
-										pos:        position{line: 184, col: 29, offset: 6043},
+										pos:        position{line: 221, col: 29, offset: 7419},
 										val:        ".",
 										ignoreCase: false,
 									},
 									&litMatcher{
-										pos:        position{line: 184, col: 35, offset: 6049},
+										pos:        position{line: 221, col: 35, offset: 7425},
 										val:        "#",
 										ignoreCase: false,
 									},
@@ -1436,49 +1762,45 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 185, col: 5, offset: 6125},
+							pos:   position{line: 222, col: 5, offset: 7501},
 							label: "attr",
 							expr: &choiceExpr{
-								pos: position{line: 185, col: 11, offset: 6131},
+								pos: position{line: 222, col: 11, offset: 7507},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 185, col: 11, offset: 6131},
+										pos:  position{line: 222, col: 11, offset: 7507},
 										name: "ElementID",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 186, col: 9, offset: 6152},
+										pos:  position{line: 223, col: 9, offset: 7528},
 										name: "ElementTitle",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 187, col: 9, offset: 6176},
+										pos:  position{line: 224, col: 9, offset: 7552},
 										name: "ElementRole",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 188, col: 9, offset: 6199},
+										pos:  position{line: 225, col: 9, offset: 7575},
 										name: "LiteralAttribute",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 189, col: 9, offset: 6227},
+										pos:  position{line: 226, col: 9, offset: 7603},
 										name: "SourceAttributes",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 190, col: 9, offset: 6255},
-										name: "QuoteAttributes",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 191, col: 9, offset: 6282},
-										name: "VerseAttributes",
+										pos:  position{line: 227, col: 9, offset: 7631},
+										name: "MasqueradeAttribute",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 192, col: 9, offset: 6309},
+										pos:  position{line: 228, col: 9, offset: 7662},
 										name: "AdmonitionMarkerAttribute",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 193, col: 9, offset: 6346},
+										pos:  position{line: 229, col: 9, offset: 7699},
 										name: "HorizontalLayout",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 194, col: 9, offset: 6374},
+										pos:  position{line: 230, col: 9, offset: 7727},
 										name: "AttributeGroup",
 									},
 								},
@@ -1490,84 +1812,163 @@ var g = &grammar{
 		},
 		{
 			name: "MasqueradeAttribute",
-			pos:  position{line: 199, col: 1, offset: 6557},
+			pos:  position{line: 235, col: 1, offset: 7910},
 			expr: &choiceExpr{
-				pos: position{line: 199, col: 24, offset: 6580},
+				pos: position{line: 235, col: 24, offset: 7933},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 199, col: 24, offset: 6580},
+						pos:  position{line: 235, col: 24, offset: 7933},
 						name: "QuoteAttributes",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 199, col: 42, offset: 6598},
+						pos:  position{line: 235, col: 42, offset: 7951},
 						name: "VerseAttributes",
 					},
+					&ruleRefExpr{
+						pos:  position{line: 235, col: 60, offset: 7969},
+						name: "BlockStyleAttribute",
+					},
 				},
 			},
 		},
 		{
-			name: "ElementID",
-			pos:  position{line: 201, col: 1, offset: 6615},
-			expr: &choiceExpr{
-				pos: position{line: 201, col: 14, offset: 6628},
-				alternatives: []interface{}{
-					&actionExpr{
-						pos: position{line: 201, col: 14, offset: 6628},
-						run: (*parser).callonElementID2,
-						expr: &seqExpr{
-							pos: position{line: 201, col: 14, offset: 6628},
-							exprs: []interface{}{
-								&litMatcher{
-									pos:        position{line: 201, col: 14, offset: 6628},
-									val:        "[[",
-									ignoreCase: false,
-								},
-								&labeledExpr{
-									pos:   position{line: 201, col: 19, offset: 6633},
-									label: "id",
-									expr: &ruleRefExpr{
-										pos:  position{line: 201, col: 23, offset: 6637},
-										name: "ID",
-									},
-								},
-								&litMatcher{
-									pos:        position{line: 201, col: 27, offset: 6641},
-									val:        "]]",
-									ignoreCase: false,
-								},
-								&ruleRefExpr{
-									pos:  position{line: 201, col: 32, offset: 6646},
-									name: "EOLS",
-								},
-							},
+			name: "BlockStyleAttribute",
+			pos:  position{line: 238, col: 1, offset: 8067},
+			expr: &actionExpr{
+				pos: position{line: 238, col: 24, offset: 8090},
+				run: (*parser).callonBlockStyleAttribute1,
+				expr: &seqExpr{
+					pos: position{line: 238, col: 24, offset: 8090},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 238, col: 24, offset: 8090},
+							val:        "[",
+							ignoreCase: false,
 						},
-					},
-					&actionExpr{
-						pos: position{line: 203, col: 5, offset: 6700},
-						run: (*parser).callonElementID9,
-						expr: &seqExpr{
-							pos: position{line: 203, col: 5, offset: 6700},
-							exprs: []interface{}{
-								&litMatcher{
-									pos:        position{line: 203, col: 5, offset: 6700},
-									val:        "[#",
-									ignoreCase: false,
-								},
-								&labeledExpr{
-									pos:   position{line: 203, col: 10, offset: 6705},
-									label: "id",
+						&labeledExpr{
+							pos:   position{line: 238, col: 28, offset: 8094},
+							label: "kind",
+							expr: &actionExpr{
+								pos: position{line: 238, col: 34, offset: 8100},
+								run: (*parser).callonBlockStyleAttribute5,
+								expr: &choiceExpr{
+									pos: position{line: 238, col: 35, offset: 8101},
+									alternatives: []interface{}{
+										&litMatcher{
+											pos:        position{line: 238, col: 35, offset: 8101},
+											val:        "listing",
+											ignoreCase: false,
+										},
+										&litMatcher{
+											pos:        position{line: 238, col: 47, offset: 8113},
+											val:        "example",
+											ignoreCase: false,
+										},
+										&litMatcher{
+											pos:        position{line: 238, col: 59, offset: 8125},
+											val:        "sidebar",
+											ignoreCase: false,
+										},
+										&litMatcher{
+											pos:        position{line: 238, col: 71, offset: 8137},
+											val:        "abstract",
+											ignoreCase: false,
+										},
+										&litMatcher{
+											pos:        position{line: 238, col: 84, offset: 8150},
+											val:        "partintro",
+											ignoreCase: false,
+										},
+										&litMatcher{
+											pos:        position{line: 238, col: 98, offset: 8164},
+											val:        "comment",
+											ignoreCase: false,
+										},
+										&litMatcher{
+											pos:        position{line: 238, col: 110, offset: 8176},
+											val:        "open",
+											ignoreCase: false,
+										},
+									},
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 240, col: 4, offset: 8220},
+							val:        "]",
+							ignoreCase: false,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 240, col: 8, offset: 8224},
+							name: "EOLS",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ElementID",
+			pos:  position{line: 244, col: 1, offset: 8289},
+			expr: &choiceExpr{
+				pos: position{line: 244, col: 14, offset: 8302},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 244, col: 14, offset: 8302},
+						run: (*parser).callonElementID2,
+						expr: &seqExpr{
+							pos: position{line: 244, col: 14, offset: 8302},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 244, col: 14, offset: 8302},
+									val:        "[[",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 244, col: 19, offset: 8307},
+									label: "id",
+									expr: &ruleRefExpr{
+										pos:  position{line: 244, col: 23, offset: 8311},
+										name: "ID",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 244, col: 27, offset: 8315},
+									val:        "]]",
+									ignoreCase: false,
+								},
+								&ruleRefExpr{
+									pos:  position{line: 244, col: 32, offset: 8320},
+									name: "EOLS",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 246, col: 5, offset: 8374},
+						run: (*parser).callonElementID9,
+						expr: &seqExpr{
+							pos: position{line: 246, col: 5, offset: 8374},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 246, col: 5, offset: 8374},
+									val:        "[#",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 246, col: 10, offset: 8379},
+									label: "id",
 									expr: &ruleRefExpr{
-										pos:  position{line: 203, col: 14, offset: 6709},
+										pos:  position{line: 246, col: 14, offset: 8383},
 										name: "ID",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 203, col: 18, offset: 6713},
+									pos:        position{line: 246, col: 18, offset: 8387},
 									val:        "]",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 203, col: 23, offset: 6718},
+									pos:  position{line: 246, col: 23, offset: 8392},
 									name: "EOLS",
 								},
 							},
@@ -1578,35 +1979,35 @@ var g = &grammar{
 		},
 		{
 			name: "InlineElementID",
-			pos:  position{line: 207, col: 1, offset: 6771},
+			pos:  position{line: 250, col: 1, offset: 8445},
 			expr: &actionExpr{
-				pos: position{line: 207, col: 20, offset: 6790},
+				pos: position{line: 250, col: 20, offset: 8464},
 				run: (*parser).callonInlineElementID1,
 				expr: &seqExpr{
-					pos: position{line: 207, col: 20, offset: 6790},
+					pos: position{line: 250, col: 20, offset: 8464},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 207, col: 20, offset: 6790},
+							pos:        position{line: 250, col: 20, offset: 8464},
 							val:        "[[",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 207, col: 25, offset: 6795},
+							pos:   position{line: 250, col: 25, offset: 8469},
 							label: "id",
 							expr: &ruleRefExpr{
-								pos:  position{line: 207, col: 29, offset: 6799},
+								pos:  position{line: 250, col: 29, offset: 8473},
 								name: "ID",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 207, col: 33, offset: 6803},
+							pos:        position{line: 250, col: 33, offset: 8477},
 							val:        "]]",
 							ignoreCase: false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 207, col: 38, offset: 6808},
+							pos: position{line: 250, col: 38, offset: 8482},
 							expr: &ruleRefExpr{
-								pos:  position{line: 207, col: 38, offset: 6808},
+								pos:  position{line: 250, col: 38, offset: 8482},
 								name: "WS",
 							},
 						},
@@ -1616,56 +2017,56 @@ var g = &grammar{
 		},
 		{
 			name: "ElementTitle",
-			pos:  position{line: 213, col: 1, offset: 7082},
+			pos:  position{line: 256, col: 1, offset: 8756},
 			expr: &actionExpr{
-				pos: position{line: 213, col: 17, offset: 7098},
+				pos: position{line: 256, col: 17, offset: 8772},
 				run: (*parser).callonElementTitle1,
 				expr: &seqExpr{
-					pos: position{line: 213, col: 17, offset: 7098},
+					pos: position{line: 256, col: 17, offset: 8772},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 213, col: 17, offset: 7098},
+							pos:        position{line: 256, col: 17, offset: 8772},
 							val:        ".",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 213, col: 21, offset: 7102},
+							pos:   position{line: 256, col: 21, offset: 8776},
 							label: "title",
 							expr: &actionExpr{
-								pos: position{line: 213, col: 28, offset: 7109},
+								pos: position{line: 256, col: 28, offset: 8783},
 								run: (*parser).callonElementTitle5,
 								expr: &seqExpr{
-									pos: position{line: 213, col: 28, offset: 7109},
+									pos: position{line: 256, col: 28, offset: 8783},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 213, col: 28, offset: 7109},
+											pos:  position{line: 256, col: 28, offset: 8783},
 											name: "Alphanums",
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 213, col: 38, offset: 7119},
+											pos: position{line: 256, col: 38, offset: 8793},
 											expr: &choiceExpr{
-												pos: position{line: 213, col: 39, offset: 7120},
+												pos: position{line: 256, col: 39, offset: 8794},
 												alternatives: []interface{}{
 													&ruleRefExpr{
-														pos:  position{line: 213, col: 39, offset: 7120},
+														pos:  position{line: 256, col: 39, offset: 8794},
 														name: "Alphanums",
 													},
 													&ruleRefExpr{
-														pos:  position{line: 213, col: 51, offset: 7132},
+														pos:  position{line: 256, col: 51, offset: 8806},
 														name: "Spaces",
 													},
 													&seqExpr{
-														pos: position{line: 213, col: 61, offset: 7142},
+														pos: position{line: 256, col: 61, offset: 8816},
 														exprs: []interface{}{
 															&notExpr{
-																pos: position{line: 213, col: 61, offset: 7142},
+																pos: position{line: 256, col: 61, offset: 8816},
 																expr: &ruleRefExpr{
-																	pos:  position{line: 213, col: 62, offset: 7143},
+																	pos:  position{line: 256, col: 62, offset: 8817},
 																	name: "NEWLINE",
 																},
 															},
 															&anyMatcher{
-																line: 213, col: 70, offset: 7151,
+																line: 256, col: 70, offset: 8825,
 															},
 														},
 													},
@@ -1677,7 +2078,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 215, col: 4, offset: 7192},
+							pos:  position{line: 258, col: 4, offset: 8866},
 							name: "EOL",
 						},
 					},
@@ -1686,64 +2087,64 @@ var g = &grammar{
 		},
 		{
 			name: "ElementRole",
-			pos:  position{line: 221, col: 1, offset: 7344},
+			pos:  position{line: 264, col: 1, offset: 9033},
 			expr: &actionExpr{
-				pos: position{line: 221, col: 16, offset: 7359},
+				pos: position{line: 264, col: 16, offset: 9048},
 				run: (*parser).callonElementRole1,
 				expr: &seqExpr{
-					pos: position{line: 221, col: 16, offset: 7359},
+					pos: position{line: 264, col: 16, offset: 9048},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 221, col: 16, offset: 7359},
+							pos:        position{line: 264, col: 16, offset: 9048},
 							val:        "[.",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 221, col: 21, offset: 7364},
+							pos:   position{line: 264, col: 21, offset: 9053},
 							label: "role",
 							expr: &actionExpr{
-								pos: position{line: 221, col: 27, offset: 7370},
+								pos: position{line: 264, col: 27, offset: 9059},
 								run: (*parser).callonElementRole5,
 								expr: &seqExpr{
-									pos: position{line: 221, col: 27, offset: 7370},
+									pos: position{line: 264, col: 27, offset: 9059},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 221, col: 27, offset: 7370},
+											pos:  position{line: 264, col: 27, offset: 9059},
 											name: "Alphanums",
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 221, col: 37, offset: 7380},
+											pos: position{line: 264, col: 37, offset: 9069},
 											expr: &choiceExpr{
-												pos: position{line: 221, col: 38, offset: 7381},
+												pos: position{line: 264, col: 38, offset: 9070},
 												alternatives: []interface{}{
 													&ruleRefExpr{
-														pos:  position{line: 221, col: 38, offset: 7381},
+														pos:  position{line: 264, col: 38, offset: 9070},
 														name: "Alphanums",
 													},
 													&ruleRefExpr{
-														pos:  position{line: 221, col: 50, offset: 7393},
+														pos:  position{line: 264, col: 50, offset: 9082},
 														name: "Spaces",
 													},
 													&seqExpr{
-														pos: position{line: 221, col: 60, offset: 7403},
+														pos: position{line: 264, col: 60, offset: 9092},
 														exprs: []interface{}{
 															&notExpr{
-																pos: position{line: 221, col: 60, offset: 7403},
+																pos: position{line: 264, col: 60, offset: 9092},
 																expr: &ruleRefExpr{
-																	pos:  position{line: 221, col: 61, offset: 7404},
+																	pos:  position{line: 264, col: 61, offset: 9093},
 																	name: "NEWLINE",
 																},
 															},
 															&notExpr{
-																pos: position{line: 221, col: 69, offset: 7412},
+																pos: position{line: 264, col: 69, offset: 9101},
 																expr: &litMatcher{
-																	pos:        position{line: 221, col: 70, offset: 7413},
+																	pos:        position{line: 264, col: 70, offset: 9102},
 																	val:        "]",
 																	ignoreCase: false,
 																},
 															},
 															&anyMatcher{
-																line: 221, col: 74, offset: 7417,
+																line: 264, col: 74, offset: 9106,
 															},
 														},
 													},
@@ -1755,12 +2156,12 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 223, col: 4, offset: 7458},
+							pos:        position{line: 266, col: 4, offset: 9147},
 							val:        "]",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 223, col: 8, offset: 7462},
+							pos:  position{line: 266, col: 8, offset: 9151},
 							name: "EOLS",
 						},
 					},
@@ -1769,27 +2170,27 @@ var g = &grammar{
 		},
 		{
 			name: "LiteralAttribute",
-			pos:  position{line: 227, col: 1, offset: 7519},
+			pos:  position{line: 270, col: 1, offset: 9208},
 			expr: &actionExpr{
-				pos: position{line: 227, col: 21, offset: 7539},
+				pos: position{line: 270, col: 21, offset: 9228},
 				run: (*parser).callonLiteralAttribute1,
 				expr: &seqExpr{
-					pos: position{line: 227, col: 21, offset: 7539},
+					pos: position{line: 270, col: 21, offset: 9228},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 227, col: 21, offset: 7539},
+							pos:        position{line: 270, col: 21, offset: 9228},
 							val:        "[literal]",
 							ignoreCase: false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 227, col: 33, offset: 7551},
+							pos: position{line: 270, col: 33, offset: 9240},
 							expr: &ruleRefExpr{
-								pos:  position{line: 227, col: 33, offset: 7551},
+								pos:  position{line: 270, col: 33, offset: 9240},
 								name: "WS",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 227, col: 37, offset: 7555},
+							pos:  position{line: 270, col: 37, offset: 9244},
 							name: "NEWLINE",
 						},
 					},
@@ -1798,33 +2199,33 @@ var g = &grammar{
 		},
 		{
 			name: "AdmonitionMarkerAttribute",
-			pos:  position{line: 232, col: 1, offset: 7687},
+			pos:  position{line: 275, col: 1, offset: 9376},
 			expr: &actionExpr{
-				pos: position{line: 232, col: 30, offset: 7716},
+				pos: position{line: 275, col: 30, offset: 9405},
 				run: (*parser).callonAdmonitionMarkerAttribute1,
 				expr: &seqExpr{
-					pos: position{line: 232, col: 30, offset: 7716},
+					pos: position{line: 275, col: 30, offset: 9405},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 232, col: 30, offset: 7716},
+							pos:        position{line: 275, col: 30, offset: 9405},
 							val:        "[",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 232, col: 34, offset: 7720},
+							pos:   position{line: 275, col: 34, offset: 9409},
 							label: "k",
 							expr: &ruleRefExpr{
-								pos:  position{line: 232, col: 37, offset: 7723},
+								pos:  position{line: 275, col: 37, offset: 9412},
 								name: "AdmonitionKind",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 232, col: 53, offset: 7739},
+							pos:        position{line: 275, col: 53, offset: 9428},
 							val:        "]",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 232, col: 57, offset: 7743},
+							pos:  position{line: 275, col: 57, offset: 9432},
 							name: "EOLS",
 						},
 					},
@@ -1833,121 +2234,184 @@ var g = &grammar{
 		},
 		{
 			name: "SourceAttributes",
-			pos:  position{line: 237, col: 1, offset: 7899},
+			pos:  position{line: 281, col: 1, offset: 9668},
 			expr: &actionExpr{
-				pos: position{line: 237, col: 21, offset: 7919},
+				pos: position{line: 281, col: 21, offset: 9688},
 				run: (*parser).callonSourceAttributes1,
 				expr: &seqExpr{
-					pos: position{line: 237, col: 21, offset: 7919},
+					pos: position{line: 281, col: 21, offset: 9688},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 237, col: 21, offset: 7919},
+							pos:        position{line: 281, col: 21, offset: 9688},
 							val:        "[source",
 							ignoreCase: false,
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 237, col: 31, offset: 7929},
+							pos: position{line: 281, col: 31, offset: 9698},
 							expr: &litMatcher{
-								pos:        position{line: 237, col: 31, offset: 7929},
+								pos:        position{line: 281, col: 31, offset: 9698},
 								val:        ",",
 								ignoreCase: false,
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 237, col: 36, offset: 7934},
+							pos:   position{line: 281, col: 36, offset: 9703},
 							label: "language",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 237, col: 45, offset: 7943},
-								expr: &actionExpr{
-									pos: position{line: 237, col: 46, offset: 7944},
-									run: (*parser).callonSourceAttributes8,
-									expr: &oneOrMoreExpr{
-										pos: position{line: 237, col: 46, offset: 7944},
-										expr: &choiceExpr{
-											pos: position{line: 237, col: 47, offset: 7945},
-											alternatives: []interface{}{
-												&ruleRefExpr{
-													pos:  position{line: 237, col: 47, offset: 7945},
-													name: "Alphanums",
-												},
-												&ruleRefExpr{
-													pos:  position{line: 237, col: 59, offset: 7957},
-													name: "Spaces",
-												},
-												&seqExpr{
-													pos: position{line: 237, col: 69, offset: 7967},
-													exprs: []interface{}{
-														&notExpr{
-															pos: position{line: 237, col: 69, offset: 7967},
-															expr: &ruleRefExpr{
-																pos:  position{line: 237, col: 70, offset: 7968},
-																name: "NEWLINE",
-															},
-														},
-														&notExpr{
-															pos: position{line: 237, col: 78, offset: 7976},
-															expr: &litMatcher{
-																pos:        position{line: 237, col: 79, offset: 7977},
-																val:        "]",
-																ignoreCase: false,
-															},
-														},
-														&anyMatcher{
-															line: 237, col: 83, offset: 7981,
-														},
-													},
-												},
-											},
-										},
-									},
+								pos: position{line: 281, col: 45, offset: 9712},
+								expr: &ruleRefExpr{
+									pos:  position{line: 281, col: 46, offset: 9713},
+									name: "SourceLanguage",
+								},
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 281, col: 63, offset: 9730},
+							expr: &litMatcher{
+								pos:        position{line: 281, col: 63, offset: 9730},
+								val:        ",",
+								ignoreCase: false,
+							},
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 281, col: 68, offset: 9735},
+							expr: &ruleRefExpr{
+								pos:  position{line: 281, col: 68, offset: 9735},
+								name: "WS",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 281, col: 72, offset: 9739},
+							label: "others",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 281, col: 79, offset: 9746},
+								expr: &ruleRefExpr{
+									pos:  position{line: 281, col: 80, offset: 9747},
+									name: "GenericAttribute",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 239, col: 9, offset: 8031},
+							pos:        position{line: 281, col: 99, offset: 9766},
 							val:        "]",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 239, col: 13, offset: 8035},
+							pos:  position{line: 281, col: 103, offset: 9770},
 							name: "EOLS",
 						},
 					},
 				},
 			},
 		},
+		{
+			name: "SourceLanguage",
+			pos:  position{line: 285, col: 1, offset: 9851},
+			expr: &actionExpr{
+				pos: position{line: 285, col: 19, offset: 9869},
+				run: (*parser).callonSourceLanguage1,
+				expr: &seqExpr{
+					pos: position{line: 285, col: 19, offset: 9869},
+					exprs: []interface{}{
+						&oneOrMoreExpr{
+							pos: position{line: 285, col: 19, offset: 9869},
+							expr: &choiceExpr{
+								pos: position{line: 285, col: 20, offset: 9870},
+								alternatives: []interface{}{
+									&ruleRefExpr{
+										pos:  position{line: 285, col: 20, offset: 9870},
+										name: "Alphanums",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 285, col: 32, offset: 9882},
+										name: "Spaces",
+									},
+									&seqExpr{
+										pos: position{line: 285, col: 42, offset: 9892},
+										exprs: []interface{}{
+											&notExpr{
+												pos: position{line: 285, col: 42, offset: 9892},
+												expr: &ruleRefExpr{
+													pos:  position{line: 285, col: 43, offset: 9893},
+													name: "NEWLINE",
+												},
+											},
+											&notExpr{
+												pos: position{line: 285, col: 51, offset: 9901},
+												expr: &litMatcher{
+													pos:        position{line: 285, col: 52, offset: 9902},
+													val:        "]",
+													ignoreCase: false,
+												},
+											},
+											&notExpr{
+												pos: position{line: 285, col: 56, offset: 9906},
+												expr: &litMatcher{
+													pos:        position{line: 285, col: 57, offset: 9907},
+													val:        ",",
+													ignoreCase: false,
+												},
+											},
+											&notExpr{
+												pos: position{line: 285, col: 61, offset: 9911},
+												expr: &litMatcher{
+													pos:        position{line: 285, col: 62, offset: 9912},
+													val:        "=",
+													ignoreCase: false,
+												},
+											},
+											&anyMatcher{
+												line: 285, col: 66, offset: 9916,
+											},
+										},
+									},
+								},
+							},
+						},
+						&notExpr{
+							pos: position{line: 285, col: 71, offset: 9921},
+							expr: &litMatcher{
+								pos:        position{line: 285, col: 72, offset: 9922},
+								val:        "=",
+								ignoreCase: false,
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "AttributeGroup",
-			pos:  position{line: 244, col: 1, offset: 8160},
+			pos:  position{line: 290, col: 1, offset: 10030},
 			expr: &actionExpr{
-				pos: position{line: 244, col: 19, offset: 8178},
+				pos: position{line: 290, col: 19, offset: 10048},
 				run: (*parser).callonAttributeGroup1,
 				expr: &seqExpr{
-					pos: position{line: 244, col: 19, offset: 8178},
+					pos: position{line: 290, col: 19, offset: 10048},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 244, col: 19, offset: 8178},
+							pos:        position{line: 290, col: 19, offset: 10048},
 							val:        "[",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 244, col: 23, offset: 8182},
+							pos:   position{line: 290, col: 23, offset: 10052},
 							label: "attributes",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 244, col: 34, offset: 8193},
+								pos: position{line: 290, col: 34, offset: 10063},
 								expr: &ruleRefExpr{
-									pos:  position{line: 244, col: 35, offset: 8194},
+									pos:  position{line: 290, col: 35, offset: 10064},
 									name: "GenericAttribute",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 244, col: 54, offset: 8213},
+							pos:        position{line: 290, col: 54, offset: 10083},
 							val:        "]",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 244, col: 58, offset: 8217},
+							pos:  position{line: 290, col: 58, offset: 10087},
 							name: "EOLS",
 						},
 					},
@@ -1956,52 +2420,61 @@ var g = &grammar{
 		},
 		{
 			name: "GenericAttribute",
-			pos:  position{line: 248, col: 1, offset: 8290},
+			pos:  position{line: 294, col: 1, offset: 10160},
 			expr: &choiceExpr{
-				pos: position{line: 249, col: 5, offset: 8315},
+				pos: position{line: 295, col: 5, offset: 10185},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 249, col: 5, offset: 8315},
+						pos: position{line: 295, col: 5, offset: 10185},
 						run: (*parser).callonGenericAttribute2,
 						expr: &seqExpr{
-							pos: position{line: 249, col: 5, offset: 8315},
+							pos: position{line: 295, col: 5, offset: 10185},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 249, col: 5, offset: 8315},
+									pos:   position{line: 295, col: 5, offset: 10185},
 									label: "key",
 									expr: &ruleRefExpr{
-										pos:  position{line: 249, col: 10, offset: 8320},
+										pos:  position{line: 295, col: 10, offset: 10190},
 										name: "AttributeKey",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 249, col: 24, offset: 8334},
+									pos:        position{line: 295, col: 24, offset: 10204},
 									val:        "=",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 249, col: 28, offset: 8338},
+									pos:   position{line: 295, col: 28, offset: 10208},
 									label: "value",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 249, col: 34, offset: 8344},
-										expr: &ruleRefExpr{
-											pos:  position{line: 249, col: 35, offset: 8345},
-											name: "AttributeValue",
+										pos: position{line: 295, col: 34, offset: 10214},
+										expr: &choiceExpr{
+											pos: position{line: 295, col: 35, offset: 10215},
+											alternatives: []interface{}{
+												&ruleRefExpr{
+													pos:  position{line: 295, col: 35, offset: 10215},
+													name: "QuotedAttributeValue",
+												},
+												&ruleRefExpr{
+													pos:  position{line: 295, col: 58, offset: 10238},
+													name: "AttributeValue",
+												},
+											},
 										},
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 249, col: 52, offset: 8362},
+									pos: position{line: 295, col: 75, offset: 10255},
 									expr: &litMatcher{
-										pos:        position{line: 249, col: 52, offset: 8362},
+										pos:        position{line: 295, col: 75, offset: 10255},
 										val:        ",",
 										ignoreCase: false,
 									},
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 249, col: 57, offset: 8367},
+									pos: position{line: 295, col: 80, offset: 10260},
 									expr: &ruleRefExpr{
-										pos:  position{line: 249, col: 57, offset: 8367},
+										pos:  position{line: 295, col: 80, offset: 10260},
 										name: "WS",
 									},
 								},
@@ -2009,31 +2482,31 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 251, col: 9, offset: 8472},
-						run: (*parser).callonGenericAttribute14,
+						pos: position{line: 297, col: 9, offset: 10365},
+						run: (*parser).callonGenericAttribute16,
 						expr: &seqExpr{
-							pos: position{line: 251, col: 9, offset: 8472},
+							pos: position{line: 297, col: 9, offset: 10365},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 251, col: 9, offset: 8472},
+									pos:   position{line: 297, col: 9, offset: 10365},
 									label: "key",
 									expr: &ruleRefExpr{
-										pos:  position{line: 251, col: 14, offset: 8477},
+										pos:  position{line: 297, col: 14, offset: 10370},
 										name: "AttributeKey",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 251, col: 28, offset: 8491},
+									pos: position{line: 297, col: 28, offset: 10384},
 									expr: &litMatcher{
-										pos:        position{line: 251, col: 28, offset: 8491},
+										pos:        position{line: 297, col: 28, offset: 10384},
 										val:        ",",
 										ignoreCase: false,
 									},
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 251, col: 33, offset: 8496},
+									pos: position{line: 297, col: 33, offset: 10389},
 									expr: &ruleRefExpr{
-										pos:  position{line: 251, col: 33, offset: 8496},
+										pos:  position{line: 297, col: 33, offset: 10389},
 										name: "WS",
 									},
 								},
@@ -2045,58 +2518,58 @@ var g = &grammar{
 		},
 		{
 			name: "AttributeKey",
-			pos:  position{line: 255, col: 1, offset: 8589},
+			pos:  position{line: 301, col: 1, offset: 10482},
 			expr: &actionExpr{
-				pos: position{line: 255, col: 17, offset: 8605},
+				pos: position{line: 301, col: 17, offset: 10498},
 				run: (*parser).callonAttributeKey1,
 				expr: &seqExpr{
-					pos: position{line: 255, col: 17, offset: 8605},
+					pos: position{line: 301, col: 17, offset: 10498},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 255, col: 17, offset: 8605},
+							pos: position{line: 301, col: 17, offset: 10498},
 							expr: &litMatcher{
-								pos:        position{line: 255, col: 18, offset: 8606},
+								pos:        position{line: 301, col: 18, offset: 10499},
 								val:        "quote",
 								ignoreCase: false,
 							},
 						},
 						&notExpr{
-							pos: position{line: 255, col: 26, offset: 8614},
+							pos: position{line: 301, col: 26, offset: 10507},
 							expr: &litMatcher{
-								pos:        position{line: 255, col: 27, offset: 8615},
+								pos:        position{line: 301, col: 27, offset: 10508},
 								val:        "verse",
 								ignoreCase: false,
 							},
 						},
 						&notExpr{
-							pos: position{line: 255, col: 35, offset: 8623},
+							pos: position{line: 301, col: 35, offset: 10516},
 							expr: &litMatcher{
-								pos:        position{line: 255, col: 36, offset: 8624},
+								pos:        position{line: 301, col: 36, offset: 10517},
 								val:        "literal",
 								ignoreCase: false,
 							},
 						},
 						&notExpr{
-							pos: position{line: 255, col: 46, offset: 8634},
+							pos: position{line: 301, col: 46, offset: 10527},
 							expr: &ruleRefExpr{
-								pos:  position{line: 255, col: 47, offset: 8635},
+								pos:  position{line: 301, col: 47, offset: 10528},
 								name: "Spaces",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 255, col: 54, offset: 8642},
+							pos:   position{line: 301, col: 54, offset: 10535},
 							label: "key",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 255, col: 58, offset: 8646},
+								pos: position{line: 301, col: 58, offset: 10539},
 								expr: &choiceExpr{
-									pos: position{line: 255, col: 59, offset: 8647},
+									pos: position{line: 301, col: 59, offset: 10540},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 255, col: 59, offset: 8647},
+											pos:  position{line: 301, col: 59, offset: 10540},
 											name: "Alphanums",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 255, col: 71, offset: 8659},
+											pos:  position{line: 301, col: 71, offset: 10552},
 											name: "OtherAttributeChar",
 										},
 									},
@@ -2104,9 +2577,9 @@ var g = &grammar{
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 255, col: 92, offset: 8680},
+							pos: position{line: 301, col: 92, offset: 10573},
 							expr: &ruleRefExpr{
-								pos:  position{line: 255, col: 92, offset: 8680},
+								pos:  position{line: 301, col: 92, offset: 10573},
 								name: "WS",
 							},
 						},
@@ -2116,31 +2589,31 @@ var g = &grammar{
 		},
 		{
 			name: "AttributeValue",
-			pos:  position{line: 259, col: 1, offset: 8720},
+			pos:  position{line: 305, col: 1, offset: 10613},
 			expr: &actionExpr{
-				pos: position{line: 259, col: 19, offset: 8738},
+				pos: position{line: 305, col: 19, offset: 10631},
 				run: (*parser).callonAttributeValue1,
 				expr: &seqExpr{
-					pos: position{line: 259, col: 19, offset: 8738},
+					pos: position{line: 305, col: 19, offset: 10631},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 259, col: 19, offset: 8738},
+							pos:   position{line: 305, col: 19, offset: 10631},
 							label: "value",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 259, col: 25, offset: 8744},
+								pos: position{line: 305, col: 25, offset: 10637},
 								expr: &choiceExpr{
-									pos: position{line: 259, col: 26, offset: 8745},
+									pos: position{line: 305, col: 26, offset: 10638},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 259, col: 26, offset: 8745},
+											pos:  position{line: 305, col: 26, offset: 10638},
 											name: "Alphanums",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 259, col: 38, offset: 8757},
+											pos:  position{line: 305, col: 38, offset: 10650},
 											name: "Spaces",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 259, col: 47, offset: 8766},
+											pos:  position{line: 305, col: 47, offset: 10659},
 											name: "OtherAttributeChar",
 										},
 									},
@@ -2148,9 +2621,9 @@ var g = &grammar{
 							},
 						},
 						&notExpr{
-							pos: position{line: 259, col: 68, offset: 8787},
+							pos: position{line: 305, col: 68, offset: 10680},
 							expr: &litMatcher{
-								pos:        position{line: 259, col: 69, offset: 8788},
+								pos:        position{line: 305, col: 69, offset: 10681},
 								val:        "=",
 								ignoreCase: false,
 							},
@@ -2159,58 +2632,147 @@ var g = &grammar{
 				},
 			},
 		},
+		{
+			name: "QuotedAttributeValue",
+			pos:  position{line: 310, col: 1, offset: 10931},
+			expr: &actionExpr{
+				pos: position{line: 310, col: 25, offset: 10955},
+				run: (*parser).callonQuotedAttributeValue1,
+				expr: &seqExpr{
+					pos: position{line: 310, col: 25, offset: 10955},
+					exprs: []interface{}{
+						&zeroOrMoreExpr{
+							pos: position{line: 310, col: 25, offset: 10955},
+							expr: &ruleRefExpr{
+								pos:  position{line: 310, col: 25, offset: 10955},
+								name: "WS",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 310, col: 29, offset: 10959},
+							val:        "\"",
+							ignoreCase: false,
+						},
+						&labeledExpr{
+							pos:   position{line: 310, col: 34, offset: 10964},
+							label: "value",
+							expr: &actionExpr{
+								pos: position{line: 310, col: 41, offset: 10971},
+								run: (*parser).callonQuotedAttributeValue7,
+								expr: &zeroOrMoreExpr{
+									pos: position{line: 310, col: 41, offset: 10971},
+									expr: &seqExpr{
+										pos: position{line: 310, col: 42, offset: 10972},
+										exprs: []interface{}{
+											&notExpr{
+												pos: position{line: 310, col: 42, offset: 10972},
+												expr: &litMatcher{
+													pos:        position{line: 310, col: 43, offset: 10973},
+													val:        "\"",
+													ignoreCase: false,
+												},
+											},
+											&notExpr{
+												pos: position{line: 310, col: 48, offset: 10978},
+												expr: &ruleRefExpr{
+													pos:  position{line: 310, col: 49, offset: 10979},
+													name: "NEWLINE",
+												},
+											},
+											&anyMatcher{
+												line: 310, col: 57, offset: 10987,
+											},
+										},
+									},
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 312, col: 4, offset: 11027},
+							val:        "\"",
+							ignoreCase: false,
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 312, col: 9, offset: 11032},
+							expr: &ruleRefExpr{
+								pos:  position{line: 312, col: 9, offset: 11032},
+								name: "WS",
+							},
+						},
+						&andExpr{
+							pos: position{line: 312, col: 13, offset: 11036},
+							expr: &choiceExpr{
+								pos: position{line: 312, col: 15, offset: 11038},
+								alternatives: []interface{}{
+									&litMatcher{
+										pos:        position{line: 312, col: 15, offset: 11038},
+										val:        ",",
+										ignoreCase: false,
+									},
+									&litMatcher{
+										pos:        position{line: 312, col: 21, offset: 11044},
+										val:        "]",
+										ignoreCase: false,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "OtherAttributeChar",
-			pos:  position{line: 263, col: 1, offset: 8943},
+			pos:  position{line: 316, col: 1, offset: 11076},
 			expr: &seqExpr{
-				pos: position{line: 263, col: 24, offset: 8966},
+				pos: position{line: 316, col: 24, offset: 11099},
 				exprs: []interface{}{
 					&notExpr{
-						pos: position{line: 263, col: 24, offset: 8966},
+						pos: position{line: 316, col: 24, offset: 11099},
 						expr: &litMatcher{
-							pos:        position{line: 263, col: 25, offset: 8967},
+							pos:        position{line: 316, col: 25, offset: 11100},
 							val:        "=",
 							ignoreCase: false,
 						},
 					},
 					&notExpr{
-						pos: position{line: 263, col: 29, offset: 8971},
+						pos: position{line: 316, col: 29, offset: 11104},
 						expr: &litMatcher{
-							pos:        position{line: 263, col: 30, offset: 8972},
+							pos:        position{line: 316, col: 30, offset: 11105},
 							val:        ",",
 							ignoreCase: false,
 						},
 					},
 					&notExpr{
-						pos: position{line: 263, col: 34, offset: 8976},
+						pos: position{line: 316, col: 34, offset: 11109},
 						expr: &litMatcher{
-							pos:        position{line: 263, col: 35, offset: 8977},
+							pos:        position{line: 316, col: 35, offset: 11110},
 							val:        "]",
 							ignoreCase: false,
 						},
 					},
 					&anyMatcher{
-						line: 263, col: 39, offset: 8981,
+						line: 316, col: 39, offset: 11114,
 					},
 				},
 			},
 		},
 		{
 			name: "HorizontalLayout",
-			pos:  position{line: 265, col: 1, offset: 8985},
+			pos:  position{line: 318, col: 1, offset: 11118},
 			expr: &actionExpr{
-				pos: position{line: 265, col: 21, offset: 9005},
+				pos: position{line: 318, col: 21, offset: 11138},
 				run: (*parser).callonHorizontalLayout1,
 				expr: &seqExpr{
-					pos: position{line: 265, col: 21, offset: 9005},
+					pos: position{line: 318, col: 21, offset: 11138},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 265, col: 21, offset: 9005},
+							pos:        position{line: 318, col: 21, offset: 11138},
 							val:        "[horizontal]",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 265, col: 36, offset: 9020},
+							pos:  position{line: 318, col: 36, offset: 11153},
 							name: "EOLS",
 						},
 					},
@@ -2219,70 +2781,70 @@ var g = &grammar{
 		},
 		{
 			name: "QuoteAttributes",
-			pos:  position{line: 269, col: 1, offset: 9094},
+			pos:  position{line: 322, col: 1, offset: 11227},
 			expr: &actionExpr{
-				pos: position{line: 269, col: 20, offset: 9113},
+				pos: position{line: 322, col: 20, offset: 11246},
 				run: (*parser).callonQuoteAttributes1,
 				expr: &seqExpr{
-					pos: position{line: 269, col: 20, offset: 9113},
+					pos: position{line: 322, col: 20, offset: 11246},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 269, col: 20, offset: 9113},
+							pos:        position{line: 322, col: 20, offset: 11246},
 							val:        "[quote",
 							ignoreCase: false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 269, col: 29, offset: 9122},
+							pos: position{line: 322, col: 29, offset: 11255},
 							expr: &ruleRefExpr{
-								pos:  position{line: 269, col: 29, offset: 9122},
+								pos:  position{line: 322, col: 29, offset: 11255},
 								name: "WS",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 269, col: 33, offset: 9126},
+							pos: position{line: 322, col: 33, offset: 11259},
 							expr: &litMatcher{
-								pos:        position{line: 269, col: 33, offset: 9126},
+								pos:        position{line: 322, col: 33, offset: 11259},
 								val:        ",",
 								ignoreCase: false,
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 269, col: 38, offset: 9131},
+							pos:   position{line: 322, col: 38, offset: 11264},
 							label: "author",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 269, col: 45, offset: 9138},
+								pos: position{line: 322, col: 45, offset: 11271},
 								expr: &ruleRefExpr{
-									pos:  position{line: 269, col: 46, offset: 9139},
+									pos:  position{line: 322, col: 46, offset: 11272},
 									name: "QuoteAttribute",
 								},
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 269, col: 63, offset: 9156},
+							pos: position{line: 322, col: 63, offset: 11289},
 							expr: &litMatcher{
-								pos:        position{line: 269, col: 63, offset: 9156},
+								pos:        position{line: 322, col: 63, offset: 11289},
 								val:        ",",
 								ignoreCase: false,
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 269, col: 68, offset: 9161},
+							pos:   position{line: 322, col: 68, offset: 11294},
 							label: "title",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 269, col: 74, offset: 9167},
+								pos: position{line: 322, col: 74, offset: 11300},
 								expr: &ruleRefExpr{
-									pos:  position{line: 269, col: 75, offset: 9168},
+									pos:  position{line: 322, col: 75, offset: 11301},
 									name: "QuoteAttribute",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 269, col: 92, offset: 9185},
+							pos:        position{line: 322, col: 92, offset: 11318},
 							val:        "]",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 269, col: 96, offset: 9189},
+							pos:  position{line: 322, col: 96, offset: 11322},
 							name: "EOLS",
 						},
 					},
@@ -2291,70 +2853,70 @@ var g = &grammar{
 		},
 		{
 			name: "VerseAttributes",
-			pos:  position{line: 273, col: 1, offset: 9259},
+			pos:  position{line: 326, col: 1, offset: 11392},
 			expr: &actionExpr{
-				pos: position{line: 273, col: 20, offset: 9278},
+				pos: position{line: 326, col: 20, offset: 11411},
 				run: (*parser).callonVerseAttributes1,
 				expr: &seqExpr{
-					pos: position{line: 273, col: 20, offset: 9278},
+					pos: position{line: 326, col: 20, offset: 11411},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 273, col: 20, offset: 9278},
+							pos:        position{line: 326, col: 20, offset: 11411},
 							val:        "[verse",
 							ignoreCase: false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 273, col: 29, offset: 9287},
+							pos: position{line: 326, col: 29, offset: 11420},
 							expr: &ruleRefExpr{
-								pos:  position{line: 273, col: 29, offset: 9287},
+								pos:  position{line: 326, col: 29, offset: 11420},
 								name: "WS",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 273, col: 33, offset: 9291},
+							pos: position{line: 326, col: 33, offset: 11424},
 							expr: &litMatcher{
-								pos:        position{line: 273, col: 33, offset: 9291},
+								pos:        position{line: 326, col: 33, offset: 11424},
 								val:        ",",
 								ignoreCase: false,
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 273, col: 38, offset: 9296},
+							pos:   position{line: 326, col: 38, offset: 11429},
 							label: "author",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 273, col: 45, offset: 9303},
+								pos: position{line: 326, col: 45, offset: 11436},
 								expr: &ruleRefExpr{
-									pos:  position{line: 273, col: 46, offset: 9304},
+									pos:  position{line: 326, col: 46, offset: 11437},
 									name: "QuoteAttribute",
 								},
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 273, col: 63, offset: 9321},
+							pos: position{line: 326, col: 63, offset: 11454},
 							expr: &litMatcher{
-								pos:        position{line: 273, col: 63, offset: 9321},
+								pos:        position{line: 326, col: 63, offset: 11454},
 								val:        ",",
 								ignoreCase: false,
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 273, col: 68, offset: 9326},
+							pos:   position{line: 326, col: 68, offset: 11459},
 							label: "title",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 273, col: 74, offset: 9332},
+								pos: position{line: 326, col: 74, offset: 11465},
 								expr: &ruleRefExpr{
-									pos:  position{line: 273, col: 75, offset: 9333},
+									pos:  position{line: 326, col: 75, offset: 11466},
 									name: "QuoteAttribute",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 273, col: 92, offset: 9350},
+							pos:        position{line: 326, col: 92, offset: 11483},
 							val:        "]",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 273, col: 96, offset: 9354},
+							pos:  position{line: 326, col: 96, offset: 11487},
 							name: "EOLS",
 						},
 					},
@@ -2363,51 +2925,51 @@ var g = &grammar{
 		},
 		{
 			name: "QuoteAttribute",
-			pos:  position{line: 277, col: 1, offset: 9442},
+			pos:  position{line: 330, col: 1, offset: 11575},
 			expr: &actionExpr{
-				pos: position{line: 277, col: 19, offset: 9460},
+				pos: position{line: 330, col: 19, offset: 11593},
 				run: (*parser).callonQuoteAttribute1,
 				expr: &zeroOrMoreExpr{
-					pos: position{line: 277, col: 19, offset: 9460},
+					pos: position{line: 330, col: 19, offset: 11593},
 					expr: &choiceExpr{
-						pos: position{line: 277, col: 20, offset: 9461},
+						pos: position{line: 330, col: 20, offset: 11594},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 277, col: 20, offset: 9461},
+								pos:  position{line: 330, col: 20, offset: 11594},
 								name: "Alphanums",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 277, col: 32, offset: 9473},
+								pos:  position{line: 330, col: 32, offset: 11606},
 								name: "Spaces",
 							},
 							&seqExpr{
-								pos: position{line: 277, col: 42, offset: 9483},
+								pos: position{line: 330, col: 42, offset: 11616},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 277, col: 42, offset: 9483},
+										pos: position{line: 330, col: 42, offset: 11616},
 										expr: &litMatcher{
-											pos:        position{line: 277, col: 43, offset: 9484},
+											pos:        position{line: 330, col: 43, offset: 11617},
 											val:        ",",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 277, col: 47, offset: 9488},
+										pos: position{line: 330, col: 47, offset: 11621},
 										expr: &litMatcher{
-											pos:        position{line: 277, col: 48, offset: 9489},
+											pos:        position{line: 330, col: 48, offset: 11622},
 											val:        "]",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 277, col: 52, offset: 9493},
+										pos: position{line: 330, col: 52, offset: 11626},
 										expr: &ruleRefExpr{
-											pos:  position{line: 277, col: 53, offset: 9494},
+											pos:  position{line: 330, col: 53, offset: 11627},
 											name: "EOL",
 										},
 									},
 									&anyMatcher{
-										line: 277, col: 57, offset: 9498,
+										line: 330, col: 57, offset: 11631,
 									},
 								},
 							},
@@ -2418,31 +2980,31 @@ var g = &grammar{
 		},
 		{
 			name: "InlineAttributes",
-			pos:  position{line: 281, col: 1, offset: 9539},
+			pos:  position{line: 334, col: 1, offset: 11672},
 			expr: &actionExpr{
-				pos: position{line: 281, col: 21, offset: 9559},
+				pos: position{line: 334, col: 21, offset: 11692},
 				run: (*parser).callonInlineAttributes1,
 				expr: &seqExpr{
-					pos: position{line: 281, col: 21, offset: 9559},
+					pos: position{line: 334, col: 21, offset: 11692},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 281, col: 21, offset: 9559},
+							pos:        position{line: 334, col: 21, offset: 11692},
 							val:        "[",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 281, col: 25, offset: 9563},
+							pos:   position{line: 334, col: 25, offset: 11696},
 							label: "attrs",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 281, col: 31, offset: 9569},
+								pos: position{line: 334, col: 31, offset: 11702},
 								expr: &ruleRefExpr{
-									pos:  position{line: 281, col: 32, offset: 9570},
+									pos:  position{line: 334, col: 32, offset: 11703},
 									name: "GenericAttribute",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 281, col: 51, offset: 9589},
+							pos:        position{line: 334, col: 51, offset: 11722},
 							val:        "]",
 							ignoreCase: false,
 						},
@@ -2452,72 +3014,85 @@ var g = &grammar{
 		},
 		{
 			name: "Section",
-			pos:  position{line: 288, col: 1, offset: 9763},
+			pos:  position{line: 341, col: 1, offset: 11896},
 			expr: &actionExpr{
-				pos: position{line: 288, col: 12, offset: 9774},
+				pos: position{line: 341, col: 12, offset: 11907},
 				run: (*parser).callonSection1,
 				expr: &seqExpr{
-					pos: position{line: 288, col: 12, offset: 9774},
+					pos: position{line: 341, col: 12, offset: 11907},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 288, col: 12, offset: 9774},
+							pos:   position{line: 341, col: 12, offset: 11907},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 288, col: 23, offset: 9785},
+								pos: position{line: 341, col: 23, offset: 11918},
 								expr: &ruleRefExpr{
-									pos:  position{line: 288, col: 24, offset: 9786},
+									pos:  position{line: 341, col: 24, offset: 11919},
 									name: "ElementAttributes",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 289, col: 5, offset: 9810},
+							pos:   position{line: 342, col: 5, offset: 11943},
 							label: "level",
 							expr: &actionExpr{
-								pos: position{line: 289, col: 12, offset: 9817},
+								pos: position{line: 342, col: 12, offset: 11950},
 								run: (*parser).callonSection7,
-								expr: &oneOrMoreExpr{
-									pos: position{line: 289, col: 12, offset: 9817},
-									expr: &litMatcher{
-										pos:        position{line: 289, col: 13, offset: 9818},
-										val:        "=",
-										ignoreCase: false,
+								expr: &choiceExpr{
+									pos: position{line: 342, col: 13, offset: 11951},
+									alternatives: []interface{}{
+										&oneOrMoreExpr{
+											pos: position{line: 342, col: 13, offset: 11951},
+											expr: &litMatcher{
+												pos:        position{line: 342, col: 14, offset: 11952},
+												val:        "=",
+												ignoreCase: false,
+											},
+										},
+										&oneOrMoreExpr{
+											pos: position{line: 342, col: 22, offset: 11960},
+											expr: &litMatcher{
+												pos:        position{line: 342, col: 23, offset: 11961},
+												val:        "#",
+												ignoreCase: false,
+											},
+										},
 									},
 								},
 							},
 						},
 						&andCodeExpr{
-							pos: position{line: 293, col: 5, offset: 9909},
-							run: (*parser).callonSection10,
+							pos: position{line: 346, col: 5, offset: 12081},
+							run: (*parser).callonSection13,
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 297, col: 5, offset: 10061},
+							pos: position{line: 350, col: 5, offset: 12233},
 							expr: &ruleRefExpr{
-								pos:  position{line: 297, col: 5, offset: 10061},
+								pos:  position{line: 350, col: 5, offset: 12233},
 								name: "WS",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 297, col: 9, offset: 10065},
+							pos:   position{line: 350, col: 9, offset: 12237},
 							label: "title",
 							expr: &ruleRefExpr{
-								pos:  position{line: 297, col: 16, offset: 10072},
+								pos:  position{line: 350, col: 16, offset: 12244},
 								name: "TitleElements",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 297, col: 31, offset: 10087},
+							pos:   position{line: 350, col: 31, offset: 12259},
 							label: "id",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 297, col: 35, offset: 10091},
+								pos: position{line: 350, col: 35, offset: 12263},
 								expr: &ruleRefExpr{
-									pos:  position{line: 297, col: 35, offset: 10091},
+									pos:  position{line: 350, col: 35, offset: 12263},
 									name: "InlineElementID",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 297, col: 53, offset: 10109},
+							pos:  position{line: 350, col: 53, offset: 12281},
 							name: "EOL",
 						},
 					},
@@ -2525,35 +3100,123 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "TitleElements",
-			pos:  position{line: 302, col: 1, offset: 10223},
+			name: "DiscreteHeading",
+			pos:  position{line: 355, col: 1, offset: 12485},
 			expr: &actionExpr{
-				pos: position{line: 302, col: 18, offset: 10240},
-				run: (*parser).callonTitleElements1,
+				pos: position{line: 355, col: 20, offset: 12504},
+				run: (*parser).callonDiscreteHeading1,
+				expr: &seqExpr{
+					pos: position{line: 355, col: 20, offset: 12504},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 355, col: 20, offset: 12504},
+							label: "attributes",
+							expr: &ruleRefExpr{
+								pos:  position{line: 355, col: 32, offset: 12516},
+								name: "ElementAttributes",
+							},
+						},
+						&andCodeExpr{
+							pos: position{line: 356, col: 5, offset: 12539},
+							run: (*parser).callonDiscreteHeading5,
+						},
+						&labeledExpr{
+							pos:   position{line: 360, col: 5, offset: 12694},
+							label: "level",
+							expr: &actionExpr{
+								pos: position{line: 360, col: 12, offset: 12701},
+								run: (*parser).callonDiscreteHeading7,
+								expr: &choiceExpr{
+									pos: position{line: 360, col: 13, offset: 12702},
+									alternatives: []interface{}{
+										&oneOrMoreExpr{
+											pos: position{line: 360, col: 13, offset: 12702},
+											expr: &litMatcher{
+												pos:        position{line: 360, col: 14, offset: 12703},
+												val:        "=",
+												ignoreCase: false,
+											},
+										},
+										&oneOrMoreExpr{
+											pos: position{line: 360, col: 22, offset: 12711},
+											expr: &litMatcher{
+												pos:        position{line: 360, col: 23, offset: 12712},
+												val:        "#",
+												ignoreCase: false,
+											},
+										},
+									},
+								},
+							},
+						},
+						&andCodeExpr{
+							pos: position{line: 363, col: 5, offset: 12772},
+							run: (*parser).callonDiscreteHeading13,
+						},
+						&oneOrMoreExpr{
+							pos: position{line: 366, col: 5, offset: 12826},
+							expr: &ruleRefExpr{
+								pos:  position{line: 366, col: 5, offset: 12826},
+								name: "WS",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 366, col: 9, offset: 12830},
+							label: "title",
+							expr: &ruleRefExpr{
+								pos:  position{line: 366, col: 16, offset: 12837},
+								name: "TitleElements",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 366, col: 31, offset: 12852},
+							label: "id",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 366, col: 35, offset: 12856},
+								expr: &ruleRefExpr{
+									pos:  position{line: 366, col: 35, offset: 12856},
+									name: "InlineElementID",
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 366, col: 53, offset: 12874},
+							name: "EOL",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "TitleElements",
+			pos:  position{line: 371, col: 1, offset: 12996},
+			expr: &actionExpr{
+				pos: position{line: 371, col: 18, offset: 13013},
+				run: (*parser).callonTitleElements1,
 				expr: &labeledExpr{
-					pos:   position{line: 302, col: 18, offset: 10240},
+					pos:   position{line: 371, col: 18, offset: 13013},
 					label: "elements",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 302, col: 27, offset: 10249},
+						pos: position{line: 371, col: 27, offset: 13022},
 						expr: &seqExpr{
-							pos: position{line: 302, col: 28, offset: 10250},
+							pos: position{line: 371, col: 28, offset: 13023},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 302, col: 28, offset: 10250},
+									pos: position{line: 371, col: 28, offset: 13023},
 									expr: &ruleRefExpr{
-										pos:  position{line: 302, col: 29, offset: 10251},
+										pos:  position{line: 371, col: 29, offset: 13024},
 										name: "NEWLINE",
 									},
 								},
 								&notExpr{
-									pos: position{line: 302, col: 37, offset: 10259},
+									pos: position{line: 371, col: 37, offset: 13032},
 									expr: &ruleRefExpr{
-										pos:  position{line: 302, col: 38, offset: 10260},
+										pos:  position{line: 371, col: 38, offset: 13033},
 										name: "InlineElementID",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 302, col: 54, offset: 10276},
+									pos:  position{line: 371, col: 54, offset: 13049},
 									name: "TitleElement",
 								},
 							},
@@ -2564,58 +3227,62 @@ var g = &grammar{
 		},
 		{
 			name: "TitleElement",
-			pos:  position{line: 306, col: 1, offset: 10397},
+			pos:  position{line: 375, col: 1, offset: 13170},
 			expr: &actionExpr{
-				pos: position{line: 306, col: 17, offset: 10413},
+				pos: position{line: 375, col: 17, offset: 13186},
 				run: (*parser).callonTitleElement1,
 				expr: &labeledExpr{
-					pos:   position{line: 306, col: 17, offset: 10413},
+					pos:   position{line: 375, col: 17, offset: 13186},
 					label: "element",
 					expr: &choiceExpr{
-						pos: position{line: 306, col: 26, offset: 10422},
+						pos: position{line: 375, col: 26, offset: 13195},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 306, col: 26, offset: 10422},
+								pos:  position{line: 375, col: 26, offset: 13195},
 								name: "SimpleWord",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 307, col: 11, offset: 10443},
+								pos:  position{line: 376, col: 11, offset: 13216},
 								name: "Spaces",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 308, col: 11, offset: 10461},
+								pos:  position{line: 377, col: 11, offset: 13234},
 								name: "CrossReference",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 309, col: 11, offset: 10486},
+								pos:  position{line: 378, col: 11, offset: 13259},
 								name: "Passthrough",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 310, col: 11, offset: 10508},
+								pos:  position{line: 379, col: 11, offset: 13281},
 								name: "InlineImage",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 311, col: 11, offset: 10531},
+								pos:  position{line: 380, col: 11, offset: 13304},
 								name: "Link",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 312, col: 11, offset: 10546},
+								pos:  position{line: 381, col: 11, offset: 13319},
 								name: "InlineFootnote",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 313, col: 11, offset: 10571},
+								pos:  position{line: 382, col: 11, offset: 13344},
+								name: "IndexTerm",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 383, col: 11, offset: 13364},
 								name: "QuotedText",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 314, col: 11, offset: 10592},
+								pos:  position{line: 384, col: 11, offset: 13385},
 								name: "DocumentAttributeSubstitution",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 315, col: 11, offset: 10632},
+								pos:  position{line: 385, col: 11, offset: 13425},
 								name: "LineBreak",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 316, col: 11, offset: 10652},
+								pos:  position{line: 386, col: 11, offset: 13445},
 								name: "OtherWord",
 							},
 						},
@@ -2625,57 +3292,61 @@ var g = &grammar{
 		},
 		{
 			name: "TableOfContentsMacro",
-			pos:  position{line: 323, col: 1, offset: 10805},
-			expr: &seqExpr{
-				pos: position{line: 323, col: 25, offset: 10829},
-				exprs: []interface{}{
-					&litMatcher{
-						pos:        position{line: 323, col: 25, offset: 10829},
-						val:        "toc::[]",
-						ignoreCase: false,
-					},
-					&ruleRefExpr{
-						pos:  position{line: 323, col: 35, offset: 10839},
-						name: "EOL",
+			pos:  position{line: 393, col: 1, offset: 13598},
+			expr: &actionExpr{
+				pos: position{line: 393, col: 25, offset: 13622},
+				run: (*parser).callonTableOfContentsMacro1,
+				expr: &seqExpr{
+					pos: position{line: 393, col: 25, offset: 13622},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 393, col: 25, offset: 13622},
+							val:        "toc::[]",
+							ignoreCase: false,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 393, col: 35, offset: 13632},
+							name: "EOL",
+						},
 					},
 				},
 			},
 		},
 		{
 			name: "UserMacroBlock",
-			pos:  position{line: 328, col: 1, offset: 10950},
+			pos:  position{line: 400, col: 1, offset: 13790},
 			expr: &actionExpr{
-				pos: position{line: 328, col: 19, offset: 10968},
+				pos: position{line: 400, col: 19, offset: 13808},
 				run: (*parser).callonUserMacroBlock1,
 				expr: &seqExpr{
-					pos: position{line: 328, col: 19, offset: 10968},
+					pos: position{line: 400, col: 19, offset: 13808},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 328, col: 19, offset: 10968},
+							pos:   position{line: 400, col: 19, offset: 13808},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 328, col: 25, offset: 10974},
+								pos:  position{line: 400, col: 25, offset: 13814},
 								name: "UserMacroName",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 328, col: 40, offset: 10989},
+							pos:        position{line: 400, col: 40, offset: 13829},
 							val:        "::",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 328, col: 45, offset: 10994},
+							pos:   position{line: 400, col: 45, offset: 13834},
 							label: "value",
 							expr: &ruleRefExpr{
-								pos:  position{line: 328, col: 52, offset: 11001},
+								pos:  position{line: 400, col: 52, offset: 13841},
 								name: "UserMacroValue",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 328, col: 68, offset: 11017},
+							pos:   position{line: 400, col: 68, offset: 13857},
 							label: "attrs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 328, col: 75, offset: 11024},
+								pos:  position{line: 400, col: 75, offset: 13864},
 								name: "UserMacroAttributes",
 							},
 						},
@@ -2685,39 +3356,39 @@ var g = &grammar{
 		},
 		{
 			name: "InlineUserMacro",
-			pos:  position{line: 332, col: 1, offset: 11165},
+			pos:  position{line: 404, col: 1, offset: 14005},
 			expr: &actionExpr{
-				pos: position{line: 332, col: 20, offset: 11184},
+				pos: position{line: 404, col: 20, offset: 14024},
 				run: (*parser).callonInlineUserMacro1,
 				expr: &seqExpr{
-					pos: position{line: 332, col: 20, offset: 11184},
+					pos: position{line: 404, col: 20, offset: 14024},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 332, col: 20, offset: 11184},
+							pos:   position{line: 404, col: 20, offset: 14024},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 332, col: 26, offset: 11190},
+								pos:  position{line: 404, col: 26, offset: 14030},
 								name: "UserMacroName",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 332, col: 41, offset: 11205},
+							pos:        position{line: 404, col: 41, offset: 14045},
 							val:        ":",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 332, col: 45, offset: 11209},
+							pos:   position{line: 404, col: 45, offset: 14049},
 							label: "value",
 							expr: &ruleRefExpr{
-								pos:  position{line: 332, col: 52, offset: 11216},
+								pos:  position{line: 404, col: 52, offset: 14056},
 								name: "UserMacroValue",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 332, col: 68, offset: 11232},
+							pos:   position{line: 404, col: 68, offset: 14072},
 							label: "attrs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 332, col: 75, offset: 11239},
+								pos:  position{line: 404, col: 75, offset: 14079},
 								name: "UserMacroAttributes",
 							},
 						},
@@ -2727,29 +3398,29 @@ var g = &grammar{
 		},
 		{
 			name: "UserMacroName",
-			pos:  position{line: 336, col: 1, offset: 11381},
+			pos:  position{line: 408, col: 1, offset: 14221},
 			expr: &actionExpr{
-				pos: position{line: 336, col: 18, offset: 11398},
+				pos: position{line: 408, col: 18, offset: 14238},
 				run: (*parser).callonUserMacroName1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 336, col: 18, offset: 11398},
+					pos: position{line: 408, col: 18, offset: 14238},
 					expr: &choiceExpr{
-						pos: position{line: 336, col: 19, offset: 11399},
+						pos: position{line: 408, col: 19, offset: 14239},
 						alternatives: []interface{}{
 							&charClassMatcher{
-								pos:        position{line: 336, col: 19, offset: 11399},
+								pos:        position{line: 408, col: 19, offset: 14239},
 								val:        "[a-zA-Z0-9]",
 								ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
 								ignoreCase: false,
 								inverted:   false,
 							},
 							&litMatcher{
-								pos:        position{line: 336, col: 33, offset: 11413},
+								pos:        position{line: 408, col: 33, offset: 14253},
 								val:        "_",
 								ignoreCase: false,
 							},
 							&litMatcher{
-								pos:        position{line: 336, col: 39, offset: 11419},
+								pos:        position{line: 408, col: 39, offset: 14259},
 								val:        "-",
 								ignoreCase: false,
 							},
@@ -2760,47 +3431,47 @@ var g = &grammar{
 		},
 		{
 			name: "UserMacroValue",
-			pos:  position{line: 340, col: 1, offset: 11461},
+			pos:  position{line: 412, col: 1, offset: 14301},
 			expr: &actionExpr{
-				pos: position{line: 340, col: 19, offset: 11479},
+				pos: position{line: 412, col: 19, offset: 14319},
 				run: (*parser).callonUserMacroValue1,
 				expr: &zeroOrMoreExpr{
-					pos: position{line: 340, col: 19, offset: 11479},
+					pos: position{line: 412, col: 19, offset: 14319},
 					expr: &choiceExpr{
-						pos: position{line: 340, col: 20, offset: 11480},
+						pos: position{line: 412, col: 20, offset: 14320},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 340, col: 20, offset: 11480},
+								pos:  position{line: 412, col: 20, offset: 14320},
 								name: "Alphanums",
 							},
 							&seqExpr{
-								pos: position{line: 340, col: 33, offset: 11493},
+								pos: position{line: 412, col: 33, offset: 14333},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 340, col: 33, offset: 11493},
+										pos: position{line: 412, col: 33, offset: 14333},
 										expr: &litMatcher{
-											pos:        position{line: 340, col: 34, offset: 11494},
+											pos:        position{line: 412, col: 34, offset: 14334},
 											val:        ":",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 340, col: 38, offset: 11498},
+										pos: position{line: 412, col: 38, offset: 14338},
 										expr: &litMatcher{
-											pos:        position{line: 340, col: 39, offset: 11499},
+											pos:        position{line: 412, col: 39, offset: 14339},
 											val:        "[",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 340, col: 43, offset: 11503},
+										pos: position{line: 412, col: 43, offset: 14343},
 										expr: &ruleRefExpr{
-											pos:  position{line: 340, col: 44, offset: 11504},
+											pos:  position{line: 412, col: 44, offset: 14344},
 											name: "EOL",
 										},
 									},
 									&anyMatcher{
-										line: 340, col: 48, offset: 11508,
+										line: 412, col: 48, offset: 14348,
 									},
 								},
 							},
@@ -2811,31 +3482,31 @@ var g = &grammar{
 		},
 		{
 			name: "UserMacroAttributes",
-			pos:  position{line: 344, col: 1, offset: 11549},
+			pos:  position{line: 416, col: 1, offset: 14389},
 			expr: &actionExpr{
-				pos: position{line: 344, col: 24, offset: 11572},
+				pos: position{line: 416, col: 24, offset: 14412},
 				run: (*parser).callonUserMacroAttributes1,
 				expr: &seqExpr{
-					pos: position{line: 344, col: 24, offset: 11572},
+					pos: position{line: 416, col: 24, offset: 14412},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 344, col: 24, offset: 11572},
+							pos:        position{line: 416, col: 24, offset: 14412},
 							val:        "[",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 344, col: 28, offset: 11576},
+							pos:   position{line: 416, col: 28, offset: 14416},
 							label: "attrs",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 344, col: 34, offset: 11582},
+								pos: position{line: 416, col: 34, offset: 14422},
 								expr: &ruleRefExpr{
-									pos:  position{line: 344, col: 35, offset: 11583},
+									pos:  position{line: 416, col: 35, offset: 14423},
 									name: "GenericAttribute",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 344, col: 54, offset: 11602},
+							pos:        position{line: 416, col: 54, offset: 14442},
 							val:        "]",
 							ignoreCase: false,
 						},
@@ -2845,40 +3516,40 @@ var g = &grammar{
 		},
 		{
 			name: "FileInclusion",
-			pos:  position{line: 351, col: 1, offset: 11782},
+			pos:  position{line: 423, col: 1, offset: 14622},
 			expr: &actionExpr{
-				pos: position{line: 351, col: 18, offset: 11799},
+				pos: position{line: 423, col: 18, offset: 14639},
 				run: (*parser).callonFileInclusion1,
 				expr: &seqExpr{
-					pos: position{line: 351, col: 18, offset: 11799},
+					pos: position{line: 423, col: 18, offset: 14639},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 351, col: 18, offset: 11799},
+							pos:   position{line: 423, col: 18, offset: 14639},
 							label: "incl",
 							expr: &actionExpr{
-								pos: position{line: 351, col: 24, offset: 11805},
+								pos: position{line: 423, col: 24, offset: 14645},
 								run: (*parser).callonFileInclusion4,
 								expr: &seqExpr{
-									pos: position{line: 351, col: 24, offset: 11805},
+									pos: position{line: 423, col: 24, offset: 14645},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 351, col: 24, offset: 11805},
+											pos:        position{line: 423, col: 24, offset: 14645},
 											val:        "include::",
 											ignoreCase: false,
 										},
 										&labeledExpr{
-											pos:   position{line: 351, col: 36, offset: 11817},
+											pos:   position{line: 423, col: 36, offset: 14657},
 											label: "path",
 											expr: &ruleRefExpr{
-												pos:  position{line: 351, col: 42, offset: 11823},
+												pos:  position{line: 423, col: 42, offset: 14663},
 												name: "FileLocation",
 											},
 										},
 										&labeledExpr{
-											pos:   position{line: 351, col: 56, offset: 11837},
+											pos:   position{line: 423, col: 56, offset: 14677},
 											label: "inlineAttributes",
 											expr: &ruleRefExpr{
-												pos:  position{line: 351, col: 74, offset: 11855},
+												pos:  position{line: 423, col: 74, offset: 14695},
 												name: "FileIncludeAttributes",
 											},
 										},
@@ -2887,7 +3558,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 353, col: 8, offset: 12009},
+							pos:  position{line: 425, col: 8, offset: 14849},
 							name: "EOLS",
 						},
 					},
@@ -2896,36 +3567,36 @@ var g = &grammar{
 		},
 		{
 			name: "FileIncludeAttributes",
-			pos:  position{line: 357, col: 1, offset: 12062},
+			pos:  position{line: 429, col: 1, offset: 14902},
 			expr: &actionExpr{
-				pos: position{line: 357, col: 26, offset: 12087},
+				pos: position{line: 429, col: 26, offset: 14927},
 				run: (*parser).callonFileIncludeAttributes1,
 				expr: &seqExpr{
-					pos: position{line: 357, col: 26, offset: 12087},
+					pos: position{line: 429, col: 26, offset: 14927},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 357, col: 26, offset: 12087},
+							pos:        position{line: 429, col: 26, offset: 14927},
 							val:        "[",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 357, col: 30, offset: 12091},
+							pos:   position{line: 429, col: 30, offset: 14931},
 							label: "attrs",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 357, col: 36, offset: 12097},
+								pos: position{line: 429, col: 36, offset: 14937},
 								expr: &choiceExpr{
-									pos: position{line: 357, col: 37, offset: 12098},
+									pos: position{line: 429, col: 37, offset: 14938},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 357, col: 37, offset: 12098},
+											pos:  position{line: 429, col: 37, offset: 14938},
 											name: "LineRangesAttribute",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 357, col: 59, offset: 12120},
+											pos:  position{line: 429, col: 59, offset: 14960},
 											name: "TagRangesAttribute",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 357, col: 80, offset: 12141},
+											pos:  position{line: 429, col: 80, offset: 14981},
 											name: "GenericAttribute",
 										},
 									},
@@ -2933,7 +3604,7 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 357, col: 99, offset: 12160},
+							pos:        position{line: 429, col: 99, offset: 15000},
 							val:        "]",
 							ignoreCase: false,
 						},
@@ -2943,30 +3614,30 @@ var g = &grammar{
 		},
 		{
 			name: "LineRangesAttribute",
-			pos:  position{line: 361, col: 1, offset: 12230},
+			pos:  position{line: 433, col: 1, offset: 15070},
 			expr: &actionExpr{
-				pos: position{line: 361, col: 24, offset: 12253},
+				pos: position{line: 433, col: 24, offset: 15093},
 				run: (*parser).callonLineRangesAttribute1,
 				expr: &seqExpr{
-					pos: position{line: 361, col: 24, offset: 12253},
+					pos: position{line: 433, col: 24, offset: 15093},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 361, col: 24, offset: 12253},
+							pos:        position{line: 433, col: 24, offset: 15093},
 							val:        "lines=",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 361, col: 33, offset: 12262},
+							pos:   position{line: 433, col: 33, offset: 15102},
 							label: "lines",
 							expr: &ruleRefExpr{
-								pos:  position{line: 361, col: 40, offset: 12269},
+								pos:  position{line: 433, col: 40, offset: 15109},
 								name: "LineRangesAttributeValue",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 361, col: 66, offset: 12295},
+							pos: position{line: 433, col: 66, offset: 15135},
 							expr: &litMatcher{
-								pos:        position{line: 361, col: 66, offset: 12295},
+								pos:        position{line: 433, col: 66, offset: 15135},
 								val:        ",",
 								ignoreCase: false,
 							},
@@ -2977,72 +3648,72 @@ var g = &grammar{
 		},
 		{
 			name: "LineRangesAttributeValue",
-			pos:  position{line: 365, col: 1, offset: 12354},
+			pos:  position{line: 437, col: 1, offset: 15194},
 			expr: &actionExpr{
-				pos: position{line: 365, col: 29, offset: 12382},
+				pos: position{line: 437, col: 29, offset: 15222},
 				run: (*parser).callonLineRangesAttributeValue1,
 				expr: &seqExpr{
-					pos: position{line: 365, col: 29, offset: 12382},
+					pos: position{line: 437, col: 29, offset: 15222},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 365, col: 29, offset: 12382},
+							pos:   position{line: 437, col: 29, offset: 15222},
 							label: "value",
 							expr: &choiceExpr{
-								pos: position{line: 365, col: 36, offset: 12389},
+								pos: position{line: 437, col: 36, offset: 15229},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 365, col: 36, offset: 12389},
+										pos:  position{line: 437, col: 36, offset: 15229},
 										name: "MultipleLineRanges",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 366, col: 11, offset: 12506},
+										pos:  position{line: 438, col: 11, offset: 15346},
 										name: "MultipleQuotedLineRanges",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 367, col: 11, offset: 12542},
+										pos:  position{line: 439, col: 11, offset: 15382},
 										name: "MultiLineRange",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 368, col: 11, offset: 12568},
+										pos:  position{line: 440, col: 11, offset: 15408},
 										name: "MultiLineQuotedRange",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 369, col: 11, offset: 12600},
+										pos:  position{line: 441, col: 11, offset: 15440},
 										name: "SingleLineQuotedRange",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 370, col: 11, offset: 12632},
+										pos:  position{line: 442, col: 11, offset: 15472},
 										name: "SingleLineRange",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 371, col: 11, offset: 12659},
+										pos:  position{line: 443, col: 11, offset: 15499},
 										name: "UndefinedLineRange",
 									},
 								},
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 371, col: 31, offset: 12679},
+							pos: position{line: 443, col: 31, offset: 15519},
 							expr: &ruleRefExpr{
-								pos:  position{line: 371, col: 31, offset: 12679},
+								pos:  position{line: 443, col: 31, offset: 15519},
 								name: "WS",
 							},
 						},
 						&choiceExpr{
-							pos: position{line: 371, col: 36, offset: 12684},
+							pos: position{line: 443, col: 36, offset: 15524},
 							alternatives: []interface{}{
 								&andExpr{
-									pos: position{line: 371, col: 36, offset: 12684},
+									pos: position{line: 443, col: 36, offset: 15524},
 									expr: &litMatcher{
-										pos:        position{line: 371, col: 37, offset: 12685},
+										pos:        position{line: 443, col: 37, offset: 15525},
 										val:        ",",
 										ignoreCase: false,
 									},
 								},
 								&andExpr{
-									pos: position{line: 371, col: 43, offset: 12691},
+									pos: position{line: 443, col: 43, offset: 15531},
 									expr: &litMatcher{
-										pos:        position{line: 371, col: 44, offset: 12692},
+										pos:        position{line: 443, col: 44, offset: 15532},
 										val:        "]",
 										ignoreCase: false,
 									},
@@ -3055,58 +3726,58 @@ var g = &grammar{
 		},
 		{
 			name: "MultipleLineRanges",
-			pos:  position{line: 375, col: 1, offset: 12724},
+			pos:  position{line: 447, col: 1, offset: 15564},
 			expr: &actionExpr{
-				pos: position{line: 375, col: 23, offset: 12746},
+				pos: position{line: 447, col: 23, offset: 15586},
 				run: (*parser).callonMultipleLineRanges1,
 				expr: &seqExpr{
-					pos: position{line: 375, col: 23, offset: 12746},
+					pos: position{line: 447, col: 23, offset: 15586},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 375, col: 23, offset: 12746},
+							pos:   position{line: 447, col: 23, offset: 15586},
 							label: "first",
 							expr: &choiceExpr{
-								pos: position{line: 375, col: 30, offset: 12753},
+								pos: position{line: 447, col: 30, offset: 15593},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 375, col: 30, offset: 12753},
+										pos:  position{line: 447, col: 30, offset: 15593},
 										name: "MultiLineRange",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 375, col: 47, offset: 12770},
+										pos:  position{line: 447, col: 47, offset: 15610},
 										name: "SingleLineRange",
 									},
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 376, col: 5, offset: 12792},
+							pos:   position{line: 448, col: 5, offset: 15632},
 							label: "others",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 376, col: 12, offset: 12799},
+								pos: position{line: 448, col: 12, offset: 15639},
 								expr: &actionExpr{
-									pos: position{line: 376, col: 13, offset: 12800},
+									pos: position{line: 448, col: 13, offset: 15640},
 									run: (*parser).callonMultipleLineRanges9,
 									expr: &seqExpr{
-										pos: position{line: 376, col: 13, offset: 12800},
+										pos: position{line: 448, col: 13, offset: 15640},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 376, col: 13, offset: 12800},
+												pos:        position{line: 448, col: 13, offset: 15640},
 												val:        ";",
 												ignoreCase: false,
 											},
 											&labeledExpr{
-												pos:   position{line: 376, col: 17, offset: 12804},
+												pos:   position{line: 448, col: 17, offset: 15644},
 												label: "other",
 												expr: &choiceExpr{
-													pos: position{line: 376, col: 24, offset: 12811},
+													pos: position{line: 448, col: 24, offset: 15651},
 													alternatives: []interface{}{
 														&ruleRefExpr{
-															pos:  position{line: 376, col: 24, offset: 12811},
+															pos:  position{line: 448, col: 24, offset: 15651},
 															name: "MultiLineRange",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 376, col: 41, offset: 12828},
+															pos:  position{line: 448, col: 41, offset: 15668},
 															name: "SingleLineRange",
 														},
 													},
@@ -3123,63 +3794,63 @@ var g = &grammar{
 		},
 		{
 			name: "MultipleQuotedLineRanges",
-			pos:  position{line: 382, col: 1, offset: 12966},
+			pos:  position{line: 454, col: 1, offset: 15806},
 			expr: &actionExpr{
-				pos: position{line: 382, col: 29, offset: 12994},
+				pos: position{line: 454, col: 29, offset: 15834},
 				run: (*parser).callonMultipleQuotedLineRanges1,
 				expr: &seqExpr{
-					pos: position{line: 382, col: 29, offset: 12994},
+					pos: position{line: 454, col: 29, offset: 15834},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 382, col: 29, offset: 12994},
+							pos:        position{line: 454, col: 29, offset: 15834},
 							val:        "\"",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 382, col: 34, offset: 12999},
+							pos:   position{line: 454, col: 34, offset: 15839},
 							label: "first",
 							expr: &choiceExpr{
-								pos: position{line: 382, col: 41, offset: 13006},
+								pos: position{line: 454, col: 41, offset: 15846},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 382, col: 41, offset: 13006},
+										pos:  position{line: 454, col: 41, offset: 15846},
 										name: "MultiLineRange",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 382, col: 58, offset: 13023},
+										pos:  position{line: 454, col: 58, offset: 15863},
 										name: "SingleLineRange",
 									},
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 383, col: 5, offset: 13045},
+							pos:   position{line: 455, col: 5, offset: 15885},
 							label: "others",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 383, col: 12, offset: 13052},
+								pos: position{line: 455, col: 12, offset: 15892},
 								expr: &actionExpr{
-									pos: position{line: 383, col: 13, offset: 13053},
+									pos: position{line: 455, col: 13, offset: 15893},
 									run: (*parser).callonMultipleQuotedLineRanges10,
 									expr: &seqExpr{
-										pos: position{line: 383, col: 13, offset: 13053},
+										pos: position{line: 455, col: 13, offset: 15893},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 383, col: 13, offset: 13053},
+												pos:        position{line: 455, col: 13, offset: 15893},
 												val:        ",",
 												ignoreCase: false,
 											},
 											&labeledExpr{
-												pos:   position{line: 383, col: 17, offset: 13057},
+												pos:   position{line: 455, col: 17, offset: 15897},
 												label: "other",
 												expr: &choiceExpr{
-													pos: position{line: 383, col: 24, offset: 13064},
+													pos: position{line: 455, col: 24, offset: 15904},
 													alternatives: []interface{}{
 														&ruleRefExpr{
-															pos:  position{line: 383, col: 24, offset: 13064},
+															pos:  position{line: 455, col: 24, offset: 15904},
 															name: "MultiLineRange",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 383, col: 41, offset: 13081},
+															pos:  position{line: 455, col: 41, offset: 15921},
 															name: "SingleLineRange",
 														},
 													},
@@ -3191,7 +3862,7 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 385, col: 9, offset: 13134},
+							pos:        position{line: 457, col: 9, offset: 15974},
 							val:        "\"",
 							ignoreCase: false,
 						},
@@ -3201,31 +3872,31 @@ var g = &grammar{
 		},
 		{
 			name: "MultiLineRange",
-			pos:  position{line: 389, col: 1, offset: 13224},
+			pos:  position{line: 461, col: 1, offset: 16064},
 			expr: &actionExpr{
-				pos: position{line: 389, col: 19, offset: 13242},
+				pos: position{line: 461, col: 19, offset: 16082},
 				run: (*parser).callonMultiLineRange1,
 				expr: &seqExpr{
-					pos: position{line: 389, col: 19, offset: 13242},
+					pos: position{line: 461, col: 19, offset: 16082},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 389, col: 19, offset: 13242},
+							pos:   position{line: 461, col: 19, offset: 16082},
 							label: "start",
 							expr: &ruleRefExpr{
-								pos:  position{line: 389, col: 26, offset: 13249},
+								pos:  position{line: 461, col: 26, offset: 16089},
 								name: "NUMBER",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 389, col: 34, offset: 13257},
+							pos:        position{line: 461, col: 34, offset: 16097},
 							val:        "..",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 389, col: 39, offset: 13262},
+							pos:   position{line: 461, col: 39, offset: 16102},
 							label: "end",
 							expr: &ruleRefExpr{
-								pos:  position{line: 389, col: 44, offset: 13267},
+								pos:  position{line: 461, col: 44, offset: 16107},
 								name: "NUMBER",
 							},
 						},
@@ -3235,41 +3906,41 @@ var g = &grammar{
 		},
 		{
 			name: "MultiLineQuotedRange",
-			pos:  position{line: 393, col: 1, offset: 13355},
+			pos:  position{line: 465, col: 1, offset: 16195},
 			expr: &actionExpr{
-				pos: position{line: 393, col: 25, offset: 13379},
+				pos: position{line: 465, col: 25, offset: 16219},
 				run: (*parser).callonMultiLineQuotedRange1,
 				expr: &seqExpr{
-					pos: position{line: 393, col: 25, offset: 13379},
+					pos: position{line: 465, col: 25, offset: 16219},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 393, col: 25, offset: 13379},
+							pos:        position{line: 465, col: 25, offset: 16219},
 							val:        "\"",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 393, col: 30, offset: 13384},
+							pos:   position{line: 465, col: 30, offset: 16224},
 							label: "start",
 							expr: &ruleRefExpr{
-								pos:  position{line: 393, col: 37, offset: 13391},
+								pos:  position{line: 465, col: 37, offset: 16231},
 								name: "NUMBER",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 393, col: 45, offset: 13399},
+							pos:        position{line: 465, col: 45, offset: 16239},
 							val:        "..",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 393, col: 50, offset: 13404},
+							pos:   position{line: 465, col: 50, offset: 16244},
 							label: "end",
 							expr: &ruleRefExpr{
-								pos:  position{line: 393, col: 55, offset: 13409},
+								pos:  position{line: 465, col: 55, offset: 16249},
 								name: "NUMBER",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 393, col: 63, offset: 13417},
+							pos:        position{line: 465, col: 63, offset: 16257},
 							val:        "\"",
 							ignoreCase: false,
 						},
@@ -3279,15 +3950,15 @@ var g = &grammar{
 		},
 		{
 			name: "SingleLineRange",
-			pos:  position{line: 397, col: 1, offset: 13502},
+			pos:  position{line: 469, col: 1, offset: 16342},
 			expr: &actionExpr{
-				pos: position{line: 397, col: 20, offset: 13521},
+				pos: position{line: 469, col: 20, offset: 16361},
 				run: (*parser).callonSingleLineRange1,
 				expr: &labeledExpr{
-					pos:   position{line: 397, col: 20, offset: 13521},
+					pos:   position{line: 469, col: 20, offset: 16361},
 					label: "singleline",
 					expr: &ruleRefExpr{
-						pos:  position{line: 397, col: 32, offset: 13533},
+						pos:  position{line: 469, col: 32, offset: 16373},
 						name: "NUMBER",
 					},
 				},
@@ -3295,28 +3966,28 @@ var g = &grammar{
 		},
 		{
 			name: "SingleLineQuotedRange",
-			pos:  position{line: 401, col: 1, offset: 13628},
+			pos:  position{line: 473, col: 1, offset: 16468},
 			expr: &actionExpr{
-				pos: position{line: 401, col: 26, offset: 13653},
+				pos: position{line: 473, col: 26, offset: 16493},
 				run: (*parser).callonSingleLineQuotedRange1,
 				expr: &seqExpr{
-					pos: position{line: 401, col: 26, offset: 13653},
+					pos: position{line: 473, col: 26, offset: 16493},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 401, col: 26, offset: 13653},
+							pos:        position{line: 473, col: 26, offset: 16493},
 							val:        "\"",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 401, col: 31, offset: 13658},
+							pos:   position{line: 473, col: 31, offset: 16498},
 							label: "singleline",
 							expr: &ruleRefExpr{
-								pos:  position{line: 401, col: 43, offset: 13670},
+								pos:  position{line: 473, col: 43, offset: 16510},
 								name: "NUMBER",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 401, col: 51, offset: 13678},
+							pos:        position{line: 473, col: 51, offset: 16518},
 							val:        "\"",
 							ignoreCase: false,
 						},
@@ -3326,40 +3997,40 @@ var g = &grammar{
 		},
 		{
 			name: "UndefinedLineRange",
-			pos:  position{line: 405, col: 1, offset: 13770},
+			pos:  position{line: 477, col: 1, offset: 16610},
 			expr: &actionExpr{
-				pos: position{line: 405, col: 23, offset: 13792},
+				pos: position{line: 477, col: 23, offset: 16632},
 				run: (*parser).callonUndefinedLineRange1,
 				expr: &zeroOrMoreExpr{
-					pos: position{line: 405, col: 23, offset: 13792},
+					pos: position{line: 477, col: 23, offset: 16632},
 					expr: &seqExpr{
-						pos: position{line: 405, col: 24, offset: 13793},
+						pos: position{line: 477, col: 24, offset: 16633},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 405, col: 24, offset: 13793},
+								pos: position{line: 477, col: 24, offset: 16633},
 								expr: &litMatcher{
-									pos:        position{line: 405, col: 25, offset: 13794},
+									pos:        position{line: 477, col: 25, offset: 16634},
 									val:        "]",
 									ignoreCase: false,
 								},
 							},
 							&notExpr{
-								pos: position{line: 405, col: 29, offset: 13798},
+								pos: position{line: 477, col: 29, offset: 16638},
 								expr: &litMatcher{
-									pos:        position{line: 405, col: 30, offset: 13799},
+									pos:        position{line: 477, col: 30, offset: 16639},
 									val:        ",",
 									ignoreCase: false,
 								},
 							},
 							&notExpr{
-								pos: position{line: 405, col: 34, offset: 13803},
+								pos: position{line: 477, col: 34, offset: 16643},
 								expr: &ruleRefExpr{
-									pos:  position{line: 405, col: 35, offset: 13804},
+									pos:  position{line: 477, col: 35, offset: 16644},
 									name: "WS",
 								},
 							},
 							&anyMatcher{
-								line: 405, col: 38, offset: 13807,
+								line: 477, col: 38, offset: 16647,
 							},
 						},
 					},
@@ -3368,40 +4039,40 @@ var g = &grammar{
 		},
 		{
 			name: "TagRangesAttribute",
-			pos:  position{line: 409, col: 1, offset: 13847},
+			pos:  position{line: 481, col: 1, offset: 16687},
 			expr: &actionExpr{
-				pos: position{line: 409, col: 23, offset: 13869},
+				pos: position{line: 481, col: 23, offset: 16709},
 				run: (*parser).callonTagRangesAttribute1,
 				expr: &seqExpr{
-					pos: position{line: 409, col: 23, offset: 13869},
+					pos: position{line: 481, col: 23, offset: 16709},
 					exprs: []interface{}{
 						&choiceExpr{
-							pos: position{line: 409, col: 24, offset: 13870},
+							pos: position{line: 481, col: 24, offset: 16710},
 							alternatives: []interface{}{
 								&litMatcher{
-									pos:        position{line: 409, col: 24, offset: 13870},
+									pos:        position{line: 481, col: 24, offset: 16710},
 									val:        "tags=",
 									ignoreCase: false,
 								},
 								&litMatcher{
-									pos:        position{line: 409, col: 34, offset: 13880},
+									pos:        position{line: 481, col: 34, offset: 16720},
 									val:        "tag=",
 									ignoreCase: false,
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 409, col: 42, offset: 13888},
+							pos:   position{line: 481, col: 42, offset: 16728},
 							label: "tags",
 							expr: &ruleRefExpr{
-								pos:  position{line: 409, col: 48, offset: 13894},
+								pos:  position{line: 481, col: 48, offset: 16734},
 								name: "TagRangesAttributeValue",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 409, col: 73, offset: 13919},
+							pos: position{line: 481, col: 73, offset: 16759},
 							expr: &litMatcher{
-								pos:        position{line: 409, col: 73, offset: 13919},
+								pos:        position{line: 481, col: 73, offset: 16759},
 								val:        ",",
 								ignoreCase: false,
 							},
@@ -3412,43 +4083,43 @@ var g = &grammar{
 		},
 		{
 			name: "TagRangesAttributeValue",
-			pos:  position{line: 413, col: 1, offset: 14052},
+			pos:  position{line: 485, col: 1, offset: 16892},
 			expr: &actionExpr{
-				pos: position{line: 413, col: 28, offset: 14079},
+				pos: position{line: 485, col: 28, offset: 16919},
 				run: (*parser).callonTagRangesAttributeValue1,
 				expr: &seqExpr{
-					pos: position{line: 413, col: 28, offset: 14079},
+					pos: position{line: 485, col: 28, offset: 16919},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 413, col: 28, offset: 14079},
+							pos:   position{line: 485, col: 28, offset: 16919},
 							label: "value",
 							expr: &ruleRefExpr{
-								pos:  position{line: 413, col: 35, offset: 14086},
+								pos:  position{line: 485, col: 35, offset: 16926},
 								name: "MultipleTagRanges",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 413, col: 54, offset: 14105},
+							pos: position{line: 485, col: 54, offset: 16945},
 							expr: &ruleRefExpr{
-								pos:  position{line: 413, col: 54, offset: 14105},
+								pos:  position{line: 485, col: 54, offset: 16945},
 								name: "WS",
 							},
 						},
 						&choiceExpr{
-							pos: position{line: 413, col: 59, offset: 14110},
+							pos: position{line: 485, col: 59, offset: 16950},
 							alternatives: []interface{}{
 								&andExpr{
-									pos: position{line: 413, col: 59, offset: 14110},
+									pos: position{line: 485, col: 59, offset: 16950},
 									expr: &litMatcher{
-										pos:        position{line: 413, col: 60, offset: 14111},
+										pos:        position{line: 485, col: 60, offset: 16951},
 										val:        ",",
 										ignoreCase: false,
 									},
 								},
 								&andExpr{
-									pos: position{line: 413, col: 66, offset: 14117},
+									pos: position{line: 485, col: 66, offset: 16957},
 									expr: &litMatcher{
-										pos:        position{line: 413, col: 67, offset: 14118},
+										pos:        position{line: 485, col: 67, offset: 16958},
 										val:        "]",
 										ignoreCase: false,
 									},
@@ -3461,51 +4132,43 @@ var g = &grammar{
 		},
 		{
 			name: "MultipleTagRanges",
-			pos:  position{line: 417, col: 1, offset: 14150},
+			pos:  position{line: 489, col: 1, offset: 16990},
 			expr: &actionExpr{
-				pos: position{line: 417, col: 22, offset: 14171},
+				pos: position{line: 489, col: 22, offset: 17011},
 				run: (*parser).callonMultipleTagRanges1,
 				expr: &seqExpr{
-					pos: position{line: 417, col: 22, offset: 14171},
+					pos: position{line: 489, col: 22, offset: 17011},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 417, col: 22, offset: 14171},
+							pos:   position{line: 489, col: 22, offset: 17011},
 							label: "first",
-							expr: &actionExpr{
-								pos: position{line: 417, col: 29, offset: 14178},
-								run: (*parser).callonMultipleTagRanges4,
-								expr: &ruleRefExpr{
-									pos:  position{line: 417, col: 29, offset: 14178},
-									name: "Alphanums",
-								},
+							expr: &ruleRefExpr{
+								pos:  position{line: 489, col: 29, offset: 17018},
+								name: "TagRange",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 420, col: 5, offset: 14236},
+							pos:   position{line: 490, col: 5, offset: 17032},
 							label: "others",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 420, col: 12, offset: 14243},
+								pos: position{line: 490, col: 12, offset: 17039},
 								expr: &actionExpr{
-									pos: position{line: 420, col: 13, offset: 14244},
-									run: (*parser).callonMultipleTagRanges8,
+									pos: position{line: 490, col: 13, offset: 17040},
+									run: (*parser).callonMultipleTagRanges7,
 									expr: &seqExpr{
-										pos: position{line: 420, col: 13, offset: 14244},
+										pos: position{line: 490, col: 13, offset: 17040},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 420, col: 13, offset: 14244},
+												pos:        position{line: 490, col: 13, offset: 17040},
 												val:        ";",
 												ignoreCase: false,
 											},
 											&labeledExpr{
-												pos:   position{line: 420, col: 17, offset: 14248},
+												pos:   position{line: 490, col: 17, offset: 17044},
 												label: "other",
-												expr: &actionExpr{
-													pos: position{line: 420, col: 24, offset: 14255},
-													run: (*parser).callonMultipleTagRanges12,
-													expr: &ruleRefExpr{
-														pos:  position{line: 420, col: 24, offset: 14255},
-														name: "Alphanums",
-													},
+												expr: &ruleRefExpr{
+													pos:  position{line: 490, col: 24, offset: 17051},
+													name: "TagRange",
 												},
 											},
 										},
@@ -3517,36 +4180,76 @@ var g = &grammar{
 				},
 			},
 		},
+		{
+			name: "TagRange",
+			pos:  position{line: 497, col: 1, offset: 17259},
+			expr: &actionExpr{
+				pos: position{line: 497, col: 13, offset: 17271},
+				run: (*parser).callonTagRange1,
+				expr: &seqExpr{
+					pos: position{line: 497, col: 13, offset: 17271},
+					exprs: []interface{}{
+						&zeroOrOneExpr{
+							pos: position{line: 497, col: 13, offset: 17271},
+							expr: &litMatcher{
+								pos:        position{line: 497, col: 13, offset: 17271},
+								val:        "!",
+								ignoreCase: false,
+							},
+						},
+						&choiceExpr{
+							pos: position{line: 497, col: 19, offset: 17277},
+							alternatives: []interface{}{
+								&litMatcher{
+									pos:        position{line: 497, col: 19, offset: 17277},
+									val:        "**",
+									ignoreCase: false,
+								},
+								&litMatcher{
+									pos:        position{line: 497, col: 26, offset: 17284},
+									val:        "*",
+									ignoreCase: false,
+								},
+								&ruleRefExpr{
+									pos:  position{line: 497, col: 32, offset: 17290},
+									name: "Alphanums",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "IncludedFileLine",
-			pos:  position{line: 431, col: 1, offset: 14565},
+			pos:  position{line: 504, col: 1, offset: 17480},
 			expr: &actionExpr{
-				pos: position{line: 431, col: 21, offset: 14585},
+				pos: position{line: 504, col: 21, offset: 17500},
 				run: (*parser).callonIncludedFileLine1,
 				expr: &seqExpr{
-					pos: position{line: 431, col: 21, offset: 14585},
+					pos: position{line: 504, col: 21, offset: 17500},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 431, col: 21, offset: 14585},
+							pos:   position{line: 504, col: 21, offset: 17500},
 							label: "content",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 431, col: 29, offset: 14593},
+								pos: position{line: 504, col: 29, offset: 17508},
 								expr: &choiceExpr{
-									pos: position{line: 431, col: 30, offset: 14594},
+									pos: position{line: 504, col: 30, offset: 17509},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 431, col: 30, offset: 14594},
+											pos:  position{line: 504, col: 30, offset: 17509},
 											name: "IncludedFileStartTag",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 431, col: 53, offset: 14617},
+											pos:  position{line: 504, col: 53, offset: 17532},
 											name: "IncludedFileEndTag",
 										},
 										&actionExpr{
-											pos: position{line: 431, col: 74, offset: 14638},
+											pos: position{line: 504, col: 74, offset: 17553},
 											run: (*parser).callonIncludedFileLine8,
 											expr: &anyMatcher{
-												line: 431, col: 74, offset: 14638,
+												line: 504, col: 74, offset: 17553,
 											},
 										},
 									},
@@ -3554,7 +4257,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 431, col: 107, offset: 14671},
+							pos:  position{line: 504, col: 107, offset: 17586},
 							name: "EOL",
 						},
 					},
@@ -3563,32 +4266,32 @@ var g = &grammar{
 		},
 		{
 			name: "IncludedFileStartTag",
-			pos:  position{line: 435, col: 1, offset: 14742},
+			pos:  position{line: 508, col: 1, offset: 17657},
 			expr: &actionExpr{
-				pos: position{line: 435, col: 25, offset: 14766},
+				pos: position{line: 508, col: 25, offset: 17681},
 				run: (*parser).callonIncludedFileStartTag1,
 				expr: &seqExpr{
-					pos: position{line: 435, col: 25, offset: 14766},
+					pos: position{line: 508, col: 25, offset: 17681},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 435, col: 25, offset: 14766},
+							pos:        position{line: 508, col: 25, offset: 17681},
 							val:        "tag::",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 435, col: 33, offset: 14774},
+							pos:   position{line: 508, col: 33, offset: 17689},
 							label: "tag",
 							expr: &actionExpr{
-								pos: position{line: 435, col: 38, offset: 14779},
+								pos: position{line: 508, col: 38, offset: 17694},
 								run: (*parser).callonIncludedFileStartTag5,
 								expr: &ruleRefExpr{
-									pos:  position{line: 435, col: 38, offset: 14779},
+									pos:  position{line: 508, col: 38, offset: 17694},
 									name: "Alphanums",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 435, col: 78, offset: 14819},
+							pos:        position{line: 508, col: 78, offset: 17734},
 							val:        "[]",
 							ignoreCase: false,
 						},
@@ -3598,32 +4301,32 @@ var g = &grammar{
 		},
 		{
 			name: "IncludedFileEndTag",
-			pos:  position{line: 439, col: 1, offset: 14884},
+			pos:  position{line: 512, col: 1, offset: 17799},
 			expr: &actionExpr{
-				pos: position{line: 439, col: 23, offset: 14906},
+				pos: position{line: 512, col: 23, offset: 17821},
 				run: (*parser).callonIncludedFileEndTag1,
 				expr: &seqExpr{
-					pos: position{line: 439, col: 23, offset: 14906},
+					pos: position{line: 512, col: 23, offset: 17821},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 439, col: 23, offset: 14906},
+							pos:        position{line: 512, col: 23, offset: 17821},
 							val:        "end::",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 439, col: 31, offset: 14914},
+							pos:   position{line: 512, col: 31, offset: 17829},
 							label: "tag",
 							expr: &actionExpr{
-								pos: position{line: 439, col: 36, offset: 14919},
+								pos: position{line: 512, col: 36, offset: 17834},
 								run: (*parser).callonIncludedFileEndTag5,
 								expr: &ruleRefExpr{
-									pos:  position{line: 439, col: 36, offset: 14919},
+									pos:  position{line: 512, col: 36, offset: 17834},
 									name: "Alphanums",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 439, col: 76, offset: 14959},
+							pos:        position{line: 512, col: 76, offset: 17874},
 							val:        "[]",
 							ignoreCase: false,
 						},
@@ -3633,35 +4336,35 @@ var g = &grammar{
 		},
 		{
 			name: "ListItems",
-			pos:  position{line: 446, col: 1, offset: 15123},
+			pos:  position{line: 519, col: 1, offset: 18038},
 			expr: &oneOrMoreExpr{
-				pos: position{line: 446, col: 14, offset: 15136},
+				pos: position{line: 519, col: 14, offset: 18051},
 				expr: &ruleRefExpr{
-					pos:  position{line: 446, col: 14, offset: 15136},
+					pos:  position{line: 519, col: 14, offset: 18051},
 					name: "ListItem",
 				},
 			},
 		},
 		{
 			name: "ListItem",
-			pos:  position{line: 448, col: 1, offset: 15147},
+			pos:  position{line: 521, col: 1, offset: 18062},
 			expr: &choiceExpr{
-				pos: position{line: 448, col: 13, offset: 15159},
+				pos: position{line: 521, col: 13, offset: 18074},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 448, col: 13, offset: 15159},
+						pos:  position{line: 521, col: 13, offset: 18074},
 						name: "OrderedListItem",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 448, col: 31, offset: 15177},
+						pos:  position{line: 521, col: 31, offset: 18092},
 						name: "UnorderedListItem",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 448, col: 51, offset: 15197},
+						pos:  position{line: 521, col: 51, offset: 18112},
 						name: "LabeledListItem",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 448, col: 69, offset: 15215},
+						pos:  position{line: 521, col: 69, offset: 18130},
 						name: "ContinuedListItemElement",
 					},
 				},
@@ -3669,32 +4372,32 @@ var g = &grammar{
 		},
 		{
 			name: "ListParagraph",
-			pos:  position{line: 450, col: 1, offset: 15241},
+			pos:  position{line: 523, col: 1, offset: 18156},
 			expr: &choiceExpr{
-				pos: position{line: 450, col: 18, offset: 15258},
+				pos: position{line: 523, col: 18, offset: 18173},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 450, col: 18, offset: 15258},
+						pos: position{line: 523, col: 18, offset: 18173},
 						run: (*parser).callonListParagraph2,
 						expr: &labeledExpr{
-							pos:   position{line: 450, col: 18, offset: 15258},
+							pos:   position{line: 523, col: 18, offset: 18173},
 							label: "comment",
 							expr: &ruleRefExpr{
-								pos:  position{line: 450, col: 27, offset: 15267},
+								pos:  position{line: 523, col: 27, offset: 18182},
 								name: "SingleLineComment",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 452, col: 9, offset: 15324},
+						pos: position{line: 525, col: 9, offset: 18239},
 						run: (*parser).callonListParagraph5,
 						expr: &labeledExpr{
-							pos:   position{line: 452, col: 9, offset: 15324},
+							pos:   position{line: 525, col: 9, offset: 18239},
 							label: "lines",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 452, col: 15, offset: 15330},
+								pos: position{line: 525, col: 15, offset: 18245},
 								expr: &ruleRefExpr{
-									pos:  position{line: 452, col: 16, offset: 15331},
+									pos:  position{line: 525, col: 16, offset: 18246},
 									name: "ListParagraphLine",
 								},
 							},
@@ -3705,112 +4408,112 @@ var g = &grammar{
 		},
 		{
 			name: "ListParagraphLine",
-			pos:  position{line: 456, col: 1, offset: 15423},
+			pos:  position{line: 529, col: 1, offset: 18338},
 			expr: &actionExpr{
-				pos: position{line: 456, col: 22, offset: 15444},
+				pos: position{line: 529, col: 22, offset: 18359},
 				run: (*parser).callonListParagraphLine1,
 				expr: &seqExpr{
-					pos: position{line: 456, col: 22, offset: 15444},
+					pos: position{line: 529, col: 22, offset: 18359},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 456, col: 22, offset: 15444},
+							pos: position{line: 529, col: 22, offset: 18359},
 							expr: &ruleRefExpr{
-								pos:  position{line: 456, col: 23, offset: 15445},
+								pos:  position{line: 529, col: 23, offset: 18360},
 								name: "EOF",
 							},
 						},
 						&notExpr{
-							pos: position{line: 457, col: 5, offset: 15453},
+							pos: position{line: 530, col: 5, offset: 18368},
 							expr: &ruleRefExpr{
-								pos:  position{line: 457, col: 6, offset: 15454},
+								pos:  position{line: 530, col: 6, offset: 18369},
 								name: "BlankLine",
 							},
 						},
 						&notExpr{
-							pos: position{line: 458, col: 5, offset: 15469},
+							pos: position{line: 531, col: 5, offset: 18384},
 							expr: &ruleRefExpr{
-								pos:  position{line: 458, col: 6, offset: 15470},
+								pos:  position{line: 531, col: 6, offset: 18385},
 								name: "SingleLineComment",
 							},
 						},
 						&notExpr{
-							pos: position{line: 459, col: 5, offset: 15492},
+							pos: position{line: 532, col: 5, offset: 18407},
 							expr: &ruleRefExpr{
-								pos:  position{line: 459, col: 6, offset: 15493},
+								pos:  position{line: 532, col: 6, offset: 18408},
 								name: "OrderedListItemPrefix",
 							},
 						},
 						&notExpr{
-							pos: position{line: 460, col: 5, offset: 15519},
+							pos: position{line: 533, col: 5, offset: 18434},
 							expr: &ruleRefExpr{
-								pos:  position{line: 460, col: 6, offset: 15520},
+								pos:  position{line: 533, col: 6, offset: 18435},
 								name: "UnorderedListItemPrefix",
 							},
 						},
 						&notExpr{
-							pos: position{line: 461, col: 5, offset: 15548},
+							pos: position{line: 534, col: 5, offset: 18463},
 							expr: &seqExpr{
-								pos: position{line: 461, col: 7, offset: 15550},
+								pos: position{line: 534, col: 7, offset: 18465},
 								exprs: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 461, col: 7, offset: 15550},
+										pos:  position{line: 534, col: 7, offset: 18465},
 										name: "LabeledListItemTerm",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 461, col: 27, offset: 15570},
+										pos:  position{line: 534, col: 27, offset: 18485},
 										name: "LabeledListItemSeparator",
 									},
 								},
 							},
 						},
 						&notExpr{
-							pos: position{line: 462, col: 5, offset: 15601},
+							pos: position{line: 535, col: 5, offset: 18516},
 							expr: &ruleRefExpr{
-								pos:  position{line: 462, col: 6, offset: 15602},
+								pos:  position{line: 535, col: 6, offset: 18517},
 								name: "ListItemContinuation",
 							},
 						},
 						&notExpr{
-							pos: position{line: 463, col: 5, offset: 15627},
+							pos: position{line: 536, col: 5, offset: 18542},
 							expr: &ruleRefExpr{
-								pos:  position{line: 463, col: 6, offset: 15628},
+								pos:  position{line: 536, col: 6, offset: 18543},
 								name: "ElementAttribute",
 							},
 						},
 						&notExpr{
-							pos: position{line: 464, col: 5, offset: 15649},
+							pos: position{line: 537, col: 5, offset: 18564},
 							expr: &ruleRefExpr{
-								pos:  position{line: 464, col: 6, offset: 15650},
+								pos:  position{line: 537, col: 6, offset: 18565},
 								name: "BlockDelimiter",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 465, col: 5, offset: 15669},
+							pos:   position{line: 538, col: 5, offset: 18584},
 							label: "line",
 							expr: &actionExpr{
-								pos: position{line: 466, col: 9, offset: 15684},
+								pos: position{line: 539, col: 9, offset: 18599},
 								run: (*parser).callonListParagraphLine24,
 								expr: &seqExpr{
-									pos: position{line: 466, col: 9, offset: 15684},
+									pos: position{line: 539, col: 9, offset: 18599},
 									exprs: []interface{}{
 										&labeledExpr{
-											pos:   position{line: 466, col: 9, offset: 15684},
+											pos:   position{line: 539, col: 9, offset: 18599},
 											label: "elements",
 											expr: &oneOrMoreExpr{
-												pos: position{line: 466, col: 18, offset: 15693},
+												pos: position{line: 539, col: 18, offset: 18608},
 												expr: &ruleRefExpr{
-													pos:  position{line: 466, col: 19, offset: 15694},
+													pos:  position{line: 539, col: 19, offset: 18609},
 													name: "InlineElement",
 												},
 											},
 										},
 										&labeledExpr{
-											pos:   position{line: 466, col: 35, offset: 15710},
+											pos:   position{line: 539, col: 35, offset: 18625},
 											label: "linebreak",
 											expr: &zeroOrOneExpr{
-												pos: position{line: 466, col: 45, offset: 15720},
+												pos: position{line: 539, col: 45, offset: 18635},
 												expr: &ruleRefExpr{
-													pos:  position{line: 466, col: 46, offset: 15721},
+													pos:  position{line: 539, col: 46, offset: 18636},
 													name: "LineBreak",
 												},
 											},
@@ -3820,7 +4523,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 468, col: 12, offset: 15873},
+							pos:  position{line: 541, col: 12, offset: 18788},
 							name: "EOL",
 						},
 					},
@@ -3829,17 +4532,17 @@ var g = &grammar{
 		},
 		{
 			name: "ListItemContinuation",
-			pos:  position{line: 472, col: 1, offset: 15920},
+			pos:  position{line: 545, col: 1, offset: 18835},
 			expr: &seqExpr{
-				pos: position{line: 472, col: 25, offset: 15944},
+				pos: position{line: 545, col: 25, offset: 18859},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 472, col: 25, offset: 15944},
+						pos:        position{line: 545, col: 25, offset: 18859},
 						val:        "+",
 						ignoreCase: false,
 					},
 					&ruleRefExpr{
-						pos:  position{line: 472, col: 29, offset: 15948},
+						pos:  position{line: 545, col: 29, offset: 18863},
 						name: "EOLS",
 					},
 				},
@@ -3847,33 +4550,33 @@ var g = &grammar{
 		},
 		{
 			name: "ContinuedListItemElement",
-			pos:  position{line: 474, col: 1, offset: 15955},
+			pos:  position{line: 547, col: 1, offset: 18870},
 			expr: &actionExpr{
-				pos: position{line: 474, col: 29, offset: 15983},
+				pos: position{line: 547, col: 29, offset: 18898},
 				run: (*parser).callonContinuedListItemElement1,
 				expr: &seqExpr{
-					pos: position{line: 474, col: 29, offset: 15983},
+					pos: position{line: 547, col: 29, offset: 18898},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 474, col: 29, offset: 15983},
+							pos:   position{line: 547, col: 29, offset: 18898},
 							label: "blanklines",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 474, col: 41, offset: 15995},
+								pos: position{line: 547, col: 41, offset: 18910},
 								expr: &ruleRefExpr{
-									pos:  position{line: 474, col: 41, offset: 15995},
+									pos:  position{line: 547, col: 41, offset: 18910},
 									name: "BlankLine",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 474, col: 53, offset: 16007},
+							pos:  position{line: 547, col: 53, offset: 18922},
 							name: "ListItemContinuation",
 						},
 						&labeledExpr{
-							pos:   position{line: 474, col: 74, offset: 16028},
+							pos:   position{line: 547, col: 74, offset: 18943},
 							label: "element",
 							expr: &ruleRefExpr{
-								pos:  position{line: 474, col: 82, offset: 16036},
+								pos:  position{line: 547, col: 82, offset: 18951},
 								name: "DocumentBlock",
 							},
 						},
@@ -3883,37 +4586,37 @@ var g = &grammar{
 		},
 		{
 			name: "OrderedListItem",
-			pos:  position{line: 481, col: 1, offset: 16278},
+			pos:  position{line: 554, col: 1, offset: 19193},
 			expr: &actionExpr{
-				pos: position{line: 481, col: 20, offset: 16297},
+				pos: position{line: 554, col: 20, offset: 19212},
 				run: (*parser).callonOrderedListItem1,
 				expr: &seqExpr{
-					pos: position{line: 481, col: 20, offset: 16297},
+					pos: position{line: 554, col: 20, offset: 19212},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 481, col: 20, offset: 16297},
+							pos:   position{line: 554, col: 20, offset: 19212},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 481, col: 31, offset: 16308},
+								pos: position{line: 554, col: 31, offset: 19223},
 								expr: &ruleRefExpr{
-									pos:  position{line: 481, col: 32, offset: 16309},
+									pos:  position{line: 554, col: 32, offset: 19224},
 									name: "ElementAttributes",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 481, col: 52, offset: 16329},
+							pos:   position{line: 554, col: 52, offset: 19244},
 							label: "prefix",
 							expr: &ruleRefExpr{
-								pos:  position{line: 481, col: 60, offset: 16337},
+								pos:  position{line: 554, col: 60, offset: 19252},
 								name: "OrderedListItemPrefix",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 481, col: 83, offset: 16360},
+							pos:   position{line: 554, col: 83, offset: 19275},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 481, col: 92, offset: 16369},
+								pos:  position{line: 554, col: 92, offset: 19284},
 								name: "OrderedListItemContent",
 							},
 						},
@@ -3923,42 +4626,42 @@ var g = &grammar{
 		},
 		{
 			name: "OrderedListItemPrefix",
-			pos:  position{line: 485, col: 1, offset: 16509},
+			pos:  position{line: 558, col: 1, offset: 19424},
 			expr: &actionExpr{
-				pos: position{line: 486, col: 5, offset: 16539},
+				pos: position{line: 559, col: 5, offset: 19454},
 				run: (*parser).callonOrderedListItemPrefix1,
 				expr: &seqExpr{
-					pos: position{line: 486, col: 5, offset: 16539},
+					pos: position{line: 559, col: 5, offset: 19454},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 486, col: 5, offset: 16539},
+							pos: position{line: 559, col: 5, offset: 19454},
 							expr: &ruleRefExpr{
-								pos:  position{line: 486, col: 5, offset: 16539},
+								pos:  position{line: 559, col: 5, offset: 19454},
 								name: "WS",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 486, col: 9, offset: 16543},
+							pos:   position{line: 559, col: 9, offset: 19458},
 							label: "prefix",
 							expr: &choiceExpr{
-								pos: position{line: 488, col: 9, offset: 16606},
+								pos: position{line: 561, col: 9, offset: 19521},
 								alternatives: []interface{}{
 									&actionExpr{
-										pos: position{line: 488, col: 9, offset: 16606},
+										pos: position{line: 561, col: 9, offset: 19521},
 										run: (*parser).callonOrderedListItemPrefix7,
 										expr: &seqExpr{
-											pos: position{line: 488, col: 9, offset: 16606},
+											pos: position{line: 561, col: 9, offset: 19521},
 											exprs: []interface{}{
 												&labeledExpr{
-													pos:   position{line: 488, col: 9, offset: 16606},
+													pos:   position{line: 561, col: 9, offset: 19521},
 													label: "depth",
 													expr: &actionExpr{
-														pos: position{line: 488, col: 16, offset: 16613},
+														pos: position{line: 561, col: 16, offset: 19528},
 														run: (*parser).callonOrderedListItemPrefix10,
 														expr: &oneOrMoreExpr{
-															pos: position{line: 488, col: 16, offset: 16613},
+															pos: position{line: 561, col: 16, offset: 19528},
 															expr: &litMatcher{
-																pos:        position{line: 488, col: 17, offset: 16614},
+																pos:        position{line: 561, col: 17, offset: 19529},
 																val:        ".",
 																ignoreCase: false,
 															},
@@ -3966,22 +4669,22 @@ var g = &grammar{
 													},
 												},
 												&andCodeExpr{
-													pos: position{line: 492, col: 9, offset: 16714},
+													pos: position{line: 565, col: 9, offset: 19629},
 													run: (*parser).callonOrderedListItemPrefix13,
 												},
 											},
 										},
 									},
 									&actionExpr{
-										pos: position{line: 511, col: 11, offset: 17431},
+										pos: position{line: 584, col: 11, offset: 20346},
 										run: (*parser).callonOrderedListItemPrefix14,
 										expr: &seqExpr{
-											pos: position{line: 511, col: 11, offset: 17431},
+											pos: position{line: 584, col: 11, offset: 20346},
 											exprs: []interface{}{
 												&oneOrMoreExpr{
-													pos: position{line: 511, col: 11, offset: 17431},
+													pos: position{line: 584, col: 11, offset: 20346},
 													expr: &charClassMatcher{
-														pos:        position{line: 511, col: 12, offset: 17432},
+														pos:        position{line: 584, col: 12, offset: 20347},
 														val:        "[0-9]",
 														ranges:     []rune{'0', '9'},
 														ignoreCase: false,
@@ -3989,7 +4692,7 @@ var g = &grammar{
 													},
 												},
 												&litMatcher{
-													pos:        position{line: 511, col: 20, offset: 17440},
+													pos:        position{line: 584, col: 20, offset: 20355},
 													val:        ".",
 													ignoreCase: false,
 												},
@@ -3997,20 +4700,20 @@ var g = &grammar{
 										},
 									},
 									&actionExpr{
-										pos: position{line: 513, col: 13, offset: 17551},
+										pos: position{line: 586, col: 13, offset: 20466},
 										run: (*parser).callonOrderedListItemPrefix19,
 										expr: &seqExpr{
-											pos: position{line: 513, col: 13, offset: 17551},
+											pos: position{line: 586, col: 13, offset: 20466},
 											exprs: []interface{}{
 												&charClassMatcher{
-													pos:        position{line: 513, col: 14, offset: 17552},
+													pos:        position{line: 586, col: 14, offset: 20467},
 													val:        "[a-z]",
 													ranges:     []rune{'a', 'z'},
 													ignoreCase: false,
 													inverted:   false,
 												},
 												&litMatcher{
-													pos:        position{line: 513, col: 21, offset: 17559},
+													pos:        position{line: 586, col: 21, offset: 20474},
 													val:        ".",
 													ignoreCase: false,
 												},
@@ -4018,20 +4721,20 @@ var g = &grammar{
 										},
 									},
 									&actionExpr{
-										pos: position{line: 515, col: 13, offset: 17673},
+										pos: position{line: 588, col: 13, offset: 20588},
 										run: (*parser).callonOrderedListItemPrefix23,
 										expr: &seqExpr{
-											pos: position{line: 515, col: 13, offset: 17673},
+											pos: position{line: 588, col: 13, offset: 20588},
 											exprs: []interface{}{
 												&charClassMatcher{
-													pos:        position{line: 515, col: 14, offset: 17674},
+													pos:        position{line: 588, col: 14, offset: 20589},
 													val:        "[A-Z]",
 													ranges:     []rune{'A', 'Z'},
 													ignoreCase: false,
 													inverted:   false,
 												},
 												&litMatcher{
-													pos:        position{line: 515, col: 21, offset: 17681},
+													pos:        position{line: 588, col: 21, offset: 20596},
 													val:        ".",
 													ignoreCase: false,
 												},
@@ -4039,15 +4742,15 @@ var g = &grammar{
 										},
 									},
 									&actionExpr{
-										pos: position{line: 517, col: 13, offset: 17795},
+										pos: position{line: 590, col: 13, offset: 20710},
 										run: (*parser).callonOrderedListItemPrefix27,
 										expr: &seqExpr{
-											pos: position{line: 517, col: 13, offset: 17795},
+											pos: position{line: 590, col: 13, offset: 20710},
 											exprs: []interface{}{
 												&oneOrMoreExpr{
-													pos: position{line: 517, col: 13, offset: 17795},
+													pos: position{line: 590, col: 13, offset: 20710},
 													expr: &charClassMatcher{
-														pos:        position{line: 517, col: 14, offset: 17796},
+														pos:        position{line: 590, col: 14, offset: 20711},
 														val:        "[a-z]",
 														ranges:     []rune{'a', 'z'},
 														ignoreCase: false,
@@ -4055,7 +4758,7 @@ var g = &grammar{
 													},
 												},
 												&litMatcher{
-													pos:        position{line: 517, col: 22, offset: 17804},
+													pos:        position{line: 590, col: 22, offset: 20719},
 													val:        ")",
 													ignoreCase: false,
 												},
@@ -4063,15 +4766,15 @@ var g = &grammar{
 										},
 									},
 									&actionExpr{
-										pos: position{line: 519, col: 13, offset: 17918},
+										pos: position{line: 592, col: 13, offset: 20833},
 										run: (*parser).callonOrderedListItemPrefix32,
 										expr: &seqExpr{
-											pos: position{line: 519, col: 13, offset: 17918},
+											pos: position{line: 592, col: 13, offset: 20833},
 											exprs: []interface{}{
 												&oneOrMoreExpr{
-													pos: position{line: 519, col: 13, offset: 17918},
+													pos: position{line: 592, col: 13, offset: 20833},
 													expr: &charClassMatcher{
-														pos:        position{line: 519, col: 14, offset: 17919},
+														pos:        position{line: 592, col: 14, offset: 20834},
 														val:        "[A-Z]",
 														ranges:     []rune{'A', 'Z'},
 														ignoreCase: false,
@@ -4079,7 +4782,7 @@ var g = &grammar{
 													},
 												},
 												&litMatcher{
-													pos:        position{line: 519, col: 22, offset: 17927},
+													pos:        position{line: 592, col: 22, offset: 20842},
 													val:        ")",
 													ignoreCase: false,
 												},
@@ -4090,9 +4793,9 @@ var g = &grammar{
 							},
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 521, col: 12, offset: 18040},
+							pos: position{line: 594, col: 12, offset: 20955},
 							expr: &ruleRefExpr{
-								pos:  position{line: 521, col: 12, offset: 18040},
+								pos:  position{line: 594, col: 12, offset: 20955},
 								name: "WS",
 							},
 						},
@@ -4102,17 +4805,17 @@ var g = &grammar{
 		},
 		{
 			name: "OrderedListItemContent",
-			pos:  position{line: 525, col: 1, offset: 18072},
+			pos:  position{line: 598, col: 1, offset: 20987},
 			expr: &actionExpr{
-				pos: position{line: 525, col: 27, offset: 18098},
+				pos: position{line: 598, col: 27, offset: 21013},
 				run: (*parser).callonOrderedListItemContent1,
 				expr: &labeledExpr{
-					pos:   position{line: 525, col: 27, offset: 18098},
+					pos:   position{line: 598, col: 27, offset: 21013},
 					label: "elements",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 525, col: 37, offset: 18108},
+						pos: position{line: 598, col: 37, offset: 21023},
 						expr: &ruleRefExpr{
-							pos:  position{line: 525, col: 37, offset: 18108},
+							pos:  position{line: 598, col: 37, offset: 21023},
 							name: "ListParagraph",
 						},
 					},
@@ -4121,48 +4824,48 @@ var g = &grammar{
 		},
 		{
 			name: "UnorderedListItem",
-			pos:  position{line: 532, col: 1, offset: 18308},
+			pos:  position{line: 605, col: 1, offset: 21223},
 			expr: &actionExpr{
-				pos: position{line: 532, col: 22, offset: 18329},
+				pos: position{line: 605, col: 22, offset: 21244},
 				run: (*parser).callonUnorderedListItem1,
 				expr: &seqExpr{
-					pos: position{line: 532, col: 22, offset: 18329},
+					pos: position{line: 605, col: 22, offset: 21244},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 532, col: 22, offset: 18329},
+							pos:   position{line: 605, col: 22, offset: 21244},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 532, col: 33, offset: 18340},
+								pos: position{line: 605, col: 33, offset: 21255},
 								expr: &ruleRefExpr{
-									pos:  position{line: 532, col: 34, offset: 18341},
+									pos:  position{line: 605, col: 34, offset: 21256},
 									name: "ElementAttributes",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 532, col: 54, offset: 18361},
+							pos:   position{line: 605, col: 54, offset: 21276},
 							label: "prefix",
 							expr: &ruleRefExpr{
-								pos:  position{line: 532, col: 62, offset: 18369},
+								pos:  position{line: 605, col: 62, offset: 21284},
 								name: "UnorderedListItemPrefix",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 532, col: 87, offset: 18394},
+							pos:   position{line: 605, col: 87, offset: 21309},
 							label: "checkstyle",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 532, col: 98, offset: 18405},
+								pos: position{line: 605, col: 98, offset: 21320},
 								expr: &ruleRefExpr{
-									pos:  position{line: 532, col: 99, offset: 18406},
+									pos:  position{line: 605, col: 99, offset: 21321},
 									name: "UnorderedListItemCheckStyle",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 532, col: 129, offset: 18436},
+							pos:   position{line: 605, col: 129, offset: 21351},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 532, col: 138, offset: 18445},
+								pos:  position{line: 605, col: 138, offset: 21360},
 								name: "UnorderedListItemContent",
 							},
 						},
@@ -4172,42 +4875,42 @@ var g = &grammar{
 		},
 		{
 			name: "UnorderedListItemPrefix",
-			pos:  position{line: 536, col: 1, offset: 18603},
+			pos:  position{line: 609, col: 1, offset: 21518},
 			expr: &actionExpr{
-				pos: position{line: 537, col: 5, offset: 18635},
+				pos: position{line: 610, col: 5, offset: 21550},
 				run: (*parser).callonUnorderedListItemPrefix1,
 				expr: &seqExpr{
-					pos: position{line: 537, col: 5, offset: 18635},
+					pos: position{line: 610, col: 5, offset: 21550},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 537, col: 5, offset: 18635},
+							pos: position{line: 610, col: 5, offset: 21550},
 							expr: &ruleRefExpr{
-								pos:  position{line: 537, col: 5, offset: 18635},
+								pos:  position{line: 610, col: 5, offset: 21550},
 								name: "WS",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 537, col: 9, offset: 18639},
+							pos:   position{line: 610, col: 9, offset: 21554},
 							label: "prefix",
 							expr: &choiceExpr{
-								pos: position{line: 537, col: 17, offset: 18647},
+								pos: position{line: 610, col: 17, offset: 21562},
 								alternatives: []interface{}{
 									&actionExpr{
-										pos: position{line: 539, col: 9, offset: 18704},
+										pos: position{line: 612, col: 9, offset: 21619},
 										run: (*parser).callonUnorderedListItemPrefix7,
 										expr: &seqExpr{
-											pos: position{line: 539, col: 9, offset: 18704},
+											pos: position{line: 612, col: 9, offset: 21619},
 											exprs: []interface{}{
 												&labeledExpr{
-													pos:   position{line: 539, col: 9, offset: 18704},
+													pos:   position{line: 612, col: 9, offset: 21619},
 													label: "depth",
 													expr: &actionExpr{
-														pos: position{line: 539, col: 16, offset: 18711},
+														pos: position{line: 612, col: 16, offset: 21626},
 														run: (*parser).callonUnorderedListItemPrefix10,
 														expr: &oneOrMoreExpr{
-															pos: position{line: 539, col: 16, offset: 18711},
+															pos: position{line: 612, col: 16, offset: 21626},
 															expr: &litMatcher{
-																pos:        position{line: 539, col: 17, offset: 18712},
+																pos:        position{line: 612, col: 17, offset: 21627},
 																val:        "*",
 																ignoreCase: false,
 															},
@@ -4215,20 +4918,20 @@ var g = &grammar{
 													},
 												},
 												&andCodeExpr{
-													pos: position{line: 543, col: 9, offset: 18812},
+													pos: position{line: 616, col: 9, offset: 21727},
 													run: (*parser).callonUnorderedListItemPrefix13,
 												},
 											},
 										},
 									},
 									&labeledExpr{
-										pos:   position{line: 560, col: 14, offset: 19519},
+										pos:   position{line: 633, col: 14, offset: 22434},
 										label: "depth",
 										expr: &actionExpr{
-											pos: position{line: 560, col: 21, offset: 19526},
+											pos: position{line: 633, col: 21, offset: 22441},
 											run: (*parser).callonUnorderedListItemPrefix15,
 											expr: &litMatcher{
-												pos:        position{line: 560, col: 22, offset: 19527},
+												pos:        position{line: 633, col: 22, offset: 22442},
 												val:        "-",
 												ignoreCase: false,
 											},
@@ -4238,9 +4941,9 @@ var g = &grammar{
 							},
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 562, col: 13, offset: 19613},
+							pos: position{line: 635, col: 13, offset: 22528},
 							expr: &ruleRefExpr{
-								pos:  position{line: 562, col: 13, offset: 19613},
+								pos:  position{line: 635, col: 13, offset: 22528},
 								name: "WS",
 							},
 						},
@@ -4250,50 +4953,50 @@ var g = &grammar{
 		},
 		{
 			name: "UnorderedListItemCheckStyle",
-			pos:  position{line: 566, col: 1, offset: 19646},
+			pos:  position{line: 639, col: 1, offset: 22561},
 			expr: &actionExpr{
-				pos: position{line: 566, col: 32, offset: 19677},
+				pos: position{line: 639, col: 32, offset: 22592},
 				run: (*parser).callonUnorderedListItemCheckStyle1,
 				expr: &seqExpr{
-					pos: position{line: 566, col: 32, offset: 19677},
+					pos: position{line: 639, col: 32, offset: 22592},
 					exprs: []interface{}{
 						&andExpr{
-							pos: position{line: 566, col: 32, offset: 19677},
+							pos: position{line: 639, col: 32, offset: 22592},
 							expr: &litMatcher{
-								pos:        position{line: 566, col: 33, offset: 19678},
+								pos:        position{line: 639, col: 33, offset: 22593},
 								val:        "[",
 								ignoreCase: false,
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 566, col: 37, offset: 19682},
+							pos:   position{line: 639, col: 37, offset: 22597},
 							label: "style",
 							expr: &choiceExpr{
-								pos: position{line: 567, col: 7, offset: 19696},
+								pos: position{line: 640, col: 7, offset: 22611},
 								alternatives: []interface{}{
 									&actionExpr{
-										pos: position{line: 567, col: 7, offset: 19696},
+										pos: position{line: 640, col: 7, offset: 22611},
 										run: (*parser).callonUnorderedListItemCheckStyle7,
 										expr: &litMatcher{
-											pos:        position{line: 567, col: 7, offset: 19696},
+											pos:        position{line: 640, col: 7, offset: 22611},
 											val:        "[ ]",
 											ignoreCase: false,
 										},
 									},
 									&actionExpr{
-										pos: position{line: 568, col: 7, offset: 19741},
+										pos: position{line: 641, col: 7, offset: 22656},
 										run: (*parser).callonUnorderedListItemCheckStyle9,
 										expr: &litMatcher{
-											pos:        position{line: 568, col: 7, offset: 19741},
+											pos:        position{line: 641, col: 7, offset: 22656},
 											val:        "[*]",
 											ignoreCase: false,
 										},
 									},
 									&actionExpr{
-										pos: position{line: 569, col: 7, offset: 19784},
+										pos: position{line: 642, col: 7, offset: 22699},
 										run: (*parser).callonUnorderedListItemCheckStyle11,
 										expr: &litMatcher{
-											pos:        position{line: 569, col: 7, offset: 19784},
+											pos:        position{line: 642, col: 7, offset: 22699},
 											val:        "[x]",
 											ignoreCase: false,
 										},
@@ -4302,9 +5005,9 @@ var g = &grammar{
 							},
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 570, col: 7, offset: 19826},
+							pos: position{line: 643, col: 7, offset: 22741},
 							expr: &ruleRefExpr{
-								pos:  position{line: 570, col: 7, offset: 19826},
+								pos:  position{line: 643, col: 7, offset: 22741},
 								name: "WS",
 							},
 						},
@@ -4314,17 +5017,17 @@ var g = &grammar{
 		},
 		{
 			name: "UnorderedListItemContent",
-			pos:  position{line: 574, col: 1, offset: 19865},
+			pos:  position{line: 647, col: 1, offset: 22780},
 			expr: &actionExpr{
-				pos: position{line: 574, col: 29, offset: 19893},
+				pos: position{line: 647, col: 29, offset: 22808},
 				run: (*parser).callonUnorderedListItemContent1,
 				expr: &labeledExpr{
-					pos:   position{line: 574, col: 29, offset: 19893},
+					pos:   position{line: 647, col: 29, offset: 22808},
 					label: "elements",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 574, col: 39, offset: 19903},
+						pos: position{line: 647, col: 39, offset: 22818},
 						expr: &ruleRefExpr{
-							pos:  position{line: 574, col: 39, offset: 19903},
+							pos:  position{line: 647, col: 39, offset: 22818},
 							name: "ListParagraph",
 						},
 					},
@@ -4333,47 +5036,47 @@ var g = &grammar{
 		},
 		{
 			name: "LabeledListItem",
-			pos:  position{line: 581, col: 1, offset: 20219},
+			pos:  position{line: 654, col: 1, offset: 23134},
 			expr: &actionExpr{
-				pos: position{line: 581, col: 20, offset: 20238},
+				pos: position{line: 654, col: 20, offset: 23153},
 				run: (*parser).callonLabeledListItem1,
 				expr: &seqExpr{
-					pos: position{line: 581, col: 20, offset: 20238},
+					pos: position{line: 654, col: 20, offset: 23153},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 581, col: 20, offset: 20238},
+							pos:   position{line: 654, col: 20, offset: 23153},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 581, col: 31, offset: 20249},
+								pos: position{line: 654, col: 31, offset: 23164},
 								expr: &ruleRefExpr{
-									pos:  position{line: 581, col: 32, offset: 20250},
+									pos:  position{line: 654, col: 32, offset: 23165},
 									name: "ElementAttributes",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 581, col: 52, offset: 20270},
+							pos:   position{line: 654, col: 52, offset: 23185},
 							label: "term",
 							expr: &ruleRefExpr{
-								pos:  position{line: 581, col: 58, offset: 20276},
+								pos:  position{line: 654, col: 58, offset: 23191},
 								name: "LabeledListItemTerm",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 581, col: 79, offset: 20297},
+							pos:   position{line: 654, col: 79, offset: 23212},
 							label: "separator",
 							expr: &ruleRefExpr{
-								pos:  position{line: 581, col: 90, offset: 20308},
+								pos:  position{line: 654, col: 90, offset: 23223},
 								name: "LabeledListItemSeparator",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 581, col: 116, offset: 20334},
+							pos:   position{line: 654, col: 116, offset: 23249},
 							label: "description",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 581, col: 128, offset: 20346},
+								pos: position{line: 654, col: 128, offset: 23261},
 								expr: &ruleRefExpr{
-									pos:  position{line: 581, col: 129, offset: 20347},
+									pos:  position{line: 654, col: 129, offset: 23262},
 									name: "LabeledListItemDescription",
 								},
 							},
@@ -4384,43 +5087,43 @@ var g = &grammar{
 		},
 		{
 			name: "LabeledListItemTerm",
-			pos:  position{line: 585, col: 1, offset: 20486},
+			pos:  position{line: 658, col: 1, offset: 23401},
 			expr: &actionExpr{
-				pos: position{line: 585, col: 24, offset: 20509},
+				pos: position{line: 658, col: 24, offset: 23424},
 				run: (*parser).callonLabeledListItemTerm1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 585, col: 24, offset: 20509},
+					pos: position{line: 658, col: 24, offset: 23424},
 					expr: &choiceExpr{
-						pos: position{line: 585, col: 25, offset: 20510},
+						pos: position{line: 658, col: 25, offset: 23425},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 585, col: 25, offset: 20510},
+								pos:  position{line: 658, col: 25, offset: 23425},
 								name: "Alphanums",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 585, col: 37, offset: 20522},
+								pos:  position{line: 658, col: 37, offset: 23437},
 								name: "Spaces",
 							},
 							&seqExpr{
-								pos: position{line: 585, col: 47, offset: 20532},
+								pos: position{line: 658, col: 47, offset: 23447},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 585, col: 47, offset: 20532},
+										pos: position{line: 658, col: 47, offset: 23447},
 										expr: &ruleRefExpr{
-											pos:  position{line: 585, col: 48, offset: 20533},
+											pos:  position{line: 658, col: 48, offset: 23448},
 											name: "NEWLINE",
 										},
 									},
 									&notExpr{
-										pos: position{line: 585, col: 56, offset: 20541},
+										pos: position{line: 658, col: 56, offset: 23456},
 										expr: &litMatcher{
-											pos:        position{line: 585, col: 57, offset: 20542},
+											pos:        position{line: 658, col: 57, offset: 23457},
 											val:        "::",
 											ignoreCase: false,
 										},
 									},
 									&anyMatcher{
-										line: 585, col: 62, offset: 20547,
+										line: 658, col: 62, offset: 23462,
 									},
 								},
 							},
@@ -4431,23 +5134,23 @@ var g = &grammar{
 		},
 		{
 			name: "LabeledListItemSeparator",
-			pos:  position{line: 589, col: 1, offset: 20589},
+			pos:  position{line: 662, col: 1, offset: 23504},
 			expr: &actionExpr{
-				pos: position{line: 590, col: 5, offset: 20622},
+				pos: position{line: 663, col: 5, offset: 23537},
 				run: (*parser).callonLabeledListItemSeparator1,
 				expr: &seqExpr{
-					pos: position{line: 590, col: 5, offset: 20622},
+					pos: position{line: 663, col: 5, offset: 23537},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 590, col: 5, offset: 20622},
+							pos:   position{line: 663, col: 5, offset: 23537},
 							label: "separator",
 							expr: &actionExpr{
-								pos: position{line: 590, col: 16, offset: 20633},
+								pos: position{line: 663, col: 16, offset: 23548},
 								run: (*parser).callonLabeledListItemSeparator4,
 								expr: &oneOrMoreExpr{
-									pos: position{line: 590, col: 16, offset: 20633},
+									pos: position{line: 663, col: 16, offset: 23548},
 									expr: &litMatcher{
-										pos:        position{line: 590, col: 17, offset: 20634},
+										pos:        position{line: 663, col: 17, offset: 23549},
 										val:        ":",
 										ignoreCase: false,
 									},
@@ -4455,30 +5158,30 @@ var g = &grammar{
 							},
 						},
 						&andCodeExpr{
-							pos: position{line: 593, col: 5, offset: 20692},
+							pos: position{line: 666, col: 5, offset: 23607},
 							run: (*parser).callonLabeledListItemSeparator7,
 						},
 						&choiceExpr{
-							pos: position{line: 597, col: 6, offset: 20868},
+							pos: position{line: 670, col: 6, offset: 23783},
 							alternatives: []interface{}{
 								&oneOrMoreExpr{
-									pos: position{line: 597, col: 6, offset: 20868},
+									pos: position{line: 670, col: 6, offset: 23783},
 									expr: &choiceExpr{
-										pos: position{line: 597, col: 7, offset: 20869},
+										pos: position{line: 670, col: 7, offset: 23784},
 										alternatives: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 597, col: 7, offset: 20869},
+												pos:  position{line: 670, col: 7, offset: 23784},
 												name: "WS",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 597, col: 12, offset: 20874},
+												pos:  position{line: 670, col: 12, offset: 23789},
 												name: "NEWLINE",
 											},
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 597, col: 24, offset: 20886},
+									pos:  position{line: 670, col: 24, offset: 23801},
 									name: "EOL",
 								},
 							},
@@ -4489,17 +5192,17 @@ var g = &grammar{
 		},
 		{
 			name: "LabeledListItemDescription",
-			pos:  position{line: 601, col: 1, offset: 20926},
+			pos:  position{line: 674, col: 1, offset: 23841},
 			expr: &actionExpr{
-				pos: position{line: 601, col: 31, offset: 20956},
+				pos: position{line: 674, col: 31, offset: 23871},
 				run: (*parser).callonLabeledListItemDescription1,
 				expr: &labeledExpr{
-					pos:   position{line: 601, col: 31, offset: 20956},
+					pos:   position{line: 674, col: 31, offset: 23871},
 					label: "elements",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 601, col: 40, offset: 20965},
+						pos: position{line: 674, col: 40, offset: 23880},
 						expr: &ruleRefExpr{
-							pos:  position{line: 601, col: 41, offset: 20966},
+							pos:  position{line: 674, col: 41, offset: 23881},
 							name: "ListParagraph",
 						},
 					},
@@ -4508,51 +5211,51 @@ var g = &grammar{
 		},
 		{
 			name: "AdmonitionKind",
-			pos:  position{line: 608, col: 1, offset: 21157},
+			pos:  position{line: 681, col: 1, offset: 24072},
 			expr: &choiceExpr{
-				pos: position{line: 608, col: 19, offset: 21175},
+				pos: position{line: 681, col: 19, offset: 24090},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 608, col: 19, offset: 21175},
+						pos: position{line: 681, col: 19, offset: 24090},
 						run: (*parser).callonAdmonitionKind2,
 						expr: &litMatcher{
-							pos:        position{line: 608, col: 19, offset: 21175},
+							pos:        position{line: 681, col: 19, offset: 24090},
 							val:        "TIP",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 610, col: 9, offset: 21221},
+						pos: position{line: 683, col: 9, offset: 24136},
 						run: (*parser).callonAdmonitionKind4,
 						expr: &litMatcher{
-							pos:        position{line: 610, col: 9, offset: 21221},
+							pos:        position{line: 683, col: 9, offset: 24136},
 							val:        "NOTE",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 612, col: 9, offset: 21269},
+						pos: position{line: 685, col: 9, offset: 24184},
 						run: (*parser).callonAdmonitionKind6,
 						expr: &litMatcher{
-							pos:        position{line: 612, col: 9, offset: 21269},
+							pos:        position{line: 685, col: 9, offset: 24184},
 							val:        "IMPORTANT",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 614, col: 9, offset: 21327},
+						pos: position{line: 687, col: 9, offset: 24242},
 						run: (*parser).callonAdmonitionKind8,
 						expr: &litMatcher{
-							pos:        position{line: 614, col: 9, offset: 21327},
+							pos:        position{line: 687, col: 9, offset: 24242},
 							val:        "WARNING",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 616, col: 9, offset: 21381},
+						pos: position{line: 689, col: 9, offset: 24296},
 						run: (*parser).callonAdmonitionKind10,
 						expr: &litMatcher{
-							pos:        position{line: 616, col: 9, offset: 21381},
+							pos:        position{line: 689, col: 9, offset: 24296},
 							val:        "CAUTION",
 							ignoreCase: false,
 						},
@@ -4562,47 +5265,47 @@ var g = &grammar{
 		},
 		{
 			name: "Paragraph",
-			pos:  position{line: 625, col: 1, offset: 21688},
+			pos:  position{line: 698, col: 1, offset: 24603},
 			expr: &choiceExpr{
-				pos: position{line: 627, col: 5, offset: 21735},
+				pos: position{line: 700, col: 5, offset: 24650},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 627, col: 5, offset: 21735},
+						pos: position{line: 700, col: 5, offset: 24650},
 						run: (*parser).callonParagraph2,
 						expr: &seqExpr{
-							pos: position{line: 627, col: 5, offset: 21735},
+							pos: position{line: 700, col: 5, offset: 24650},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 627, col: 5, offset: 21735},
+									pos:   position{line: 700, col: 5, offset: 24650},
 									label: "attributes",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 627, col: 16, offset: 21746},
+										pos: position{line: 700, col: 16, offset: 24661},
 										expr: &ruleRefExpr{
-											pos:  position{line: 627, col: 17, offset: 21747},
+											pos:  position{line: 700, col: 17, offset: 24662},
 											name: "ElementAttributes",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 627, col: 37, offset: 21767},
+									pos:   position{line: 700, col: 37, offset: 24682},
 									label: "t",
 									expr: &ruleRefExpr{
-										pos:  position{line: 627, col: 40, offset: 21770},
+										pos:  position{line: 700, col: 40, offset: 24685},
 										name: "AdmonitionKind",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 627, col: 56, offset: 21786},
+									pos:        position{line: 700, col: 56, offset: 24701},
 									val:        ": ",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 627, col: 61, offset: 21791},
+									pos:   position{line: 700, col: 61, offset: 24706},
 									label: "lines",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 627, col: 67, offset: 21797},
+										pos: position{line: 700, col: 67, offset: 24712},
 										expr: &ruleRefExpr{
-											pos:  position{line: 627, col: 68, offset: 21798},
+											pos:  position{line: 700, col: 68, offset: 24713},
 											name: "InlineElements",
 										},
 									},
@@ -4611,29 +5314,29 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 631, col: 5, offset: 21990},
+						pos: position{line: 704, col: 5, offset: 24905},
 						run: (*parser).callonParagraph13,
 						expr: &seqExpr{
-							pos: position{line: 631, col: 5, offset: 21990},
+							pos: position{line: 704, col: 5, offset: 24905},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 631, col: 5, offset: 21990},
+									pos:   position{line: 704, col: 5, offset: 24905},
 									label: "attributes",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 631, col: 16, offset: 22001},
+										pos: position{line: 704, col: 16, offset: 24916},
 										expr: &ruleRefExpr{
-											pos:  position{line: 631, col: 17, offset: 22002},
+											pos:  position{line: 704, col: 17, offset: 24917},
 											name: "ElementAttributes",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 631, col: 37, offset: 22022},
+									pos:   position{line: 704, col: 37, offset: 24937},
 									label: "lines",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 631, col: 43, offset: 22028},
+										pos: position{line: 704, col: 43, offset: 24943},
 										expr: &ruleRefExpr{
-											pos:  position{line: 631, col: 44, offset: 22029},
+											pos:  position{line: 704, col: 44, offset: 24944},
 											name: "InlineElements",
 										},
 									},
@@ -4645,212 +5348,50 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "SimpleParagraph",
-			pos:  position{line: 636, col: 1, offset: 22194},
-			expr: &actionExpr{
-				pos: position{line: 636, col: 20, offset: 22213},
-				run: (*parser).callonSimpleParagraph1,
-				expr: &seqExpr{
-					pos: position{line: 636, col: 20, offset: 22213},
-					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 636, col: 20, offset: 22213},
-							label: "attributes",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 636, col: 31, offset: 22224},
-								expr: &ruleRefExpr{
-									pos:  position{line: 636, col: 32, offset: 22225},
-									name: "ElementAttributes",
-								},
-							},
-						},
-						&andCodeExpr{
-							pos: position{line: 637, col: 5, offset: 22250},
-							run: (*parser).callonSimpleParagraph6,
-						},
-						&labeledExpr{
-							pos:   position{line: 645, col: 5, offset: 22541},
-							label: "firstLine",
-							expr: &ruleRefExpr{
-								pos:  position{line: 645, col: 16, offset: 22552},
-								name: "FirstParagraphLine",
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 646, col: 5, offset: 22575},
-							label: "otherLines",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 646, col: 16, offset: 22586},
-								expr: &ruleRefExpr{
-									pos:  position{line: 646, col: 17, offset: 22587},
-									name: "OtherParagraphLine",
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "ParagraphLines",
-			pos:  position{line: 650, col: 1, offset: 22721},
-			expr: &actionExpr{
-				pos: position{line: 650, col: 19, offset: 22739},
-				run: (*parser).callonParagraphLines1,
-				expr: &seqExpr{
-					pos: position{line: 650, col: 19, offset: 22739},
-					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 650, col: 19, offset: 22739},
-							label: "firstLine",
-							expr: &ruleRefExpr{
-								pos:  position{line: 650, col: 30, offset: 22750},
-								name: "FirstParagraphLine",
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 650, col: 50, offset: 22770},
-							label: "otherLines",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 650, col: 61, offset: 22781},
-								expr: &ruleRefExpr{
-									pos:  position{line: 650, col: 62, offset: 22782},
-									name: "OtherParagraphLine",
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "FirstParagraphLine",
-			pos:  position{line: 654, col: 1, offset: 22888},
-			expr: &actionExpr{
-				pos: position{line: 654, col: 23, offset: 22910},
-				run: (*parser).callonFirstParagraphLine1,
-				expr: &seqExpr{
-					pos: position{line: 654, col: 23, offset: 22910},
-					exprs: []interface{}{
-						&notExpr{
-							pos: position{line: 654, col: 23, offset: 22910},
-							expr: &seqExpr{
-								pos: position{line: 654, col: 25, offset: 22912},
-								exprs: []interface{}{
-									&ruleRefExpr{
-										pos:  position{line: 654, col: 25, offset: 22912},
-										name: "LabeledListItemTerm",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 654, col: 45, offset: 22932},
-										name: "LabeledListItemSeparator",
-									},
-								},
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 655, col: 5, offset: 22962},
-							label: "elements",
-							expr: &seqExpr{
-								pos: position{line: 655, col: 15, offset: 22972},
-								exprs: []interface{}{
-									&ruleRefExpr{
-										pos:  position{line: 655, col: 15, offset: 22972},
-										name: "SimpleWord",
-									},
-									&zeroOrMoreExpr{
-										pos: position{line: 655, col: 26, offset: 22983},
-										expr: &ruleRefExpr{
-											pos:  position{line: 655, col: 26, offset: 22983},
-											name: "InlineElement",
-										},
-									},
-								},
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 655, col: 42, offset: 22999},
-							label: "linebreak",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 655, col: 52, offset: 23009},
-								expr: &ruleRefExpr{
-									pos:  position{line: 655, col: 53, offset: 23010},
-									name: "LineBreak",
-								},
-							},
-						},
-						&ruleRefExpr{
-							pos:  position{line: 655, col: 65, offset: 23022},
-							name: "EOL",
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "OtherParagraphLine",
-			pos:  position{line: 659, col: 1, offset: 23112},
-			expr: &actionExpr{
-				pos: position{line: 659, col: 23, offset: 23134},
-				run: (*parser).callonOtherParagraphLine1,
-				expr: &labeledExpr{
-					pos:   position{line: 659, col: 23, offset: 23134},
-					label: "elements",
-					expr: &ruleRefExpr{
-						pos:  position{line: 659, col: 33, offset: 23144},
-						name: "InlineElements",
-					},
-				},
-			},
-		},
-		{
-			name: "VerseParagraph",
-			pos:  position{line: 663, col: 1, offset: 23190},
+			name: "ParagraphWithSubstitutions",
+			pos:  position{line: 710, col: 1, offset: 25202},
 			expr: &choiceExpr{
-				pos: position{line: 665, col: 5, offset: 23242},
+				pos: position{line: 712, col: 5, offset: 25265},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 665, col: 5, offset: 23242},
-						run: (*parser).callonVerseParagraph2,
+						pos: position{line: 712, col: 5, offset: 25265},
+						run: (*parser).callonParagraphWithSubstitutions2,
 						expr: &seqExpr{
-							pos: position{line: 665, col: 5, offset: 23242},
+							pos: position{line: 712, col: 5, offset: 25265},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 665, col: 5, offset: 23242},
+									pos:   position{line: 712, col: 5, offset: 25265},
 									label: "attributes",
-									expr: &zeroOrOneExpr{
-										pos: position{line: 665, col: 16, offset: 23253},
-										expr: &ruleRefExpr{
-											pos:  position{line: 665, col: 17, offset: 23254},
-											name: "ElementAttributes",
-										},
+									expr: &ruleRefExpr{
+										pos:  position{line: 712, col: 17, offset: 25277},
+										name: "ElementAttributes",
 									},
 								},
 								&andCodeExpr{
-									pos: position{line: 666, col: 5, offset: 23278},
-									run: (*parser).callonVerseParagraph7,
+									pos: position{line: 713, col: 5, offset: 25300},
+									run: (*parser).callonParagraphWithSubstitutions6,
 								},
 								&labeledExpr{
-									pos:   position{line: 673, col: 5, offset: 23490},
+									pos:   position{line: 716, col: 5, offset: 25399},
 									label: "t",
 									expr: &ruleRefExpr{
-										pos:  position{line: 673, col: 8, offset: 23493},
+										pos:  position{line: 716, col: 8, offset: 25402},
 										name: "AdmonitionKind",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 673, col: 24, offset: 23509},
+									pos:        position{line: 716, col: 24, offset: 25418},
 									val:        ": ",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 673, col: 29, offset: 23514},
+									pos:   position{line: 716, col: 29, offset: 25423},
 									label: "lines",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 673, col: 35, offset: 23520},
+										pos: position{line: 716, col: 35, offset: 25429},
 										expr: &ruleRefExpr{
-											pos:  position{line: 673, col: 36, offset: 23521},
-											name: "InlineElements",
+											pos:  position{line: 716, col: 36, offset: 25430},
+											name: "ParagraphWithSubstitutionsLine",
 										},
 									},
 								},
@@ -4858,34 +5399,101 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 677, col: 5, offset: 23713},
-						run: (*parser).callonVerseParagraph14,
+						pos: position{line: 720, col: 5, offset: 25613},
+						run: (*parser).callonParagraphWithSubstitutions13,
 						expr: &seqExpr{
-							pos: position{line: 677, col: 5, offset: 23713},
+							pos: position{line: 720, col: 5, offset: 25613},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 677, col: 5, offset: 23713},
+									pos:   position{line: 720, col: 5, offset: 25613},
 									label: "attributes",
-									expr: &zeroOrOneExpr{
-										pos: position{line: 677, col: 16, offset: 23724},
-										expr: &ruleRefExpr{
-											pos:  position{line: 677, col: 17, offset: 23725},
-											name: "ElementAttributes",
-										},
+									expr: &ruleRefExpr{
+										pos:  position{line: 720, col: 17, offset: 25625},
+										name: "ElementAttributes",
 									},
 								},
 								&andCodeExpr{
-									pos: position{line: 678, col: 5, offset: 23749},
-									run: (*parser).callonVerseParagraph19,
+									pos: position{line: 721, col: 5, offset: 25648},
+									run: (*parser).callonParagraphWithSubstitutions17,
 								},
-								&labeledExpr{
-									pos:   position{line: 685, col: 5, offset: 23961},
-									label: "lines",
-									expr: &oneOrMoreExpr{
-										pos: position{line: 685, col: 11, offset: 23967},
-										expr: &ruleRefExpr{
-											pos:  position{line: 685, col: 12, offset: 23968},
-											name: "InlineElements",
+								&notExpr{
+									pos: position{line: 724, col: 5, offset: 25747},
+									expr: &ruleRefExpr{
+										pos:  position{line: 724, col: 6, offset: 25748},
+										name: "WS",
+									},
+								},
+								&notExpr{
+									pos: position{line: 726, col: 5, offset: 25875},
+									expr: &ruleRefExpr{
+										pos:  position{line: 726, col: 6, offset: 25876},
+										name: "ListItem",
+									},
+								},
+								&notExpr{
+									pos: position{line: 726, col: 15, offset: 25885},
+									expr: &ruleRefExpr{
+										pos:  position{line: 726, col: 16, offset: 25886},
+										name: "Section",
+									},
+								},
+								&notExpr{
+									pos: position{line: 726, col: 24, offset: 25894},
+									expr: &ruleRefExpr{
+										pos:  position{line: 726, col: 25, offset: 25895},
+										name: "ThematicBreak",
+									},
+								},
+								&notExpr{
+									pos: position{line: 726, col: 39, offset: 25909},
+									expr: &ruleRefExpr{
+										pos:  position{line: 726, col: 40, offset: 25910},
+										name: "PageBreak",
+									},
+								},
+								&notExpr{
+									pos: position{line: 727, col: 5, offset: 25925},
+									expr: &ruleRefExpr{
+										pos:  position{line: 727, col: 6, offset: 25926},
+										name: "ImageBlock",
+									},
+								},
+								&notExpr{
+									pos: position{line: 727, col: 17, offset: 25937},
+									expr: &ruleRefExpr{
+										pos:  position{line: 727, col: 18, offset: 25938},
+										name: "VideoBlock",
+									},
+								},
+								&notExpr{
+									pos: position{line: 727, col: 29, offset: 25949},
+									expr: &ruleRefExpr{
+										pos:  position{line: 727, col: 30, offset: 25950},
+										name: "AudioBlock",
+									},
+								},
+								&notExpr{
+									pos: position{line: 727, col: 41, offset: 25961},
+									expr: &ruleRefExpr{
+										pos:  position{line: 727, col: 42, offset: 25962},
+										name: "TableOfContentsMacro",
+									},
+								},
+								&notExpr{
+									pos: position{line: 727, col: 63, offset: 25983},
+									expr: &ruleRefExpr{
+										pos:  position{line: 727, col: 64, offset: 25984},
+										name: "UserMacroBlock",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 728, col: 5, offset: 26003},
+									label: "lines",
+									expr: &oneOrMoreExpr{
+										pos: position{line: 728, col: 11, offset: 26009},
+										expr: &ruleRefExpr{
+											pos:  position{line: 728, col: 12, offset: 26010},
+											name: "ParagraphWithSubstitutionsLine",
 										},
 									},
 								},
@@ -4896,83 +5504,87 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "InlineElements",
-			pos:  position{line: 689, col: 1, offset: 24069},
+			name: "ParagraphWithSubstitutionsLine",
+			pos:  position{line: 732, col: 1, offset: 26117},
 			expr: &actionExpr{
-				pos: position{line: 689, col: 19, offset: 24087},
-				run: (*parser).callonInlineElements1,
+				pos: position{line: 732, col: 35, offset: 26151},
+				run: (*parser).callonParagraphWithSubstitutionsLine1,
 				expr: &seqExpr{
-					pos: position{line: 689, col: 19, offset: 24087},
+					pos: position{line: 732, col: 35, offset: 26151},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 689, col: 19, offset: 24087},
+							pos: position{line: 732, col: 35, offset: 26151},
 							expr: &ruleRefExpr{
-								pos:  position{line: 689, col: 20, offset: 24088},
+								pos:  position{line: 732, col: 36, offset: 26152},
 								name: "EOF",
 							},
 						},
 						&notExpr{
-							pos: position{line: 689, col: 24, offset: 24092},
+							pos: position{line: 732, col: 40, offset: 26156},
 							expr: &ruleRefExpr{
-								pos:  position{line: 689, col: 25, offset: 24093},
+								pos:  position{line: 732, col: 41, offset: 26157},
 								name: "BlankLine",
 							},
 						},
+						&notExpr{
+							pos: position{line: 732, col: 51, offset: 26167},
+							expr: &ruleRefExpr{
+								pos:  position{line: 732, col: 52, offset: 26168},
+								name: "BlockDelimiter",
+							},
+						},
 						&labeledExpr{
-							pos:   position{line: 690, col: 5, offset: 24107},
-							label: "elements",
+							pos:   position{line: 733, col: 5, offset: 26188},
+							label: "line",
 							expr: &choiceExpr{
-								pos: position{line: 690, col: 15, offset: 24117},
+								pos: position{line: 733, col: 11, offset: 26194},
 								alternatives: []interface{}{
 									&actionExpr{
-										pos: position{line: 690, col: 15, offset: 24117},
-										run: (*parser).callonInlineElements9,
+										pos: position{line: 733, col: 11, offset: 26194},
+										run: (*parser).callonParagraphWithSubstitutionsLine11,
 										expr: &labeledExpr{
-											pos:   position{line: 690, col: 15, offset: 24117},
+											pos:   position{line: 733, col: 11, offset: 26194},
 											label: "comment",
 											expr: &ruleRefExpr{
-												pos:  position{line: 690, col: 24, offset: 24126},
+												pos:  position{line: 733, col: 20, offset: 26203},
 												name: "SingleLineComment",
 											},
 										},
 									},
 									&actionExpr{
-										pos: position{line: 692, col: 9, offset: 24218},
-										run: (*parser).callonInlineElements12,
+										pos: position{line: 735, col: 9, offset: 26280},
+										run: (*parser).callonParagraphWithSubstitutionsLine14,
 										expr: &seqExpr{
-											pos: position{line: 692, col: 9, offset: 24218},
+											pos: position{line: 735, col: 9, offset: 26280},
 											exprs: []interface{}{
-												&notExpr{
-													pos: position{line: 692, col: 9, offset: 24218},
-													expr: &ruleRefExpr{
-														pos:  position{line: 692, col: 10, offset: 24219},
-														name: "BlockDelimiter",
-													},
-												},
-												&labeledExpr{
-													pos:   position{line: 692, col: 25, offset: 24234},
-													label: "elements",
-													expr: &oneOrMoreExpr{
-														pos: position{line: 692, col: 34, offset: 24243},
-														expr: &ruleRefExpr{
-															pos:  position{line: 692, col: 35, offset: 24244},
-															name: "InlineElement",
-														},
-													},
-												},
 												&labeledExpr{
-													pos:   position{line: 692, col: 51, offset: 24260},
-													label: "linebreak",
-													expr: &zeroOrOneExpr{
-														pos: position{line: 692, col: 61, offset: 24270},
-														expr: &ruleRefExpr{
-															pos:  position{line: 692, col: 62, offset: 24271},
-															name: "LineBreak",
+													pos:   position{line: 735, col: 9, offset: 26280},
+													label: "content",
+													expr: &actionExpr{
+														pos: position{line: 735, col: 18, offset: 26289},
+														run: (*parser).callonParagraphWithSubstitutionsLine17,
+														expr: &oneOrMoreExpr{
+															pos: position{line: 735, col: 18, offset: 26289},
+															expr: &seqExpr{
+																pos: position{line: 735, col: 19, offset: 26290},
+																exprs: []interface{}{
+																	&notExpr{
+																		pos: position{line: 735, col: 19, offset: 26290},
+																		expr: &ruleRefExpr{
+																			pos:  position{line: 735, col: 20, offset: 26291},
+																			name: "EOL",
+																		},
+																	},
+																	&anyMatcher{
+																		line: 735, col: 24, offset: 26295,
+																	},
+																},
+															},
 														},
 													},
 												},
 												&ruleRefExpr{
-													pos:  position{line: 692, col: 74, offset: 24283},
+													pos:  position{line: 737, col: 8, offset: 26343},
 													name: "EOL",
 												},
 											},
@@ -4986,82 +5598,45 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "InlineElement",
-			pos:  position{line: 698, col: 1, offset: 24419},
+			name: "SimpleParagraph",
+			pos:  position{line: 744, col: 1, offset: 26502},
 			expr: &actionExpr{
-				pos: position{line: 698, col: 18, offset: 24436},
-				run: (*parser).callonInlineElement1,
+				pos: position{line: 744, col: 20, offset: 26521},
+				run: (*parser).callonSimpleParagraph1,
 				expr: &seqExpr{
-					pos: position{line: 698, col: 18, offset: 24436},
+					pos: position{line: 744, col: 20, offset: 26521},
 					exprs: []interface{}{
-						&notExpr{
-							pos: position{line: 698, col: 18, offset: 24436},
-							expr: &ruleRefExpr{
-								pos:  position{line: 698, col: 19, offset: 24437},
-								name: "EOL",
+						&labeledExpr{
+							pos:   position{line: 744, col: 20, offset: 26521},
+							label: "attributes",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 744, col: 31, offset: 26532},
+								expr: &ruleRefExpr{
+									pos:  position{line: 744, col: 32, offset: 26533},
+									name: "ElementAttributes",
+								},
 							},
 						},
-						&notExpr{
-							pos: position{line: 698, col: 23, offset: 24441},
+						&andCodeExpr{
+							pos: position{line: 745, col: 5, offset: 26558},
+							run: (*parser).callonSimpleParagraph6,
+						},
+						&labeledExpr{
+							pos:   position{line: 753, col: 5, offset: 26849},
+							label: "firstLine",
 							expr: &ruleRefExpr{
-								pos:  position{line: 698, col: 24, offset: 24442},
-								name: "LineBreak",
+								pos:  position{line: 753, col: 16, offset: 26860},
+								name: "FirstParagraphLine",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 699, col: 5, offset: 24457},
-							label: "element",
-							expr: &choiceExpr{
-								pos: position{line: 699, col: 14, offset: 24466},
-								alternatives: []interface{}{
-									&ruleRefExpr{
-										pos:  position{line: 699, col: 14, offset: 24466},
-										name: "SimpleWord",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 700, col: 11, offset: 24487},
-										name: "Spaces",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 701, col: 11, offset: 24505},
-										name: "InlineImage",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 702, col: 11, offset: 24528},
-										name: "Link",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 703, col: 11, offset: 24544},
-										name: "Passthrough",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 704, col: 11, offset: 24567},
-										name: "InlineFootnote",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 705, col: 11, offset: 24593},
-										name: "InlineUserMacro",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 706, col: 11, offset: 24620},
-										name: "QuotedText",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 707, col: 11, offset: 24642},
-										name: "CrossReference",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 708, col: 11, offset: 24668},
-										name: "DocumentAttributeSubstitution",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 709, col: 11, offset: 24709},
-										name: "InlineElementID",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 710, col: 11, offset: 24736},
-										name: "OtherWord",
-									},
+							pos:   position{line: 754, col: 5, offset: 26883},
+							label: "otherLines",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 754, col: 16, offset: 26894},
+								expr: &ruleRefExpr{
+									pos:  position{line: 754, col: 17, offset: 26895},
+									name: "OtherParagraphLine",
 								},
 							},
 						},
@@ -5070,217 +5645,200 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "InlineElementsWithoutSubtitution",
-			pos:  position{line: 717, col: 1, offset: 24996},
+			name: "ParagraphLines",
+			pos:  position{line: 758, col: 1, offset: 27029},
 			expr: &actionExpr{
-				pos: position{line: 717, col: 37, offset: 25032},
-				run: (*parser).callonInlineElementsWithoutSubtitution1,
+				pos: position{line: 758, col: 19, offset: 27047},
+				run: (*parser).callonParagraphLines1,
 				expr: &seqExpr{
-					pos: position{line: 717, col: 37, offset: 25032},
+					pos: position{line: 758, col: 19, offset: 27047},
 					exprs: []interface{}{
-						&notExpr{
-							pos: position{line: 717, col: 37, offset: 25032},
-							expr: &ruleRefExpr{
-								pos:  position{line: 717, col: 38, offset: 25033},
-								name: "BlankLine",
-							},
-						},
-						&notExpr{
-							pos: position{line: 717, col: 48, offset: 25043},
+						&labeledExpr{
+							pos:   position{line: 758, col: 19, offset: 27047},
+							label: "firstLine",
 							expr: &ruleRefExpr{
-								pos:  position{line: 717, col: 49, offset: 25044},
-								name: "BlockDelimiter",
+								pos:  position{line: 758, col: 30, offset: 27058},
+								name: "FirstParagraphLine",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 717, col: 64, offset: 25059},
-							label: "elements",
+							pos:   position{line: 758, col: 50, offset: 27078},
+							label: "otherLines",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 717, col: 73, offset: 25068},
-								expr: &ruleRefExpr{
-									pos:  position{line: 717, col: 74, offset: 25069},
-									name: "InlineElementWithoutSubtitution",
-								},
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 717, col: 108, offset: 25103},
-							label: "linebreak",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 717, col: 118, offset: 25113},
+								pos: position{line: 758, col: 61, offset: 27089},
 								expr: &ruleRefExpr{
-									pos:  position{line: 717, col: 119, offset: 25114},
-									name: "LineBreak",
+									pos:  position{line: 758, col: 62, offset: 27090},
+									name: "OtherParagraphLine",
 								},
 							},
 						},
-						&ruleRefExpr{
-							pos:  position{line: 717, col: 131, offset: 25126},
-							name: "EOL",
-						},
 					},
 				},
 			},
 		},
 		{
-			name: "InlineElementWithoutSubtitution",
-			pos:  position{line: 721, col: 1, offset: 25217},
+			name: "FirstParagraphLine",
+			pos:  position{line: 762, col: 1, offset: 27196},
 			expr: &actionExpr{
-				pos: position{line: 721, col: 36, offset: 25252},
-				run: (*parser).callonInlineElementWithoutSubtitution1,
+				pos: position{line: 762, col: 23, offset: 27218},
+				run: (*parser).callonFirstParagraphLine1,
 				expr: &seqExpr{
-					pos: position{line: 721, col: 36, offset: 25252},
+					pos: position{line: 762, col: 23, offset: 27218},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 721, col: 36, offset: 25252},
-							expr: &ruleRefExpr{
-								pos:  position{line: 721, col: 37, offset: 25253},
-								name: "EOL",
-							},
-						},
-						&notExpr{
-							pos: position{line: 721, col: 41, offset: 25257},
-							expr: &ruleRefExpr{
-								pos:  position{line: 721, col: 42, offset: 25258},
-								name: "LineBreak",
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 722, col: 5, offset: 25273},
-							label: "element",
-							expr: &choiceExpr{
-								pos: position{line: 722, col: 14, offset: 25282},
-								alternatives: []interface{}{
-									&ruleRefExpr{
-										pos:  position{line: 722, col: 14, offset: 25282},
-										name: "SimpleWord",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 723, col: 11, offset: 25303},
-										name: "Spaces",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 724, col: 11, offset: 25321},
-										name: "InlineImage",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 725, col: 11, offset: 25344},
-										name: "Link",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 726, col: 11, offset: 25360},
-										name: "Passthrough",
-									},
+							pos: position{line: 762, col: 23, offset: 27218},
+							expr: &seqExpr{
+								pos: position{line: 762, col: 25, offset: 27220},
+								exprs: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 727, col: 11, offset: 25383},
-										name: "QuotedText",
+										pos:  position{line: 762, col: 25, offset: 27220},
+										name: "LabeledListItemTerm",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 728, col: 11, offset: 25405},
-										name: "CrossReference",
+										pos:  position{line: 762, col: 45, offset: 27240},
+										name: "LabeledListItemSeparator",
 									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 763, col: 5, offset: 27270},
+							label: "elements",
+							expr: &seqExpr{
+								pos: position{line: 763, col: 15, offset: 27280},
+								exprs: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 729, col: 11, offset: 25431},
-										name: "InlineElementID",
+										pos:  position{line: 763, col: 15, offset: 27280},
+										name: "SimpleWord",
 									},
-									&ruleRefExpr{
-										pos:  position{line: 730, col: 11, offset: 25457},
-										name: "OtherWord",
+									&zeroOrMoreExpr{
+										pos: position{line: 763, col: 26, offset: 27291},
+										expr: &ruleRefExpr{
+											pos:  position{line: 763, col: 26, offset: 27291},
+											name: "InlineElement",
+										},
 									},
 								},
 							},
 						},
+						&labeledExpr{
+							pos:   position{line: 763, col: 42, offset: 27307},
+							label: "linebreak",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 763, col: 52, offset: 27317},
+								expr: &ruleRefExpr{
+									pos:  position{line: 763, col: 53, offset: 27318},
+									name: "LineBreak",
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 763, col: 65, offset: 27330},
+							name: "EOL",
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "VerbatimBlock",
-			pos:  position{line: 735, col: 1, offset: 25591},
+			name: "OtherParagraphLine",
+			pos:  position{line: 767, col: 1, offset: 27420},
 			expr: &actionExpr{
-				pos: position{line: 735, col: 18, offset: 25608},
-				run: (*parser).callonVerbatimBlock1,
-				expr: &seqExpr{
-					pos: position{line: 735, col: 18, offset: 25608},
-					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 735, col: 18, offset: 25608},
-							label: "elements",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 735, col: 27, offset: 25617},
-								expr: &choiceExpr{
-									pos: position{line: 735, col: 28, offset: 25618},
-									alternatives: []interface{}{
-										&ruleRefExpr{
-											pos:  position{line: 735, col: 28, offset: 25618},
-											name: "BlankLine",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 735, col: 40, offset: 25630},
-											name: "FileInclusion",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 735, col: 56, offset: 25646},
-											name: "VerbatimParagraph",
-										},
-									},
-								},
-							},
-						},
-						&ruleRefExpr{
-							pos:  position{line: 735, col: 76, offset: 25666},
-							name: "EOF",
-						},
+				pos: position{line: 767, col: 23, offset: 27442},
+				run: (*parser).callonOtherParagraphLine1,
+				expr: &labeledExpr{
+					pos:   position{line: 767, col: 23, offset: 27442},
+					label: "elements",
+					expr: &ruleRefExpr{
+						pos:  position{line: 767, col: 33, offset: 27452},
+						name: "InlineElements",
 					},
 				},
 			},
 		},
 		{
-			name: "VerbatimParagraph",
-			pos:  position{line: 739, col: 1, offset: 25700},
-			expr: &actionExpr{
-				pos: position{line: 739, col: 22, offset: 25721},
-				run: (*parser).callonVerbatimParagraph1,
-				expr: &seqExpr{
-					pos: position{line: 739, col: 22, offset: 25721},
-					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 739, col: 22, offset: 25721},
-							label: "attributes",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 739, col: 33, offset: 25732},
-								expr: &ruleRefExpr{
-									pos:  position{line: 739, col: 34, offset: 25733},
-									name: "ElementAttributes",
+			name: "VerseParagraph",
+			pos:  position{line: 771, col: 1, offset: 27498},
+			expr: &choiceExpr{
+				pos: position{line: 773, col: 5, offset: 27550},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 773, col: 5, offset: 27550},
+						run: (*parser).callonVerseParagraph2,
+						expr: &seqExpr{
+							pos: position{line: 773, col: 5, offset: 27550},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 773, col: 5, offset: 27550},
+									label: "attributes",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 773, col: 16, offset: 27561},
+										expr: &ruleRefExpr{
+											pos:  position{line: 773, col: 17, offset: 27562},
+											name: "ElementAttributes",
+										},
+									},
+								},
+								&andCodeExpr{
+									pos: position{line: 774, col: 5, offset: 27586},
+									run: (*parser).callonVerseParagraph7,
+								},
+								&labeledExpr{
+									pos:   position{line: 781, col: 5, offset: 27798},
+									label: "t",
+									expr: &ruleRefExpr{
+										pos:  position{line: 781, col: 8, offset: 27801},
+										name: "AdmonitionKind",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 781, col: 24, offset: 27817},
+									val:        ": ",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 781, col: 29, offset: 27822},
+									label: "lines",
+									expr: &oneOrMoreExpr{
+										pos: position{line: 781, col: 35, offset: 27828},
+										expr: &ruleRefExpr{
+											pos:  position{line: 781, col: 36, offset: 27829},
+											name: "InlineElements",
+										},
+									},
 								},
 							},
 						},
-						&labeledExpr{
-							pos:   position{line: 739, col: 54, offset: 25753},
-							label: "lines",
-							expr: &oneOrMoreExpr{
-								pos: position{line: 739, col: 60, offset: 25759},
-								expr: &actionExpr{
-									pos: position{line: 739, col: 61, offset: 25760},
-									run: (*parser).callonVerbatimParagraph8,
-									expr: &seqExpr{
-										pos: position{line: 739, col: 61, offset: 25760},
-										exprs: []interface{}{
-											&notExpr{
-												pos: position{line: 739, col: 61, offset: 25760},
-												expr: &ruleRefExpr{
-													pos:  position{line: 739, col: 62, offset: 25761},
-													name: "EOF",
-												},
-											},
-											&labeledExpr{
-												pos:   position{line: 739, col: 66, offset: 25765},
-												label: "line",
-												expr: &ruleRefExpr{
-													pos:  position{line: 739, col: 72, offset: 25771},
-													name: "VerbatimParagraphLine",
-												},
-											},
+					},
+					&actionExpr{
+						pos: position{line: 785, col: 5, offset: 28021},
+						run: (*parser).callonVerseParagraph14,
+						expr: &seqExpr{
+							pos: position{line: 785, col: 5, offset: 28021},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 785, col: 5, offset: 28021},
+									label: "attributes",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 785, col: 16, offset: 28032},
+										expr: &ruleRefExpr{
+											pos:  position{line: 785, col: 17, offset: 28033},
+											name: "ElementAttributes",
+										},
+									},
+								},
+								&andCodeExpr{
+									pos: position{line: 786, col: 5, offset: 28057},
+									run: (*parser).callonVerseParagraph19,
+								},
+								&labeledExpr{
+									pos:   position{line: 793, col: 5, offset: 28269},
+									label: "lines",
+									expr: &oneOrMoreExpr{
+										pos: position{line: 793, col: 11, offset: 28275},
+										expr: &ruleRefExpr{
+											pos:  position{line: 793, col: 12, offset: 28276},
+											name: "InlineElements",
 										},
 									},
 								},
@@ -5291,192 +5849,175 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "VerbatimParagraphLine",
-			pos:  position{line: 745, col: 1, offset: 25891},
+			name: "InlineElements",
+			pos:  position{line: 797, col: 1, offset: 28377},
 			expr: &actionExpr{
-				pos: position{line: 745, col: 26, offset: 25916},
-				run: (*parser).callonVerbatimParagraphLine1,
+				pos: position{line: 797, col: 19, offset: 28395},
+				run: (*parser).callonInlineElements1,
 				expr: &seqExpr{
-					pos: position{line: 745, col: 26, offset: 25916},
+					pos: position{line: 797, col: 19, offset: 28395},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 745, col: 26, offset: 25916},
+							pos: position{line: 797, col: 19, offset: 28395},
 							expr: &ruleRefExpr{
-								pos:  position{line: 745, col: 27, offset: 25917},
-								name: "BlockDelimiter",
+								pos:  position{line: 797, col: 20, offset: 28396},
+								name: "EOF",
 							},
 						},
 						&notExpr{
-							pos: position{line: 745, col: 42, offset: 25932},
+							pos: position{line: 797, col: 24, offset: 28400},
 							expr: &ruleRefExpr{
-								pos:  position{line: 745, col: 43, offset: 25933},
+								pos:  position{line: 797, col: 25, offset: 28401},
 								name: "BlankLine",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 745, col: 53, offset: 25943},
+							pos:   position{line: 798, col: 5, offset: 28415},
 							label: "elements",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 745, col: 62, offset: 25952},
-								expr: &ruleRefExpr{
-									pos:  position{line: 745, col: 63, offset: 25953},
-									name: "VerbatimParagraphLineElement",
-								},
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 745, col: 94, offset: 25984},
-							label: "linebreak",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 745, col: 104, offset: 25994},
-								expr: &ruleRefExpr{
-									pos:  position{line: 745, col: 105, offset: 25995},
-									name: "LineBreak",
-								},
-							},
-						},
-						&ruleRefExpr{
-							pos:  position{line: 745, col: 117, offset: 26007},
-							name: "EOL",
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "VerbatimParagraphLineElement",
-			pos:  position{line: 749, col: 1, offset: 26098},
-			expr: &actionExpr{
-				pos: position{line: 749, col: 33, offset: 26130},
-				run: (*parser).callonVerbatimParagraphLineElement1,
-				expr: &oneOrMoreExpr{
-					pos: position{line: 749, col: 33, offset: 26130},
-					expr: &seqExpr{
-						pos: position{line: 749, col: 34, offset: 26131},
-						exprs: []interface{}{
-							&notExpr{
-								pos: position{line: 749, col: 34, offset: 26131},
-								expr: &ruleRefExpr{
-									pos:  position{line: 749, col: 35, offset: 26132},
-									name: "EOL",
-								},
-							},
-							&notExpr{
-								pos: position{line: 749, col: 39, offset: 26136},
-								expr: &ruleRefExpr{
-									pos:  position{line: 749, col: 40, offset: 26137},
-									name: "LineBreak",
+							expr: &choiceExpr{
+								pos: position{line: 798, col: 15, offset: 28425},
+								alternatives: []interface{}{
+									&actionExpr{
+										pos: position{line: 798, col: 15, offset: 28425},
+										run: (*parser).callonInlineElements9,
+										expr: &labeledExpr{
+											pos:   position{line: 798, col: 15, offset: 28425},
+											label: "comment",
+											expr: &ruleRefExpr{
+												pos:  position{line: 798, col: 24, offset: 28434},
+												name: "SingleLineComment",
+											},
+										},
+									},
+									&actionExpr{
+										pos: position{line: 800, col: 9, offset: 28526},
+										run: (*parser).callonInlineElements12,
+										expr: &seqExpr{
+											pos: position{line: 800, col: 9, offset: 28526},
+											exprs: []interface{}{
+												&notExpr{
+													pos: position{line: 800, col: 9, offset: 28526},
+													expr: &ruleRefExpr{
+														pos:  position{line: 800, col: 10, offset: 28527},
+														name: "BlockDelimiter",
+													},
+												},
+												&labeledExpr{
+													pos:   position{line: 800, col: 25, offset: 28542},
+													label: "elements",
+													expr: &oneOrMoreExpr{
+														pos: position{line: 800, col: 34, offset: 28551},
+														expr: &ruleRefExpr{
+															pos:  position{line: 800, col: 35, offset: 28552},
+															name: "InlineElement",
+														},
+													},
+												},
+												&labeledExpr{
+													pos:   position{line: 800, col: 51, offset: 28568},
+													label: "linebreak",
+													expr: &zeroOrOneExpr{
+														pos: position{line: 800, col: 61, offset: 28578},
+														expr: &ruleRefExpr{
+															pos:  position{line: 800, col: 62, offset: 28579},
+															name: "LineBreak",
+														},
+													},
+												},
+												&ruleRefExpr{
+													pos:  position{line: 800, col: 74, offset: 28591},
+													name: "EOL",
+												},
+											},
+										},
+									},
 								},
 							},
-							&anyMatcher{
-								line: 749, col: 50, offset: 26147,
-							},
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "LineBreak",
-			pos:  position{line: 756, col: 1, offset: 26371},
+			name: "InlineElement",
+			pos:  position{line: 806, col: 1, offset: 28727},
 			expr: &actionExpr{
-				pos: position{line: 756, col: 14, offset: 26384},
-				run: (*parser).callonLineBreak1,
+				pos: position{line: 806, col: 18, offset: 28744},
+				run: (*parser).callonInlineElement1,
 				expr: &seqExpr{
-					pos: position{line: 756, col: 14, offset: 26384},
+					pos: position{line: 806, col: 18, offset: 28744},
 					exprs: []interface{}{
-						&ruleRefExpr{
-							pos:  position{line: 756, col: 14, offset: 26384},
-							name: "WS",
-						},
-						&litMatcher{
-							pos:        position{line: 756, col: 17, offset: 26387},
-							val:        "+",
-							ignoreCase: false,
-						},
-						&zeroOrMoreExpr{
-							pos: position{line: 756, col: 21, offset: 26391},
-							expr: &ruleRefExpr{
-								pos:  position{line: 756, col: 21, offset: 26391},
-								name: "WS",
-							},
-						},
-						&andExpr{
-							pos: position{line: 756, col: 25, offset: 26395},
+						&notExpr{
+							pos: position{line: 806, col: 18, offset: 28744},
 							expr: &ruleRefExpr{
-								pos:  position{line: 756, col: 26, offset: 26396},
+								pos:  position{line: 806, col: 19, offset: 28745},
 								name: "EOL",
 							},
 						},
-					},
-				},
-			},
-		},
-		{
-			name: "QuotedText",
-			pos:  position{line: 763, col: 1, offset: 26680},
-			expr: &actionExpr{
-				pos: position{line: 763, col: 15, offset: 26694},
-				run: (*parser).callonQuotedText1,
-				expr: &seqExpr{
-					pos: position{line: 763, col: 15, offset: 26694},
-					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 763, col: 15, offset: 26694},
+							pos: position{line: 806, col: 23, offset: 28749},
 							expr: &ruleRefExpr{
-								pos:  position{line: 763, col: 16, offset: 26695},
-								name: "WS",
+								pos:  position{line: 806, col: 24, offset: 28750},
+								name: "LineBreak",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 763, col: 19, offset: 26698},
-							label: "text",
+							pos:   position{line: 807, col: 5, offset: 28765},
+							label: "element",
 							expr: &choiceExpr{
-								pos: position{line: 763, col: 25, offset: 26704},
+								pos: position{line: 807, col: 14, offset: 28774},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 763, col: 25, offset: 26704},
-										name: "BoldText",
+										pos:  position{line: 807, col: 14, offset: 28774},
+										name: "SimpleWord",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 764, col: 15, offset: 26728},
-										name: "ItalicText",
+										pos:  position{line: 808, col: 11, offset: 28795},
+										name: "Spaces",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 765, col: 15, offset: 26754},
-										name: "MonospaceText",
+										pos:  position{line: 809, col: 11, offset: 28813},
+										name: "InlineImage",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 766, col: 15, offset: 26783},
-										name: "SubscriptText",
+										pos:  position{line: 810, col: 11, offset: 28836},
+										name: "Link",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 767, col: 15, offset: 26812},
-										name: "SuperscriptText",
+										pos:  position{line: 811, col: 11, offset: 28852},
+										name: "Passthrough",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 768, col: 15, offset: 26843},
-										name: "EscapedBoldText",
+										pos:  position{line: 812, col: 11, offset: 28875},
+										name: "InlineFootnote",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 769, col: 15, offset: 26874},
-										name: "EscapedItalicText",
+										pos:  position{line: 813, col: 11, offset: 28901},
+										name: "IndexTerm",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 770, col: 15, offset: 26907},
-										name: "EscapedMonospaceText",
+										pos:  position{line: 814, col: 11, offset: 28921},
+										name: "InlineUserMacro",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 771, col: 15, offset: 26943},
-										name: "EscapedSubscriptText",
+										pos:  position{line: 815, col: 11, offset: 28948},
+										name: "QuotedText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 772, col: 15, offset: 26979},
-										name: "EscapedSuperscriptText",
+										pos:  position{line: 816, col: 11, offset: 28970},
+										name: "CrossReference",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 773, col: 15, offset: 27016},
-										name: "SubscriptOrSuperscriptPrefix",
+										pos:  position{line: 817, col: 11, offset: 28996},
+										name: "DocumentAttributeSubstitution",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 818, col: 11, offset: 29037},
+										name: "InlineElementID",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 819, col: 11, offset: 29064},
+										name: "OtherWord",
 									},
 								},
 							},
@@ -5486,282 +6027,122 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "QuotedTextPrefix",
-			pos:  position{line: 777, col: 1, offset: 27170},
-			expr: &choiceExpr{
-				pos: position{line: 777, col: 21, offset: 27190},
-				alternatives: []interface{}{
-					&litMatcher{
-						pos:        position{line: 777, col: 21, offset: 27190},
-						val:        "**",
-						ignoreCase: false,
-					},
-					&litMatcher{
-						pos:        position{line: 777, col: 28, offset: 27197},
-						val:        "*",
-						ignoreCase: false,
-					},
-					&litMatcher{
-						pos:        position{line: 777, col: 34, offset: 27203},
-						val:        "__",
-						ignoreCase: false,
-					},
-					&litMatcher{
-						pos:        position{line: 777, col: 41, offset: 27210},
-						val:        "_",
-						ignoreCase: false,
-					},
-					&litMatcher{
-						pos:        position{line: 777, col: 47, offset: 27216},
-						val:        "``",
-						ignoreCase: false,
-					},
-					&litMatcher{
-						pos:        position{line: 777, col: 54, offset: 27223},
-						val:        "`",
-						ignoreCase: false,
-					},
-					&litMatcher{
-						pos:        position{line: 777, col: 60, offset: 27229},
-						val:        "^",
-						ignoreCase: false,
-					},
-					&litMatcher{
-						pos:        position{line: 777, col: 66, offset: 27235},
-						val:        "~",
-						ignoreCase: false,
-					},
-				},
-			},
-		},
-		{
-			name: "SubscriptOrSuperscriptPrefix",
-			pos:  position{line: 779, col: 1, offset: 27240},
-			expr: &choiceExpr{
-				pos: position{line: 779, col: 33, offset: 27272},
-				alternatives: []interface{}{
-					&litMatcher{
-						pos:        position{line: 779, col: 33, offset: 27272},
-						val:        "^",
-						ignoreCase: false,
-					},
-					&actionExpr{
-						pos: position{line: 779, col: 39, offset: 27278},
-						run: (*parser).callonSubscriptOrSuperscriptPrefix3,
-						expr: &litMatcher{
-							pos:        position{line: 779, col: 39, offset: 27278},
-							val:        "~",
-							ignoreCase: false,
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "OneOrMoreBackslashes",
-			pos:  position{line: 783, col: 1, offset: 27411},
-			expr: &actionExpr{
-				pos: position{line: 783, col: 25, offset: 27435},
-				run: (*parser).callonOneOrMoreBackslashes1,
-				expr: &oneOrMoreExpr{
-					pos: position{line: 783, col: 25, offset: 27435},
-					expr: &litMatcher{
-						pos:        position{line: 783, col: 25, offset: 27435},
-						val:        "\\",
-						ignoreCase: false,
-					},
-				},
-			},
-		},
-		{
-			name: "TwoOrMoreBackslashes",
-			pos:  position{line: 787, col: 1, offset: 27476},
+			name: "InlineElementsWithoutSubtitution",
+			pos:  position{line: 826, col: 1, offset: 29324},
 			expr: &actionExpr{
-				pos: position{line: 787, col: 25, offset: 27500},
-				run: (*parser).callonTwoOrMoreBackslashes1,
+				pos: position{line: 826, col: 37, offset: 29360},
+				run: (*parser).callonInlineElementsWithoutSubtitution1,
 				expr: &seqExpr{
-					pos: position{line: 787, col: 25, offset: 27500},
+					pos: position{line: 826, col: 37, offset: 29360},
 					exprs: []interface{}{
-						&litMatcher{
-							pos:        position{line: 787, col: 25, offset: 27500},
-							val:        "\\\\",
-							ignoreCase: false,
-						},
-						&zeroOrMoreExpr{
-							pos: position{line: 787, col: 30, offset: 27505},
-							expr: &litMatcher{
-								pos:        position{line: 787, col: 30, offset: 27505},
-								val:        "\\",
-								ignoreCase: false,
+						&notExpr{
+							pos: position{line: 826, col: 37, offset: 29360},
+							expr: &ruleRefExpr{
+								pos:  position{line: 826, col: 38, offset: 29361},
+								name: "BlankLine",
 							},
 						},
-					},
-				},
-			},
-		},
-		{
-			name: "BoldText",
-			pos:  position{line: 795, col: 1, offset: 27602},
-			expr: &choiceExpr{
-				pos: position{line: 795, col: 13, offset: 27614},
-				alternatives: []interface{}{
-					&ruleRefExpr{
-						pos:  position{line: 795, col: 13, offset: 27614},
-						name: "DoubleQuoteBoldText",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 795, col: 35, offset: 27636},
-						name: "SingleQuoteBoldText",
-					},
-				},
-			},
-		},
-		{
-			name: "DoubleQuoteBoldText",
-			pos:  position{line: 797, col: 1, offset: 27657},
-			expr: &actionExpr{
-				pos: position{line: 797, col: 24, offset: 27680},
-				run: (*parser).callonDoubleQuoteBoldText1,
-				expr: &seqExpr{
-					pos: position{line: 797, col: 24, offset: 27680},
-					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 797, col: 24, offset: 27680},
-							expr: &litMatcher{
-								pos:        position{line: 797, col: 25, offset: 27681},
-								val:        "\\\\",
-								ignoreCase: false,
+							pos: position{line: 826, col: 48, offset: 29371},
+							expr: &ruleRefExpr{
+								pos:  position{line: 826, col: 49, offset: 29372},
+								name: "BlockDelimiter",
 							},
 						},
-						&litMatcher{
-							pos:        position{line: 797, col: 30, offset: 27686},
-							val:        "**",
-							ignoreCase: false,
-						},
 						&labeledExpr{
-							pos:   position{line: 797, col: 35, offset: 27691},
-							label: "content",
-							expr: &ruleRefExpr{
-								pos:  position{line: 797, col: 44, offset: 27700},
-								name: "DoubleQuoteBoldTextContent",
+							pos:   position{line: 826, col: 64, offset: 29387},
+							label: "elements",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 826, col: 73, offset: 29396},
+								expr: &ruleRefExpr{
+									pos:  position{line: 826, col: 74, offset: 29397},
+									name: "InlineElementWithoutSubtitution",
+								},
 							},
 						},
-						&litMatcher{
-							pos:        position{line: 797, col: 72, offset: 27728},
-							val:        "**",
-							ignoreCase: false,
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "DoubleQuoteBoldTextContent",
-			pos:  position{line: 801, col: 1, offset: 27853},
-			expr: &seqExpr{
-				pos: position{line: 801, col: 31, offset: 27883},
-				exprs: []interface{}{
-					&ruleRefExpr{
-						pos:  position{line: 801, col: 31, offset: 27883},
-						name: "DoubleQuoteBoldTextElement",
-					},
-					&zeroOrMoreExpr{
-						pos: position{line: 801, col: 58, offset: 27910},
-						expr: &actionExpr{
-							pos: position{line: 801, col: 59, offset: 27911},
-							run: (*parser).callonDoubleQuoteBoldTextContent4,
-							expr: &seqExpr{
-								pos: position{line: 801, col: 59, offset: 27911},
-								exprs: []interface{}{
-									&notExpr{
-										pos: position{line: 801, col: 59, offset: 27911},
-										expr: &litMatcher{
-											pos:        position{line: 801, col: 61, offset: 27913},
-											val:        "**",
-											ignoreCase: false,
-										},
-									},
-									&labeledExpr{
-										pos:   position{line: 801, col: 67, offset: 27919},
-										label: "element",
-										expr: &choiceExpr{
-											pos: position{line: 801, col: 76, offset: 27928},
-											alternatives: []interface{}{
-												&ruleRefExpr{
-													pos:  position{line: 801, col: 76, offset: 27928},
-													name: "WS",
-												},
-												&ruleRefExpr{
-													pos:  position{line: 801, col: 81, offset: 27933},
-													name: "DoubleQuoteBoldTextElement",
-												},
-											},
-										},
-									},
+						&labeledExpr{
+							pos:   position{line: 826, col: 108, offset: 29431},
+							label: "linebreak",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 826, col: 118, offset: 29441},
+								expr: &ruleRefExpr{
+									pos:  position{line: 826, col: 119, offset: 29442},
+									name: "LineBreak",
 								},
 							},
 						},
+						&ruleRefExpr{
+							pos:  position{line: 826, col: 131, offset: 29454},
+							name: "EOL",
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "DoubleQuoteBoldTextElement",
-			pos:  position{line: 805, col: 1, offset: 28025},
+			name: "InlineElementWithoutSubtitution",
+			pos:  position{line: 830, col: 1, offset: 29545},
 			expr: &actionExpr{
-				pos: position{line: 805, col: 31, offset: 28055},
-				run: (*parser).callonDoubleQuoteBoldTextElement1,
+				pos: position{line: 830, col: 36, offset: 29580},
+				run: (*parser).callonInlineElementWithoutSubtitution1,
 				expr: &seqExpr{
-					pos: position{line: 805, col: 31, offset: 28055},
+					pos: position{line: 830, col: 36, offset: 29580},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 805, col: 31, offset: 28055},
+							pos: position{line: 830, col: 36, offset: 29580},
 							expr: &ruleRefExpr{
-								pos:  position{line: 805, col: 32, offset: 28056},
-								name: "NEWLINE",
+								pos:  position{line: 830, col: 37, offset: 29581},
+								name: "EOL",
+							},
+						},
+						&notExpr{
+							pos: position{line: 830, col: 41, offset: 29585},
+							expr: &ruleRefExpr{
+								pos:  position{line: 830, col: 42, offset: 29586},
+								name: "LineBreak",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 805, col: 40, offset: 28064},
+							pos:   position{line: 831, col: 5, offset: 29601},
 							label: "element",
 							expr: &choiceExpr{
-								pos: position{line: 805, col: 49, offset: 28073},
+								pos: position{line: 831, col: 14, offset: 29610},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 805, col: 49, offset: 28073},
-										name: "SingleQuoteBoldText",
+										pos:  position{line: 831, col: 14, offset: 29610},
+										name: "SimpleWord",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 806, col: 11, offset: 28104},
-										name: "ItalicText",
+										pos:  position{line: 832, col: 11, offset: 29631},
+										name: "Spaces",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 807, col: 11, offset: 28126},
-										name: "MonospaceText",
+										pos:  position{line: 833, col: 11, offset: 29649},
+										name: "InlineImage",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 808, col: 11, offset: 28150},
-										name: "SubscriptText",
+										pos:  position{line: 834, col: 11, offset: 29672},
+										name: "Link",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 809, col: 11, offset: 28174},
-										name: "SuperscriptText",
+										pos:  position{line: 835, col: 11, offset: 29688},
+										name: "Passthrough",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 810, col: 11, offset: 28200},
-										name: "InlineImage",
+										pos:  position{line: 836, col: 11, offset: 29711},
+										name: "QuotedText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 811, col: 11, offset: 28223},
-										name: "Link",
+										pos:  position{line: 837, col: 11, offset: 29733},
+										name: "CrossReference",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 812, col: 11, offset: 28239},
-										name: "Passthrough",
+										pos:  position{line: 838, col: 11, offset: 29759},
+										name: "InlineElementID",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 813, col: 11, offset: 28262},
-										name: "NonDoubleQuoteBoldText",
+										pos:  position{line: 839, col: 11, offset: 29785},
+										name: "OtherWord",
 									},
 								},
 							},
@@ -5771,285 +6152,200 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "NonDoubleQuoteBoldText",
-			pos:  position{line: 817, col: 1, offset: 28418},
+			name: "InlineElementsWithSubstitutions",
+			pos:  position{line: 846, col: 1, offset: 30091},
 			expr: &actionExpr{
-				pos: position{line: 817, col: 27, offset: 28444},
-				run: (*parser).callonNonDoubleQuoteBoldText1,
+				pos: position{line: 846, col: 36, offset: 30126},
+				run: (*parser).callonInlineElementsWithSubstitutions1,
 				expr: &seqExpr{
-					pos: position{line: 817, col: 27, offset: 28444},
+					pos: position{line: 846, col: 36, offset: 30126},
 					exprs: []interface{}{
-						&anyMatcher{
-							line: 817, col: 28, offset: 28445,
-						},
-						&zeroOrMoreExpr{
-							pos: position{line: 817, col: 31, offset: 28448},
-							expr: &seqExpr{
-								pos: position{line: 817, col: 32, offset: 28449},
-								exprs: []interface{}{
-									&notExpr{
-										pos: position{line: 817, col: 32, offset: 28449},
-										expr: &litMatcher{
-											pos:        position{line: 817, col: 33, offset: 28450},
-											val:        "**",
-											ignoreCase: false,
-										},
-									},
-									&notExpr{
-										pos: position{line: 817, col: 38, offset: 28455},
-										expr: &ruleRefExpr{
-											pos:  position{line: 817, col: 39, offset: 28456},
-											name: "WS",
-										},
-									},
-									&notExpr{
-										pos: position{line: 817, col: 42, offset: 28459},
-										expr: &litMatcher{
-											pos:        position{line: 817, col: 43, offset: 28460},
-											val:        "^",
-											ignoreCase: false,
-										},
-									},
-									&notExpr{
-										pos: position{line: 817, col: 47, offset: 28464},
-										expr: &litMatcher{
-											pos:        position{line: 817, col: 48, offset: 28465},
-											val:        "~",
-											ignoreCase: false,
-										},
-									},
-									&notExpr{
-										pos: position{line: 817, col: 52, offset: 28469},
-										expr: &ruleRefExpr{
-											pos:  position{line: 817, col: 53, offset: 28470},
-											name: "NEWLINE",
-										},
-									},
-									&anyMatcher{
-										line: 817, col: 61, offset: 28478,
-									},
+						&labeledExpr{
+							pos:   position{line: 846, col: 36, offset: 30126},
+							label: "elements",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 846, col: 45, offset: 30135},
+								expr: &ruleRefExpr{
+									pos:  position{line: 846, col: 46, offset: 30136},
+									name: "InlineElementWithSubstitutions",
 								},
 							},
 						},
+						&ruleRefExpr{
+							pos:  position{line: 846, col: 79, offset: 30169},
+							name: "EOF",
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "SingleQuoteBoldText",
-			pos:  position{line: 821, col: 1, offset: 28538},
-			expr: &choiceExpr{
-				pos: position{line: 821, col: 24, offset: 28561},
-				alternatives: []interface{}{
-					&actionExpr{
-						pos: position{line: 821, col: 24, offset: 28561},
-						run: (*parser).callonSingleQuoteBoldText2,
-						expr: &seqExpr{
-							pos: position{line: 821, col: 24, offset: 28561},
-							exprs: []interface{}{
-								&notExpr{
-									pos: position{line: 821, col: 24, offset: 28561},
-									expr: &litMatcher{
-										pos:        position{line: 821, col: 25, offset: 28562},
-										val:        "\\",
-										ignoreCase: false,
-									},
-								},
-								&notExpr{
-									pos: position{line: 821, col: 29, offset: 28566},
-									expr: &litMatcher{
-										pos:        position{line: 821, col: 30, offset: 28567},
-										val:        "**",
-										ignoreCase: false,
-									},
-								},
-								&litMatcher{
-									pos:        position{line: 821, col: 35, offset: 28572},
-									val:        "*",
-									ignoreCase: false,
-								},
-								&labeledExpr{
-									pos:   position{line: 821, col: 39, offset: 28576},
-									label: "content",
-									expr: &ruleRefExpr{
-										pos:  position{line: 821, col: 48, offset: 28585},
-										name: "SingleQuoteBoldTextContent",
-									},
-								},
-								&litMatcher{
-									pos:        position{line: 821, col: 76, offset: 28613},
-									val:        "*",
-									ignoreCase: false,
-								},
-							},
-						},
-					},
-					&actionExpr{
-						pos: position{line: 823, col: 5, offset: 28793},
-						run: (*parser).callonSingleQuoteBoldText12,
-						expr: &seqExpr{
-							pos: position{line: 823, col: 5, offset: 28793},
-							exprs: []interface{}{
-								&notExpr{
-									pos: position{line: 823, col: 5, offset: 28793},
-									expr: &litMatcher{
-										pos:        position{line: 823, col: 6, offset: 28794},
-										val:        "\\\\",
-										ignoreCase: false,
-									},
-								},
-								&litMatcher{
-									pos:        position{line: 823, col: 11, offset: 28799},
-									val:        "**",
-									ignoreCase: false,
-								},
-								&labeledExpr{
-									pos:   position{line: 823, col: 16, offset: 28804},
-									label: "content",
-									expr: &ruleRefExpr{
-										pos:  position{line: 823, col: 25, offset: 28813},
-										name: "SingleQuoteBoldTextContent",
-									},
-								},
-								&litMatcher{
-									pos:        position{line: 823, col: 53, offset: 28841},
-									val:        "*",
-									ignoreCase: false,
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "SingleQuoteBoldTextContent",
-			pos:  position{line: 827, col: 1, offset: 29099},
-			expr: &seqExpr{
-				pos: position{line: 827, col: 31, offset: 29129},
-				exprs: []interface{}{
-					&notExpr{
-						pos: position{line: 827, col: 31, offset: 29129},
-						expr: &ruleRefExpr{
-							pos:  position{line: 827, col: 32, offset: 29130},
-							name: "WS",
-						},
-					},
-					&ruleRefExpr{
-						pos:  position{line: 827, col: 35, offset: 29133},
-						name: "SingleQuoteBoldTextElement",
-					},
-					&zeroOrMoreExpr{
-						pos: position{line: 827, col: 62, offset: 29160},
-						expr: &actionExpr{
-							pos: position{line: 827, col: 63, offset: 29161},
-							run: (*parser).callonSingleQuoteBoldTextContent6,
-							expr: &seqExpr{
-								pos: position{line: 827, col: 63, offset: 29161},
-								exprs: []interface{}{
-									&notExpr{
-										pos: position{line: 827, col: 63, offset: 29161},
-										expr: &seqExpr{
-											pos: position{line: 827, col: 65, offset: 29163},
-											exprs: []interface{}{
-												&litMatcher{
-													pos:        position{line: 827, col: 65, offset: 29163},
-													val:        "*",
-													ignoreCase: false,
-												},
-												&notExpr{
-													pos: position{line: 827, col: 69, offset: 29167},
-													expr: &ruleRefExpr{
-														pos:  position{line: 827, col: 70, offset: 29168},
-														name: "Alphanum",
-													},
-												},
-											},
-										},
-									},
-									&labeledExpr{
-										pos:   position{line: 827, col: 80, offset: 29178},
-										label: "spaces",
-										expr: &zeroOrMoreExpr{
-											pos: position{line: 827, col: 88, offset: 29186},
-											expr: &ruleRefExpr{
-												pos:  position{line: 827, col: 88, offset: 29186},
-												name: "WS",
-											},
-										},
-									},
-									&labeledExpr{
-										pos:   position{line: 827, col: 93, offset: 29191},
-										label: "element",
-										expr: &ruleRefExpr{
-											pos:  position{line: 827, col: 102, offset: 29200},
-											name: "SingleQuoteBoldTextElement",
-										},
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "SingleQuoteBoldTextElement",
-			pos:  position{line: 831, col: 1, offset: 29291},
+			name: "InlineElementWithSubstitutions",
+			pos:  position{line: 850, col: 1, offset: 30239},
 			expr: &actionExpr{
-				pos: position{line: 831, col: 31, offset: 29321},
-				run: (*parser).callonSingleQuoteBoldTextElement1,
+				pos: position{line: 850, col: 35, offset: 30273},
+				run: (*parser).callonInlineElementWithSubstitutions1,
 				expr: &seqExpr{
-					pos: position{line: 831, col: 31, offset: 29321},
+					pos: position{line: 850, col: 35, offset: 30273},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 831, col: 31, offset: 29321},
+							pos: position{line: 850, col: 35, offset: 30273},
 							expr: &ruleRefExpr{
-								pos:  position{line: 831, col: 32, offset: 29322},
-								name: "NEWLINE",
+								pos:  position{line: 850, col: 36, offset: 30274},
+								name: "EOF",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 831, col: 40, offset: 29330},
+							pos:   position{line: 851, col: 5, offset: 30282},
 							label: "element",
 							expr: &choiceExpr{
-								pos: position{line: 831, col: 49, offset: 29339},
+								pos: position{line: 851, col: 14, offset: 30291},
 								alternatives: []interface{}{
-									&ruleRefExpr{
-										pos:  position{line: 831, col: 49, offset: 29339},
-										name: "DoubleQuoteBoldText",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 832, col: 11, offset: 29369},
-										name: "ItalicText",
+									&actionExpr{
+										pos: position{line: 851, col: 14, offset: 30291},
+										run: (*parser).callonInlineElementWithSubstitutions7,
+										expr: &seqExpr{
+											pos: position{line: 851, col: 14, offset: 30291},
+											exprs: []interface{}{
+												&andCodeExpr{
+													pos: position{line: 851, col: 14, offset: 30291},
+													run: (*parser).callonInlineElementWithSubstitutions9,
+												},
+												&labeledExpr{
+													pos:   position{line: 853, col: 11, offset: 30389},
+													label: "linebreak",
+													expr: &ruleRefExpr{
+														pos:  position{line: 853, col: 22, offset: 30400},
+														name: "LineBreak",
+													},
+												},
+											},
+										},
 									},
 									&ruleRefExpr{
-										pos:  position{line: 833, col: 11, offset: 29391},
-										name: "MonospaceText",
+										pos:  position{line: 856, col: 11, offset: 30467},
+										name: "SimpleWord",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 834, col: 11, offset: 29415},
-										name: "SubscriptText",
+										pos:  position{line: 857, col: 11, offset: 30488},
+										name: "Spaces",
 									},
-									&ruleRefExpr{
-										pos:  position{line: 835, col: 11, offset: 29439},
-										name: "SuperscriptText",
+									&actionExpr{
+										pos: position{line: 858, col: 11, offset: 30506},
+										run: (*parser).callonInlineElementWithSubstitutions14,
+										expr: &seqExpr{
+											pos: position{line: 858, col: 11, offset: 30506},
+											exprs: []interface{}{
+												&andCodeExpr{
+													pos: position{line: 858, col: 11, offset: 30506},
+													run: (*parser).callonInlineElementWithSubstitutions16,
+												},
+												&labeledExpr{
+													pos:   position{line: 860, col: 11, offset: 30566},
+													label: "term",
+													expr: &ruleRefExpr{
+														pos:  position{line: 860, col: 17, offset: 30572},
+														name: "IndexTerm",
+													},
+												},
+											},
+										},
 									},
-									&ruleRefExpr{
-										pos:  position{line: 836, col: 11, offset: 29465},
-										name: "InlineImage",
+									&actionExpr{
+										pos: position{line: 863, col: 11, offset: 30634},
+										run: (*parser).callonInlineElementWithSubstitutions19,
+										expr: &seqExpr{
+											pos: position{line: 863, col: 11, offset: 30634},
+											exprs: []interface{}{
+												&andCodeExpr{
+													pos: position{line: 863, col: 11, offset: 30634},
+													run: (*parser).callonInlineElementWithSubstitutions21,
+												},
+												&labeledExpr{
+													pos:   position{line: 865, col: 11, offset: 30722},
+													label: "macro",
+													expr: &choiceExpr{
+														pos: position{line: 865, col: 18, offset: 30729},
+														alternatives: []interface{}{
+															&ruleRefExpr{
+																pos:  position{line: 865, col: 18, offset: 30729},
+																name: "InlineImage",
+															},
+															&ruleRefExpr{
+																pos:  position{line: 865, col: 32, offset: 30743},
+																name: "Link",
+															},
+															&ruleRefExpr{
+																pos:  position{line: 865, col: 39, offset: 30750},
+																name: "Passthrough",
+															},
+															&ruleRefExpr{
+																pos:  position{line: 865, col: 53, offset: 30764},
+																name: "InlineFootnote",
+															},
+															&ruleRefExpr{
+																pos:  position{line: 865, col: 70, offset: 30781},
+																name: "IndexTerm",
+															},
+															&ruleRefExpr{
+																pos:  position{line: 865, col: 82, offset: 30793},
+																name: "InlineUserMacro",
+															},
+															&ruleRefExpr{
+																pos:  position{line: 865, col: 100, offset: 30811},
+																name: "CrossReference",
+															},
+														},
+													},
+												},
+											},
+										},
 									},
-									&ruleRefExpr{
-										pos:  position{line: 837, col: 11, offset: 29488},
-										name: "Link",
+									&actionExpr{
+										pos: position{line: 868, col: 11, offset: 30879},
+										run: (*parser).callonInlineElementWithSubstitutions31,
+										expr: &seqExpr{
+											pos: position{line: 868, col: 11, offset: 30879},
+											exprs: []interface{}{
+												&andCodeExpr{
+													pos: position{line: 868, col: 11, offset: 30879},
+													run: (*parser).callonInlineElementWithSubstitutions33,
+												},
+												&labeledExpr{
+													pos:   position{line: 870, col: 11, offset: 30967},
+													label: "quotedText",
+													expr: &ruleRefExpr{
+														pos:  position{line: 870, col: 23, offset: 30979},
+														name: "QuotedText",
+													},
+												},
+											},
+										},
 									},
-									&ruleRefExpr{
-										pos:  position{line: 838, col: 11, offset: 29504},
-										name: "Passthrough",
+									&actionExpr{
+										pos: position{line: 873, col: 11, offset: 31048},
+										run: (*parser).callonInlineElementWithSubstitutions36,
+										expr: &seqExpr{
+											pos: position{line: 873, col: 11, offset: 31048},
+											exprs: []interface{}{
+												&andCodeExpr{
+													pos: position{line: 873, col: 11, offset: 31048},
+													run: (*parser).callonInlineElementWithSubstitutions38,
+												},
+												&labeledExpr{
+													pos:   position{line: 875, col: 11, offset: 31140},
+													label: "substitution",
+													expr: &ruleRefExpr{
+														pos:  position{line: 875, col: 25, offset: 31154},
+														name: "DocumentAttributeSubstitution",
+													},
+												},
+											},
+										},
 									},
 									&ruleRefExpr{
-										pos:  position{line: 839, col: 11, offset: 29527},
-										name: "NonSingleQuoteBoldText",
+										pos:  position{line: 878, col: 11, offset: 31244},
+										name: "OtherWord",
 									},
 								},
 							},
@@ -6059,284 +6355,217 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "NonSingleQuoteBoldText",
-			pos:  position{line: 843, col: 1, offset: 29683},
+			name: "VerbatimBlock",
+			pos:  position{line: 883, col: 1, offset: 31378},
 			expr: &actionExpr{
-				pos: position{line: 843, col: 27, offset: 29709},
-				run: (*parser).callonNonSingleQuoteBoldText1,
+				pos: position{line: 883, col: 18, offset: 31395},
+				run: (*parser).callonVerbatimBlock1,
 				expr: &seqExpr{
-					pos: position{line: 843, col: 27, offset: 29709},
+					pos: position{line: 883, col: 18, offset: 31395},
 					exprs: []interface{}{
-						&anyMatcher{
-							line: 843, col: 28, offset: 29710,
-						},
-						&zeroOrMoreExpr{
-							pos: position{line: 843, col: 31, offset: 29713},
-							expr: &seqExpr{
-								pos: position{line: 843, col: 32, offset: 29714},
-								exprs: []interface{}{
-									&notExpr{
-										pos: position{line: 843, col: 32, offset: 29714},
-										expr: &litMatcher{
-											pos:        position{line: 843, col: 33, offset: 29715},
-											val:        "*",
-											ignoreCase: false,
-										},
-									},
-									&notExpr{
-										pos: position{line: 843, col: 37, offset: 29719},
-										expr: &ruleRefExpr{
-											pos:  position{line: 843, col: 38, offset: 29720},
-											name: "WS",
-										},
-									},
-									&notExpr{
-										pos: position{line: 843, col: 41, offset: 29723},
-										expr: &litMatcher{
-											pos:        position{line: 843, col: 42, offset: 29724},
-											val:        "^",
-											ignoreCase: false,
+						&labeledExpr{
+							pos:   position{line: 883, col: 18, offset: 31395},
+							label: "elements",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 883, col: 27, offset: 31404},
+								expr: &choiceExpr{
+									pos: position{line: 883, col: 28, offset: 31405},
+									alternatives: []interface{}{
+										&ruleRefExpr{
+											pos:  position{line: 883, col: 28, offset: 31405},
+											name: "BlankLine",
 										},
-									},
-									&notExpr{
-										pos: position{line: 843, col: 46, offset: 29728},
-										expr: &litMatcher{
-											pos:        position{line: 843, col: 47, offset: 29729},
-											val:        "~",
-											ignoreCase: false,
+										&ruleRefExpr{
+											pos:  position{line: 883, col: 40, offset: 31417},
+											name: "FileInclusion",
 										},
-									},
-									&notExpr{
-										pos: position{line: 843, col: 51, offset: 29733},
-										expr: &ruleRefExpr{
-											pos:  position{line: 843, col: 52, offset: 29734},
-											name: "NEWLINE",
+										&ruleRefExpr{
+											pos:  position{line: 883, col: 56, offset: 31433},
+											name: "VerbatimParagraph",
 										},
 									},
-									&anyMatcher{
-										line: 843, col: 60, offset: 29742,
-									},
 								},
 							},
 						},
+						&ruleRefExpr{
+							pos:  position{line: 883, col: 76, offset: 31453},
+							name: "EOF",
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "EscapedBoldText",
-			pos:  position{line: 847, col: 1, offset: 29802},
-			expr: &choiceExpr{
-				pos: position{line: 848, col: 5, offset: 29826},
-				alternatives: []interface{}{
-					&actionExpr{
-						pos: position{line: 848, col: 5, offset: 29826},
-						run: (*parser).callonEscapedBoldText2,
-						expr: &seqExpr{
-							pos: position{line: 848, col: 5, offset: 29826},
-							exprs: []interface{}{
-								&labeledExpr{
-									pos:   position{line: 848, col: 5, offset: 29826},
-									label: "backslashes",
-									expr: &ruleRefExpr{
-										pos:  position{line: 848, col: 18, offset: 29839},
-										name: "TwoOrMoreBackslashes",
-									},
-								},
-								&litMatcher{
-									pos:        position{line: 848, col: 40, offset: 29861},
-									val:        "**",
-									ignoreCase: false,
-								},
-								&labeledExpr{
-									pos:   position{line: 848, col: 45, offset: 29866},
-									label: "content",
-									expr: &ruleRefExpr{
-										pos:  position{line: 848, col: 54, offset: 29875},
-										name: "DoubleQuoteBoldTextContent",
-									},
-								},
-								&litMatcher{
-									pos:        position{line: 848, col: 82, offset: 29903},
-									val:        "**",
-									ignoreCase: false,
+			name: "VerbatimParagraph",
+			pos:  position{line: 887, col: 1, offset: 31487},
+			expr: &actionExpr{
+				pos: position{line: 887, col: 22, offset: 31508},
+				run: (*parser).callonVerbatimParagraph1,
+				expr: &seqExpr{
+					pos: position{line: 887, col: 22, offset: 31508},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 887, col: 22, offset: 31508},
+							label: "attributes",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 887, col: 33, offset: 31519},
+								expr: &ruleRefExpr{
+									pos:  position{line: 887, col: 34, offset: 31520},
+									name: "ElementAttributes",
 								},
 							},
 						},
-					},
-					&actionExpr{
-						pos: position{line: 850, col: 9, offset: 30059},
-						run: (*parser).callonEscapedBoldText10,
-						expr: &seqExpr{
-							pos: position{line: 850, col: 9, offset: 30059},
-							exprs: []interface{}{
-								&labeledExpr{
-									pos:   position{line: 850, col: 9, offset: 30059},
-									label: "backslashes",
-									expr: &ruleRefExpr{
-										pos:  position{line: 850, col: 22, offset: 30072},
-										name: "OneOrMoreBackslashes",
-									},
-								},
-								&litMatcher{
-									pos:        position{line: 850, col: 44, offset: 30094},
-									val:        "**",
-									ignoreCase: false,
-								},
-								&labeledExpr{
-									pos:   position{line: 850, col: 49, offset: 30099},
-									label: "content",
-									expr: &ruleRefExpr{
-										pos:  position{line: 850, col: 58, offset: 30108},
-										name: "SingleQuoteBoldTextContent",
+						&labeledExpr{
+							pos:   position{line: 887, col: 54, offset: 31540},
+							label: "lines",
+							expr: &oneOrMoreExpr{
+								pos: position{line: 887, col: 60, offset: 31546},
+								expr: &actionExpr{
+									pos: position{line: 887, col: 61, offset: 31547},
+									run: (*parser).callonVerbatimParagraph8,
+									expr: &seqExpr{
+										pos: position{line: 887, col: 61, offset: 31547},
+										exprs: []interface{}{
+											&notExpr{
+												pos: position{line: 887, col: 61, offset: 31547},
+												expr: &ruleRefExpr{
+													pos:  position{line: 887, col: 62, offset: 31548},
+													name: "EOF",
+												},
+											},
+											&labeledExpr{
+												pos:   position{line: 887, col: 66, offset: 31552},
+												label: "line",
+												expr: &ruleRefExpr{
+													pos:  position{line: 887, col: 72, offset: 31558},
+													name: "VerbatimParagraphLine",
+												},
+											},
+										},
 									},
 								},
-								&litMatcher{
-									pos:        position{line: 850, col: 86, offset: 30136},
-									val:        "*",
-									ignoreCase: false,
-								},
 							},
 						},
 					},
-					&actionExpr{
-						pos: position{line: 853, col: 9, offset: 30335},
-						run: (*parser).callonEscapedBoldText18,
-						expr: &seqExpr{
-							pos: position{line: 853, col: 9, offset: 30335},
-							exprs: []interface{}{
-								&labeledExpr{
-									pos:   position{line: 853, col: 9, offset: 30335},
-									label: "backslashes",
-									expr: &ruleRefExpr{
-										pos:  position{line: 853, col: 22, offset: 30348},
-										name: "OneOrMoreBackslashes",
-									},
-								},
-								&litMatcher{
-									pos:        position{line: 853, col: 44, offset: 30370},
-									val:        "*",
-									ignoreCase: false,
-								},
-								&labeledExpr{
-									pos:   position{line: 853, col: 48, offset: 30374},
-									label: "content",
-									expr: &ruleRefExpr{
-										pos:  position{line: 853, col: 57, offset: 30383},
-										name: "SingleQuoteBoldTextContent",
-									},
+				},
+			},
+		},
+		{
+			name: "VerbatimParagraphLine",
+			pos:  position{line: 893, col: 1, offset: 31678},
+			expr: &actionExpr{
+				pos: position{line: 893, col: 26, offset: 31703},
+				run: (*parser).callonVerbatimParagraphLine1,
+				expr: &seqExpr{
+					pos: position{line: 893, col: 26, offset: 31703},
+					exprs: []interface{}{
+						&notExpr{
+							pos: position{line: 893, col: 26, offset: 31703},
+							expr: &ruleRefExpr{
+								pos:  position{line: 893, col: 27, offset: 31704},
+								name: "BlockDelimiter",
+							},
+						},
+						&notExpr{
+							pos: position{line: 893, col: 42, offset: 31719},
+							expr: &ruleRefExpr{
+								pos:  position{line: 893, col: 43, offset: 31720},
+								name: "BlankLine",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 893, col: 53, offset: 31730},
+							label: "elements",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 893, col: 62, offset: 31739},
+								expr: &ruleRefExpr{
+									pos:  position{line: 893, col: 63, offset: 31740},
+									name: "VerbatimParagraphLineElement",
 								},
-								&litMatcher{
-									pos:        position{line: 853, col: 85, offset: 30411},
-									val:        "*",
-									ignoreCase: false,
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 893, col: 94, offset: 31771},
+							label: "linebreak",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 893, col: 104, offset: 31781},
+								expr: &ruleRefExpr{
+									pos:  position{line: 893, col: 105, offset: 31782},
+									name: "LineBreak",
 								},
 							},
 						},
+						&ruleRefExpr{
+							pos:  position{line: 893, col: 117, offset: 31794},
+							name: "EOL",
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "ItalicText",
-			pos:  position{line: 861, col: 1, offset: 30618},
-			expr: &choiceExpr{
-				pos: position{line: 861, col: 15, offset: 30632},
-				alternatives: []interface{}{
-					&ruleRefExpr{
-						pos:  position{line: 861, col: 15, offset: 30632},
-						name: "DoubleQuoteItalicText",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 861, col: 39, offset: 30656},
-						name: "SingleQuoteItalicText",
+			name: "VerbatimParagraphLineElement",
+			pos:  position{line: 897, col: 1, offset: 31885},
+			expr: &actionExpr{
+				pos: position{line: 897, col: 33, offset: 31917},
+				run: (*parser).callonVerbatimParagraphLineElement1,
+				expr: &oneOrMoreExpr{
+					pos: position{line: 897, col: 33, offset: 31917},
+					expr: &seqExpr{
+						pos: position{line: 897, col: 34, offset: 31918},
+						exprs: []interface{}{
+							&notExpr{
+								pos: position{line: 897, col: 34, offset: 31918},
+								expr: &ruleRefExpr{
+									pos:  position{line: 897, col: 35, offset: 31919},
+									name: "EOL",
+								},
+							},
+							&notExpr{
+								pos: position{line: 897, col: 39, offset: 31923},
+								expr: &ruleRefExpr{
+									pos:  position{line: 897, col: 40, offset: 31924},
+									name: "LineBreak",
+								},
+							},
+							&anyMatcher{
+								line: 897, col: 50, offset: 31934,
+							},
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "DoubleQuoteItalicText",
-			pos:  position{line: 863, col: 1, offset: 30679},
+			name: "LineBreak",
+			pos:  position{line: 904, col: 1, offset: 32158},
 			expr: &actionExpr{
-				pos: position{line: 863, col: 26, offset: 30704},
-				run: (*parser).callonDoubleQuoteItalicText1,
+				pos: position{line: 904, col: 14, offset: 32171},
+				run: (*parser).callonLineBreak1,
 				expr: &seqExpr{
-					pos: position{line: 863, col: 26, offset: 30704},
+					pos: position{line: 904, col: 14, offset: 32171},
 					exprs: []interface{}{
-						&notExpr{
-							pos: position{line: 863, col: 26, offset: 30704},
-							expr: &litMatcher{
-								pos:        position{line: 863, col: 27, offset: 30705},
-								val:        "\\\\",
-								ignoreCase: false,
-							},
+						&ruleRefExpr{
+							pos:  position{line: 904, col: 14, offset: 32171},
+							name: "WS",
 						},
 						&litMatcher{
-							pos:        position{line: 863, col: 32, offset: 30710},
-							val:        "__",
+							pos:        position{line: 904, col: 17, offset: 32174},
+							val:        "+",
 							ignoreCase: false,
 						},
-						&labeledExpr{
-							pos:   position{line: 863, col: 37, offset: 30715},
-							label: "content",
+						&zeroOrMoreExpr{
+							pos: position{line: 904, col: 21, offset: 32178},
 							expr: &ruleRefExpr{
-								pos:  position{line: 863, col: 46, offset: 30724},
-								name: "DoubleQuoteItalicTextContent",
+								pos:  position{line: 904, col: 21, offset: 32178},
+								name: "WS",
 							},
 						},
-						&litMatcher{
-							pos:        position{line: 863, col: 76, offset: 30754},
-							val:        "__",
-							ignoreCase: false,
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "DoubleQuoteItalicTextContent",
-			pos:  position{line: 867, col: 1, offset: 30880},
-			expr: &seqExpr{
-				pos: position{line: 867, col: 33, offset: 30912},
-				exprs: []interface{}{
-					&ruleRefExpr{
-						pos:  position{line: 867, col: 33, offset: 30912},
-						name: "DoubleQuoteItalicTextElement",
-					},
-					&zeroOrMoreExpr{
-						pos: position{line: 867, col: 62, offset: 30941},
-						expr: &actionExpr{
-							pos: position{line: 867, col: 63, offset: 30942},
-							run: (*parser).callonDoubleQuoteItalicTextContent4,
-							expr: &seqExpr{
-								pos: position{line: 867, col: 63, offset: 30942},
-								exprs: []interface{}{
-									&notExpr{
-										pos: position{line: 867, col: 63, offset: 30942},
-										expr: &litMatcher{
-											pos:        position{line: 867, col: 65, offset: 30944},
-											val:        "__",
-											ignoreCase: false,
-										},
-									},
-									&labeledExpr{
-										pos:   position{line: 867, col: 71, offset: 30950},
-										label: "element",
-										expr: &choiceExpr{
-											pos: position{line: 867, col: 80, offset: 30959},
-											alternatives: []interface{}{
-												&ruleRefExpr{
-													pos:  position{line: 867, col: 80, offset: 30959},
-													name: "WS",
-												},
-												&ruleRefExpr{
-													pos:  position{line: 867, col: 85, offset: 30964},
-													name: "DoubleQuoteItalicTextElement",
-												},
-											},
-										},
-									},
-								},
+						&andExpr{
+							pos: position{line: 904, col: 25, offset: 32182},
+							expr: &ruleRefExpr{
+								pos:  position{line: 904, col: 26, offset: 32183},
+								name: "EOL",
 							},
 						},
 					},
@@ -6344,62 +6573,58 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "DoubleQuoteItalicTextElement",
-			pos:  position{line: 871, col: 1, offset: 31058},
+			name: "QuotedText",
+			pos:  position{line: 911, col: 1, offset: 32467},
 			expr: &actionExpr{
-				pos: position{line: 871, col: 33, offset: 31090},
-				run: (*parser).callonDoubleQuoteItalicTextElement1,
+				pos: position{line: 911, col: 15, offset: 32481},
+				run: (*parser).callonQuotedText1,
 				expr: &seqExpr{
-					pos: position{line: 871, col: 33, offset: 31090},
+					pos: position{line: 911, col: 15, offset: 32481},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 871, col: 33, offset: 31090},
+							pos: position{line: 911, col: 15, offset: 32481},
 							expr: &ruleRefExpr{
-								pos:  position{line: 871, col: 34, offset: 31091},
-								name: "NEWLINE",
+								pos:  position{line: 911, col: 16, offset: 32482},
+								name: "WS",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 871, col: 42, offset: 31099},
-							label: "element",
+							pos:   position{line: 911, col: 19, offset: 32485},
+							label: "text",
 							expr: &choiceExpr{
-								pos: position{line: 871, col: 51, offset: 31108},
+								pos: position{line: 911, col: 25, offset: 32491},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 871, col: 51, offset: 31108},
-										name: "SingleQuoteItalicText",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 872, col: 11, offset: 31141},
-										name: "BoldText",
+										pos:  position{line: 911, col: 25, offset: 32491},
+										name: "RoleQuotedText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 873, col: 11, offset: 31161},
-										name: "MonospaceText",
+										pos:  position{line: 912, col: 15, offset: 32520},
+										name: "EscapedBoldText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 874, col: 11, offset: 31185},
-										name: "SubscriptText",
+										pos:  position{line: 913, col: 15, offset: 32551},
+										name: "EscapedItalicText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 875, col: 11, offset: 31209},
-										name: "SuperscriptText",
+										pos:  position{line: 914, col: 15, offset: 32584},
+										name: "EscapedMonospaceText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 876, col: 11, offset: 31235},
-										name: "InlineImage",
+										pos:  position{line: 915, col: 15, offset: 32620},
+										name: "EscapedMarkedText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 877, col: 11, offset: 31258},
-										name: "Link",
+										pos:  position{line: 916, col: 15, offset: 32653},
+										name: "EscapedSubscriptText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 878, col: 11, offset: 31274},
-										name: "Passthrough",
+										pos:  position{line: 917, col: 15, offset: 32689},
+										name: "EscapedSuperscriptText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 879, col: 11, offset: 31297},
-										name: "NonDoubleQuoteItalicText",
+										pos:  position{line: 918, col: 15, offset: 32726},
+										name: "SubscriptOrSuperscriptPrefix",
 									},
 								},
 							},
@@ -6409,278 +6634,58 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "NonDoubleQuoteItalicText",
-			pos:  position{line: 883, col: 1, offset: 31455},
+			name: "RoleQuotedText",
+			pos:  position{line: 923, col: 1, offset: 32947},
 			expr: &actionExpr{
-				pos: position{line: 883, col: 29, offset: 31483},
-				run: (*parser).callonNonDoubleQuoteItalicText1,
+				pos: position{line: 923, col: 19, offset: 32965},
+				run: (*parser).callonRoleQuotedText1,
 				expr: &seqExpr{
-					pos: position{line: 883, col: 29, offset: 31483},
+					pos: position{line: 923, col: 19, offset: 32965},
 					exprs: []interface{}{
-						&anyMatcher{
-							line: 883, col: 30, offset: 31484,
+						&labeledExpr{
+							pos:   position{line: 923, col: 19, offset: 32965},
+							label: "role",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 923, col: 24, offset: 32970},
+								expr: &ruleRefExpr{
+									pos:  position{line: 923, col: 25, offset: 32971},
+									name: "QuotedTextRole",
+								},
+							},
 						},
-						&zeroOrMoreExpr{
-							pos: position{line: 883, col: 33, offset: 31487},
-							expr: &seqExpr{
-								pos: position{line: 883, col: 34, offset: 31488},
-								exprs: []interface{}{
-									&notExpr{
-										pos: position{line: 883, col: 34, offset: 31488},
-										expr: &litMatcher{
-											pos:        position{line: 883, col: 35, offset: 31489},
-											val:        "__",
-											ignoreCase: false,
-										},
-									},
-									&notExpr{
-										pos: position{line: 883, col: 40, offset: 31494},
-										expr: &litMatcher{
-											pos:        position{line: 883, col: 41, offset: 31495},
-											val:        "^",
-											ignoreCase: false,
-										},
-									},
-									&notExpr{
-										pos: position{line: 883, col: 45, offset: 31499},
-										expr: &litMatcher{
-											pos:        position{line: 883, col: 46, offset: 31500},
-											val:        "~",
-											ignoreCase: false,
-										},
-									},
-									&notExpr{
-										pos: position{line: 883, col: 50, offset: 31504},
-										expr: &ruleRefExpr{
-											pos:  position{line: 883, col: 51, offset: 31505},
-											name: "NEWLINE",
-										},
-									},
-									&anyMatcher{
-										line: 883, col: 59, offset: 31513,
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "SingleQuoteItalicText",
-			pos:  position{line: 887, col: 1, offset: 31573},
-			expr: &choiceExpr{
-				pos: position{line: 887, col: 26, offset: 31598},
-				alternatives: []interface{}{
-					&actionExpr{
-						pos: position{line: 887, col: 26, offset: 31598},
-						run: (*parser).callonSingleQuoteItalicText2,
-						expr: &seqExpr{
-							pos: position{line: 887, col: 26, offset: 31598},
-							exprs: []interface{}{
-								&notExpr{
-									pos: position{line: 887, col: 26, offset: 31598},
-									expr: &litMatcher{
-										pos:        position{line: 887, col: 27, offset: 31599},
-										val:        "\\",
-										ignoreCase: false,
-									},
-								},
-								&notExpr{
-									pos: position{line: 887, col: 31, offset: 31603},
-									expr: &litMatcher{
-										pos:        position{line: 887, col: 32, offset: 31604},
-										val:        "__",
-										ignoreCase: false,
-									},
-								},
-								&litMatcher{
-									pos:        position{line: 887, col: 37, offset: 31609},
-									val:        "_",
-									ignoreCase: false,
-								},
-								&labeledExpr{
-									pos:   position{line: 887, col: 41, offset: 31613},
-									label: "content",
-									expr: &ruleRefExpr{
-										pos:  position{line: 887, col: 50, offset: 31622},
-										name: "SingleQuoteItalicTextContent",
-									},
-								},
-								&litMatcher{
-									pos:        position{line: 887, col: 80, offset: 31652},
-									val:        "_",
-									ignoreCase: false,
-								},
-							},
-						},
-					},
-					&actionExpr{
-						pos: position{line: 889, col: 5, offset: 31834},
-						run: (*parser).callonSingleQuoteItalicText12,
-						expr: &seqExpr{
-							pos: position{line: 889, col: 5, offset: 31834},
-							exprs: []interface{}{
-								&notExpr{
-									pos: position{line: 889, col: 5, offset: 31834},
-									expr: &litMatcher{
-										pos:        position{line: 889, col: 6, offset: 31835},
-										val:        "\\\\",
-										ignoreCase: false,
-									},
-								},
-								&litMatcher{
-									pos:        position{line: 889, col: 11, offset: 31840},
-									val:        "__",
-									ignoreCase: false,
-								},
-								&labeledExpr{
-									pos:   position{line: 889, col: 16, offset: 31845},
-									label: "content",
-									expr: &ruleRefExpr{
-										pos:  position{line: 889, col: 25, offset: 31854},
-										name: "SingleQuoteItalicTextContent",
-									},
-								},
-								&litMatcher{
-									pos:        position{line: 889, col: 55, offset: 31884},
-									val:        "_",
-									ignoreCase: false,
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "SingleQuoteItalicTextContent",
-			pos:  position{line: 893, col: 1, offset: 32146},
-			expr: &seqExpr{
-				pos: position{line: 893, col: 33, offset: 32178},
-				exprs: []interface{}{
-					&notExpr{
-						pos: position{line: 893, col: 33, offset: 32178},
-						expr: &ruleRefExpr{
-							pos:  position{line: 893, col: 34, offset: 32179},
-							name: "WS",
-						},
-					},
-					&ruleRefExpr{
-						pos:  position{line: 893, col: 37, offset: 32182},
-						name: "SingleQuoteItalicTextElement",
-					},
-					&zeroOrMoreExpr{
-						pos: position{line: 893, col: 66, offset: 32211},
-						expr: &actionExpr{
-							pos: position{line: 893, col: 67, offset: 32212},
-							run: (*parser).callonSingleQuoteItalicTextContent6,
-							expr: &seqExpr{
-								pos: position{line: 893, col: 67, offset: 32212},
-								exprs: []interface{}{
-									&notExpr{
-										pos: position{line: 893, col: 67, offset: 32212},
-										expr: &seqExpr{
-											pos: position{line: 893, col: 69, offset: 32214},
-											exprs: []interface{}{
-												&litMatcher{
-													pos:        position{line: 893, col: 69, offset: 32214},
-													val:        "_",
-													ignoreCase: false,
-												},
-												&notExpr{
-													pos: position{line: 893, col: 73, offset: 32218},
-													expr: &ruleRefExpr{
-														pos:  position{line: 893, col: 74, offset: 32219},
-														name: "Alphanum",
-													},
-												},
-											},
-										},
-									},
-									&labeledExpr{
-										pos:   position{line: 893, col: 84, offset: 32229},
-										label: "spaces",
-										expr: &zeroOrMoreExpr{
-											pos: position{line: 893, col: 92, offset: 32237},
-											expr: &ruleRefExpr{
-												pos:  position{line: 893, col: 92, offset: 32237},
-												name: "WS",
-											},
-										},
-									},
-									&labeledExpr{
-										pos:   position{line: 893, col: 97, offset: 32242},
-										label: "element",
-										expr: &ruleRefExpr{
-											pos:  position{line: 893, col: 106, offset: 32251},
-											name: "SingleQuoteItalicTextElement",
-										},
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "SingleQuoteItalicTextElement",
-			pos:  position{line: 897, col: 1, offset: 32344},
-			expr: &actionExpr{
-				pos: position{line: 897, col: 33, offset: 32376},
-				run: (*parser).callonSingleQuoteItalicTextElement1,
-				expr: &seqExpr{
-					pos: position{line: 897, col: 33, offset: 32376},
-					exprs: []interface{}{
-						&notExpr{
-							pos: position{line: 897, col: 33, offset: 32376},
-							expr: &ruleRefExpr{
-								pos:  position{line: 897, col: 34, offset: 32377},
-								name: "NEWLINE",
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 897, col: 42, offset: 32385},
-							label: "element",
-							expr: &choiceExpr{
-								pos: position{line: 897, col: 51, offset: 32394},
-								alternatives: []interface{}{
-									&ruleRefExpr{
-										pos:  position{line: 897, col: 51, offset: 32394},
-										name: "DoubleQuoteItalicText",
+						&labeledExpr{
+							pos:   position{line: 923, col: 42, offset: 32988},
+							label: "text",
+							expr: &choiceExpr{
+								pos: position{line: 923, col: 48, offset: 32994},
+								alternatives: []interface{}{
+									&ruleRefExpr{
+										pos:  position{line: 923, col: 48, offset: 32994},
+										name: "CurvedQuotedText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 898, col: 11, offset: 32426},
+										pos:  position{line: 924, col: 15, offset: 33026},
 										name: "BoldText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 899, col: 11, offset: 32446},
-										name: "MonospaceText",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 900, col: 11, offset: 32470},
-										name: "SubscriptText",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 901, col: 11, offset: 32494},
-										name: "SuperscriptText",
+										pos:  position{line: 925, col: 15, offset: 33050},
+										name: "ItalicText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 902, col: 11, offset: 32520},
-										name: "InlineImage",
+										pos:  position{line: 926, col: 15, offset: 33076},
+										name: "MonospaceText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 903, col: 11, offset: 32543},
-										name: "Link",
+										pos:  position{line: 927, col: 15, offset: 33105},
+										name: "MarkedText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 904, col: 11, offset: 32559},
-										name: "Passthrough",
+										pos:  position{line: 928, col: 15, offset: 33131},
+										name: "SubscriptText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 905, col: 11, offset: 32582},
-										name: "NonSingleQuoteItalicText",
+										pos:  position{line: 929, col: 15, offset: 33160},
+										name: "SuperscriptText",
 									},
 								},
 							},
@@ -6690,178 +6695,181 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "NonSingleQuoteItalicText",
-			pos:  position{line: 909, col: 1, offset: 32740},
+			name: "QuotedTextRole",
+			pos:  position{line: 933, col: 1, offset: 33252},
 			expr: &actionExpr{
-				pos: position{line: 909, col: 29, offset: 32768},
-				run: (*parser).callonNonSingleQuoteItalicText1,
+				pos: position{line: 933, col: 19, offset: 33270},
+				run: (*parser).callonQuotedTextRole1,
 				expr: &seqExpr{
-					pos: position{line: 909, col: 29, offset: 32768},
+					pos: position{line: 933, col: 19, offset: 33270},
 					exprs: []interface{}{
-						&anyMatcher{
-							line: 909, col: 30, offset: 32769,
+						&litMatcher{
+							pos:        position{line: 933, col: 19, offset: 33270},
+							val:        "[.",
+							ignoreCase: false,
 						},
-						&zeroOrMoreExpr{
-							pos: position{line: 909, col: 33, offset: 32772},
-							expr: &seqExpr{
-								pos: position{line: 909, col: 34, offset: 32773},
-								exprs: []interface{}{
-									&notExpr{
-										pos: position{line: 909, col: 34, offset: 32773},
-										expr: &litMatcher{
-											pos:        position{line: 909, col: 35, offset: 32774},
-											val:        "_",
-											ignoreCase: false,
-										},
-									},
-									&notExpr{
-										pos: position{line: 909, col: 39, offset: 32778},
-										expr: &ruleRefExpr{
-											pos:  position{line: 909, col: 40, offset: 32779},
-											name: "WS",
-										},
-									},
-									&notExpr{
-										pos: position{line: 909, col: 43, offset: 32782},
-										expr: &litMatcher{
-											pos:        position{line: 909, col: 44, offset: 32783},
-											val:        "^",
-											ignoreCase: false,
-										},
-									},
-									&notExpr{
-										pos: position{line: 909, col: 48, offset: 32787},
-										expr: &litMatcher{
-											pos:        position{line: 909, col: 49, offset: 32788},
-											val:        "~",
-											ignoreCase: false,
-										},
-									},
-									&notExpr{
-										pos: position{line: 909, col: 53, offset: 32792},
-										expr: &ruleRefExpr{
-											pos:  position{line: 909, col: 54, offset: 32793},
-											name: "NEWLINE",
+						&labeledExpr{
+							pos:   position{line: 933, col: 24, offset: 33275},
+							label: "role",
+							expr: &actionExpr{
+								pos: position{line: 933, col: 30, offset: 33281},
+								run: (*parser).callonQuotedTextRole5,
+								expr: &oneOrMoreExpr{
+									pos: position{line: 933, col: 30, offset: 33281},
+									expr: &choiceExpr{
+										pos: position{line: 933, col: 31, offset: 33282},
+										alternatives: []interface{}{
+											&ruleRefExpr{
+												pos:  position{line: 933, col: 31, offset: 33282},
+												name: "Alphanums",
+											},
+											&litMatcher{
+												pos:        position{line: 933, col: 43, offset: 33294},
+												val:        "-",
+												ignoreCase: false,
+											},
+											&litMatcher{
+												pos:        position{line: 933, col: 49, offset: 33300},
+												val:        "_",
+												ignoreCase: false,
+											},
+											&litMatcher{
+												pos:        position{line: 933, col: 55, offset: 33306},
+												val:        ".",
+												ignoreCase: false,
+											},
 										},
 									},
-									&anyMatcher{
-										line: 909, col: 62, offset: 32801,
-									},
 								},
 							},
 						},
+						&litMatcher{
+							pos:        position{line: 935, col: 4, offset: 33348},
+							val:        "]",
+							ignoreCase: false,
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "EscapedItalicText",
-			pos:  position{line: 913, col: 1, offset: 32861},
+			name: "QuotedTextPrefix",
+			pos:  position{line: 939, col: 1, offset: 33378},
 			expr: &choiceExpr{
-				pos: position{line: 914, col: 5, offset: 32887},
+				pos: position{line: 939, col: 21, offset: 33398},
 				alternatives: []interface{}{
-					&actionExpr{
-						pos: position{line: 914, col: 5, offset: 32887},
-						run: (*parser).callonEscapedItalicText2,
-						expr: &seqExpr{
-							pos: position{line: 914, col: 5, offset: 32887},
-							exprs: []interface{}{
-								&labeledExpr{
-									pos:   position{line: 914, col: 5, offset: 32887},
-									label: "backslashes",
-									expr: &ruleRefExpr{
-										pos:  position{line: 914, col: 18, offset: 32900},
-										name: "TwoOrMoreBackslashes",
-									},
-								},
-								&litMatcher{
-									pos:        position{line: 914, col: 40, offset: 32922},
-									val:        "__",
-									ignoreCase: false,
-								},
-								&labeledExpr{
-									pos:   position{line: 914, col: 45, offset: 32927},
-									label: "content",
-									expr: &ruleRefExpr{
-										pos:  position{line: 914, col: 54, offset: 32936},
-										name: "DoubleQuoteItalicTextContent",
-									},
-								},
-								&litMatcher{
-									pos:        position{line: 914, col: 84, offset: 32966},
-									val:        "__",
-									ignoreCase: false,
-								},
-							},
-						},
+					&litMatcher{
+						pos:        position{line: 939, col: 21, offset: 33398},
+						val:        "**",
+						ignoreCase: false,
+					},
+					&litMatcher{
+						pos:        position{line: 939, col: 28, offset: 33405},
+						val:        "*",
+						ignoreCase: false,
+					},
+					&litMatcher{
+						pos:        position{line: 939, col: 34, offset: 33411},
+						val:        "__",
+						ignoreCase: false,
+					},
+					&litMatcher{
+						pos:        position{line: 939, col: 41, offset: 33418},
+						val:        "_",
+						ignoreCase: false,
+					},
+					&litMatcher{
+						pos:        position{line: 939, col: 47, offset: 33424},
+						val:        "``",
+						ignoreCase: false,
+					},
+					&litMatcher{
+						pos:        position{line: 939, col: 54, offset: 33431},
+						val:        "`",
+						ignoreCase: false,
+					},
+					&litMatcher{
+						pos:        position{line: 939, col: 60, offset: 33437},
+						val:        "##",
+						ignoreCase: false,
+					},
+					&litMatcher{
+						pos:        position{line: 939, col: 67, offset: 33444},
+						val:        "#",
+						ignoreCase: false,
+					},
+					&litMatcher{
+						pos:        position{line: 939, col: 73, offset: 33450},
+						val:        "^",
+						ignoreCase: false,
+					},
+					&litMatcher{
+						pos:        position{line: 939, col: 79, offset: 33456},
+						val:        "~",
+						ignoreCase: false,
+					},
+				},
+			},
+		},
+		{
+			name: "SubscriptOrSuperscriptPrefix",
+			pos:  position{line: 941, col: 1, offset: 33461},
+			expr: &choiceExpr{
+				pos: position{line: 941, col: 33, offset: 33493},
+				alternatives: []interface{}{
+					&litMatcher{
+						pos:        position{line: 941, col: 33, offset: 33493},
+						val:        "^",
+						ignoreCase: false,
 					},
 					&actionExpr{
-						pos: position{line: 916, col: 9, offset: 33122},
-						run: (*parser).callonEscapedItalicText10,
-						expr: &seqExpr{
-							pos: position{line: 916, col: 9, offset: 33122},
-							exprs: []interface{}{
-								&labeledExpr{
-									pos:   position{line: 916, col: 9, offset: 33122},
-									label: "backslashes",
-									expr: &ruleRefExpr{
-										pos:  position{line: 916, col: 22, offset: 33135},
-										name: "OneOrMoreBackslashes",
-									},
-								},
-								&litMatcher{
-									pos:        position{line: 916, col: 44, offset: 33157},
-									val:        "__",
-									ignoreCase: false,
-								},
-								&labeledExpr{
-									pos:   position{line: 916, col: 49, offset: 33162},
-									label: "content",
-									expr: &ruleRefExpr{
-										pos:  position{line: 916, col: 58, offset: 33171},
-										name: "SingleQuoteItalicTextContent",
-									},
-								},
-								&litMatcher{
-									pos:        position{line: 916, col: 88, offset: 33201},
-									val:        "_",
-									ignoreCase: false,
-								},
-							},
+						pos: position{line: 941, col: 39, offset: 33499},
+						run: (*parser).callonSubscriptOrSuperscriptPrefix3,
+						expr: &litMatcher{
+							pos:        position{line: 941, col: 39, offset: 33499},
+							val:        "~",
+							ignoreCase: false,
 						},
 					},
-					&actionExpr{
-						pos: position{line: 919, col: 9, offset: 33400},
-						run: (*parser).callonEscapedItalicText18,
-						expr: &seqExpr{
-							pos: position{line: 919, col: 9, offset: 33400},
-							exprs: []interface{}{
-								&labeledExpr{
-									pos:   position{line: 919, col: 9, offset: 33400},
-									label: "backslashes",
-									expr: &ruleRefExpr{
-										pos:  position{line: 919, col: 22, offset: 33413},
-										name: "OneOrMoreBackslashes",
-									},
-								},
-								&litMatcher{
-									pos:        position{line: 919, col: 44, offset: 33435},
-									val:        "_",
-									ignoreCase: false,
-								},
-								&labeledExpr{
-									pos:   position{line: 919, col: 48, offset: 33439},
-									label: "content",
-									expr: &ruleRefExpr{
-										pos:  position{line: 919, col: 57, offset: 33448},
-										name: "SingleQuoteItalicTextContent",
-									},
-								},
-								&litMatcher{
-									pos:        position{line: 919, col: 87, offset: 33478},
-									val:        "_",
-									ignoreCase: false,
-								},
+				},
+			},
+		},
+		{
+			name: "OneOrMoreBackslashes",
+			pos:  position{line: 945, col: 1, offset: 33632},
+			expr: &actionExpr{
+				pos: position{line: 945, col: 25, offset: 33656},
+				run: (*parser).callonOneOrMoreBackslashes1,
+				expr: &oneOrMoreExpr{
+					pos: position{line: 945, col: 25, offset: 33656},
+					expr: &litMatcher{
+						pos:        position{line: 945, col: 25, offset: 33656},
+						val:        "\\",
+						ignoreCase: false,
+					},
+				},
+			},
+		},
+		{
+			name: "TwoOrMoreBackslashes",
+			pos:  position{line: 949, col: 1, offset: 33697},
+			expr: &actionExpr{
+				pos: position{line: 949, col: 25, offset: 33721},
+				run: (*parser).callonTwoOrMoreBackslashes1,
+				expr: &seqExpr{
+					pos: position{line: 949, col: 25, offset: 33721},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 949, col: 25, offset: 33721},
+							val:        "\\\\",
+							ignoreCase: false,
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 949, col: 30, offset: 33726},
+							expr: &litMatcher{
+								pos:        position{line: 949, col: 30, offset: 33726},
+								val:        "\\",
+								ignoreCase: false,
 							},
 						},
 					},
@@ -6869,55 +6877,55 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "MonospaceText",
-			pos:  position{line: 926, col: 1, offset: 33687},
+			name: "BoldText",
+			pos:  position{line: 957, col: 1, offset: 33823},
 			expr: &choiceExpr{
-				pos: position{line: 926, col: 18, offset: 33704},
+				pos: position{line: 957, col: 13, offset: 33835},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 926, col: 18, offset: 33704},
-						name: "DoubleQuoteMonospaceText",
+						pos:  position{line: 957, col: 13, offset: 33835},
+						name: "DoubleQuoteBoldText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 926, col: 45, offset: 33731},
-						name: "SingleQuoteMonospaceText",
+						pos:  position{line: 957, col: 35, offset: 33857},
+						name: "SingleQuoteBoldText",
 					},
 				},
 			},
 		},
 		{
-			name: "DoubleQuoteMonospaceText",
-			pos:  position{line: 928, col: 1, offset: 33757},
+			name: "DoubleQuoteBoldText",
+			pos:  position{line: 959, col: 1, offset: 33878},
 			expr: &actionExpr{
-				pos: position{line: 928, col: 29, offset: 33785},
-				run: (*parser).callonDoubleQuoteMonospaceText1,
+				pos: position{line: 959, col: 24, offset: 33901},
+				run: (*parser).callonDoubleQuoteBoldText1,
 				expr: &seqExpr{
-					pos: position{line: 928, col: 29, offset: 33785},
+					pos: position{line: 959, col: 24, offset: 33901},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 928, col: 29, offset: 33785},
+							pos: position{line: 959, col: 24, offset: 33901},
 							expr: &litMatcher{
-								pos:        position{line: 928, col: 30, offset: 33786},
+								pos:        position{line: 959, col: 25, offset: 33902},
 								val:        "\\\\",
 								ignoreCase: false,
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 928, col: 35, offset: 33791},
-							val:        "``",
+							pos:        position{line: 959, col: 30, offset: 33907},
+							val:        "**",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 928, col: 40, offset: 33796},
+							pos:   position{line: 959, col: 35, offset: 33912},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 928, col: 49, offset: 33805},
-								name: "DoubleQuoteMonospaceTextContent",
+								pos:  position{line: 959, col: 44, offset: 33921},
+								name: "DoubleQuoteBoldTextContent",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 928, col: 82, offset: 33838},
-							val:        "``",
+							pos:        position{line: 959, col: 72, offset: 33949},
+							val:        "**",
 							ignoreCase: false,
 						},
 					},
@@ -6925,44 +6933,44 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "DoubleQuoteMonospaceTextContent",
-			pos:  position{line: 932, col: 1, offset: 33967},
+			name: "DoubleQuoteBoldTextContent",
+			pos:  position{line: 963, col: 1, offset: 34074},
 			expr: &seqExpr{
-				pos: position{line: 932, col: 36, offset: 34002},
+				pos: position{line: 963, col: 31, offset: 34104},
 				exprs: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 932, col: 36, offset: 34002},
-						name: "DoubleQuoteMonospaceTextElement",
+						pos:  position{line: 963, col: 31, offset: 34104},
+						name: "DoubleQuoteBoldTextElement",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 932, col: 68, offset: 34034},
+						pos: position{line: 963, col: 58, offset: 34131},
 						expr: &actionExpr{
-							pos: position{line: 932, col: 69, offset: 34035},
-							run: (*parser).callonDoubleQuoteMonospaceTextContent4,
+							pos: position{line: 963, col: 59, offset: 34132},
+							run: (*parser).callonDoubleQuoteBoldTextContent4,
 							expr: &seqExpr{
-								pos: position{line: 932, col: 69, offset: 34035},
+								pos: position{line: 963, col: 59, offset: 34132},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 932, col: 69, offset: 34035},
+										pos: position{line: 963, col: 59, offset: 34132},
 										expr: &litMatcher{
-											pos:        position{line: 932, col: 71, offset: 34037},
-											val:        "``",
+											pos:        position{line: 963, col: 61, offset: 34134},
+											val:        "**",
 											ignoreCase: false,
 										},
 									},
 									&labeledExpr{
-										pos:   position{line: 932, col: 77, offset: 34043},
+										pos:   position{line: 963, col: 67, offset: 34140},
 										label: "element",
 										expr: &choiceExpr{
-											pos: position{line: 932, col: 86, offset: 34052},
+											pos: position{line: 963, col: 76, offset: 34149},
 											alternatives: []interface{}{
 												&ruleRefExpr{
-													pos:  position{line: 932, col: 86, offset: 34052},
+													pos:  position{line: 963, col: 76, offset: 34149},
 													name: "WS",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 932, col: 91, offset: 34057},
-													name: "DoubleQuoteMonospaceTextElement",
+													pos:  position{line: 963, col: 81, offset: 34154},
+													name: "DoubleQuoteBoldTextElement",
 												},
 											},
 										},
@@ -6975,62 +6983,70 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "DoubleQuoteMonospaceTextElement",
-			pos:  position{line: 936, col: 1, offset: 34154},
+			name: "DoubleQuoteBoldTextElement",
+			pos:  position{line: 967, col: 1, offset: 34246},
 			expr: &actionExpr{
-				pos: position{line: 936, col: 36, offset: 34189},
-				run: (*parser).callonDoubleQuoteMonospaceTextElement1,
+				pos: position{line: 967, col: 31, offset: 34276},
+				run: (*parser).callonDoubleQuoteBoldTextElement1,
 				expr: &seqExpr{
-					pos: position{line: 936, col: 36, offset: 34189},
+					pos: position{line: 967, col: 31, offset: 34276},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 936, col: 36, offset: 34189},
+							pos: position{line: 967, col: 31, offset: 34276},
 							expr: &ruleRefExpr{
-								pos:  position{line: 936, col: 37, offset: 34190},
+								pos:  position{line: 967, col: 32, offset: 34277},
 								name: "NEWLINE",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 936, col: 45, offset: 34198},
+							pos:   position{line: 967, col: 40, offset: 34285},
 							label: "element",
 							expr: &choiceExpr{
-								pos: position{line: 936, col: 54, offset: 34207},
+								pos: position{line: 967, col: 49, offset: 34294},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 936, col: 54, offset: 34207},
-										name: "SingleQuoteMonospaceText",
+										pos:  position{line: 967, col: 49, offset: 34294},
+										name: "SingleQuoteBoldText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 937, col: 11, offset: 34243},
-										name: "BoldText",
+										pos:  position{line: 968, col: 11, offset: 34325},
+										name: "ItalicText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 938, col: 11, offset: 34262},
-										name: "ItalicText",
+										pos:  position{line: 969, col: 11, offset: 34347},
+										name: "CurvedQuotedText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 970, col: 11, offset: 34374},
+										name: "MonospaceText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 971, col: 11, offset: 34398},
+										name: "MarkedText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 939, col: 11, offset: 34284},
+										pos:  position{line: 972, col: 11, offset: 34419},
 										name: "SubscriptText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 940, col: 11, offset: 34308},
+										pos:  position{line: 973, col: 11, offset: 34443},
 										name: "SuperscriptText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 941, col: 11, offset: 34334},
+										pos:  position{line: 974, col: 11, offset: 34469},
 										name: "InlineImage",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 942, col: 11, offset: 34357},
+										pos:  position{line: 975, col: 11, offset: 34492},
 										name: "Link",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 943, col: 11, offset: 34373},
+										pos:  position{line: 976, col: 11, offset: 34508},
 										name: "Passthrough",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 944, col: 11, offset: 34396},
-										name: "NonDoubleQuoteMonospaceText",
+										pos:  position{line: 977, col: 11, offset: 34531},
+										name: "NonDoubleQuoteBoldText",
 									},
 								},
 							},
@@ -7040,62 +7056,62 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "NonDoubleQuoteMonospaceText",
-			pos:  position{line: 948, col: 1, offset: 34557},
+			name: "NonDoubleQuoteBoldText",
+			pos:  position{line: 981, col: 1, offset: 34687},
 			expr: &actionExpr{
-				pos: position{line: 948, col: 32, offset: 34588},
-				run: (*parser).callonNonDoubleQuoteMonospaceText1,
+				pos: position{line: 981, col: 27, offset: 34713},
+				run: (*parser).callonNonDoubleQuoteBoldText1,
 				expr: &seqExpr{
-					pos: position{line: 948, col: 32, offset: 34588},
+					pos: position{line: 981, col: 27, offset: 34713},
 					exprs: []interface{}{
 						&anyMatcher{
-							line: 948, col: 33, offset: 34589,
+							line: 981, col: 28, offset: 34714,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 948, col: 36, offset: 34592},
+							pos: position{line: 981, col: 31, offset: 34717},
 							expr: &seqExpr{
-								pos: position{line: 948, col: 37, offset: 34593},
+								pos: position{line: 981, col: 32, offset: 34718},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 948, col: 37, offset: 34593},
+										pos: position{line: 981, col: 32, offset: 34718},
 										expr: &litMatcher{
-											pos:        position{line: 948, col: 38, offset: 34594},
-											val:        "``",
+											pos:        position{line: 981, col: 33, offset: 34719},
+											val:        "**",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 948, col: 43, offset: 34599},
+										pos: position{line: 981, col: 38, offset: 34724},
 										expr: &ruleRefExpr{
-											pos:  position{line: 948, col: 44, offset: 34600},
+											pos:  position{line: 981, col: 39, offset: 34725},
 											name: "WS",
 										},
 									},
 									&notExpr{
-										pos: position{line: 948, col: 47, offset: 34603},
+										pos: position{line: 981, col: 42, offset: 34728},
 										expr: &litMatcher{
-											pos:        position{line: 948, col: 48, offset: 34604},
+											pos:        position{line: 981, col: 43, offset: 34729},
 											val:        "^",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 948, col: 52, offset: 34608},
+										pos: position{line: 981, col: 47, offset: 34733},
 										expr: &litMatcher{
-											pos:        position{line: 948, col: 53, offset: 34609},
+											pos:        position{line: 981, col: 48, offset: 34734},
 											val:        "~",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 948, col: 57, offset: 34613},
+										pos: position{line: 981, col: 52, offset: 34738},
 										expr: &ruleRefExpr{
-											pos:  position{line: 948, col: 58, offset: 34614},
+											pos:  position{line: 981, col: 53, offset: 34739},
 											name: "NEWLINE",
 										},
 									},
 									&anyMatcher{
-										line: 948, col: 66, offset: 34622,
+										line: 981, col: 61, offset: 34747,
 									},
 								},
 							},
@@ -7105,84 +7121,84 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "SingleQuoteMonospaceText",
-			pos:  position{line: 952, col: 1, offset: 34682},
+			name: "SingleQuoteBoldText",
+			pos:  position{line: 985, col: 1, offset: 34807},
 			expr: &choiceExpr{
-				pos: position{line: 952, col: 29, offset: 34710},
+				pos: position{line: 985, col: 24, offset: 34830},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 952, col: 29, offset: 34710},
-						run: (*parser).callonSingleQuoteMonospaceText2,
+						pos: position{line: 985, col: 24, offset: 34830},
+						run: (*parser).callonSingleQuoteBoldText2,
 						expr: &seqExpr{
-							pos: position{line: 952, col: 29, offset: 34710},
+							pos: position{line: 985, col: 24, offset: 34830},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 952, col: 29, offset: 34710},
+									pos: position{line: 985, col: 24, offset: 34830},
 									expr: &litMatcher{
-										pos:        position{line: 952, col: 30, offset: 34711},
+										pos:        position{line: 985, col: 25, offset: 34831},
 										val:        "\\",
 										ignoreCase: false,
 									},
 								},
 								&notExpr{
-									pos: position{line: 952, col: 34, offset: 34715},
+									pos: position{line: 985, col: 29, offset: 34835},
 									expr: &litMatcher{
-										pos:        position{line: 952, col: 35, offset: 34716},
-										val:        "``",
+										pos:        position{line: 985, col: 30, offset: 34836},
+										val:        "**",
 										ignoreCase: false,
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 952, col: 40, offset: 34721},
-									val:        "`",
+									pos:        position{line: 985, col: 35, offset: 34841},
+									val:        "*",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 952, col: 44, offset: 34725},
+									pos:   position{line: 985, col: 39, offset: 34845},
 									label: "content",
 									expr: &ruleRefExpr{
-										pos:  position{line: 952, col: 53, offset: 34734},
-										name: "SingleQuoteMonospaceTextContent",
+										pos:  position{line: 985, col: 48, offset: 34854},
+										name: "SingleQuoteBoldTextContent",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 952, col: 86, offset: 34767},
-									val:        "`",
+									pos:        position{line: 985, col: 76, offset: 34882},
+									val:        "*",
 									ignoreCase: false,
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 954, col: 5, offset: 34952},
-						run: (*parser).callonSingleQuoteMonospaceText12,
+						pos: position{line: 987, col: 5, offset: 35062},
+						run: (*parser).callonSingleQuoteBoldText12,
 						expr: &seqExpr{
-							pos: position{line: 954, col: 5, offset: 34952},
+							pos: position{line: 987, col: 5, offset: 35062},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 954, col: 5, offset: 34952},
+									pos: position{line: 987, col: 5, offset: 35062},
 									expr: &litMatcher{
-										pos:        position{line: 954, col: 6, offset: 34953},
+										pos:        position{line: 987, col: 6, offset: 35063},
 										val:        "\\\\",
 										ignoreCase: false,
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 954, col: 11, offset: 34958},
-									val:        "``",
+									pos:        position{line: 987, col: 11, offset: 35068},
+									val:        "**",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 954, col: 16, offset: 34963},
+									pos:   position{line: 987, col: 16, offset: 35073},
 									label: "content",
 									expr: &ruleRefExpr{
-										pos:  position{line: 954, col: 25, offset: 34972},
-										name: "SingleQuoteMonospaceTextContent",
+										pos:  position{line: 987, col: 25, offset: 35082},
+										name: "SingleQuoteBoldTextContent",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 954, col: 58, offset: 35005},
-									val:        "`",
+									pos:        position{line: 987, col: 53, offset: 35110},
+									val:        "*",
 									ignoreCase: false,
 								},
 							},
@@ -7192,44 +7208,44 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "SingleQuoteMonospaceTextContent",
-			pos:  position{line: 958, col: 1, offset: 35273},
+			name: "SingleQuoteBoldTextContent",
+			pos:  position{line: 991, col: 1, offset: 35368},
 			expr: &seqExpr{
-				pos: position{line: 958, col: 36, offset: 35308},
+				pos: position{line: 991, col: 31, offset: 35398},
 				exprs: []interface{}{
 					&notExpr{
-						pos: position{line: 958, col: 36, offset: 35308},
+						pos: position{line: 991, col: 31, offset: 35398},
 						expr: &ruleRefExpr{
-							pos:  position{line: 958, col: 37, offset: 35309},
+							pos:  position{line: 991, col: 32, offset: 35399},
 							name: "WS",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 958, col: 40, offset: 35312},
-						name: "SingleQuoteMonospaceTextElement",
+						pos:  position{line: 991, col: 35, offset: 35402},
+						name: "SingleQuoteBoldTextElement",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 958, col: 72, offset: 35344},
+						pos: position{line: 991, col: 62, offset: 35429},
 						expr: &actionExpr{
-							pos: position{line: 958, col: 73, offset: 35345},
-							run: (*parser).callonSingleQuoteMonospaceTextContent6,
+							pos: position{line: 991, col: 63, offset: 35430},
+							run: (*parser).callonSingleQuoteBoldTextContent6,
 							expr: &seqExpr{
-								pos: position{line: 958, col: 73, offset: 35345},
+								pos: position{line: 991, col: 63, offset: 35430},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 958, col: 73, offset: 35345},
+										pos: position{line: 991, col: 63, offset: 35430},
 										expr: &seqExpr{
-											pos: position{line: 958, col: 75, offset: 35347},
+											pos: position{line: 991, col: 65, offset: 35432},
 											exprs: []interface{}{
 												&litMatcher{
-													pos:        position{line: 958, col: 75, offset: 35347},
-													val:        "`",
+													pos:        position{line: 991, col: 65, offset: 35432},
+													val:        "*",
 													ignoreCase: false,
 												},
 												&notExpr{
-													pos: position{line: 958, col: 79, offset: 35351},
+													pos: position{line: 991, col: 69, offset: 35436},
 													expr: &ruleRefExpr{
-														pos:  position{line: 958, col: 80, offset: 35352},
+														pos:  position{line: 991, col: 70, offset: 35437},
 														name: "Alphanum",
 													},
 												},
@@ -7237,22 +7253,22 @@ var g = &grammar{
 										},
 									},
 									&labeledExpr{
-										pos:   position{line: 958, col: 90, offset: 35362},
+										pos:   position{line: 991, col: 80, offset: 35447},
 										label: "spaces",
 										expr: &zeroOrMoreExpr{
-											pos: position{line: 958, col: 98, offset: 35370},
+											pos: position{line: 991, col: 88, offset: 35455},
 											expr: &ruleRefExpr{
-												pos:  position{line: 958, col: 98, offset: 35370},
+												pos:  position{line: 991, col: 88, offset: 35455},
 												name: "WS",
 											},
 										},
 									},
 									&labeledExpr{
-										pos:   position{line: 958, col: 103, offset: 35375},
+										pos:   position{line: 991, col: 93, offset: 35460},
 										label: "element",
 										expr: &ruleRefExpr{
-											pos:  position{line: 958, col: 112, offset: 35384},
-											name: "SingleQuoteMonospaceTextElement",
+											pos:  position{line: 991, col: 102, offset: 35469},
+											name: "SingleQuoteBoldTextElement",
 										},
 									},
 								},
@@ -7263,119 +7279,135 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "SingleQuoteMonospaceTextElement",
-			pos:  position{line: 962, col: 1, offset: 35480},
+			name: "SingleQuoteBoldTextElement",
+			pos:  position{line: 995, col: 1, offset: 35560},
 			expr: &actionExpr{
-				pos: position{line: 962, col: 37, offset: 35516},
-				run: (*parser).callonSingleQuoteMonospaceTextElement1,
-				expr: &labeledExpr{
-					pos:   position{line: 962, col: 37, offset: 35516},
-					label: "element",
-					expr: &choiceExpr{
-						pos: position{line: 962, col: 46, offset: 35525},
-						alternatives: []interface{}{
-							&ruleRefExpr{
-								pos:  position{line: 962, col: 46, offset: 35525},
+				pos: position{line: 995, col: 31, offset: 35590},
+				run: (*parser).callonSingleQuoteBoldTextElement1,
+				expr: &seqExpr{
+					pos: position{line: 995, col: 31, offset: 35590},
+					exprs: []interface{}{
+						&notExpr{
+							pos: position{line: 995, col: 31, offset: 35590},
+							expr: &ruleRefExpr{
+								pos:  position{line: 995, col: 32, offset: 35591},
 								name: "NEWLINE",
 							},
-							&ruleRefExpr{
-								pos:  position{line: 963, col: 11, offset: 35563},
-								name: "DoubleQuoteMonospaceText",
-							},
-							&ruleRefExpr{
-								pos:  position{line: 964, col: 11, offset: 35599},
-								name: "BoldText",
-							},
-							&ruleRefExpr{
-								pos:  position{line: 965, col: 11, offset: 35619},
-								name: "ItalicText",
-							},
-							&ruleRefExpr{
-								pos:  position{line: 966, col: 11, offset: 35640},
-								name: "SubscriptText",
-							},
-							&ruleRefExpr{
-								pos:  position{line: 967, col: 11, offset: 35664},
-								name: "SuperscriptText",
-							},
-							&ruleRefExpr{
-								pos:  position{line: 968, col: 11, offset: 35690},
-								name: "InlineImage",
-							},
-							&ruleRefExpr{
-								pos:  position{line: 969, col: 11, offset: 35713},
-								name: "Link",
-							},
-							&ruleRefExpr{
-								pos:  position{line: 970, col: 11, offset: 35729},
-								name: "Passthrough",
-							},
-							&ruleRefExpr{
-								pos:  position{line: 971, col: 11, offset: 35752},
-								name: "NonSingleQuoteMonospaceText",
-							},
 						},
-					},
-				},
+						&labeledExpr{
+							pos:   position{line: 995, col: 40, offset: 35599},
+							label: "element",
+							expr: &choiceExpr{
+								pos: position{line: 995, col: 49, offset: 35608},
+								alternatives: []interface{}{
+									&ruleRefExpr{
+										pos:  position{line: 995, col: 49, offset: 35608},
+										name: "DoubleQuoteBoldText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 996, col: 11, offset: 35638},
+										name: "ItalicText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 997, col: 11, offset: 35660},
+										name: "CurvedQuotedText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 998, col: 11, offset: 35687},
+										name: "MonospaceText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 999, col: 11, offset: 35711},
+										name: "MarkedText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1000, col: 11, offset: 35732},
+										name: "SubscriptText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1001, col: 11, offset: 35756},
+										name: "SuperscriptText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1002, col: 11, offset: 35782},
+										name: "InlineImage",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1003, col: 11, offset: 35805},
+										name: "Link",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1004, col: 11, offset: 35821},
+										name: "Passthrough",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1005, col: 11, offset: 35844},
+										name: "NonSingleQuoteBoldText",
+									},
+								},
+							},
+						},
+					},
+				},
 			},
 		},
 		{
-			name: "NonSingleQuoteMonospaceText",
-			pos:  position{line: 975, col: 1, offset: 35913},
+			name: "NonSingleQuoteBoldText",
+			pos:  position{line: 1009, col: 1, offset: 36000},
 			expr: &actionExpr{
-				pos: position{line: 975, col: 32, offset: 35944},
-				run: (*parser).callonNonSingleQuoteMonospaceText1,
+				pos: position{line: 1009, col: 27, offset: 36026},
+				run: (*parser).callonNonSingleQuoteBoldText1,
 				expr: &seqExpr{
-					pos: position{line: 975, col: 32, offset: 35944},
+					pos: position{line: 1009, col: 27, offset: 36026},
 					exprs: []interface{}{
 						&anyMatcher{
-							line: 975, col: 33, offset: 35945,
+							line: 1009, col: 28, offset: 36027,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 975, col: 36, offset: 35948},
+							pos: position{line: 1009, col: 31, offset: 36030},
 							expr: &seqExpr{
-								pos: position{line: 975, col: 37, offset: 35949},
+								pos: position{line: 1009, col: 32, offset: 36031},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 975, col: 37, offset: 35949},
-										expr: &ruleRefExpr{
-											pos:  position{line: 975, col: 38, offset: 35950},
-											name: "WS",
+										pos: position{line: 1009, col: 32, offset: 36031},
+										expr: &litMatcher{
+											pos:        position{line: 1009, col: 33, offset: 36032},
+											val:        "*",
+											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 975, col: 41, offset: 35953},
-										expr: &litMatcher{
-											pos:        position{line: 975, col: 42, offset: 35954},
-											val:        "`",
-											ignoreCase: false,
+										pos: position{line: 1009, col: 37, offset: 36036},
+										expr: &ruleRefExpr{
+											pos:  position{line: 1009, col: 38, offset: 36037},
+											name: "WS",
 										},
 									},
 									&notExpr{
-										pos: position{line: 975, col: 46, offset: 35958},
+										pos: position{line: 1009, col: 41, offset: 36040},
 										expr: &litMatcher{
-											pos:        position{line: 975, col: 47, offset: 35959},
+											pos:        position{line: 1009, col: 42, offset: 36041},
 											val:        "^",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 975, col: 51, offset: 35963},
+										pos: position{line: 1009, col: 46, offset: 36045},
 										expr: &litMatcher{
-											pos:        position{line: 975, col: 52, offset: 35964},
+											pos:        position{line: 1009, col: 47, offset: 36046},
 											val:        "~",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 975, col: 56, offset: 35968},
+										pos: position{line: 1009, col: 51, offset: 36050},
 										expr: &ruleRefExpr{
-											pos:  position{line: 975, col: 57, offset: 35969},
+											pos:  position{line: 1009, col: 52, offset: 36051},
 											name: "NEWLINE",
 										},
 									},
 									&anyMatcher{
-										line: 975, col: 65, offset: 35977,
+										line: 1009, col: 60, offset: 36059,
 									},
 								},
 							},
@@ -7385,111 +7417,111 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "EscapedMonospaceText",
-			pos:  position{line: 979, col: 1, offset: 36058},
+			name: "EscapedBoldText",
+			pos:  position{line: 1013, col: 1, offset: 36119},
 			expr: &choiceExpr{
-				pos: position{line: 980, col: 5, offset: 36087},
+				pos: position{line: 1014, col: 5, offset: 36143},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 980, col: 5, offset: 36087},
-						run: (*parser).callonEscapedMonospaceText2,
+						pos: position{line: 1014, col: 5, offset: 36143},
+						run: (*parser).callonEscapedBoldText2,
 						expr: &seqExpr{
-							pos: position{line: 980, col: 5, offset: 36087},
+							pos: position{line: 1014, col: 5, offset: 36143},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 980, col: 5, offset: 36087},
+									pos:   position{line: 1014, col: 5, offset: 36143},
 									label: "backslashes",
 									expr: &ruleRefExpr{
-										pos:  position{line: 980, col: 18, offset: 36100},
+										pos:  position{line: 1014, col: 18, offset: 36156},
 										name: "TwoOrMoreBackslashes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 980, col: 40, offset: 36122},
-									val:        "``",
+									pos:        position{line: 1014, col: 40, offset: 36178},
+									val:        "**",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 980, col: 45, offset: 36127},
+									pos:   position{line: 1014, col: 45, offset: 36183},
 									label: "content",
 									expr: &ruleRefExpr{
-										pos:  position{line: 980, col: 54, offset: 36136},
-										name: "DoubleQuoteMonospaceTextContent",
+										pos:  position{line: 1014, col: 54, offset: 36192},
+										name: "DoubleQuoteBoldTextContent",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 980, col: 87, offset: 36169},
-									val:        "``",
+									pos:        position{line: 1014, col: 82, offset: 36220},
+									val:        "**",
 									ignoreCase: false,
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 982, col: 9, offset: 36325},
-						run: (*parser).callonEscapedMonospaceText10,
+						pos: position{line: 1016, col: 9, offset: 36376},
+						run: (*parser).callonEscapedBoldText10,
 						expr: &seqExpr{
-							pos: position{line: 982, col: 9, offset: 36325},
+							pos: position{line: 1016, col: 9, offset: 36376},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 982, col: 9, offset: 36325},
+									pos:   position{line: 1016, col: 9, offset: 36376},
 									label: "backslashes",
 									expr: &ruleRefExpr{
-										pos:  position{line: 982, col: 22, offset: 36338},
+										pos:  position{line: 1016, col: 22, offset: 36389},
 										name: "OneOrMoreBackslashes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 982, col: 44, offset: 36360},
-									val:        "``",
+									pos:        position{line: 1016, col: 44, offset: 36411},
+									val:        "**",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 982, col: 49, offset: 36365},
+									pos:   position{line: 1016, col: 49, offset: 36416},
 									label: "content",
 									expr: &ruleRefExpr{
-										pos:  position{line: 982, col: 58, offset: 36374},
-										name: "SingleQuoteMonospaceTextContent",
+										pos:  position{line: 1016, col: 58, offset: 36425},
+										name: "SingleQuoteBoldTextContent",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 982, col: 91, offset: 36407},
-									val:        "`",
+									pos:        position{line: 1016, col: 86, offset: 36453},
+									val:        "*",
 									ignoreCase: false,
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 985, col: 9, offset: 36606},
-						run: (*parser).callonEscapedMonospaceText18,
+						pos: position{line: 1019, col: 9, offset: 36652},
+						run: (*parser).callonEscapedBoldText18,
 						expr: &seqExpr{
-							pos: position{line: 985, col: 9, offset: 36606},
+							pos: position{line: 1019, col: 9, offset: 36652},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 985, col: 9, offset: 36606},
+									pos:   position{line: 1019, col: 9, offset: 36652},
 									label: "backslashes",
 									expr: &ruleRefExpr{
-										pos:  position{line: 985, col: 22, offset: 36619},
+										pos:  position{line: 1019, col: 22, offset: 36665},
 										name: "OneOrMoreBackslashes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 985, col: 44, offset: 36641},
-									val:        "`",
+									pos:        position{line: 1019, col: 44, offset: 36687},
+									val:        "*",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 985, col: 48, offset: 36645},
+									pos:   position{line: 1019, col: 48, offset: 36691},
 									label: "content",
 									expr: &ruleRefExpr{
-										pos:  position{line: 985, col: 57, offset: 36654},
-										name: "SingleQuoteMonospaceTextContent",
+										pos:  position{line: 1019, col: 57, offset: 36700},
+										name: "SingleQuoteBoldTextContent",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 985, col: 90, offset: 36687},
-									val:        "`",
+									pos:        position{line: 1019, col: 85, offset: 36728},
+									val:        "*",
 									ignoreCase: false,
 								},
 							},
@@ -7499,38 +7531,55 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "SubscriptText",
-			pos:  position{line: 989, col: 1, offset: 36836},
+			name: "ItalicText",
+			pos:  position{line: 1027, col: 1, offset: 36935},
+			expr: &choiceExpr{
+				pos: position{line: 1027, col: 15, offset: 36949},
+				alternatives: []interface{}{
+					&ruleRefExpr{
+						pos:  position{line: 1027, col: 15, offset: 36949},
+						name: "DoubleQuoteItalicText",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1027, col: 39, offset: 36973},
+						name: "SingleQuoteItalicText",
+					},
+				},
+			},
+		},
+		{
+			name: "DoubleQuoteItalicText",
+			pos:  position{line: 1029, col: 1, offset: 36996},
 			expr: &actionExpr{
-				pos: position{line: 989, col: 18, offset: 36853},
-				run: (*parser).callonSubscriptText1,
+				pos: position{line: 1029, col: 26, offset: 37021},
+				run: (*parser).callonDoubleQuoteItalicText1,
 				expr: &seqExpr{
-					pos: position{line: 989, col: 18, offset: 36853},
+					pos: position{line: 1029, col: 26, offset: 37021},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 989, col: 18, offset: 36853},
+							pos: position{line: 1029, col: 26, offset: 37021},
 							expr: &litMatcher{
-								pos:        position{line: 989, col: 19, offset: 36854},
-								val:        "\\",
+								pos:        position{line: 1029, col: 27, offset: 37022},
+								val:        "\\\\",
 								ignoreCase: false,
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 989, col: 23, offset: 36858},
-							val:        "~",
+							pos:        position{line: 1029, col: 32, offset: 37027},
+							val:        "__",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 989, col: 27, offset: 36862},
+							pos:   position{line: 1029, col: 37, offset: 37032},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 989, col: 36, offset: 36871},
-								name: "SubscriptTextElement",
+								pos:  position{line: 1029, col: 46, offset: 37041},
+								name: "DoubleQuoteItalicTextContent",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 989, col: 58, offset: 36893},
-							val:        "~",
+							pos:        position{line: 1029, col: 76, offset: 37071},
+							val:        "__",
 							ignoreCase: false,
 						},
 					},
@@ -7538,193 +7587,3801 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "SubscriptTextElement",
-			pos:  position{line: 993, col: 1, offset: 36982},
-			expr: &choiceExpr{
-				pos: position{line: 993, col: 25, offset: 37006},
-				alternatives: []interface{}{
+			name: "DoubleQuoteItalicTextContent",
+			pos:  position{line: 1033, col: 1, offset: 37197},
+			expr: &seqExpr{
+				pos: position{line: 1033, col: 33, offset: 37229},
+				exprs: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 993, col: 25, offset: 37006},
-						name: "QuotedText",
+						pos:  position{line: 1033, col: 33, offset: 37229},
+						name: "DoubleQuoteItalicTextElement",
 					},
-					&ruleRefExpr{
-						pos:  position{line: 993, col: 38, offset: 37019},
-						name: "NonSubscriptText",
+					&zeroOrMoreExpr{
+						pos: position{line: 1033, col: 62, offset: 37258},
+						expr: &actionExpr{
+							pos: position{line: 1033, col: 63, offset: 37259},
+							run: (*parser).callonDoubleQuoteItalicTextContent4,
+							expr: &seqExpr{
+								pos: position{line: 1033, col: 63, offset: 37259},
+								exprs: []interface{}{
+									&notExpr{
+										pos: position{line: 1033, col: 63, offset: 37259},
+										expr: &litMatcher{
+											pos:        position{line: 1033, col: 65, offset: 37261},
+											val:        "__",
+											ignoreCase: false,
+										},
+									},
+									&labeledExpr{
+										pos:   position{line: 1033, col: 71, offset: 37267},
+										label: "element",
+										expr: &choiceExpr{
+											pos: position{line: 1033, col: 80, offset: 37276},
+											alternatives: []interface{}{
+												&ruleRefExpr{
+													pos:  position{line: 1033, col: 80, offset: 37276},
+													name: "WS",
+												},
+												&ruleRefExpr{
+													pos:  position{line: 1033, col: 85, offset: 37281},
+													name: "DoubleQuoteItalicTextElement",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "NonSubscriptText",
-			pos:  position{line: 995, col: 1, offset: 37038},
+			name: "DoubleQuoteItalicTextElement",
+			pos:  position{line: 1037, col: 1, offset: 37375},
 			expr: &actionExpr{
-				pos: position{line: 995, col: 21, offset: 37058},
-				run: (*parser).callonNonSubscriptText1,
-				expr: &oneOrMoreExpr{
-					pos: position{line: 995, col: 21, offset: 37058},
-					expr: &seqExpr{
-						pos: position{line: 995, col: 22, offset: 37059},
-						exprs: []interface{}{
-							&notExpr{
-								pos: position{line: 995, col: 22, offset: 37059},
-								expr: &ruleRefExpr{
-									pos:  position{line: 995, col: 23, offset: 37060},
-									name: "NEWLINE",
+				pos: position{line: 1037, col: 33, offset: 37407},
+				run: (*parser).callonDoubleQuoteItalicTextElement1,
+				expr: &seqExpr{
+					pos: position{line: 1037, col: 33, offset: 37407},
+					exprs: []interface{}{
+						&notExpr{
+							pos: position{line: 1037, col: 33, offset: 37407},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1037, col: 34, offset: 37408},
+								name: "NEWLINE",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1037, col: 42, offset: 37416},
+							label: "element",
+							expr: &choiceExpr{
+								pos: position{line: 1037, col: 51, offset: 37425},
+								alternatives: []interface{}{
+									&ruleRefExpr{
+										pos:  position{line: 1037, col: 51, offset: 37425},
+										name: "SingleQuoteItalicText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1038, col: 11, offset: 37458},
+										name: "BoldText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1039, col: 11, offset: 37478},
+										name: "CurvedQuotedText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1040, col: 11, offset: 37505},
+										name: "MonospaceText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1041, col: 11, offset: 37529},
+										name: "MarkedText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1042, col: 11, offset: 37550},
+										name: "SubscriptText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1043, col: 11, offset: 37574},
+										name: "SuperscriptText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1044, col: 11, offset: 37600},
+										name: "InlineImage",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1045, col: 11, offset: 37623},
+										name: "Link",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1046, col: 11, offset: 37639},
+										name: "Passthrough",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1047, col: 11, offset: 37662},
+										name: "NonDoubleQuoteItalicText",
+									},
 								},
 							},
-							&notExpr{
-								pos: position{line: 995, col: 31, offset: 37068},
-								expr: &ruleRefExpr{
-									pos:  position{line: 995, col: 32, offset: 37069},
-									name: "WS",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "NonDoubleQuoteItalicText",
+			pos:  position{line: 1051, col: 1, offset: 37820},
+			expr: &actionExpr{
+				pos: position{line: 1051, col: 29, offset: 37848},
+				run: (*parser).callonNonDoubleQuoteItalicText1,
+				expr: &seqExpr{
+					pos: position{line: 1051, col: 29, offset: 37848},
+					exprs: []interface{}{
+						&anyMatcher{
+							line: 1051, col: 30, offset: 37849,
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 1051, col: 33, offset: 37852},
+							expr: &seqExpr{
+								pos: position{line: 1051, col: 34, offset: 37853},
+								exprs: []interface{}{
+									&notExpr{
+										pos: position{line: 1051, col: 34, offset: 37853},
+										expr: &litMatcher{
+											pos:        position{line: 1051, col: 35, offset: 37854},
+											val:        "__",
+											ignoreCase: false,
+										},
+									},
+									&notExpr{
+										pos: position{line: 1051, col: 40, offset: 37859},
+										expr: &litMatcher{
+											pos:        position{line: 1051, col: 41, offset: 37860},
+											val:        "^",
+											ignoreCase: false,
+										},
+									},
+									&notExpr{
+										pos: position{line: 1051, col: 45, offset: 37864},
+										expr: &litMatcher{
+											pos:        position{line: 1051, col: 46, offset: 37865},
+											val:        "~",
+											ignoreCase: false,
+										},
+									},
+									&notExpr{
+										pos: position{line: 1051, col: 50, offset: 37869},
+										expr: &ruleRefExpr{
+											pos:  position{line: 1051, col: 51, offset: 37870},
+											name: "NEWLINE",
+										},
+									},
+									&anyMatcher{
+										line: 1051, col: 59, offset: 37878,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "SingleQuoteItalicText",
+			pos:  position{line: 1055, col: 1, offset: 37938},
+			expr: &choiceExpr{
+				pos: position{line: 1055, col: 26, offset: 37963},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 1055, col: 26, offset: 37963},
+						run: (*parser).callonSingleQuoteItalicText2,
+						expr: &seqExpr{
+							pos: position{line: 1055, col: 26, offset: 37963},
+							exprs: []interface{}{
+								&notExpr{
+									pos: position{line: 1055, col: 26, offset: 37963},
+									expr: &litMatcher{
+										pos:        position{line: 1055, col: 27, offset: 37964},
+										val:        "\\",
+										ignoreCase: false,
+									},
+								},
+								&notExpr{
+									pos: position{line: 1055, col: 31, offset: 37968},
+									expr: &litMatcher{
+										pos:        position{line: 1055, col: 32, offset: 37969},
+										val:        "__",
+										ignoreCase: false,
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1055, col: 37, offset: 37974},
+									val:        "_",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 1055, col: 41, offset: 37978},
+									label: "content",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1055, col: 50, offset: 37987},
+										name: "SingleQuoteItalicTextContent",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1055, col: 80, offset: 38017},
+									val:        "_",
+									ignoreCase: false,
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 1057, col: 5, offset: 38199},
+						run: (*parser).callonSingleQuoteItalicText12,
+						expr: &seqExpr{
+							pos: position{line: 1057, col: 5, offset: 38199},
+							exprs: []interface{}{
+								&notExpr{
+									pos: position{line: 1057, col: 5, offset: 38199},
+									expr: &litMatcher{
+										pos:        position{line: 1057, col: 6, offset: 38200},
+										val:        "\\\\",
+										ignoreCase: false,
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1057, col: 11, offset: 38205},
+									val:        "__",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 1057, col: 16, offset: 38210},
+									label: "content",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1057, col: 25, offset: 38219},
+										name: "SingleQuoteItalicTextContent",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1057, col: 55, offset: 38249},
+									val:        "_",
+									ignoreCase: false,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "SingleQuoteItalicTextContent",
+			pos:  position{line: 1061, col: 1, offset: 38511},
+			expr: &seqExpr{
+				pos: position{line: 1061, col: 33, offset: 38543},
+				exprs: []interface{}{
+					&notExpr{
+						pos: position{line: 1061, col: 33, offset: 38543},
+						expr: &ruleRefExpr{
+							pos:  position{line: 1061, col: 34, offset: 38544},
+							name: "WS",
+						},
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1061, col: 37, offset: 38547},
+						name: "SingleQuoteItalicTextElement",
+					},
+					&zeroOrMoreExpr{
+						pos: position{line: 1061, col: 66, offset: 38576},
+						expr: &actionExpr{
+							pos: position{line: 1061, col: 67, offset: 38577},
+							run: (*parser).callonSingleQuoteItalicTextContent6,
+							expr: &seqExpr{
+								pos: position{line: 1061, col: 67, offset: 38577},
+								exprs: []interface{}{
+									&notExpr{
+										pos: position{line: 1061, col: 67, offset: 38577},
+										expr: &seqExpr{
+											pos: position{line: 1061, col: 69, offset: 38579},
+											exprs: []interface{}{
+												&litMatcher{
+													pos:        position{line: 1061, col: 69, offset: 38579},
+													val:        "_",
+													ignoreCase: false,
+												},
+												&notExpr{
+													pos: position{line: 1061, col: 73, offset: 38583},
+													expr: &ruleRefExpr{
+														pos:  position{line: 1061, col: 74, offset: 38584},
+														name: "Alphanum",
+													},
+												},
+											},
+										},
+									},
+									&labeledExpr{
+										pos:   position{line: 1061, col: 84, offset: 38594},
+										label: "spaces",
+										expr: &zeroOrMoreExpr{
+											pos: position{line: 1061, col: 92, offset: 38602},
+											expr: &ruleRefExpr{
+												pos:  position{line: 1061, col: 92, offset: 38602},
+												name: "WS",
+											},
+										},
+									},
+									&labeledExpr{
+										pos:   position{line: 1061, col: 97, offset: 38607},
+										label: "element",
+										expr: &ruleRefExpr{
+											pos:  position{line: 1061, col: 106, offset: 38616},
+											name: "SingleQuoteItalicTextElement",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "SingleQuoteItalicTextElement",
+			pos:  position{line: 1065, col: 1, offset: 38709},
+			expr: &actionExpr{
+				pos: position{line: 1065, col: 33, offset: 38741},
+				run: (*parser).callonSingleQuoteItalicTextElement1,
+				expr: &seqExpr{
+					pos: position{line: 1065, col: 33, offset: 38741},
+					exprs: []interface{}{
+						&notExpr{
+							pos: position{line: 1065, col: 33, offset: 38741},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1065, col: 34, offset: 38742},
+								name: "NEWLINE",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1065, col: 42, offset: 38750},
+							label: "element",
+							expr: &choiceExpr{
+								pos: position{line: 1065, col: 51, offset: 38759},
+								alternatives: []interface{}{
+									&ruleRefExpr{
+										pos:  position{line: 1065, col: 51, offset: 38759},
+										name: "DoubleQuoteItalicText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1066, col: 11, offset: 38791},
+										name: "BoldText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1067, col: 11, offset: 38811},
+										name: "CurvedQuotedText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1068, col: 11, offset: 38838},
+										name: "MonospaceText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1069, col: 11, offset: 38862},
+										name: "MarkedText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1070, col: 11, offset: 38883},
+										name: "SubscriptText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1071, col: 11, offset: 38907},
+										name: "SuperscriptText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1072, col: 11, offset: 38933},
+										name: "InlineImage",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1073, col: 11, offset: 38956},
+										name: "Link",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1074, col: 11, offset: 38972},
+										name: "Passthrough",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1075, col: 11, offset: 38995},
+										name: "NonSingleQuoteItalicText",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "NonSingleQuoteItalicText",
+			pos:  position{line: 1079, col: 1, offset: 39153},
+			expr: &actionExpr{
+				pos: position{line: 1079, col: 29, offset: 39181},
+				run: (*parser).callonNonSingleQuoteItalicText1,
+				expr: &seqExpr{
+					pos: position{line: 1079, col: 29, offset: 39181},
+					exprs: []interface{}{
+						&anyMatcher{
+							line: 1079, col: 30, offset: 39182,
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 1079, col: 33, offset: 39185},
+							expr: &seqExpr{
+								pos: position{line: 1079, col: 34, offset: 39186},
+								exprs: []interface{}{
+									&notExpr{
+										pos: position{line: 1079, col: 34, offset: 39186},
+										expr: &litMatcher{
+											pos:        position{line: 1079, col: 35, offset: 39187},
+											val:        "_",
+											ignoreCase: false,
+										},
+									},
+									&notExpr{
+										pos: position{line: 1079, col: 39, offset: 39191},
+										expr: &ruleRefExpr{
+											pos:  position{line: 1079, col: 40, offset: 39192},
+											name: "WS",
+										},
+									},
+									&notExpr{
+										pos: position{line: 1079, col: 43, offset: 39195},
+										expr: &litMatcher{
+											pos:        position{line: 1079, col: 44, offset: 39196},
+											val:        "^",
+											ignoreCase: false,
+										},
+									},
+									&notExpr{
+										pos: position{line: 1079, col: 48, offset: 39200},
+										expr: &litMatcher{
+											pos:        position{line: 1079, col: 49, offset: 39201},
+											val:        "~",
+											ignoreCase: false,
+										},
+									},
+									&notExpr{
+										pos: position{line: 1079, col: 53, offset: 39205},
+										expr: &ruleRefExpr{
+											pos:  position{line: 1079, col: 54, offset: 39206},
+											name: "NEWLINE",
+										},
+									},
+									&anyMatcher{
+										line: 1079, col: 62, offset: 39214,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "EscapedItalicText",
+			pos:  position{line: 1083, col: 1, offset: 39274},
+			expr: &choiceExpr{
+				pos: position{line: 1084, col: 5, offset: 39300},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 1084, col: 5, offset: 39300},
+						run: (*parser).callonEscapedItalicText2,
+						expr: &seqExpr{
+							pos: position{line: 1084, col: 5, offset: 39300},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 1084, col: 5, offset: 39300},
+									label: "backslashes",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1084, col: 18, offset: 39313},
+										name: "TwoOrMoreBackslashes",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1084, col: 40, offset: 39335},
+									val:        "__",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 1084, col: 45, offset: 39340},
+									label: "content",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1084, col: 54, offset: 39349},
+										name: "DoubleQuoteItalicTextContent",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1084, col: 84, offset: 39379},
+									val:        "__",
+									ignoreCase: false,
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 1086, col: 9, offset: 39535},
+						run: (*parser).callonEscapedItalicText10,
+						expr: &seqExpr{
+							pos: position{line: 1086, col: 9, offset: 39535},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 1086, col: 9, offset: 39535},
+									label: "backslashes",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1086, col: 22, offset: 39548},
+										name: "OneOrMoreBackslashes",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1086, col: 44, offset: 39570},
+									val:        "__",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 1086, col: 49, offset: 39575},
+									label: "content",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1086, col: 58, offset: 39584},
+										name: "SingleQuoteItalicTextContent",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1086, col: 88, offset: 39614},
+									val:        "_",
+									ignoreCase: false,
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 1089, col: 9, offset: 39813},
+						run: (*parser).callonEscapedItalicText18,
+						expr: &seqExpr{
+							pos: position{line: 1089, col: 9, offset: 39813},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 1089, col: 9, offset: 39813},
+									label: "backslashes",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1089, col: 22, offset: 39826},
+										name: "OneOrMoreBackslashes",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1089, col: 44, offset: 39848},
+									val:        "_",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 1089, col: 48, offset: 39852},
+									label: "content",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1089, col: 57, offset: 39861},
+										name: "SingleQuoteItalicTextContent",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1089, col: 87, offset: 39891},
+									val:        "_",
+									ignoreCase: false,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "MonospaceText",
+			pos:  position{line: 1096, col: 1, offset: 40100},
+			expr: &choiceExpr{
+				pos: position{line: 1096, col: 18, offset: 40117},
+				alternatives: []interface{}{
+					&ruleRefExpr{
+						pos:  position{line: 1096, col: 18, offset: 40117},
+						name: "DoubleQuoteMonospaceText",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1096, col: 45, offset: 40144},
+						name: "SingleQuoteMonospaceText",
+					},
+				},
+			},
+		},
+		{
+			name: "DoubleQuoteMonospaceText",
+			pos:  position{line: 1098, col: 1, offset: 40170},
+			expr: &actionExpr{
+				pos: position{line: 1098, col: 29, offset: 40198},
+				run: (*parser).callonDoubleQuoteMonospaceText1,
+				expr: &seqExpr{
+					pos: position{line: 1098, col: 29, offset: 40198},
+					exprs: []interface{}{
+						&notExpr{
+							pos: position{line: 1098, col: 29, offset: 40198},
+							expr: &litMatcher{
+								pos:        position{line: 1098, col: 30, offset: 40199},
+								val:        "\\\\",
+								ignoreCase: false,
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 1098, col: 35, offset: 40204},
+							val:        "``",
+							ignoreCase: false,
+						},
+						&labeledExpr{
+							pos:   position{line: 1098, col: 40, offset: 40209},
+							label: "content",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1098, col: 49, offset: 40218},
+								name: "DoubleQuoteMonospaceTextContent",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 1098, col: 82, offset: 40251},
+							val:        "``",
+							ignoreCase: false,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "DoubleQuoteMonospaceTextContent",
+			pos:  position{line: 1102, col: 1, offset: 40380},
+			expr: &seqExpr{
+				pos: position{line: 1102, col: 36, offset: 40415},
+				exprs: []interface{}{
+					&ruleRefExpr{
+						pos:  position{line: 1102, col: 36, offset: 40415},
+						name: "DoubleQuoteMonospaceTextElement",
+					},
+					&zeroOrMoreExpr{
+						pos: position{line: 1102, col: 68, offset: 40447},
+						expr: &actionExpr{
+							pos: position{line: 1102, col: 69, offset: 40448},
+							run: (*parser).callonDoubleQuoteMonospaceTextContent4,
+							expr: &seqExpr{
+								pos: position{line: 1102, col: 69, offset: 40448},
+								exprs: []interface{}{
+									&notExpr{
+										pos: position{line: 1102, col: 69, offset: 40448},
+										expr: &litMatcher{
+											pos:        position{line: 1102, col: 71, offset: 40450},
+											val:        "``",
+											ignoreCase: false,
+										},
+									},
+									&labeledExpr{
+										pos:   position{line: 1102, col: 77, offset: 40456},
+										label: "element",
+										expr: &choiceExpr{
+											pos: position{line: 1102, col: 86, offset: 40465},
+											alternatives: []interface{}{
+												&ruleRefExpr{
+													pos:  position{line: 1102, col: 86, offset: 40465},
+													name: "WS",
+												},
+												&ruleRefExpr{
+													pos:  position{line: 1102, col: 91, offset: 40470},
+													name: "DoubleQuoteMonospaceTextElement",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "DoubleQuoteMonospaceTextElement",
+			pos:  position{line: 1106, col: 1, offset: 40567},
+			expr: &actionExpr{
+				pos: position{line: 1106, col: 36, offset: 40602},
+				run: (*parser).callonDoubleQuoteMonospaceTextElement1,
+				expr: &seqExpr{
+					pos: position{line: 1106, col: 36, offset: 40602},
+					exprs: []interface{}{
+						&notExpr{
+							pos: position{line: 1106, col: 36, offset: 40602},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1106, col: 37, offset: 40603},
+								name: "NEWLINE",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1106, col: 45, offset: 40611},
+							label: "element",
+							expr: &choiceExpr{
+								pos: position{line: 1106, col: 54, offset: 40620},
+								alternatives: []interface{}{
+									&ruleRefExpr{
+										pos:  position{line: 1106, col: 54, offset: 40620},
+										name: "SingleQuoteMonospaceText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1107, col: 11, offset: 40656},
+										name: "BoldText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1108, col: 11, offset: 40675},
+										name: "ItalicText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1109, col: 11, offset: 40697},
+										name: "MarkedText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1110, col: 11, offset: 40718},
+										name: "SubscriptText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1111, col: 11, offset: 40742},
+										name: "SuperscriptText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1112, col: 11, offset: 40768},
+										name: "InlineImage",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1113, col: 11, offset: 40791},
+										name: "Link",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1114, col: 11, offset: 40807},
+										name: "Passthrough",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1115, col: 11, offset: 40830},
+										name: "NonDoubleQuoteMonospaceText",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "NonDoubleQuoteMonospaceText",
+			pos:  position{line: 1119, col: 1, offset: 40991},
+			expr: &actionExpr{
+				pos: position{line: 1119, col: 32, offset: 41022},
+				run: (*parser).callonNonDoubleQuoteMonospaceText1,
+				expr: &seqExpr{
+					pos: position{line: 1119, col: 32, offset: 41022},
+					exprs: []interface{}{
+						&anyMatcher{
+							line: 1119, col: 33, offset: 41023,
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 1119, col: 36, offset: 41026},
+							expr: &seqExpr{
+								pos: position{line: 1119, col: 37, offset: 41027},
+								exprs: []interface{}{
+									&notExpr{
+										pos: position{line: 1119, col: 37, offset: 41027},
+										expr: &litMatcher{
+											pos:        position{line: 1119, col: 38, offset: 41028},
+											val:        "``",
+											ignoreCase: false,
+										},
+									},
+									&notExpr{
+										pos: position{line: 1119, col: 43, offset: 41033},
+										expr: &ruleRefExpr{
+											pos:  position{line: 1119, col: 44, offset: 41034},
+											name: "WS",
+										},
+									},
+									&notExpr{
+										pos: position{line: 1119, col: 47, offset: 41037},
+										expr: &litMatcher{
+											pos:        position{line: 1119, col: 48, offset: 41038},
+											val:        "^",
+											ignoreCase: false,
+										},
+									},
+									&notExpr{
+										pos: position{line: 1119, col: 52, offset: 41042},
+										expr: &litMatcher{
+											pos:        position{line: 1119, col: 53, offset: 41043},
+											val:        "~",
+											ignoreCase: false,
+										},
+									},
+									&notExpr{
+										pos: position{line: 1119, col: 57, offset: 41047},
+										expr: &ruleRefExpr{
+											pos:  position{line: 1119, col: 58, offset: 41048},
+											name: "NEWLINE",
+										},
+									},
+									&anyMatcher{
+										line: 1119, col: 66, offset: 41056,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "SingleQuoteMonospaceText",
+			pos:  position{line: 1123, col: 1, offset: 41116},
+			expr: &choiceExpr{
+				pos: position{line: 1123, col: 29, offset: 41144},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 1123, col: 29, offset: 41144},
+						run: (*parser).callonSingleQuoteMonospaceText2,
+						expr: &seqExpr{
+							pos: position{line: 1123, col: 29, offset: 41144},
+							exprs: []interface{}{
+								&notExpr{
+									pos: position{line: 1123, col: 29, offset: 41144},
+									expr: &litMatcher{
+										pos:        position{line: 1123, col: 30, offset: 41145},
+										val:        "\\",
+										ignoreCase: false,
+									},
+								},
+								&notExpr{
+									pos: position{line: 1123, col: 34, offset: 41149},
+									expr: &litMatcher{
+										pos:        position{line: 1123, col: 35, offset: 41150},
+										val:        "``",
+										ignoreCase: false,
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1123, col: 40, offset: 41155},
+									val:        "`",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 1123, col: 44, offset: 41159},
+									label: "content",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1123, col: 53, offset: 41168},
+										name: "SingleQuoteMonospaceTextContent",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1123, col: 86, offset: 41201},
+									val:        "`",
+									ignoreCase: false,
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 1125, col: 5, offset: 41386},
+						run: (*parser).callonSingleQuoteMonospaceText12,
+						expr: &seqExpr{
+							pos: position{line: 1125, col: 5, offset: 41386},
+							exprs: []interface{}{
+								&notExpr{
+									pos: position{line: 1125, col: 5, offset: 41386},
+									expr: &litMatcher{
+										pos:        position{line: 1125, col: 6, offset: 41387},
+										val:        "\\\\",
+										ignoreCase: false,
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1125, col: 11, offset: 41392},
+									val:        "``",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 1125, col: 16, offset: 41397},
+									label: "content",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1125, col: 25, offset: 41406},
+										name: "SingleQuoteMonospaceTextContent",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1125, col: 58, offset: 41439},
+									val:        "`",
+									ignoreCase: false,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "SingleQuoteMonospaceTextContent",
+			pos:  position{line: 1129, col: 1, offset: 41707},
+			expr: &seqExpr{
+				pos: position{line: 1129, col: 36, offset: 41742},
+				exprs: []interface{}{
+					&notExpr{
+						pos: position{line: 1129, col: 36, offset: 41742},
+						expr: &ruleRefExpr{
+							pos:  position{line: 1129, col: 37, offset: 41743},
+							name: "WS",
+						},
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1129, col: 40, offset: 41746},
+						name: "SingleQuoteMonospaceTextElement",
+					},
+					&zeroOrMoreExpr{
+						pos: position{line: 1129, col: 72, offset: 41778},
+						expr: &actionExpr{
+							pos: position{line: 1129, col: 73, offset: 41779},
+							run: (*parser).callonSingleQuoteMonospaceTextContent6,
+							expr: &seqExpr{
+								pos: position{line: 1129, col: 73, offset: 41779},
+								exprs: []interface{}{
+									&notExpr{
+										pos: position{line: 1129, col: 73, offset: 41779},
+										expr: &seqExpr{
+											pos: position{line: 1129, col: 75, offset: 41781},
+											exprs: []interface{}{
+												&litMatcher{
+													pos:        position{line: 1129, col: 75, offset: 41781},
+													val:        "`",
+													ignoreCase: false,
+												},
+												&notExpr{
+													pos: position{line: 1129, col: 79, offset: 41785},
+													expr: &ruleRefExpr{
+														pos:  position{line: 1129, col: 80, offset: 41786},
+														name: "Alphanum",
+													},
+												},
+											},
+										},
+									},
+									&labeledExpr{
+										pos:   position{line: 1129, col: 90, offset: 41796},
+										label: "spaces",
+										expr: &zeroOrMoreExpr{
+											pos: position{line: 1129, col: 98, offset: 41804},
+											expr: &ruleRefExpr{
+												pos:  position{line: 1129, col: 98, offset: 41804},
+												name: "WS",
+											},
+										},
+									},
+									&labeledExpr{
+										pos:   position{line: 1129, col: 103, offset: 41809},
+										label: "element",
+										expr: &ruleRefExpr{
+											pos:  position{line: 1129, col: 112, offset: 41818},
+											name: "SingleQuoteMonospaceTextElement",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "SingleQuoteMonospaceTextElement",
+			pos:  position{line: 1133, col: 1, offset: 41914},
+			expr: &actionExpr{
+				pos: position{line: 1133, col: 37, offset: 41950},
+				run: (*parser).callonSingleQuoteMonospaceTextElement1,
+				expr: &labeledExpr{
+					pos:   position{line: 1133, col: 37, offset: 41950},
+					label: "element",
+					expr: &choiceExpr{
+						pos: position{line: 1133, col: 46, offset: 41959},
+						alternatives: []interface{}{
+							&ruleRefExpr{
+								pos:  position{line: 1133, col: 46, offset: 41959},
+								name: "NEWLINE",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 1134, col: 11, offset: 41997},
+								name: "DoubleQuoteMonospaceText",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 1135, col: 11, offset: 42033},
+								name: "BoldText",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 1136, col: 11, offset: 42053},
+								name: "ItalicText",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 1137, col: 11, offset: 42074},
+								name: "MarkedText",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 1138, col: 11, offset: 42095},
+								name: "SubscriptText",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 1139, col: 11, offset: 42119},
+								name: "SuperscriptText",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 1140, col: 11, offset: 42145},
+								name: "InlineImage",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 1141, col: 11, offset: 42168},
+								name: "Link",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 1142, col: 11, offset: 42184},
+								name: "Passthrough",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 1143, col: 11, offset: 42207},
+								name: "NonSingleQuoteMonospaceText",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "NonSingleQuoteMonospaceText",
+			pos:  position{line: 1147, col: 1, offset: 42368},
+			expr: &actionExpr{
+				pos: position{line: 1147, col: 32, offset: 42399},
+				run: (*parser).callonNonSingleQuoteMonospaceText1,
+				expr: &seqExpr{
+					pos: position{line: 1147, col: 32, offset: 42399},
+					exprs: []interface{}{
+						&anyMatcher{
+							line: 1147, col: 33, offset: 42400,
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 1147, col: 36, offset: 42403},
+							expr: &seqExpr{
+								pos: position{line: 1147, col: 37, offset: 42404},
+								exprs: []interface{}{
+									&notExpr{
+										pos: position{line: 1147, col: 37, offset: 42404},
+										expr: &ruleRefExpr{
+											pos:  position{line: 1147, col: 38, offset: 42405},
+											name: "WS",
+										},
+									},
+									&notExpr{
+										pos: position{line: 1147, col: 41, offset: 42408},
+										expr: &litMatcher{
+											pos:        position{line: 1147, col: 42, offset: 42409},
+											val:        "`",
+											ignoreCase: false,
+										},
+									},
+									&notExpr{
+										pos: position{line: 1147, col: 46, offset: 42413},
+										expr: &litMatcher{
+											pos:        position{line: 1147, col: 47, offset: 42414},
+											val:        "^",
+											ignoreCase: false,
+										},
+									},
+									&notExpr{
+										pos: position{line: 1147, col: 51, offset: 42418},
+										expr: &litMatcher{
+											pos:        position{line: 1147, col: 52, offset: 42419},
+											val:        "~",
+											ignoreCase: false,
+										},
+									},
+									&notExpr{
+										pos: position{line: 1147, col: 56, offset: 42423},
+										expr: &ruleRefExpr{
+											pos:  position{line: 1147, col: 57, offset: 42424},
+											name: "NEWLINE",
+										},
+									},
+									&anyMatcher{
+										line: 1147, col: 65, offset: 42432,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "EscapedMonospaceText",
+			pos:  position{line: 1151, col: 1, offset: 42513},
+			expr: &choiceExpr{
+				pos: position{line: 1152, col: 5, offset: 42542},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 1152, col: 5, offset: 42542},
+						run: (*parser).callonEscapedMonospaceText2,
+						expr: &seqExpr{
+							pos: position{line: 1152, col: 5, offset: 42542},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 1152, col: 5, offset: 42542},
+									label: "backslashes",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1152, col: 18, offset: 42555},
+										name: "TwoOrMoreBackslashes",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1152, col: 40, offset: 42577},
+									val:        "``",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 1152, col: 45, offset: 42582},
+									label: "content",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1152, col: 54, offset: 42591},
+										name: "DoubleQuoteMonospaceTextContent",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1152, col: 87, offset: 42624},
+									val:        "``",
+									ignoreCase: false,
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 1154, col: 9, offset: 42780},
+						run: (*parser).callonEscapedMonospaceText10,
+						expr: &seqExpr{
+							pos: position{line: 1154, col: 9, offset: 42780},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 1154, col: 9, offset: 42780},
+									label: "backslashes",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1154, col: 22, offset: 42793},
+										name: "OneOrMoreBackslashes",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1154, col: 44, offset: 42815},
+									val:        "``",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 1154, col: 49, offset: 42820},
+									label: "content",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1154, col: 58, offset: 42829},
+										name: "SingleQuoteMonospaceTextContent",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1154, col: 91, offset: 42862},
+									val:        "`",
+									ignoreCase: false,
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 1157, col: 9, offset: 43061},
+						run: (*parser).callonEscapedMonospaceText18,
+						expr: &seqExpr{
+							pos: position{line: 1157, col: 9, offset: 43061},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 1157, col: 9, offset: 43061},
+									label: "backslashes",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1157, col: 22, offset: 43074},
+										name: "OneOrMoreBackslashes",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1157, col: 44, offset: 43096},
+									val:        "`",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 1157, col: 48, offset: 43100},
+									label: "content",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1157, col: 57, offset: 43109},
+										name: "SingleQuoteMonospaceTextContent",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1157, col: 90, offset: 43142},
+									val:        "`",
+									ignoreCase: false,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "MarkedText",
+			pos:  position{line: 1164, col: 1, offset: 43348},
+			expr: &choiceExpr{
+				pos: position{line: 1164, col: 15, offset: 43362},
+				alternatives: []interface{}{
+					&ruleRefExpr{
+						pos:  position{line: 1164, col: 15, offset: 43362},
+						name: "DoubleQuoteMarkedText",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1164, col: 39, offset: 43386},
+						name: "SingleQuoteMarkedText",
+					},
+				},
+			},
+		},
+		{
+			name: "DoubleQuoteMarkedText",
+			pos:  position{line: 1166, col: 1, offset: 43409},
+			expr: &actionExpr{
+				pos: position{line: 1166, col: 26, offset: 43434},
+				run: (*parser).callonDoubleQuoteMarkedText1,
+				expr: &seqExpr{
+					pos: position{line: 1166, col: 26, offset: 43434},
+					exprs: []interface{}{
+						&notExpr{
+							pos: position{line: 1166, col: 26, offset: 43434},
+							expr: &litMatcher{
+								pos:        position{line: 1166, col: 27, offset: 43435},
+								val:        "\\\\",
+								ignoreCase: false,
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 1166, col: 32, offset: 43440},
+							val:        "##",
+							ignoreCase: false,
+						},
+						&labeledExpr{
+							pos:   position{line: 1166, col: 37, offset: 43445},
+							label: "content",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1166, col: 46, offset: 43454},
+								name: "DoubleQuoteMarkedTextContent",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 1166, col: 76, offset: 43484},
+							val:        "##",
+							ignoreCase: false,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "DoubleQuoteMarkedTextContent",
+			pos:  position{line: 1170, col: 1, offset: 43610},
+			expr: &seqExpr{
+				pos: position{line: 1170, col: 33, offset: 43642},
+				exprs: []interface{}{
+					&ruleRefExpr{
+						pos:  position{line: 1170, col: 33, offset: 43642},
+						name: "DoubleQuoteMarkedTextElement",
+					},
+					&zeroOrMoreExpr{
+						pos: position{line: 1170, col: 62, offset: 43671},
+						expr: &actionExpr{
+							pos: position{line: 1170, col: 63, offset: 43672},
+							run: (*parser).callonDoubleQuoteMarkedTextContent4,
+							expr: &seqExpr{
+								pos: position{line: 1170, col: 63, offset: 43672},
+								exprs: []interface{}{
+									&notExpr{
+										pos: position{line: 1170, col: 63, offset: 43672},
+										expr: &litMatcher{
+											pos:        position{line: 1170, col: 65, offset: 43674},
+											val:        "##",
+											ignoreCase: false,
+										},
+									},
+									&labeledExpr{
+										pos:   position{line: 1170, col: 71, offset: 43680},
+										label: "element",
+										expr: &choiceExpr{
+											pos: position{line: 1170, col: 80, offset: 43689},
+											alternatives: []interface{}{
+												&ruleRefExpr{
+													pos:  position{line: 1170, col: 80, offset: 43689},
+													name: "WS",
+												},
+												&ruleRefExpr{
+													pos:  position{line: 1170, col: 85, offset: 43694},
+													name: "DoubleQuoteMarkedTextElement",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "DoubleQuoteMarkedTextElement",
+			pos:  position{line: 1174, col: 1, offset: 43788},
+			expr: &actionExpr{
+				pos: position{line: 1174, col: 33, offset: 43820},
+				run: (*parser).callonDoubleQuoteMarkedTextElement1,
+				expr: &seqExpr{
+					pos: position{line: 1174, col: 33, offset: 43820},
+					exprs: []interface{}{
+						&notExpr{
+							pos: position{line: 1174, col: 33, offset: 43820},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1174, col: 34, offset: 43821},
+								name: "NEWLINE",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1174, col: 42, offset: 43829},
+							label: "element",
+							expr: &choiceExpr{
+								pos: position{line: 1174, col: 51, offset: 43838},
+								alternatives: []interface{}{
+									&ruleRefExpr{
+										pos:  position{line: 1174, col: 51, offset: 43838},
+										name: "SingleQuoteMarkedText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1175, col: 11, offset: 43871},
+										name: "BoldText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1176, col: 11, offset: 43890},
+										name: "ItalicText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1177, col: 11, offset: 43912},
+										name: "MonospaceText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1178, col: 11, offset: 43937},
+										name: "SubscriptText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1179, col: 11, offset: 43961},
+										name: "SuperscriptText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1180, col: 11, offset: 43987},
+										name: "InlineImage",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1181, col: 11, offset: 44010},
+										name: "Link",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1182, col: 11, offset: 44026},
+										name: "Passthrough",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1183, col: 11, offset: 44049},
+										name: "NonDoubleQuoteMarkedText",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "NonDoubleQuoteMarkedText",
+			pos:  position{line: 1187, col: 1, offset: 44207},
+			expr: &actionExpr{
+				pos: position{line: 1187, col: 29, offset: 44235},
+				run: (*parser).callonNonDoubleQuoteMarkedText1,
+				expr: &seqExpr{
+					pos: position{line: 1187, col: 29, offset: 44235},
+					exprs: []interface{}{
+						&anyMatcher{
+							line: 1187, col: 30, offset: 44236,
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 1187, col: 33, offset: 44239},
+							expr: &seqExpr{
+								pos: position{line: 1187, col: 34, offset: 44240},
+								exprs: []interface{}{
+									&notExpr{
+										pos: position{line: 1187, col: 34, offset: 44240},
+										expr: &litMatcher{
+											pos:        position{line: 1187, col: 35, offset: 44241},
+											val:        "##",
+											ignoreCase: false,
+										},
+									},
+									&notExpr{
+										pos: position{line: 1187, col: 40, offset: 44246},
+										expr: &ruleRefExpr{
+											pos:  position{line: 1187, col: 41, offset: 44247},
+											name: "WS",
+										},
+									},
+									&notExpr{
+										pos: position{line: 1187, col: 44, offset: 44250},
+										expr: &litMatcher{
+											pos:        position{line: 1187, col: 45, offset: 44251},
+											val:        "^",
+											ignoreCase: false,
+										},
+									},
+									&notExpr{
+										pos: position{line: 1187, col: 49, offset: 44255},
+										expr: &litMatcher{
+											pos:        position{line: 1187, col: 50, offset: 44256},
+											val:        "~",
+											ignoreCase: false,
+										},
+									},
+									&notExpr{
+										pos: position{line: 1187, col: 54, offset: 44260},
+										expr: &ruleRefExpr{
+											pos:  position{line: 1187, col: 55, offset: 44261},
+											name: "NEWLINE",
+										},
+									},
+									&anyMatcher{
+										line: 1187, col: 63, offset: 44269,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "SingleQuoteMarkedText",
+			pos:  position{line: 1191, col: 1, offset: 44329},
+			expr: &choiceExpr{
+				pos: position{line: 1191, col: 26, offset: 44354},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 1191, col: 26, offset: 44354},
+						run: (*parser).callonSingleQuoteMarkedText2,
+						expr: &seqExpr{
+							pos: position{line: 1191, col: 26, offset: 44354},
+							exprs: []interface{}{
+								&notExpr{
+									pos: position{line: 1191, col: 26, offset: 44354},
+									expr: &litMatcher{
+										pos:        position{line: 1191, col: 27, offset: 44355},
+										val:        "\\",
+										ignoreCase: false,
+									},
+								},
+								&notExpr{
+									pos: position{line: 1191, col: 31, offset: 44359},
+									expr: &litMatcher{
+										pos:        position{line: 1191, col: 32, offset: 44360},
+										val:        "##",
+										ignoreCase: false,
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1191, col: 37, offset: 44365},
+									val:        "#",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 1191, col: 41, offset: 44369},
+									label: "content",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1191, col: 50, offset: 44378},
+										name: "SingleQuoteMarkedTextContent",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1191, col: 80, offset: 44408},
+									val:        "#",
+									ignoreCase: false,
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 1193, col: 5, offset: 44585},
+						run: (*parser).callonSingleQuoteMarkedText12,
+						expr: &seqExpr{
+							pos: position{line: 1193, col: 5, offset: 44585},
+							exprs: []interface{}{
+								&notExpr{
+									pos: position{line: 1193, col: 5, offset: 44585},
+									expr: &litMatcher{
+										pos:        position{line: 1193, col: 6, offset: 44586},
+										val:        "\\\\",
+										ignoreCase: false,
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1193, col: 11, offset: 44591},
+									val:        "##",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 1193, col: 16, offset: 44596},
+									label: "content",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1193, col: 25, offset: 44605},
+										name: "SingleQuoteMarkedTextContent",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1193, col: 55, offset: 44635},
+									val:        "#",
+									ignoreCase: false,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "SingleQuoteMarkedTextContent",
+			pos:  position{line: 1197, col: 1, offset: 44897},
+			expr: &seqExpr{
+				pos: position{line: 1197, col: 33, offset: 44929},
+				exprs: []interface{}{
+					&notExpr{
+						pos: position{line: 1197, col: 33, offset: 44929},
+						expr: &ruleRefExpr{
+							pos:  position{line: 1197, col: 34, offset: 44930},
+							name: "WS",
+						},
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1197, col: 37, offset: 44933},
+						name: "SingleQuoteMarkedTextElement",
+					},
+					&zeroOrMoreExpr{
+						pos: position{line: 1197, col: 66, offset: 44962},
+						expr: &actionExpr{
+							pos: position{line: 1197, col: 67, offset: 44963},
+							run: (*parser).callonSingleQuoteMarkedTextContent6,
+							expr: &seqExpr{
+								pos: position{line: 1197, col: 67, offset: 44963},
+								exprs: []interface{}{
+									&notExpr{
+										pos: position{line: 1197, col: 67, offset: 44963},
+										expr: &seqExpr{
+											pos: position{line: 1197, col: 69, offset: 44965},
+											exprs: []interface{}{
+												&litMatcher{
+													pos:        position{line: 1197, col: 69, offset: 44965},
+													val:        "#",
+													ignoreCase: false,
+												},
+												&notExpr{
+													pos: position{line: 1197, col: 73, offset: 44969},
+													expr: &ruleRefExpr{
+														pos:  position{line: 1197, col: 74, offset: 44970},
+														name: "Alphanum",
+													},
+												},
+											},
+										},
+									},
+									&labeledExpr{
+										pos:   position{line: 1197, col: 84, offset: 44980},
+										label: "spaces",
+										expr: &zeroOrMoreExpr{
+											pos: position{line: 1197, col: 92, offset: 44988},
+											expr: &ruleRefExpr{
+												pos:  position{line: 1197, col: 92, offset: 44988},
+												name: "WS",
+											},
+										},
+									},
+									&labeledExpr{
+										pos:   position{line: 1197, col: 97, offset: 44993},
+										label: "element",
+										expr: &ruleRefExpr{
+											pos:  position{line: 1197, col: 106, offset: 45002},
+											name: "SingleQuoteMarkedTextElement",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "SingleQuoteMarkedTextElement",
+			pos:  position{line: 1201, col: 1, offset: 45095},
+			expr: &actionExpr{
+				pos: position{line: 1201, col: 33, offset: 45127},
+				run: (*parser).callonSingleQuoteMarkedTextElement1,
+				expr: &seqExpr{
+					pos: position{line: 1201, col: 33, offset: 45127},
+					exprs: []interface{}{
+						&notExpr{
+							pos: position{line: 1201, col: 33, offset: 45127},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1201, col: 34, offset: 45128},
+								name: "NEWLINE",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1201, col: 42, offset: 45136},
+							label: "element",
+							expr: &choiceExpr{
+								pos: position{line: 1201, col: 51, offset: 45145},
+								alternatives: []interface{}{
+									&ruleRefExpr{
+										pos:  position{line: 1201, col: 51, offset: 45145},
+										name: "DoubleQuoteMarkedText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1202, col: 11, offset: 45177},
+										name: "BoldText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1203, col: 11, offset: 45197},
+										name: "ItalicText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1204, col: 11, offset: 45218},
+										name: "MonospaceText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1205, col: 11, offset: 45242},
+										name: "SubscriptText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1206, col: 11, offset: 45266},
+										name: "SuperscriptText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1207, col: 11, offset: 45292},
+										name: "InlineImage",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1208, col: 11, offset: 45315},
+										name: "Link",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1209, col: 11, offset: 45331},
+										name: "Passthrough",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1210, col: 11, offset: 45354},
+										name: "NonSingleQuoteMarkedText",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "NonSingleQuoteMarkedText",
+			pos:  position{line: 1214, col: 1, offset: 45512},
+			expr: &actionExpr{
+				pos: position{line: 1214, col: 29, offset: 45540},
+				run: (*parser).callonNonSingleQuoteMarkedText1,
+				expr: &seqExpr{
+					pos: position{line: 1214, col: 29, offset: 45540},
+					exprs: []interface{}{
+						&anyMatcher{
+							line: 1214, col: 30, offset: 45541,
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 1214, col: 33, offset: 45544},
+							expr: &seqExpr{
+								pos: position{line: 1214, col: 34, offset: 45545},
+								exprs: []interface{}{
+									&notExpr{
+										pos: position{line: 1214, col: 34, offset: 45545},
+										expr: &litMatcher{
+											pos:        position{line: 1214, col: 35, offset: 45546},
+											val:        "#",
+											ignoreCase: false,
+										},
+									},
+									&notExpr{
+										pos: position{line: 1214, col: 39, offset: 45550},
+										expr: &ruleRefExpr{
+											pos:  position{line: 1214, col: 40, offset: 45551},
+											name: "WS",
+										},
+									},
+									&notExpr{
+										pos: position{line: 1214, col: 43, offset: 45554},
+										expr: &litMatcher{
+											pos:        position{line: 1214, col: 44, offset: 45555},
+											val:        "^",
+											ignoreCase: false,
+										},
+									},
+									&notExpr{
+										pos: position{line: 1214, col: 48, offset: 45559},
+										expr: &litMatcher{
+											pos:        position{line: 1214, col: 49, offset: 45560},
+											val:        "~",
+											ignoreCase: false,
+										},
+									},
+									&notExpr{
+										pos: position{line: 1214, col: 53, offset: 45564},
+										expr: &ruleRefExpr{
+											pos:  position{line: 1214, col: 54, offset: 45565},
+											name: "NEWLINE",
+										},
+									},
+									&anyMatcher{
+										line: 1214, col: 62, offset: 45573,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "EscapedMarkedText",
+			pos:  position{line: 1218, col: 1, offset: 45633},
+			expr: &choiceExpr{
+				pos: position{line: 1219, col: 5, offset: 45659},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 1219, col: 5, offset: 45659},
+						run: (*parser).callonEscapedMarkedText2,
+						expr: &seqExpr{
+							pos: position{line: 1219, col: 5, offset: 45659},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 1219, col: 5, offset: 45659},
+									label: "backslashes",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1219, col: 18, offset: 45672},
+										name: "TwoOrMoreBackslashes",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1219, col: 40, offset: 45694},
+									val:        "##",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 1219, col: 45, offset: 45699},
+									label: "content",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1219, col: 54, offset: 45708},
+										name: "DoubleQuoteMarkedTextContent",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1219, col: 84, offset: 45738},
+									val:        "##",
+									ignoreCase: false,
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 1221, col: 9, offset: 45894},
+						run: (*parser).callonEscapedMarkedText10,
+						expr: &seqExpr{
+							pos: position{line: 1221, col: 9, offset: 45894},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 1221, col: 9, offset: 45894},
+									label: "backslashes",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1221, col: 22, offset: 45907},
+										name: "OneOrMoreBackslashes",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1221, col: 44, offset: 45929},
+									val:        "##",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 1221, col: 49, offset: 45934},
+									label: "content",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1221, col: 58, offset: 45943},
+										name: "SingleQuoteMarkedTextContent",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1221, col: 88, offset: 45973},
+									val:        "#",
+									ignoreCase: false,
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 1224, col: 9, offset: 46172},
+						run: (*parser).callonEscapedMarkedText18,
+						expr: &seqExpr{
+							pos: position{line: 1224, col: 9, offset: 46172},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 1224, col: 9, offset: 46172},
+									label: "backslashes",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1224, col: 22, offset: 46185},
+										name: "OneOrMoreBackslashes",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1224, col: 44, offset: 46207},
+									val:        "#",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 1224, col: 48, offset: 46211},
+									label: "content",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1224, col: 57, offset: 46220},
+										name: "SingleQuoteMarkedTextContent",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1224, col: 87, offset: 46250},
+									val:        "#",
+									ignoreCase: false,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "CurvedQuotedText",
+			pos:  position{line: 1231, col: 1, offset: 46458},
+			expr: &choiceExpr{
+				pos: position{line: 1231, col: 21, offset: 46478},
+				alternatives: []interface{}{
+					&ruleRefExpr{
+						pos:  position{line: 1231, col: 21, offset: 46478},
+						name: "DoubleCurvedQuoteText",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1231, col: 45, offset: 46502},
+						name: "SingleCurvedQuoteText",
+					},
+				},
+			},
+		},
+		{
+			name: "DoubleCurvedQuoteText",
+			pos:  position{line: 1233, col: 1, offset: 46525},
+			expr: &actionExpr{
+				pos: position{line: 1233, col: 26, offset: 46550},
+				run: (*parser).callonDoubleCurvedQuoteText1,
+				expr: &seqExpr{
+					pos: position{line: 1233, col: 26, offset: 46550},
+					exprs: []interface{}{
+						&notExpr{
+							pos: position{line: 1233, col: 26, offset: 46550},
+							expr: &litMatcher{
+								pos:        position{line: 1233, col: 27, offset: 46551},
+								val:        "\\",
+								ignoreCase: false,
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 1233, col: 31, offset: 46555},
+							val:        "\"`",
+							ignoreCase: false,
+						},
+						&labeledExpr{
+							pos:   position{line: 1233, col: 37, offset: 46561},
+							label: "content",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1233, col: 46, offset: 46570},
+								name: "CurvedQuoteTextContent",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 1233, col: 70, offset: 46594},
+							val:        "`\"",
+							ignoreCase: false,
+						},
+						&notExpr{
+							pos: position{line: 1233, col: 76, offset: 46600},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1233, col: 77, offset: 46601},
+								name: "Alphanum",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "SingleCurvedQuoteText",
+			pos:  position{line: 1237, col: 1, offset: 46696},
+			expr: &actionExpr{
+				pos: position{line: 1237, col: 26, offset: 46721},
+				run: (*parser).callonSingleCurvedQuoteText1,
+				expr: &seqExpr{
+					pos: position{line: 1237, col: 26, offset: 46721},
+					exprs: []interface{}{
+						&notExpr{
+							pos: position{line: 1237, col: 26, offset: 46721},
+							expr: &litMatcher{
+								pos:        position{line: 1237, col: 27, offset: 46722},
+								val:        "\\",
+								ignoreCase: false,
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 1237, col: 31, offset: 46726},
+							val:        "'`",
+							ignoreCase: false,
+						},
+						&labeledExpr{
+							pos:   position{line: 1237, col: 36, offset: 46731},
+							label: "content",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1237, col: 45, offset: 46740},
+								name: "CurvedQuoteTextContent",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 1237, col: 69, offset: 46764},
+							val:        "`'",
+							ignoreCase: false,
+						},
+						&notExpr{
+							pos: position{line: 1237, col: 74, offset: 46769},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1237, col: 75, offset: 46770},
+								name: "Alphanum",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "CurvedQuoteTextContent",
+			pos:  position{line: 1241, col: 1, offset: 46865},
+			expr: &seqExpr{
+				pos: position{line: 1241, col: 27, offset: 46891},
+				exprs: []interface{}{
+					&notExpr{
+						pos: position{line: 1241, col: 27, offset: 46891},
+						expr: &ruleRefExpr{
+							pos:  position{line: 1241, col: 28, offset: 46892},
+							name: "WS",
+						},
+					},
+					&oneOrMoreExpr{
+						pos: position{line: 1241, col: 31, offset: 46895},
+						expr: &actionExpr{
+							pos: position{line: 1241, col: 32, offset: 46896},
+							run: (*parser).callonCurvedQuoteTextContent5,
+							expr: &seqExpr{
+								pos: position{line: 1241, col: 32, offset: 46896},
+								exprs: []interface{}{
+									&notExpr{
+										pos: position{line: 1241, col: 32, offset: 46896},
+										expr: &litMatcher{
+											pos:        position{line: 1241, col: 33, offset: 46897},
+											val:        "`\"",
+											ignoreCase: false,
+										},
+									},
+									&notExpr{
+										pos: position{line: 1241, col: 39, offset: 46903},
+										expr: &litMatcher{
+											pos:        position{line: 1241, col: 40, offset: 46904},
+											val:        "`'",
+											ignoreCase: false,
+										},
+									},
+									&labeledExpr{
+										pos:   position{line: 1241, col: 45, offset: 46909},
+										label: "element",
+										expr: &ruleRefExpr{
+											pos:  position{line: 1241, col: 54, offset: 46918},
+											name: "CurvedQuoteTextElement",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "CurvedQuoteTextElement",
+			pos:  position{line: 1245, col: 1, offset: 46973},
+			expr: &actionExpr{
+				pos: position{line: 1245, col: 27, offset: 46999},
+				run: (*parser).callonCurvedQuoteTextElement1,
+				expr: &seqExpr{
+					pos: position{line: 1245, col: 27, offset: 46999},
+					exprs: []interface{}{
+						&notExpr{
+							pos: position{line: 1245, col: 27, offset: 46999},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1245, col: 28, offset: 47000},
+								name: "NEWLINE",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1245, col: 36, offset: 47008},
+							label: "element",
+							expr: &choiceExpr{
+								pos: position{line: 1245, col: 45, offset: 47017},
+								alternatives: []interface{}{
+									&ruleRefExpr{
+										pos:  position{line: 1245, col: 45, offset: 47017},
+										name: "WS",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1246, col: 11, offset: 47030},
+										name: "BoldText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1247, col: 11, offset: 47050},
+										name: "ItalicText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1248, col: 11, offset: 47071},
+										name: "MonospaceText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1249, col: 11, offset: 47095},
+										name: "MarkedText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1250, col: 11, offset: 47116},
+										name: "SubscriptText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1251, col: 11, offset: 47140},
+										name: "SuperscriptText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1252, col: 11, offset: 47166},
+										name: "InlineImage",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1253, col: 11, offset: 47189},
+										name: "Link",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1254, col: 11, offset: 47205},
+										name: "Passthrough",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1255, col: 11, offset: 47228},
+										name: "NonCurvedQuoteText",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "NonCurvedQuoteText",
+			pos:  position{line: 1259, col: 1, offset: 47277},
+			expr: &actionExpr{
+				pos: position{line: 1259, col: 23, offset: 47299},
+				run: (*parser).callonNonCurvedQuoteText1,
+				expr: &seqExpr{
+					pos: position{line: 1259, col: 23, offset: 47299},
+					exprs: []interface{}{
+						&anyMatcher{
+							line: 1259, col: 24, offset: 47300,
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 1259, col: 27, offset: 47303},
+							expr: &seqExpr{
+								pos: position{line: 1259, col: 28, offset: 47304},
+								exprs: []interface{}{
+									&notExpr{
+										pos: position{line: 1259, col: 28, offset: 47304},
+										expr: &litMatcher{
+											pos:        position{line: 1259, col: 29, offset: 47305},
+											val:        "`",
+											ignoreCase: false,
+										},
+									},
+									&notExpr{
+										pos: position{line: 1259, col: 33, offset: 47309},
+										expr: &ruleRefExpr{
+											pos:  position{line: 1259, col: 34, offset: 47310},
+											name: "WS",
+										},
+									},
+									&notExpr{
+										pos: position{line: 1259, col: 37, offset: 47313},
+										expr: &litMatcher{
+											pos:        position{line: 1259, col: 38, offset: 47314},
+											val:        "^",
+											ignoreCase: false,
+										},
+									},
+									&notExpr{
+										pos: position{line: 1259, col: 42, offset: 47318},
+										expr: &litMatcher{
+											pos:        position{line: 1259, col: 43, offset: 47319},
+											val:        "~",
+											ignoreCase: false,
+										},
+									},
+									&notExpr{
+										pos: position{line: 1259, col: 47, offset: 47323},
+										expr: &ruleRefExpr{
+											pos:  position{line: 1259, col: 48, offset: 47324},
+											name: "NEWLINE",
+										},
+									},
+									&anyMatcher{
+										line: 1259, col: 56, offset: 47332,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "SubscriptText",
+			pos:  position{line: 1263, col: 1, offset: 47392},
+			expr: &actionExpr{
+				pos: position{line: 1263, col: 18, offset: 47409},
+				run: (*parser).callonSubscriptText1,
+				expr: &seqExpr{
+					pos: position{line: 1263, col: 18, offset: 47409},
+					exprs: []interface{}{
+						&notExpr{
+							pos: position{line: 1263, col: 18, offset: 47409},
+							expr: &litMatcher{
+								pos:        position{line: 1263, col: 19, offset: 47410},
+								val:        "\\",
+								ignoreCase: false,
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 1263, col: 23, offset: 47414},
+							val:        "~",
+							ignoreCase: false,
+						},
+						&labeledExpr{
+							pos:   position{line: 1263, col: 27, offset: 47418},
+							label: "content",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1263, col: 36, offset: 47427},
+								name: "SubscriptTextElement",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 1263, col: 58, offset: 47449},
+							val:        "~",
+							ignoreCase: false,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "SubscriptTextElement",
+			pos:  position{line: 1267, col: 1, offset: 47538},
+			expr: &choiceExpr{
+				pos: position{line: 1267, col: 25, offset: 47562},
+				alternatives: []interface{}{
+					&ruleRefExpr{
+						pos:  position{line: 1267, col: 25, offset: 47562},
+						name: "QuotedText",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1267, col: 38, offset: 47575},
+						name: "NonSubscriptText",
+					},
+				},
+			},
+		},
+		{
+			name: "NonSubscriptText",
+			pos:  position{line: 1269, col: 1, offset: 47594},
+			expr: &actionExpr{
+				pos: position{line: 1269, col: 21, offset: 47614},
+				run: (*parser).callonNonSubscriptText1,
+				expr: &oneOrMoreExpr{
+					pos: position{line: 1269, col: 21, offset: 47614},
+					expr: &seqExpr{
+						pos: position{line: 1269, col: 22, offset: 47615},
+						exprs: []interface{}{
+							&notExpr{
+								pos: position{line: 1269, col: 22, offset: 47615},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1269, col: 23, offset: 47616},
+									name: "NEWLINE",
+								},
+							},
+							&notExpr{
+								pos: position{line: 1269, col: 31, offset: 47624},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1269, col: 32, offset: 47625},
+									name: "WS",
+								},
+							},
+							&notExpr{
+								pos: position{line: 1269, col: 35, offset: 47628},
+								expr: &litMatcher{
+									pos:        position{line: 1269, col: 36, offset: 47629},
+									val:        "~",
+									ignoreCase: false,
+								},
+							},
+							&anyMatcher{
+								line: 1269, col: 40, offset: 47633,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "EscapedSubscriptText",
+			pos:  position{line: 1273, col: 1, offset: 47666},
+			expr: &actionExpr{
+				pos: position{line: 1273, col: 25, offset: 47690},
+				run: (*parser).callonEscapedSubscriptText1,
+				expr: &seqExpr{
+					pos: position{line: 1273, col: 25, offset: 47690},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 1273, col: 25, offset: 47690},
+							label: "backslashes",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1273, col: 38, offset: 47703},
+								name: "OneOrMoreBackslashes",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 1273, col: 60, offset: 47725},
+							val:        "~",
+							ignoreCase: false,
+						},
+						&labeledExpr{
+							pos:   position{line: 1273, col: 64, offset: 47729},
+							label: "content",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1273, col: 73, offset: 47738},
+								name: "SubscriptTextElement",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 1273, col: 95, offset: 47760},
+							val:        "~",
+							ignoreCase: false,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "SuperscriptText",
+			pos:  position{line: 1277, col: 1, offset: 47889},
+			expr: &actionExpr{
+				pos: position{line: 1277, col: 20, offset: 47908},
+				run: (*parser).callonSuperscriptText1,
+				expr: &seqExpr{
+					pos: position{line: 1277, col: 20, offset: 47908},
+					exprs: []interface{}{
+						&notExpr{
+							pos: position{line: 1277, col: 20, offset: 47908},
+							expr: &litMatcher{
+								pos:        position{line: 1277, col: 21, offset: 47909},
+								val:        "\\",
+								ignoreCase: false,
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 1277, col: 25, offset: 47913},
+							val:        "^",
+							ignoreCase: false,
+						},
+						&labeledExpr{
+							pos:   position{line: 1277, col: 29, offset: 47917},
+							label: "content",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1277, col: 38, offset: 47926},
+								name: "SuperscriptTextElement",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 1277, col: 62, offset: 47950},
+							val:        "^",
+							ignoreCase: false,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "SuperscriptTextElement",
+			pos:  position{line: 1281, col: 1, offset: 48041},
+			expr: &choiceExpr{
+				pos: position{line: 1281, col: 27, offset: 48067},
+				alternatives: []interface{}{
+					&ruleRefExpr{
+						pos:  position{line: 1281, col: 27, offset: 48067},
+						name: "QuotedText",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1281, col: 40, offset: 48080},
+						name: "NonSuperscriptText",
+					},
+				},
+			},
+		},
+		{
+			name: "NonSuperscriptText",
+			pos:  position{line: 1283, col: 1, offset: 48101},
+			expr: &actionExpr{
+				pos: position{line: 1283, col: 23, offset: 48123},
+				run: (*parser).callonNonSuperscriptText1,
+				expr: &oneOrMoreExpr{
+					pos: position{line: 1283, col: 23, offset: 48123},
+					expr: &seqExpr{
+						pos: position{line: 1283, col: 24, offset: 48124},
+						exprs: []interface{}{
+							&notExpr{
+								pos: position{line: 1283, col: 24, offset: 48124},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1283, col: 25, offset: 48125},
+									name: "NEWLINE",
+								},
+							},
+							&notExpr{
+								pos: position{line: 1283, col: 33, offset: 48133},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1283, col: 34, offset: 48134},
+									name: "WS",
+								},
+							},
+							&notExpr{
+								pos: position{line: 1283, col: 37, offset: 48137},
+								expr: &litMatcher{
+									pos:        position{line: 1283, col: 38, offset: 48138},
+									val:        "^",
+									ignoreCase: false,
+								},
+							},
+							&anyMatcher{
+								line: 1283, col: 42, offset: 48142,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "EscapedSuperscriptText",
+			pos:  position{line: 1287, col: 1, offset: 48175},
+			expr: &actionExpr{
+				pos: position{line: 1287, col: 27, offset: 48201},
+				run: (*parser).callonEscapedSuperscriptText1,
+				expr: &seqExpr{
+					pos: position{line: 1287, col: 27, offset: 48201},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 1287, col: 27, offset: 48201},
+							label: "backslashes",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1287, col: 40, offset: 48214},
+								name: "OneOrMoreBackslashes",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 1287, col: 62, offset: 48236},
+							val:        "^",
+							ignoreCase: false,
+						},
+						&labeledExpr{
+							pos:   position{line: 1287, col: 66, offset: 48240},
+							label: "content",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1287, col: 75, offset: 48249},
+								name: "SuperscriptTextElement",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 1287, col: 99, offset: 48273},
+							val:        "^",
+							ignoreCase: false,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Passthrough",
+			pos:  position{line: 1294, col: 1, offset: 48509},
+			expr: &choiceExpr{
+				pos: position{line: 1294, col: 16, offset: 48524},
+				alternatives: []interface{}{
+					&ruleRefExpr{
+						pos:  position{line: 1294, col: 16, offset: 48524},
+						name: "TriplePlusPassthrough",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1294, col: 40, offset: 48548},
+						name: "SinglePlusPassthrough",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1294, col: 64, offset: 48572},
+						name: "PassthroughMacro",
+					},
+				},
+			},
+		},
+		{
+			name: "SinglePlusPassthroughPrefix",
+			pos:  position{line: 1296, col: 1, offset: 48590},
+			expr: &litMatcher{
+				pos:        position{line: 1296, col: 32, offset: 48621},
+				val:        "+",
+				ignoreCase: false,
+			},
+		},
+		{
+			name: "SinglePlusPassthrough",
+			pos:  position{line: 1298, col: 1, offset: 48626},
+			expr: &actionExpr{
+				pos: position{line: 1298, col: 26, offset: 48651},
+				run: (*parser).callonSinglePlusPassthrough1,
+				expr: &seqExpr{
+					pos: position{line: 1298, col: 26, offset: 48651},
+					exprs: []interface{}{
+						&ruleRefExpr{
+							pos:  position{line: 1298, col: 26, offset: 48651},
+							name: "SinglePlusPassthroughPrefix",
+						},
+						&labeledExpr{
+							pos:   position{line: 1298, col: 54, offset: 48679},
+							label: "content",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1298, col: 63, offset: 48688},
+								name: "SinglePlusPassthroughContent",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1298, col: 93, offset: 48718},
+							name: "SinglePlusPassthroughPrefix",
+						},
+						&notExpr{
+							pos: position{line: 1298, col: 121, offset: 48746},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1298, col: 122, offset: 48747},
+								name: "Alphanum",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "SinglePlusPassthroughContent",
+			pos:  position{line: 1302, col: 1, offset: 48846},
+			expr: &choiceExpr{
+				pos: position{line: 1302, col: 33, offset: 48878},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 1302, col: 34, offset: 48879},
+						run: (*parser).callonSinglePlusPassthroughContent2,
+						expr: &seqExpr{
+							pos: position{line: 1302, col: 34, offset: 48879},
+							exprs: []interface{}{
+								&seqExpr{
+									pos: position{line: 1302, col: 35, offset: 48880},
+									exprs: []interface{}{
+										&notExpr{
+											pos: position{line: 1302, col: 35, offset: 48880},
+											expr: &ruleRefExpr{
+												pos:  position{line: 1302, col: 36, offset: 48881},
+												name: "SinglePlusPassthroughPrefix",
+											},
+										},
+										&notExpr{
+											pos: position{line: 1302, col: 64, offset: 48909},
+											expr: &ruleRefExpr{
+												pos:  position{line: 1302, col: 65, offset: 48910},
+												name: "WS",
+											},
+										},
+										&notExpr{
+											pos: position{line: 1302, col: 68, offset: 48913},
+											expr: &ruleRefExpr{
+												pos:  position{line: 1302, col: 69, offset: 48914},
+												name: "NEWLINE",
+											},
+										},
+										&anyMatcher{
+											line: 1302, col: 77, offset: 48922,
+										},
+									},
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 1302, col: 80, offset: 48925},
+									expr: &seqExpr{
+										pos: position{line: 1302, col: 81, offset: 48926},
+										exprs: []interface{}{
+											&notExpr{
+												pos: position{line: 1302, col: 81, offset: 48926},
+												expr: &seqExpr{
+													pos: position{line: 1302, col: 83, offset: 48928},
+													exprs: []interface{}{
+														&oneOrMoreExpr{
+															pos: position{line: 1302, col: 83, offset: 48928},
+															expr: &ruleRefExpr{
+																pos:  position{line: 1302, col: 83, offset: 48928},
+																name: "WS",
+															},
+														},
+														&ruleRefExpr{
+															pos:  position{line: 1302, col: 87, offset: 48932},
+															name: "SinglePlusPassthroughPrefix",
+														},
+													},
+												},
+											},
+											&notExpr{
+												pos: position{line: 1302, col: 116, offset: 48961},
+												expr: &ruleRefExpr{
+													pos:  position{line: 1302, col: 117, offset: 48962},
+													name: "SinglePlusPassthroughPrefix",
+												},
+											},
+											&notExpr{
+												pos: position{line: 1302, col: 145, offset: 48990},
+												expr: &ruleRefExpr{
+													pos:  position{line: 1302, col: 146, offset: 48991},
+													name: "NEWLINE",
+												},
+											},
+											&anyMatcher{
+												line: 1302, col: 154, offset: 48999,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 1304, col: 7, offset: 49141},
+						run: (*parser).callonSinglePlusPassthroughContent24,
+						expr: &seqExpr{
+							pos: position{line: 1304, col: 8, offset: 49142},
+							exprs: []interface{}{
+								&notExpr{
+									pos: position{line: 1304, col: 8, offset: 49142},
+									expr: &ruleRefExpr{
+										pos:  position{line: 1304, col: 9, offset: 49143},
+										name: "WS",
+									},
+								},
+								&notExpr{
+									pos: position{line: 1304, col: 12, offset: 49146},
+									expr: &ruleRefExpr{
+										pos:  position{line: 1304, col: 13, offset: 49147},
+										name: "NEWLINE",
+									},
+								},
+								&notExpr{
+									pos: position{line: 1304, col: 21, offset: 49155},
+									expr: &ruleRefExpr{
+										pos:  position{line: 1304, col: 22, offset: 49156},
+										name: "SinglePlusPassthroughPrefix",
+									},
+								},
+								&anyMatcher{
+									line: 1304, col: 50, offset: 49184,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "TriplePlusPassthroughPrefix",
+			pos:  position{line: 1308, col: 1, offset: 49266},
+			expr: &litMatcher{
+				pos:        position{line: 1308, col: 32, offset: 49297},
+				val:        "+++",
+				ignoreCase: false,
+			},
+		},
+		{
+			name: "TriplePlusPassthrough",
+			pos:  position{line: 1310, col: 1, offset: 49304},
+			expr: &actionExpr{
+				pos: position{line: 1310, col: 26, offset: 49329},
+				run: (*parser).callonTriplePlusPassthrough1,
+				expr: &seqExpr{
+					pos: position{line: 1310, col: 26, offset: 49329},
+					exprs: []interface{}{
+						&ruleRefExpr{
+							pos:  position{line: 1310, col: 26, offset: 49329},
+							name: "TriplePlusPassthroughPrefix",
+						},
+						&labeledExpr{
+							pos:   position{line: 1310, col: 54, offset: 49357},
+							label: "content",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1310, col: 63, offset: 49366},
+								name: "TriplePlusPassthroughContent",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1310, col: 93, offset: 49396},
+							name: "TriplePlusPassthroughPrefix",
+						},
+						&notExpr{
+							pos: position{line: 1310, col: 121, offset: 49424},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1310, col: 122, offset: 49425},
+								name: "Alphanum",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "TriplePlusPassthroughContent",
+			pos:  position{line: 1314, col: 1, offset: 49524},
+			expr: &choiceExpr{
+				pos: position{line: 1314, col: 33, offset: 49556},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 1314, col: 34, offset: 49557},
+						run: (*parser).callonTriplePlusPassthroughContent2,
+						expr: &zeroOrMoreExpr{
+							pos: position{line: 1314, col: 34, offset: 49557},
+							expr: &seqExpr{
+								pos: position{line: 1314, col: 35, offset: 49558},
+								exprs: []interface{}{
+									&notExpr{
+										pos: position{line: 1314, col: 35, offset: 49558},
+										expr: &ruleRefExpr{
+											pos:  position{line: 1314, col: 36, offset: 49559},
+											name: "TriplePlusPassthroughPrefix",
+										},
+									},
+									&anyMatcher{
+										line: 1314, col: 64, offset: 49587,
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 1316, col: 7, offset: 49752},
+						run: (*parser).callonTriplePlusPassthroughContent8,
+						expr: &zeroOrOneExpr{
+							pos: position{line: 1316, col: 7, offset: 49752},
+							expr: &seqExpr{
+								pos: position{line: 1316, col: 8, offset: 49753},
+								exprs: []interface{}{
+									&notExpr{
+										pos: position{line: 1316, col: 8, offset: 49753},
+										expr: &ruleRefExpr{
+											pos:  position{line: 1316, col: 9, offset: 49754},
+											name: "WS",
+										},
+									},
+									&notExpr{
+										pos: position{line: 1316, col: 12, offset: 49757},
+										expr: &ruleRefExpr{
+											pos:  position{line: 1316, col: 13, offset: 49758},
+											name: "NEWLINE",
+										},
+									},
+									&notExpr{
+										pos: position{line: 1316, col: 21, offset: 49766},
+										expr: &ruleRefExpr{
+											pos:  position{line: 1316, col: 22, offset: 49767},
+											name: "TriplePlusPassthroughPrefix",
+										},
+									},
+									&anyMatcher{
+										line: 1316, col: 50, offset: 49795,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "PassthroughMacro",
+			pos:  position{line: 1320, col: 1, offset: 49878},
+			expr: &choiceExpr{
+				pos: position{line: 1320, col: 21, offset: 49898},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 1320, col: 21, offset: 49898},
+						run: (*parser).callonPassthroughMacro2,
+						expr: &seqExpr{
+							pos: position{line: 1320, col: 21, offset: 49898},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 1320, col: 21, offset: 49898},
+									val:        "pass:[",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 1320, col: 30, offset: 49907},
+									label: "content",
+									expr: &zeroOrMoreExpr{
+										pos: position{line: 1320, col: 38, offset: 49915},
+										expr: &ruleRefExpr{
+											pos:  position{line: 1320, col: 39, offset: 49916},
+											name: "PassthroughMacroCharacter",
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1320, col: 67, offset: 49944},
+									val:        "]",
+									ignoreCase: false,
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 1322, col: 5, offset: 50034},
+						run: (*parser).callonPassthroughMacro9,
+						expr: &seqExpr{
+							pos: position{line: 1322, col: 5, offset: 50034},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 1322, col: 5, offset: 50034},
+									val:        "pass:q[",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 1322, col: 15, offset: 50044},
+									label: "content",
+									expr: &zeroOrMoreExpr{
+										pos: position{line: 1322, col: 23, offset: 50052},
+										expr: &choiceExpr{
+											pos: position{line: 1322, col: 24, offset: 50053},
+											alternatives: []interface{}{
+												&ruleRefExpr{
+													pos:  position{line: 1322, col: 24, offset: 50053},
+													name: "QuotedText",
+												},
+												&ruleRefExpr{
+													pos:  position{line: 1322, col: 37, offset: 50066},
+													name: "PassthroughMacroCharacter",
+												},
+											},
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1322, col: 65, offset: 50094},
+									val:        "]",
+									ignoreCase: false,
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 1324, col: 5, offset: 50185},
+						run: (*parser).callonPassthroughMacro18,
+						expr: &seqExpr{
+							pos: position{line: 1324, col: 5, offset: 50185},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 1324, col: 5, offset: 50185},
+									val:        "pass:",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 1324, col: 13, offset: 50193},
+									label: "subs",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1324, col: 19, offset: 50199},
+										name: "PassthroughMacroSubstitutions",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1324, col: 50, offset: 50230},
+									val:        "[",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 1324, col: 54, offset: 50234},
+									label: "content",
+									expr: &actionExpr{
+										pos: position{line: 1324, col: 63, offset: 50243},
+										run: (*parser).callonPassthroughMacro25,
+										expr: &zeroOrMoreExpr{
+											pos: position{line: 1324, col: 63, offset: 50243},
+											expr: &seqExpr{
+												pos: position{line: 1324, col: 64, offset: 50244},
+												exprs: []interface{}{
+													&notExpr{
+														pos: position{line: 1324, col: 64, offset: 50244},
+														expr: &litMatcher{
+															pos:        position{line: 1324, col: 65, offset: 50245},
+															val:        "]",
+															ignoreCase: false,
+														},
+													},
+													&anyMatcher{
+														line: 1324, col: 69, offset: 50249,
+													},
+												},
+											},
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1326, col: 4, offset: 50308},
+									val:        "]",
+									ignoreCase: false,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "PassthroughMacroSubstitutions",
+			pos:  position{line: 1331, col: 1, offset: 50502},
+			expr: &actionExpr{
+				pos: position{line: 1331, col: 34, offset: 50535},
+				run: (*parser).callonPassthroughMacroSubstitutions1,
+				expr: &oneOrMoreExpr{
+					pos: position{line: 1331, col: 34, offset: 50535},
+					expr: &choiceExpr{
+						pos: position{line: 1331, col: 35, offset: 50536},
+						alternatives: []interface{}{
+							&charClassMatcher{
+								pos:        position{line: 1331, col: 35, offset: 50536},
+								val:        "[a-z]",
+								ranges:     []rune{'a', 'z'},
+								ignoreCase: false,
+								inverted:   false,
+							},
+							&litMatcher{
+								pos:        position{line: 1331, col: 43, offset: 50544},
+								val:        "_",
+								ignoreCase: false,
+							},
+							&litMatcher{
+								pos:        position{line: 1331, col: 49, offset: 50550},
+								val:        ",",
+								ignoreCase: false,
+							},
+							&litMatcher{
+								pos:        position{line: 1331, col: 55, offset: 50556},
+								val:        "+",
+								ignoreCase: false,
+							},
+							&litMatcher{
+								pos:        position{line: 1331, col: 61, offset: 50562},
+								val:        "-",
+								ignoreCase: false,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "PassthroughMacroCharacter",
+			pos:  position{line: 1335, col: 1, offset: 50604},
+			expr: &choiceExpr{
+				pos: position{line: 1335, col: 31, offset: 50634},
+				alternatives: []interface{}{
+					&ruleRefExpr{
+						pos:  position{line: 1335, col: 31, offset: 50634},
+						name: "Alphanums",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1335, col: 43, offset: 50646},
+						name: "Spaces",
+					},
+					&actionExpr{
+						pos: position{line: 1335, col: 52, offset: 50655},
+						run: (*parser).callonPassthroughMacroCharacter4,
+						expr: &seqExpr{
+							pos: position{line: 1335, col: 53, offset: 50656},
+							exprs: []interface{}{
+								&notExpr{
+									pos: position{line: 1335, col: 53, offset: 50656},
+									expr: &litMatcher{
+										pos:        position{line: 1335, col: 54, offset: 50657},
+										val:        "]",
+										ignoreCase: false,
+									},
+								},
+								&anyMatcher{
+									line: 1335, col: 58, offset: 50661,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "CrossReference",
+			pos:  position{line: 1342, col: 1, offset: 50831},
+			expr: &choiceExpr{
+				pos: position{line: 1342, col: 19, offset: 50849},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 1342, col: 19, offset: 50849},
+						run: (*parser).callonCrossReference2,
+						expr: &seqExpr{
+							pos: position{line: 1342, col: 19, offset: 50849},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 1342, col: 19, offset: 50849},
+									val:        "<<",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 1342, col: 24, offset: 50854},
+									label: "id",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1342, col: 28, offset: 50858},
+										name: "ID",
+									},
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 1342, col: 32, offset: 50862},
+									expr: &ruleRefExpr{
+										pos:  position{line: 1342, col: 32, offset: 50862},
+										name: "WS",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1342, col: 36, offset: 50866},
+									val:        ",",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 1342, col: 40, offset: 50870},
+									label: "label",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1342, col: 47, offset: 50877},
+										name: "CrossReferenceLabel",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1342, col: 68, offset: 50898},
+									val:        ">>",
+									ignoreCase: false,
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 1344, col: 5, offset: 50973},
+						run: (*parser).callonCrossReference13,
+						expr: &seqExpr{
+							pos: position{line: 1344, col: 5, offset: 50973},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 1344, col: 5, offset: 50973},
+									val:        "<<",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 1344, col: 10, offset: 50978},
+									label: "id",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1344, col: 14, offset: 50982},
+										name: "ID",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1344, col: 18, offset: 50986},
+									val:        ">>",
+									ignoreCase: false,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "CrossReferenceLabel",
+			pos:  position{line: 1348, col: 1, offset: 51049},
+			expr: &actionExpr{
+				pos: position{line: 1348, col: 24, offset: 51072},
+				run: (*parser).callonCrossReferenceLabel1,
+				expr: &oneOrMoreExpr{
+					pos: position{line: 1348, col: 24, offset: 51072},
+					expr: &choiceExpr{
+						pos: position{line: 1348, col: 25, offset: 51073},
+						alternatives: []interface{}{
+							&ruleRefExpr{
+								pos:  position{line: 1348, col: 25, offset: 51073},
+								name: "Alphanums",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 1348, col: 37, offset: 51085},
+								name: "Spaces",
+							},
+							&seqExpr{
+								pos: position{line: 1348, col: 47, offset: 51095},
+								exprs: []interface{}{
+									&notExpr{
+										pos: position{line: 1348, col: 47, offset: 51095},
+										expr: &litMatcher{
+											pos:        position{line: 1348, col: 48, offset: 51096},
+											val:        ">>",
+											ignoreCase: false,
+										},
+									},
+									&anyMatcher{
+										line: 1348, col: 54, offset: 51102,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Link",
+			pos:  position{line: 1355, col: 1, offset: 51244},
+			expr: &actionExpr{
+				pos: position{line: 1355, col: 9, offset: 51252},
+				run: (*parser).callonLink1,
+				expr: &labeledExpr{
+					pos:   position{line: 1355, col: 9, offset: 51252},
+					label: "link",
+					expr: &choiceExpr{
+						pos: position{line: 1355, col: 15, offset: 51258},
+						alternatives: []interface{}{
+							&ruleRefExpr{
+								pos:  position{line: 1355, col: 15, offset: 51258},
+								name: "RelativeLink",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 1355, col: 30, offset: 51273},
+								name: "ExternalLink",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "RelativeLink",
+			pos:  position{line: 1360, col: 1, offset: 51380},
+			expr: &actionExpr{
+				pos: position{line: 1360, col: 17, offset: 51396},
+				run: (*parser).callonRelativeLink1,
+				expr: &seqExpr{
+					pos: position{line: 1360, col: 17, offset: 51396},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 1360, col: 17, offset: 51396},
+							val:        "link:",
+							ignoreCase: false,
+						},
+						&labeledExpr{
+							pos:   position{line: 1360, col: 25, offset: 51404},
+							label: "url",
+							expr: &choiceExpr{
+								pos: position{line: 1360, col: 30, offset: 51409},
+								alternatives: []interface{}{
+									&ruleRefExpr{
+										pos:  position{line: 1360, col: 30, offset: 51409},
+										name: "Location",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1360, col: 41, offset: 51420},
+										name: "FileLocation",
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1360, col: 55, offset: 51434},
+							label: "inlineAttributes",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1360, col: 73, offset: 51452},
+								name: "LinkAttributes",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ExternalLink",
+			pos:  position{line: 1364, col: 1, offset: 51570},
+			expr: &actionExpr{
+				pos: position{line: 1364, col: 17, offset: 51586},
+				run: (*parser).callonExternalLink1,
+				expr: &seqExpr{
+					pos: position{line: 1364, col: 17, offset: 51586},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 1364, col: 17, offset: 51586},
+							label: "url",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1364, col: 22, offset: 51591},
+								name: "Location",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1364, col: 32, offset: 51601},
+							label: "inlineAttributes",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 1364, col: 49, offset: 51618},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1364, col: 50, offset: 51619},
+									name: "LinkAttributes",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "LinkAttributes",
+			pos:  position{line: 1368, col: 1, offset: 51712},
+			expr: &choiceExpr{
+				pos: position{line: 1368, col: 19, offset: 51730},
+				alternatives: []interface{}{
+					&ruleRefExpr{
+						pos:  position{line: 1368, col: 19, offset: 51730},
+						name: "TextOnlyLinkAttributes",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1368, col: 44, offset: 51755},
+						name: "TextAndMoreLinkAttributes",
+					},
+				},
+			},
+		},
+		{
+			name: "TextOnlyLinkAttributes",
+			pos:  position{line: 1370, col: 1, offset: 51782},
+			expr: &actionExpr{
+				pos: position{line: 1370, col: 27, offset: 51808},
+				run: (*parser).callonTextOnlyLinkAttributes1,
+				expr: &seqExpr{
+					pos: position{line: 1370, col: 27, offset: 51808},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 1370, col: 27, offset: 51808},
+							val:        "[",
+							ignoreCase: false,
+						},
+						&labeledExpr{
+							pos:   position{line: 1370, col: 31, offset: 51812},
+							label: "text",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 1370, col: 36, offset: 51817},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1370, col: 37, offset: 51818},
+									name: "LinkTextWithCommaAttribute",
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 1370, col: 66, offset: 51847},
+							val:        "]",
+							ignoreCase: false,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "LinkTextWithCommaAttribute",
+			pos:  position{line: 1374, col: 1, offset: 51909},
+			expr: &choiceExpr{
+				pos: position{line: 1376, col: 5, offset: 51981},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 1376, col: 5, offset: 51981},
+						run: (*parser).callonLinkTextWithCommaAttribute2,
+						expr: &seqExpr{
+							pos: position{line: 1376, col: 5, offset: 51981},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 1376, col: 5, offset: 51981},
+									val:        "\"",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 1376, col: 10, offset: 51986},
+									label: "elements",
+									expr: &oneOrMoreExpr{
+										pos: position{line: 1376, col: 19, offset: 51995},
+										expr: &seqExpr{
+											pos: position{line: 1376, col: 20, offset: 51996},
+											exprs: []interface{}{
+												&notExpr{
+													pos: position{line: 1376, col: 20, offset: 51996},
+													expr: &litMatcher{
+														pos:        position{line: 1376, col: 21, offset: 51997},
+														val:        "=",
+														ignoreCase: false,
+													},
+												},
+												&notExpr{
+													pos: position{line: 1376, col: 25, offset: 52001},
+													expr: &litMatcher{
+														pos:        position{line: 1376, col: 26, offset: 52002},
+														val:        "]",
+														ignoreCase: false,
+													},
+												},
+												&notExpr{
+													pos: position{line: 1376, col: 30, offset: 52006},
+													expr: &litMatcher{
+														pos:        position{line: 1376, col: 31, offset: 52007},
+														val:        "\"",
+														ignoreCase: false,
+													},
+												},
+												&choiceExpr{
+													pos: position{line: 1376, col: 37, offset: 52013},
+													alternatives: []interface{}{
+														&ruleRefExpr{
+															pos:  position{line: 1376, col: 37, offset: 52013},
+															name: "QuotedText",
+														},
+														&ruleRefExpr{
+															pos:  position{line: 1376, col: 50, offset: 52026},
+															name: "SimpleWord",
+														},
+														&ruleRefExpr{
+															pos:  position{line: 1376, col: 63, offset: 52039},
+															name: "Spaces",
+														},
+														&actionExpr{
+															pos: position{line: 1376, col: 73, offset: 52049},
+															run: (*parser).callonLinkTextWithCommaAttribute18,
+															expr: &seqExpr{
+																pos: position{line: 1376, col: 74, offset: 52050},
+																exprs: []interface{}{
+																	&notExpr{
+																		pos: position{line: 1376, col: 74, offset: 52050},
+																		expr: &ruleRefExpr{
+																			pos:  position{line: 1376, col: 75, offset: 52051},
+																			name: "QuotedTextPrefix",
+																		},
+																	},
+																	&anyMatcher{
+																		line: 1376, col: 92, offset: 52068,
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1378, col: 11, offset: 52137},
+									val:        "\"",
+									ignoreCase: false,
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 1378, col: 16, offset: 52142},
+									expr: &ruleRefExpr{
+										pos:  position{line: 1378, col: 16, offset: 52142},
+										name: "Spaces",
+									},
+								},
+								&andExpr{
+									pos: position{line: 1378, col: 24, offset: 52150},
+									expr: &notExpr{
+										pos: position{line: 1378, col: 26, offset: 52152},
+										expr: &litMatcher{
+											pos:        position{line: 1378, col: 27, offset: 52153},
+											val:        "=",
+											ignoreCase: false,
+										},
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 1382, col: 5, offset: 52278},
+						run: (*parser).callonLinkTextWithCommaAttribute29,
+						expr: &seqExpr{
+							pos: position{line: 1382, col: 5, offset: 52278},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 1382, col: 5, offset: 52278},
+									label: "elements",
+									expr: &oneOrMoreExpr{
+										pos: position{line: 1382, col: 14, offset: 52287},
+										expr: &seqExpr{
+											pos: position{line: 1382, col: 15, offset: 52288},
+											exprs: []interface{}{
+												&notExpr{
+													pos: position{line: 1382, col: 15, offset: 52288},
+													expr: &litMatcher{
+														pos:        position{line: 1382, col: 16, offset: 52289},
+														val:        "=",
+														ignoreCase: false,
+													},
+												},
+												&notExpr{
+													pos: position{line: 1382, col: 20, offset: 52293},
+													expr: &litMatcher{
+														pos:        position{line: 1382, col: 21, offset: 52294},
+														val:        "]",
+														ignoreCase: false,
+													},
+												},
+												&choiceExpr{
+													pos: position{line: 1382, col: 26, offset: 52299},
+													alternatives: []interface{}{
+														&ruleRefExpr{
+															pos:  position{line: 1382, col: 26, offset: 52299},
+															name: "QuotedText",
+														},
+														&ruleRefExpr{
+															pos:  position{line: 1382, col: 39, offset: 52312},
+															name: "SimpleWord",
+														},
+														&ruleRefExpr{
+															pos:  position{line: 1382, col: 52, offset: 52325},
+															name: "Spaces",
+														},
+														&actionExpr{
+															pos: position{line: 1382, col: 62, offset: 52335},
+															run: (*parser).callonLinkTextWithCommaAttribute42,
+															expr: &seqExpr{
+																pos: position{line: 1382, col: 63, offset: 52336},
+																exprs: []interface{}{
+																	&notExpr{
+																		pos: position{line: 1382, col: 63, offset: 52336},
+																		expr: &ruleRefExpr{
+																			pos:  position{line: 1382, col: 64, offset: 52337},
+																			name: "QuotedTextPrefix",
+																		},
+																	},
+																	&anyMatcher{
+																		line: 1382, col: 81, offset: 52354,
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								&andExpr{
+									pos: position{line: 1384, col: 11, offset: 52423},
+									expr: &notExpr{
+										pos: position{line: 1384, col: 13, offset: 52425},
+										expr: &litMatcher{
+											pos:        position{line: 1384, col: 14, offset: 52426},
+											val:        "=",
+											ignoreCase: false,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "TextAndMoreLinkAttributes",
+			pos:  position{line: 1389, col: 1, offset: 52506},
+			expr: &actionExpr{
+				pos: position{line: 1389, col: 30, offset: 52535},
+				run: (*parser).callonTextAndMoreLinkAttributes1,
+				expr: &seqExpr{
+					pos: position{line: 1389, col: 30, offset: 52535},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 1389, col: 30, offset: 52535},
+							val:        "[",
+							ignoreCase: false,
+						},
+						&labeledExpr{
+							pos:   position{line: 1389, col: 34, offset: 52539},
+							label: "text",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 1389, col: 39, offset: 52544},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1389, col: 40, offset: 52545},
+									name: "LinkTextAttribute",
+								},
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 1389, col: 60, offset: 52565},
+							expr: &litMatcher{
+								pos:        position{line: 1389, col: 60, offset: 52565},
+								val:        ",",
+								ignoreCase: false,
+							},
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 1389, col: 65, offset: 52570},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1389, col: 65, offset: 52570},
+								name: "WS",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1389, col: 69, offset: 52574},
+							label: "otherattrs",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 1389, col: 80, offset: 52585},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1389, col: 81, offset: 52586},
+									name: "GenericAttribute",
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 1389, col: 100, offset: 52605},
+							val:        "]",
+							ignoreCase: false,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "LinkTextAttribute",
+			pos:  position{line: 1393, col: 1, offset: 52690},
+			expr: &choiceExpr{
+				pos: position{line: 1395, col: 5, offset: 52753},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 1395, col: 5, offset: 52753},
+						run: (*parser).callonLinkTextAttribute2,
+						expr: &seqExpr{
+							pos: position{line: 1395, col: 5, offset: 52753},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 1395, col: 5, offset: 52753},
+									val:        "\"",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 1395, col: 10, offset: 52758},
+									label: "elements",
+									expr: &oneOrMoreExpr{
+										pos: position{line: 1395, col: 19, offset: 52767},
+										expr: &seqExpr{
+											pos: position{line: 1395, col: 20, offset: 52768},
+											exprs: []interface{}{
+												&notExpr{
+													pos: position{line: 1395, col: 20, offset: 52768},
+													expr: &litMatcher{
+														pos:        position{line: 1395, col: 21, offset: 52769},
+														val:        "=",
+														ignoreCase: false,
+													},
+												},
+												&notExpr{
+													pos: position{line: 1395, col: 25, offset: 52773},
+													expr: &litMatcher{
+														pos:        position{line: 1395, col: 26, offset: 52774},
+														val:        "]",
+														ignoreCase: false,
+													},
+												},
+												&notExpr{
+													pos: position{line: 1395, col: 30, offset: 52778},
+													expr: &litMatcher{
+														pos:        position{line: 1395, col: 31, offset: 52779},
+														val:        "\"",
+														ignoreCase: false,
+													},
+												},
+												&choiceExpr{
+													pos: position{line: 1395, col: 37, offset: 52785},
+													alternatives: []interface{}{
+														&ruleRefExpr{
+															pos:  position{line: 1395, col: 37, offset: 52785},
+															name: "QuotedText",
+														},
+														&ruleRefExpr{
+															pos:  position{line: 1395, col: 50, offset: 52798},
+															name: "SimpleWord",
+														},
+														&ruleRefExpr{
+															pos:  position{line: 1395, col: 63, offset: 52811},
+															name: "Spaces",
+														},
+														&actionExpr{
+															pos: position{line: 1395, col: 73, offset: 52821},
+															run: (*parser).callonLinkTextAttribute18,
+															expr: &seqExpr{
+																pos: position{line: 1395, col: 74, offset: 52822},
+																exprs: []interface{}{
+																	&notExpr{
+																		pos: position{line: 1395, col: 74, offset: 52822},
+																		expr: &ruleRefExpr{
+																			pos:  position{line: 1395, col: 75, offset: 52823},
+																			name: "QuotedTextPrefix",
+																		},
+																	},
+																	&anyMatcher{
+																		line: 1395, col: 92, offset: 52840,
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1397, col: 11, offset: 52909},
+									val:        "\"",
+									ignoreCase: false,
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 1397, col: 16, offset: 52914},
+									expr: &ruleRefExpr{
+										pos:  position{line: 1397, col: 16, offset: 52914},
+										name: "Spaces",
+									},
+								},
+								&andExpr{
+									pos: position{line: 1397, col: 24, offset: 52922},
+									expr: &notExpr{
+										pos: position{line: 1397, col: 26, offset: 52924},
+										expr: &litMatcher{
+											pos:        position{line: 1397, col: 27, offset: 52925},
+											val:        "=",
+											ignoreCase: false,
+										},
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 1401, col: 5, offset: 53050},
+						run: (*parser).callonLinkTextAttribute29,
+						expr: &seqExpr{
+							pos: position{line: 1401, col: 5, offset: 53050},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 1401, col: 5, offset: 53050},
+									label: "elements",
+									expr: &oneOrMoreExpr{
+										pos: position{line: 1401, col: 14, offset: 53059},
+										expr: &seqExpr{
+											pos: position{line: 1401, col: 15, offset: 53060},
+											exprs: []interface{}{
+												&notExpr{
+													pos: position{line: 1401, col: 15, offset: 53060},
+													expr: &litMatcher{
+														pos:        position{line: 1401, col: 16, offset: 53061},
+														val:        "=",
+														ignoreCase: false,
+													},
+												},
+												&notExpr{
+													pos: position{line: 1401, col: 20, offset: 53065},
+													expr: &litMatcher{
+														pos:        position{line: 1401, col: 21, offset: 53066},
+														val:        ",",
+														ignoreCase: false,
+													},
+												},
+												&notExpr{
+													pos: position{line: 1401, col: 25, offset: 53070},
+													expr: &litMatcher{
+														pos:        position{line: 1401, col: 26, offset: 53071},
+														val:        "]",
+														ignoreCase: false,
+													},
+												},
+												&choiceExpr{
+													pos: position{line: 1401, col: 31, offset: 53076},
+													alternatives: []interface{}{
+														&ruleRefExpr{
+															pos:  position{line: 1401, col: 31, offset: 53076},
+															name: "QuotedText",
+														},
+														&ruleRefExpr{
+															pos:  position{line: 1401, col: 44, offset: 53089},
+															name: "SimpleWord",
+														},
+														&ruleRefExpr{
+															pos:  position{line: 1401, col: 57, offset: 53102},
+															name: "Spaces",
+														},
+														&actionExpr{
+															pos: position{line: 1401, col: 67, offset: 53112},
+															run: (*parser).callonLinkTextAttribute44,
+															expr: &seqExpr{
+																pos: position{line: 1401, col: 68, offset: 53113},
+																exprs: []interface{}{
+																	&notExpr{
+																		pos: position{line: 1401, col: 68, offset: 53113},
+																		expr: &ruleRefExpr{
+																			pos:  position{line: 1401, col: 69, offset: 53114},
+																			name: "QuotedTextPrefix",
+																		},
+																	},
+																	&anyMatcher{
+																		line: 1401, col: 86, offset: 53131,
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
 								},
-							},
-							&notExpr{
-								pos: position{line: 995, col: 35, offset: 37072},
-								expr: &litMatcher{
-									pos:        position{line: 995, col: 36, offset: 37073},
-									val:        "~",
-									ignoreCase: false,
+								&andExpr{
+									pos: position{line: 1403, col: 11, offset: 53200},
+									expr: &notExpr{
+										pos: position{line: 1403, col: 13, offset: 53202},
+										expr: &litMatcher{
+											pos:        position{line: 1403, col: 14, offset: 53203},
+											val:        "=",
+											ignoreCase: false,
+										},
+									},
 								},
 							},
-							&anyMatcher{
-								line: 995, col: 40, offset: 37077,
-							},
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "EscapedSubscriptText",
-			pos:  position{line: 999, col: 1, offset: 37110},
+			name: "ImageBlock",
+			pos:  position{line: 1410, col: 1, offset: 53384},
 			expr: &actionExpr{
-				pos: position{line: 999, col: 25, offset: 37134},
-				run: (*parser).callonEscapedSubscriptText1,
+				pos: position{line: 1410, col: 15, offset: 53398},
+				run: (*parser).callonImageBlock1,
 				expr: &seqExpr{
-					pos: position{line: 999, col: 25, offset: 37134},
+					pos: position{line: 1410, col: 15, offset: 53398},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 999, col: 25, offset: 37134},
-							label: "backslashes",
-							expr: &ruleRefExpr{
-								pos:  position{line: 999, col: 38, offset: 37147},
-								name: "OneOrMoreBackslashes",
+							pos:   position{line: 1410, col: 15, offset: 53398},
+							label: "attributes",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 1410, col: 26, offset: 53409},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1410, col: 27, offset: 53410},
+									name: "ElementAttributes",
+								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 999, col: 60, offset: 37169},
-							val:        "~",
+							pos:        position{line: 1410, col: 47, offset: 53430},
+							val:        "image::",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 999, col: 64, offset: 37173},
-							label: "content",
+							pos:   position{line: 1410, col: 57, offset: 53440},
+							label: "path",
 							expr: &ruleRefExpr{
-								pos:  position{line: 999, col: 73, offset: 37182},
-								name: "SubscriptTextElement",
+								pos:  position{line: 1410, col: 63, offset: 53446},
+								name: "URL",
 							},
 						},
-						&litMatcher{
-							pos:        position{line: 999, col: 95, offset: 37204},
-							val:        "~",
-							ignoreCase: false,
+						&labeledExpr{
+							pos:   position{line: 1410, col: 68, offset: 53451},
+							label: "inlineAttributes",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1410, col: 86, offset: 53469},
+								name: "ImageAttributes",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1410, col: 103, offset: 53486},
+							name: "EOLS",
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "SuperscriptText",
-			pos:  position{line: 1003, col: 1, offset: 37333},
+			name: "InlineImage",
+			pos:  position{line: 1414, col: 1, offset: 53613},
 			expr: &actionExpr{
-				pos: position{line: 1003, col: 20, offset: 37352},
-				run: (*parser).callonSuperscriptText1,
+				pos: position{line: 1414, col: 16, offset: 53628},
+				run: (*parser).callonInlineImage1,
 				expr: &seqExpr{
-					pos: position{line: 1003, col: 20, offset: 37352},
+					pos: position{line: 1414, col: 16, offset: 53628},
 					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 1414, col: 16, offset: 53628},
+							val:        "image:",
+							ignoreCase: false,
+						},
 						&notExpr{
-							pos: position{line: 1003, col: 20, offset: 37352},
+							pos: position{line: 1414, col: 25, offset: 53637},
 							expr: &litMatcher{
-								pos:        position{line: 1003, col: 21, offset: 37353},
-								val:        "\\",
+								pos:        position{line: 1414, col: 26, offset: 53638},
+								val:        ":",
 								ignoreCase: false,
 							},
 						},
-						&litMatcher{
-							pos:        position{line: 1003, col: 25, offset: 37357},
-							val:        "^",
-							ignoreCase: false,
-						},
 						&labeledExpr{
-							pos:   position{line: 1003, col: 29, offset: 37361},
-							label: "content",
+							pos:   position{line: 1414, col: 30, offset: 53642},
+							label: "path",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1003, col: 38, offset: 37370},
-								name: "SuperscriptTextElement",
+								pos:  position{line: 1414, col: 36, offset: 53648},
+								name: "URL",
 							},
 						},
-						&litMatcher{
-							pos:        position{line: 1003, col: 62, offset: 37394},
-							val:        "^",
-							ignoreCase: false,
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "SuperscriptTextElement",
-			pos:  position{line: 1007, col: 1, offset: 37485},
-			expr: &choiceExpr{
-				pos: position{line: 1007, col: 27, offset: 37511},
-				alternatives: []interface{}{
-					&ruleRefExpr{
-						pos:  position{line: 1007, col: 27, offset: 37511},
-						name: "QuotedText",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 1007, col: 40, offset: 37524},
-						name: "NonSuperscriptText",
-					},
-				},
-			},
-		},
-		{
-			name: "NonSuperscriptText",
-			pos:  position{line: 1009, col: 1, offset: 37545},
-			expr: &actionExpr{
-				pos: position{line: 1009, col: 23, offset: 37567},
-				run: (*parser).callonNonSuperscriptText1,
-				expr: &oneOrMoreExpr{
-					pos: position{line: 1009, col: 23, offset: 37567},
-					expr: &seqExpr{
-						pos: position{line: 1009, col: 24, offset: 37568},
-						exprs: []interface{}{
-							&notExpr{
-								pos: position{line: 1009, col: 24, offset: 37568},
-								expr: &ruleRefExpr{
-									pos:  position{line: 1009, col: 25, offset: 37569},
-									name: "NEWLINE",
-								},
-							},
-							&notExpr{
-								pos: position{line: 1009, col: 33, offset: 37577},
-								expr: &ruleRefExpr{
-									pos:  position{line: 1009, col: 34, offset: 37578},
-									name: "WS",
-								},
-							},
-							&notExpr{
-								pos: position{line: 1009, col: 37, offset: 37581},
-								expr: &litMatcher{
-									pos:        position{line: 1009, col: 38, offset: 37582},
-									val:        "^",
-									ignoreCase: false,
-								},
-							},
-							&anyMatcher{
-								line: 1009, col: 42, offset: 37586,
+						&labeledExpr{
+							pos:   position{line: 1414, col: 41, offset: 53653},
+							label: "inlineAttributes",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1414, col: 59, offset: 53671},
+								name: "ImageAttributes",
 							},
 						},
 					},
@@ -7732,536 +11389,435 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "EscapedSuperscriptText",
-			pos:  position{line: 1013, col: 1, offset: 37619},
+			name: "ImageAttributes",
+			pos:  position{line: 1418, col: 1, offset: 53799},
 			expr: &actionExpr{
-				pos: position{line: 1013, col: 27, offset: 37645},
-				run: (*parser).callonEscapedSuperscriptText1,
+				pos: position{line: 1418, col: 20, offset: 53818},
+				run: (*parser).callonImageAttributes1,
 				expr: &seqExpr{
-					pos: position{line: 1013, col: 27, offset: 37645},
+					pos: position{line: 1418, col: 20, offset: 53818},
 					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 1013, col: 27, offset: 37645},
-							label: "backslashes",
-							expr: &ruleRefExpr{
-								pos:  position{line: 1013, col: 40, offset: 37658},
-								name: "OneOrMoreBackslashes",
-							},
-						},
 						&litMatcher{
-							pos:        position{line: 1013, col: 62, offset: 37680},
-							val:        "^",
+							pos:        position{line: 1418, col: 20, offset: 53818},
+							val:        "[",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 1013, col: 66, offset: 37684},
-							label: "content",
-							expr: &ruleRefExpr{
-								pos:  position{line: 1013, col: 75, offset: 37693},
-								name: "SuperscriptTextElement",
+							pos:   position{line: 1418, col: 24, offset: 53822},
+							label: "alt",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 1418, col: 28, offset: 53826},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1418, col: 29, offset: 53827},
+									name: "AttributeValue",
+								},
 							},
 						},
-						&litMatcher{
-							pos:        position{line: 1013, col: 99, offset: 37717},
-							val:        "^",
-							ignoreCase: false,
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "Passthrough",
-			pos:  position{line: 1020, col: 1, offset: 37953},
-			expr: &choiceExpr{
-				pos: position{line: 1020, col: 16, offset: 37968},
-				alternatives: []interface{}{
-					&ruleRefExpr{
-						pos:  position{line: 1020, col: 16, offset: 37968},
-						name: "TriplePlusPassthrough",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 1020, col: 40, offset: 37992},
-						name: "SinglePlusPassthrough",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 1020, col: 64, offset: 38016},
-						name: "PassthroughMacro",
-					},
-				},
-			},
-		},
-		{
-			name: "SinglePlusPassthroughPrefix",
-			pos:  position{line: 1022, col: 1, offset: 38034},
-			expr: &litMatcher{
-				pos:        position{line: 1022, col: 32, offset: 38065},
-				val:        "+",
-				ignoreCase: false,
-			},
-		},
-		{
-			name: "SinglePlusPassthrough",
-			pos:  position{line: 1024, col: 1, offset: 38070},
-			expr: &actionExpr{
-				pos: position{line: 1024, col: 26, offset: 38095},
-				run: (*parser).callonSinglePlusPassthrough1,
-				expr: &seqExpr{
-					pos: position{line: 1024, col: 26, offset: 38095},
-					exprs: []interface{}{
-						&ruleRefExpr{
-							pos:  position{line: 1024, col: 26, offset: 38095},
-							name: "SinglePlusPassthroughPrefix",
-						},
-						&labeledExpr{
-							pos:   position{line: 1024, col: 54, offset: 38123},
-							label: "content",
-							expr: &ruleRefExpr{
-								pos:  position{line: 1024, col: 63, offset: 38132},
-								name: "SinglePlusPassthroughContent",
+						&zeroOrOneExpr{
+							pos: position{line: 1418, col: 46, offset: 53844},
+							expr: &litMatcher{
+								pos:        position{line: 1418, col: 46, offset: 53844},
+								val:        ",",
+								ignoreCase: false,
 							},
 						},
-						&ruleRefExpr{
-							pos:  position{line: 1024, col: 93, offset: 38162},
-							name: "SinglePlusPassthroughPrefix",
-						},
-						&notExpr{
-							pos: position{line: 1024, col: 121, offset: 38190},
-							expr: &ruleRefExpr{
-								pos:  position{line: 1024, col: 122, offset: 38191},
-								name: "Alphanum",
+						&labeledExpr{
+							pos:   position{line: 1418, col: 51, offset: 53849},
+							label: "width",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 1418, col: 57, offset: 53855},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1418, col: 58, offset: 53856},
+									name: "AttributeValue",
+								},
 							},
 						},
-					},
-				},
-			},
-		},
-		{
-			name: "SinglePlusPassthroughContent",
-			pos:  position{line: 1028, col: 1, offset: 38290},
-			expr: &choiceExpr{
-				pos: position{line: 1028, col: 33, offset: 38322},
-				alternatives: []interface{}{
-					&actionExpr{
-						pos: position{line: 1028, col: 34, offset: 38323},
-						run: (*parser).callonSinglePlusPassthroughContent2,
-						expr: &seqExpr{
-							pos: position{line: 1028, col: 34, offset: 38323},
-							exprs: []interface{}{
-								&seqExpr{
-									pos: position{line: 1028, col: 35, offset: 38324},
-									exprs: []interface{}{
-										&notExpr{
-											pos: position{line: 1028, col: 35, offset: 38324},
-											expr: &ruleRefExpr{
-												pos:  position{line: 1028, col: 36, offset: 38325},
-												name: "SinglePlusPassthroughPrefix",
-											},
-										},
-										&notExpr{
-											pos: position{line: 1028, col: 64, offset: 38353},
-											expr: &ruleRefExpr{
-												pos:  position{line: 1028, col: 65, offset: 38354},
-												name: "WS",
-											},
-										},
-										&notExpr{
-											pos: position{line: 1028, col: 68, offset: 38357},
-											expr: &ruleRefExpr{
-												pos:  position{line: 1028, col: 69, offset: 38358},
-												name: "NEWLINE",
-											},
-										},
-										&anyMatcher{
-											line: 1028, col: 77, offset: 38366,
-										},
-									},
-								},
-								&zeroOrMoreExpr{
-									pos: position{line: 1028, col: 80, offset: 38369},
-									expr: &seqExpr{
-										pos: position{line: 1028, col: 81, offset: 38370},
-										exprs: []interface{}{
-											&notExpr{
-												pos: position{line: 1028, col: 81, offset: 38370},
-												expr: &seqExpr{
-													pos: position{line: 1028, col: 83, offset: 38372},
-													exprs: []interface{}{
-														&oneOrMoreExpr{
-															pos: position{line: 1028, col: 83, offset: 38372},
-															expr: &ruleRefExpr{
-																pos:  position{line: 1028, col: 83, offset: 38372},
-																name: "WS",
-															},
-														},
-														&ruleRefExpr{
-															pos:  position{line: 1028, col: 87, offset: 38376},
-															name: "SinglePlusPassthroughPrefix",
-														},
-													},
-												},
-											},
-											&notExpr{
-												pos: position{line: 1028, col: 116, offset: 38405},
-												expr: &ruleRefExpr{
-													pos:  position{line: 1028, col: 117, offset: 38406},
-													name: "SinglePlusPassthroughPrefix",
-												},
-											},
-											&notExpr{
-												pos: position{line: 1028, col: 145, offset: 38434},
-												expr: &ruleRefExpr{
-													pos:  position{line: 1028, col: 146, offset: 38435},
-													name: "NEWLINE",
-												},
-											},
-											&anyMatcher{
-												line: 1028, col: 154, offset: 38443,
-											},
-										},
-									},
-								},
+						&zeroOrOneExpr{
+							pos: position{line: 1418, col: 75, offset: 53873},
+							expr: &litMatcher{
+								pos:        position{line: 1418, col: 75, offset: 53873},
+								val:        ",",
+								ignoreCase: false,
 							},
 						},
-					},
-					&actionExpr{
-						pos: position{line: 1030, col: 7, offset: 38585},
-						run: (*parser).callonSinglePlusPassthroughContent24,
-						expr: &seqExpr{
-							pos: position{line: 1030, col: 8, offset: 38586},
-							exprs: []interface{}{
-								&notExpr{
-									pos: position{line: 1030, col: 8, offset: 38586},
-									expr: &ruleRefExpr{
-										pos:  position{line: 1030, col: 9, offset: 38587},
-										name: "WS",
-									},
-								},
-								&notExpr{
-									pos: position{line: 1030, col: 12, offset: 38590},
-									expr: &ruleRefExpr{
-										pos:  position{line: 1030, col: 13, offset: 38591},
-										name: "NEWLINE",
-									},
-								},
-								&notExpr{
-									pos: position{line: 1030, col: 21, offset: 38599},
-									expr: &ruleRefExpr{
-										pos:  position{line: 1030, col: 22, offset: 38600},
-										name: "SinglePlusPassthroughPrefix",
-									},
+						&labeledExpr{
+							pos:   position{line: 1418, col: 80, offset: 53878},
+							label: "height",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 1418, col: 87, offset: 53885},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1418, col: 88, offset: 53886},
+									name: "AttributeValue",
 								},
-								&anyMatcher{
-									line: 1030, col: 50, offset: 38628,
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 1418, col: 105, offset: 53903},
+							expr: &litMatcher{
+								pos:        position{line: 1418, col: 105, offset: 53903},
+								val:        ",",
+								ignoreCase: false,
+							},
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 1418, col: 110, offset: 53908},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1418, col: 110, offset: 53908},
+								name: "WS",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1418, col: 114, offset: 53912},
+							label: "otherattrs",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 1418, col: 125, offset: 53923},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1418, col: 126, offset: 53924},
+									name: "GenericAttribute",
 								},
 							},
 						},
+						&litMatcher{
+							pos:        position{line: 1418, col: 145, offset: 53943},
+							val:        "]",
+							ignoreCase: false,
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "TriplePlusPassthroughPrefix",
-			pos:  position{line: 1034, col: 1, offset: 38710},
-			expr: &litMatcher{
-				pos:        position{line: 1034, col: 32, offset: 38741},
-				val:        "+++",
-				ignoreCase: false,
-			},
-		},
-		{
-			name: "TriplePlusPassthrough",
-			pos:  position{line: 1036, col: 1, offset: 38748},
+			name: "VideoBlock",
+			pos:  position{line: 1425, col: 1, offset: 54149},
 			expr: &actionExpr{
-				pos: position{line: 1036, col: 26, offset: 38773},
-				run: (*parser).callonTriplePlusPassthrough1,
+				pos: position{line: 1425, col: 15, offset: 54163},
+				run: (*parser).callonVideoBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1036, col: 26, offset: 38773},
+					pos: position{line: 1425, col: 15, offset: 54163},
 					exprs: []interface{}{
-						&ruleRefExpr{
-							pos:  position{line: 1036, col: 26, offset: 38773},
-							name: "TriplePlusPassthroughPrefix",
+						&labeledExpr{
+							pos:   position{line: 1425, col: 15, offset: 54163},
+							label: "attributes",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 1425, col: 26, offset: 54174},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1425, col: 27, offset: 54175},
+									name: "ElementAttributes",
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 1425, col: 47, offset: 54195},
+							val:        "video::",
+							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 1036, col: 54, offset: 38801},
-							label: "content",
+							pos:   position{line: 1425, col: 57, offset: 54205},
+							label: "path",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1036, col: 63, offset: 38810},
-								name: "TriplePlusPassthroughContent",
+								pos:  position{line: 1425, col: 63, offset: 54211},
+								name: "URL",
 							},
 						},
-						&ruleRefExpr{
-							pos:  position{line: 1036, col: 93, offset: 38840},
-							name: "TriplePlusPassthroughPrefix",
-						},
-						&notExpr{
-							pos: position{line: 1036, col: 121, offset: 38868},
+						&labeledExpr{
+							pos:   position{line: 1425, col: 68, offset: 54216},
+							label: "inlineAttributes",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1036, col: 122, offset: 38869},
-								name: "Alphanum",
+								pos:  position{line: 1425, col: 86, offset: 54234},
+								name: "VideoAttributes",
 							},
 						},
+						&ruleRefExpr{
+							pos:  position{line: 1425, col: 103, offset: 54251},
+							name: "EOLS",
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "TriplePlusPassthroughContent",
-			pos:  position{line: 1040, col: 1, offset: 38968},
-			expr: &choiceExpr{
-				pos: position{line: 1040, col: 33, offset: 39000},
-				alternatives: []interface{}{
-					&actionExpr{
-						pos: position{line: 1040, col: 34, offset: 39001},
-						run: (*parser).callonTriplePlusPassthroughContent2,
-						expr: &zeroOrMoreExpr{
-							pos: position{line: 1040, col: 34, offset: 39001},
-							expr: &seqExpr{
-								pos: position{line: 1040, col: 35, offset: 39002},
-								exprs: []interface{}{
-									&notExpr{
-										pos: position{line: 1040, col: 35, offset: 39002},
-										expr: &ruleRefExpr{
-											pos:  position{line: 1040, col: 36, offset: 39003},
-											name: "TriplePlusPassthroughPrefix",
-										},
-									},
-									&anyMatcher{
-										line: 1040, col: 64, offset: 39031,
-									},
+			name: "VideoAttributes",
+			pos:  position{line: 1429, col: 1, offset: 54363},
+			expr: &actionExpr{
+				pos: position{line: 1429, col: 20, offset: 54382},
+				run: (*parser).callonVideoAttributes1,
+				expr: &seqExpr{
+					pos: position{line: 1429, col: 20, offset: 54382},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 1429, col: 20, offset: 54382},
+							val:        "[",
+							ignoreCase: false,
+						},
+						&labeledExpr{
+							pos:   position{line: 1429, col: 24, offset: 54386},
+							label: "poster",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 1429, col: 31, offset: 54393},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1429, col: 32, offset: 54394},
+									name: "AttributeValue",
 								},
 							},
 						},
-					},
-					&actionExpr{
-						pos: position{line: 1042, col: 7, offset: 39196},
-						run: (*parser).callonTriplePlusPassthroughContent8,
-						expr: &zeroOrOneExpr{
-							pos: position{line: 1042, col: 7, offset: 39196},
-							expr: &seqExpr{
-								pos: position{line: 1042, col: 8, offset: 39197},
-								exprs: []interface{}{
-									&notExpr{
-										pos: position{line: 1042, col: 8, offset: 39197},
-										expr: &ruleRefExpr{
-											pos:  position{line: 1042, col: 9, offset: 39198},
-											name: "WS",
-										},
-									},
-									&notExpr{
-										pos: position{line: 1042, col: 12, offset: 39201},
-										expr: &ruleRefExpr{
-											pos:  position{line: 1042, col: 13, offset: 39202},
-											name: "NEWLINE",
-										},
-									},
-									&notExpr{
-										pos: position{line: 1042, col: 21, offset: 39210},
-										expr: &ruleRefExpr{
-											pos:  position{line: 1042, col: 22, offset: 39211},
-											name: "TriplePlusPassthroughPrefix",
-										},
-									},
-									&anyMatcher{
-										line: 1042, col: 50, offset: 39239,
-									},
+						&zeroOrOneExpr{
+							pos: position{line: 1429, col: 49, offset: 54411},
+							expr: &litMatcher{
+								pos:        position{line: 1429, col: 49, offset: 54411},
+								val:        ",",
+								ignoreCase: false,
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1429, col: 54, offset: 54416},
+							label: "width",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 1429, col: 60, offset: 54422},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1429, col: 61, offset: 54423},
+									name: "AttributeValue",
+								},
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 1429, col: 78, offset: 54440},
+							expr: &litMatcher{
+								pos:        position{line: 1429, col: 78, offset: 54440},
+								val:        ",",
+								ignoreCase: false,
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1429, col: 83, offset: 54445},
+							label: "height",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 1429, col: 90, offset: 54452},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1429, col: 91, offset: 54453},
+									name: "AttributeValue",
 								},
 							},
 						},
+						&zeroOrOneExpr{
+							pos: position{line: 1429, col: 108, offset: 54470},
+							expr: &litMatcher{
+								pos:        position{line: 1429, col: 108, offset: 54470},
+								val:        ",",
+								ignoreCase: false,
+							},
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 1429, col: 113, offset: 54475},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1429, col: 113, offset: 54475},
+								name: "WS",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1429, col: 117, offset: 54479},
+							label: "otherattrs",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 1429, col: 128, offset: 54490},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1429, col: 129, offset: 54491},
+									name: "GenericAttribute",
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 1429, col: 148, offset: 54510},
+							val:        "]",
+							ignoreCase: false,
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "PassthroughMacro",
-			pos:  position{line: 1046, col: 1, offset: 39322},
-			expr: &choiceExpr{
-				pos: position{line: 1046, col: 21, offset: 39342},
-				alternatives: []interface{}{
-					&actionExpr{
-						pos: position{line: 1046, col: 21, offset: 39342},
-						run: (*parser).callonPassthroughMacro2,
-						expr: &seqExpr{
-							pos: position{line: 1046, col: 21, offset: 39342},
-							exprs: []interface{}{
-								&litMatcher{
-									pos:        position{line: 1046, col: 21, offset: 39342},
-									val:        "pass:[",
-									ignoreCase: false,
-								},
-								&labeledExpr{
-									pos:   position{line: 1046, col: 30, offset: 39351},
-									label: "content",
-									expr: &zeroOrMoreExpr{
-										pos: position{line: 1046, col: 38, offset: 39359},
-										expr: &ruleRefExpr{
-											pos:  position{line: 1046, col: 39, offset: 39360},
-											name: "PassthroughMacroCharacter",
-										},
-									},
-								},
-								&litMatcher{
-									pos:        position{line: 1046, col: 67, offset: 39388},
-									val:        "]",
-									ignoreCase: false,
+			name: "AudioBlock",
+			pos:  position{line: 1433, col: 1, offset: 54606},
+			expr: &actionExpr{
+				pos: position{line: 1433, col: 15, offset: 54620},
+				run: (*parser).callonAudioBlock1,
+				expr: &seqExpr{
+					pos: position{line: 1433, col: 15, offset: 54620},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 1433, col: 15, offset: 54620},
+							label: "attributes",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 1433, col: 26, offset: 54631},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1433, col: 27, offset: 54632},
+									name: "ElementAttributes",
 								},
 							},
 						},
-					},
-					&actionExpr{
-						pos: position{line: 1048, col: 5, offset: 39478},
-						run: (*parser).callonPassthroughMacro9,
-						expr: &seqExpr{
-							pos: position{line: 1048, col: 5, offset: 39478},
-							exprs: []interface{}{
-								&litMatcher{
-									pos:        position{line: 1048, col: 5, offset: 39478},
-									val:        "pass:q[",
-									ignoreCase: false,
-								},
-								&labeledExpr{
-									pos:   position{line: 1048, col: 15, offset: 39488},
-									label: "content",
-									expr: &zeroOrMoreExpr{
-										pos: position{line: 1048, col: 23, offset: 39496},
-										expr: &choiceExpr{
-											pos: position{line: 1048, col: 24, offset: 39497},
-											alternatives: []interface{}{
-												&ruleRefExpr{
-													pos:  position{line: 1048, col: 24, offset: 39497},
-													name: "QuotedText",
-												},
-												&ruleRefExpr{
-													pos:  position{line: 1048, col: 37, offset: 39510},
-													name: "PassthroughMacroCharacter",
-												},
-											},
-										},
-									},
-								},
-								&litMatcher{
-									pos:        position{line: 1048, col: 65, offset: 39538},
-									val:        "]",
-									ignoreCase: false,
-								},
+						&litMatcher{
+							pos:        position{line: 1433, col: 47, offset: 54652},
+							val:        "audio::",
+							ignoreCase: false,
+						},
+						&labeledExpr{
+							pos:   position{line: 1433, col: 57, offset: 54662},
+							label: "path",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1433, col: 63, offset: 54668},
+								name: "URL",
 							},
 						},
+						&labeledExpr{
+							pos:   position{line: 1433, col: 68, offset: 54673},
+							label: "inlineAttributes",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1433, col: 86, offset: 54691},
+								name: "AudioAttributes",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1433, col: 103, offset: 54708},
+							name: "EOLS",
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "PassthroughMacroCharacter",
-			pos:  position{line: 1052, col: 1, offset: 39628},
-			expr: &choiceExpr{
-				pos: position{line: 1052, col: 31, offset: 39658},
-				alternatives: []interface{}{
-					&ruleRefExpr{
-						pos:  position{line: 1052, col: 31, offset: 39658},
-						name: "Alphanums",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 1052, col: 43, offset: 39670},
-						name: "Spaces",
-					},
-					&actionExpr{
-						pos: position{line: 1052, col: 52, offset: 39679},
-						run: (*parser).callonPassthroughMacroCharacter4,
-						expr: &seqExpr{
-							pos: position{line: 1052, col: 53, offset: 39680},
-							exprs: []interface{}{
-								&notExpr{
-									pos: position{line: 1052, col: 53, offset: 39680},
-									expr: &litMatcher{
-										pos:        position{line: 1052, col: 54, offset: 39681},
-										val:        "]",
-										ignoreCase: false,
-									},
-								},
-								&anyMatcher{
-									line: 1052, col: 58, offset: 39685,
+			name: "AudioAttributes",
+			pos:  position{line: 1437, col: 1, offset: 54820},
+			expr: &actionExpr{
+				pos: position{line: 1437, col: 20, offset: 54839},
+				run: (*parser).callonAudioAttributes1,
+				expr: &seqExpr{
+					pos: position{line: 1437, col: 20, offset: 54839},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 1437, col: 20, offset: 54839},
+							val:        "[",
+							ignoreCase: false,
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 1437, col: 24, offset: 54843},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1437, col: 24, offset: 54843},
+								name: "WS",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1437, col: 28, offset: 54847},
+							label: "attrs",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 1437, col: 34, offset: 54853},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1437, col: 35, offset: 54854},
+									name: "GenericAttribute",
 								},
 							},
 						},
+						&litMatcher{
+							pos:        position{line: 1437, col: 54, offset: 54873},
+							val:        "]",
+							ignoreCase: false,
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "CrossReference",
-			pos:  position{line: 1059, col: 1, offset: 39855},
+			name: "InlineFootnote",
+			pos:  position{line: 1444, col: 1, offset: 55138},
 			expr: &choiceExpr{
-				pos: position{line: 1059, col: 19, offset: 39873},
+				pos: position{line: 1444, col: 19, offset: 55156},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1059, col: 19, offset: 39873},
-						run: (*parser).callonCrossReference2,
+						pos: position{line: 1444, col: 19, offset: 55156},
+						run: (*parser).callonInlineFootnote2,
 						expr: &seqExpr{
-							pos: position{line: 1059, col: 19, offset: 39873},
+							pos: position{line: 1444, col: 19, offset: 55156},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1059, col: 19, offset: 39873},
-									val:        "<<",
+									pos:        position{line: 1444, col: 19, offset: 55156},
+									val:        "footnote:[",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1059, col: 24, offset: 39878},
-									label: "id",
+									pos:   position{line: 1444, col: 32, offset: 55169},
+									label: "content",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1059, col: 28, offset: 39882},
-										name: "ID",
+										pos:  position{line: 1444, col: 41, offset: 55178},
+										name: "FootnoteContent",
 									},
 								},
-								&zeroOrMoreExpr{
-									pos: position{line: 1059, col: 32, offset: 39886},
+								&litMatcher{
+									pos:        position{line: 1444, col: 58, offset: 55195},
+									val:        "]",
+									ignoreCase: false,
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 1446, col: 5, offset: 55270},
+						run: (*parser).callonInlineFootnote8,
+						expr: &seqExpr{
+							pos: position{line: 1446, col: 5, offset: 55270},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 1446, col: 5, offset: 55270},
+									val:        "footnoteref:[",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 1446, col: 21, offset: 55286},
+									label: "ref",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1059, col: 32, offset: 39886},
-										name: "WS",
+										pos:  position{line: 1446, col: 26, offset: 55291},
+										name: "FootnoteRef",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1059, col: 36, offset: 39890},
+									pos:        position{line: 1446, col: 39, offset: 55304},
 									val:        ",",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1059, col: 40, offset: 39894},
-									label: "label",
+									pos:   position{line: 1446, col: 43, offset: 55308},
+									label: "content",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1059, col: 47, offset: 39901},
-										name: "CrossReferenceLabel",
+										pos:  position{line: 1446, col: 52, offset: 55317},
+										name: "FootnoteContent",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1059, col: 68, offset: 39922},
-									val:        ">>",
+									pos:        position{line: 1446, col: 69, offset: 55334},
+									val:        "]",
 									ignoreCase: false,
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1061, col: 5, offset: 39997},
-						run: (*parser).callonCrossReference13,
+						pos: position{line: 1448, col: 5, offset: 55419},
+						run: (*parser).callonInlineFootnote17,
 						expr: &seqExpr{
-							pos: position{line: 1061, col: 5, offset: 39997},
+							pos: position{line: 1448, col: 5, offset: 55419},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1061, col: 5, offset: 39997},
-									val:        "<<",
+									pos:        position{line: 1448, col: 5, offset: 55419},
+									val:        "footnoteref:[",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1061, col: 10, offset: 40002},
-									label: "id",
+									pos:   position{line: 1448, col: 21, offset: 55435},
+									label: "ref",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1061, col: 14, offset: 40006},
-										name: "ID",
+										pos:  position{line: 1448, col: 26, offset: 55440},
+										name: "FootnoteRef",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1061, col: 18, offset: 40010},
-									val:        ">>",
+									pos:        position{line: 1448, col: 39, offset: 55453},
+									val:        "]",
 									ignoreCase: false,
 								},
 							},
@@ -8271,37 +11827,52 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "CrossReferenceLabel",
-			pos:  position{line: 1065, col: 1, offset: 40073},
+			name: "FootnoteRef",
+			pos:  position{line: 1452, col: 1, offset: 55568},
 			expr: &actionExpr{
-				pos: position{line: 1065, col: 24, offset: 40096},
-				run: (*parser).callonCrossReferenceLabel1,
-				expr: &oneOrMoreExpr{
-					pos: position{line: 1065, col: 24, offset: 40096},
+				pos: position{line: 1452, col: 16, offset: 55583},
+				run: (*parser).callonFootnoteRef1,
+				expr: &zeroOrMoreExpr{
+					pos: position{line: 1452, col: 16, offset: 55583},
 					expr: &choiceExpr{
-						pos: position{line: 1065, col: 25, offset: 40097},
+						pos: position{line: 1452, col: 17, offset: 55584},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 1065, col: 25, offset: 40097},
+								pos:  position{line: 1452, col: 17, offset: 55584},
 								name: "Alphanums",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1065, col: 37, offset: 40109},
+								pos:  position{line: 1452, col: 29, offset: 55596},
 								name: "Spaces",
 							},
 							&seqExpr{
-								pos: position{line: 1065, col: 47, offset: 40119},
+								pos: position{line: 1452, col: 39, offset: 55606},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 1065, col: 47, offset: 40119},
+										pos: position{line: 1452, col: 39, offset: 55606},
 										expr: &litMatcher{
-											pos:        position{line: 1065, col: 48, offset: 40120},
-											val:        ">>",
+											pos:        position{line: 1452, col: 40, offset: 55607},
+											val:        ",",
+											ignoreCase: false,
+										},
+									},
+									&notExpr{
+										pos: position{line: 1452, col: 44, offset: 55611},
+										expr: &litMatcher{
+											pos:        position{line: 1452, col: 45, offset: 55612},
+											val:        "]",
 											ignoreCase: false,
 										},
 									},
+									&notExpr{
+										pos: position{line: 1452, col: 49, offset: 55616},
+										expr: &ruleRefExpr{
+											pos:  position{line: 1452, col: 50, offset: 55617},
+											name: "EOL",
+										},
+									},
 									&anyMatcher{
-										line: 1065, col: 54, offset: 40126,
+										line: 1452, col: 55, offset: 55622,
 									},
 								},
 							},
@@ -8311,67 +11882,59 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "Link",
-			pos:  position{line: 1072, col: 1, offset: 40268},
+			name: "FootnoteContent",
+			pos:  position{line: 1456, col: 1, offset: 55707},
 			expr: &actionExpr{
-				pos: position{line: 1072, col: 9, offset: 40276},
-				run: (*parser).callonLink1,
+				pos: position{line: 1456, col: 20, offset: 55726},
+				run: (*parser).callonFootnoteContent1,
 				expr: &labeledExpr{
-					pos:   position{line: 1072, col: 9, offset: 40276},
-					label: "link",
-					expr: &choiceExpr{
-						pos: position{line: 1072, col: 15, offset: 40282},
-						alternatives: []interface{}{
-							&ruleRefExpr{
-								pos:  position{line: 1072, col: 15, offset: 40282},
-								name: "RelativeLink",
-							},
-							&ruleRefExpr{
-								pos:  position{line: 1072, col: 30, offset: 40297},
-								name: "ExternalLink",
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "RelativeLink",
-			pos:  position{line: 1077, col: 1, offset: 40404},
-			expr: &actionExpr{
-				pos: position{line: 1077, col: 17, offset: 40420},
-				run: (*parser).callonRelativeLink1,
-				expr: &seqExpr{
-					pos: position{line: 1077, col: 17, offset: 40420},
-					exprs: []interface{}{
-						&litMatcher{
-							pos:        position{line: 1077, col: 17, offset: 40420},
-							val:        "link:",
-							ignoreCase: false,
-						},
-						&labeledExpr{
-							pos:   position{line: 1077, col: 25, offset: 40428},
-							label: "url",
-							expr: &choiceExpr{
-								pos: position{line: 1077, col: 30, offset: 40433},
-								alternatives: []interface{}{
-									&ruleRefExpr{
-										pos:  position{line: 1077, col: 30, offset: 40433},
-										name: "Location",
+					pos:   position{line: 1456, col: 20, offset: 55726},
+					label: "elements",
+					expr: &oneOrMoreExpr{
+						pos: position{line: 1456, col: 29, offset: 55735},
+						expr: &seqExpr{
+							pos: position{line: 1456, col: 30, offset: 55736},
+							exprs: []interface{}{
+								&notExpr{
+									pos: position{line: 1456, col: 30, offset: 55736},
+									expr: &litMatcher{
+										pos:        position{line: 1456, col: 31, offset: 55737},
+										val:        "]",
+										ignoreCase: false,
 									},
-									&ruleRefExpr{
-										pos:  position{line: 1077, col: 41, offset: 40444},
-										name: "FileLocation",
+								},
+								&notExpr{
+									pos: position{line: 1456, col: 35, offset: 55741},
+									expr: &ruleRefExpr{
+										pos:  position{line: 1456, col: 36, offset: 55742},
+										name: "EOL",
+									},
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 1456, col: 40, offset: 55746},
+									expr: &ruleRefExpr{
+										pos:  position{line: 1456, col: 40, offset: 55746},
+										name: "WS",
+									},
+								},
+								&notExpr{
+									pos: position{line: 1456, col: 44, offset: 55750},
+									expr: &ruleRefExpr{
+										pos:  position{line: 1456, col: 45, offset: 55751},
+										name: "InlineElementID",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1456, col: 61, offset: 55767},
+									name: "InlineElement",
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 1456, col: 75, offset: 55781},
+									expr: &ruleRefExpr{
+										pos:  position{line: 1456, col: 75, offset: 55781},
+										name: "WS",
 									},
 								},
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 1077, col: 55, offset: 40458},
-							label: "inlineAttributes",
-							expr: &ruleRefExpr{
-								pos:  position{line: 1077, col: 73, offset: 40476},
-								name: "LinkAttributes",
 							},
 						},
 					},
@@ -8379,82 +11942,121 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "ExternalLink",
-			pos:  position{line: 1081, col: 1, offset: 40594},
-			expr: &actionExpr{
-				pos: position{line: 1081, col: 17, offset: 40610},
-				run: (*parser).callonExternalLink1,
-				expr: &seqExpr{
-					pos: position{line: 1081, col: 17, offset: 40610},
-					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 1081, col: 17, offset: 40610},
-							label: "url",
-							expr: &ruleRefExpr{
-								pos:  position{line: 1081, col: 22, offset: 40615},
-								name: "Location",
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 1081, col: 32, offset: 40625},
-							label: "inlineAttributes",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 1081, col: 49, offset: 40642},
-								expr: &ruleRefExpr{
-									pos:  position{line: 1081, col: 50, offset: 40643},
-									name: "LinkAttributes",
-								},
-							},
-						},
+			name: "IndexTerm",
+			pos:  position{line: 1463, col: 1, offset: 56089},
+			expr: &choiceExpr{
+				pos: position{line: 1463, col: 14, offset: 56102},
+				alternatives: []interface{}{
+					&ruleRefExpr{
+						pos:  position{line: 1463, col: 14, offset: 56102},
+						name: "ConcealedIndexTerm",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1463, col: 35, offset: 56123},
+						name: "BlankConcealedIndexTerm",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1463, col: 61, offset: 56149},
+						name: "VisibleIndexTerm",
 					},
 				},
 			},
 		},
 		{
-			name: "LinkAttributes",
-			pos:  position{line: 1085, col: 1, offset: 40736},
+			name: "ConcealedIndexTerm",
+			pos:  position{line: 1465, col: 1, offset: 56167},
 			expr: &choiceExpr{
-				pos: position{line: 1085, col: 19, offset: 40754},
+				pos: position{line: 1465, col: 23, offset: 56189},
 				alternatives: []interface{}{
-					&ruleRefExpr{
-						pos:  position{line: 1085, col: 19, offset: 40754},
-						name: "TextOnlyLinkAttributes",
+					&actionExpr{
+						pos: position{line: 1465, col: 23, offset: 56189},
+						run: (*parser).callonConcealedIndexTerm2,
+						expr: &seqExpr{
+							pos: position{line: 1465, col: 23, offset: 56189},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 1465, col: 23, offset: 56189},
+									val:        "(((",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 1465, col: 29, offset: 56195},
+									label: "terms",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1465, col: 36, offset: 56202},
+										name: "IndexTermContent",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1465, col: 54, offset: 56220},
+									val:        ")))",
+									ignoreCase: false,
+								},
+								&andCodeExpr{
+									pos: position{line: 1465, col: 60, offset: 56226},
+									run: (*parser).callonConcealedIndexTerm8,
+								},
+							},
+						},
 					},
-					&ruleRefExpr{
-						pos:  position{line: 1085, col: 44, offset: 40779},
-						name: "TextAndMoreLinkAttributes",
+					&actionExpr{
+						pos: position{line: 1469, col: 5, offset: 56344},
+						run: (*parser).callonConcealedIndexTerm9,
+						expr: &seqExpr{
+							pos: position{line: 1469, col: 5, offset: 56344},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 1469, col: 5, offset: 56344},
+									val:        "indexterm:[",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 1469, col: 19, offset: 56358},
+									label: "terms",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1469, col: 26, offset: 56365},
+										name: "IndexTermContent",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1469, col: 44, offset: 56383},
+									val:        "]",
+									ignoreCase: false,
+								},
+								&andCodeExpr{
+									pos: position{line: 1469, col: 48, offset: 56387},
+									run: (*parser).callonConcealedIndexTerm15,
+								},
+							},
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "TextOnlyLinkAttributes",
-			pos:  position{line: 1087, col: 1, offset: 40806},
+			name: "BlankConcealedIndexTerm",
+			pos:  position{line: 1476, col: 1, offset: 56562},
 			expr: &actionExpr{
-				pos: position{line: 1087, col: 27, offset: 40832},
-				run: (*parser).callonTextOnlyLinkAttributes1,
+				pos: position{line: 1476, col: 28, offset: 56589},
+				run: (*parser).callonBlankConcealedIndexTerm1,
 				expr: &seqExpr{
-					pos: position{line: 1087, col: 27, offset: 40832},
+					pos: position{line: 1476, col: 28, offset: 56589},
 					exprs: []interface{}{
-						&litMatcher{
-							pos:        position{line: 1087, col: 27, offset: 40832},
-							val:        "[",
-							ignoreCase: false,
-						},
-						&labeledExpr{
-							pos:   position{line: 1087, col: 31, offset: 40836},
-							label: "text",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 1087, col: 36, offset: 40841},
-								expr: &ruleRefExpr{
-									pos:  position{line: 1087, col: 37, offset: 40842},
-									name: "LinkTextWithCommaAttribute",
-								},
+						&litMatcher{
+							pos:        position{line: 1476, col: 28, offset: 56589},
+							val:        "(((",
+							ignoreCase: false,
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 1476, col: 34, offset: 56595},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1476, col: 34, offset: 56595},
+								name: "IndexTermContent",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1087, col: 66, offset: 40871},
-							val:        "]",
+							pos:        position{line: 1476, col: 52, offset: 56613},
+							val:        ")))",
 							ignoreCase: false,
 						},
 					},
@@ -8462,199 +12064,191 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "LinkTextWithCommaAttribute",
-			pos:  position{line: 1091, col: 1, offset: 40933},
+			name: "VisibleIndexTerm",
+			pos:  position{line: 1480, col: 1, offset: 56674},
 			expr: &choiceExpr{
-				pos: position{line: 1093, col: 5, offset: 41005},
+				pos: position{line: 1480, col: 21, offset: 56694},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1093, col: 5, offset: 41005},
-						run: (*parser).callonLinkTextWithCommaAttribute2,
+						pos: position{line: 1480, col: 21, offset: 56694},
+						run: (*parser).callonVisibleIndexTerm2,
 						expr: &seqExpr{
-							pos: position{line: 1093, col: 5, offset: 41005},
+							pos: position{line: 1480, col: 21, offset: 56694},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1093, col: 5, offset: 41005},
-									val:        "\"",
+									pos:        position{line: 1480, col: 21, offset: 56694},
+									val:        "((",
 									ignoreCase: false,
 								},
+								&notExpr{
+									pos: position{line: 1480, col: 26, offset: 56699},
+									expr: &litMatcher{
+										pos:        position{line: 1480, col: 27, offset: 56700},
+										val:        "(",
+										ignoreCase: false,
+									},
+								},
 								&labeledExpr{
-									pos:   position{line: 1093, col: 10, offset: 41010},
-									label: "elements",
-									expr: &oneOrMoreExpr{
-										pos: position{line: 1093, col: 19, offset: 41019},
-										expr: &seqExpr{
-											pos: position{line: 1093, col: 20, offset: 41020},
-											exprs: []interface{}{
-												&notExpr{
-													pos: position{line: 1093, col: 20, offset: 41020},
-													expr: &litMatcher{
-														pos:        position{line: 1093, col: 21, offset: 41021},
-														val:        "=",
-														ignoreCase: false,
-													},
-												},
-												&notExpr{
-													pos: position{line: 1093, col: 25, offset: 41025},
-													expr: &litMatcher{
-														pos:        position{line: 1093, col: 26, offset: 41026},
-														val:        "]",
-														ignoreCase: false,
-													},
-												},
-												&notExpr{
-													pos: position{line: 1093, col: 30, offset: 41030},
-													expr: &litMatcher{
-														pos:        position{line: 1093, col: 31, offset: 41031},
-														val:        "\"",
-														ignoreCase: false,
-													},
-												},
-												&choiceExpr{
-													pos: position{line: 1093, col: 37, offset: 41037},
-													alternatives: []interface{}{
-														&ruleRefExpr{
-															pos:  position{line: 1093, col: 37, offset: 41037},
-															name: "QuotedText",
-														},
-														&ruleRefExpr{
-															pos:  position{line: 1093, col: 50, offset: 41050},
-															name: "SimpleWord",
-														},
-														&ruleRefExpr{
-															pos:  position{line: 1093, col: 63, offset: 41063},
-															name: "Spaces",
-														},
-														&actionExpr{
-															pos: position{line: 1093, col: 73, offset: 41073},
-															run: (*parser).callonLinkTextWithCommaAttribute18,
-															expr: &seqExpr{
-																pos: position{line: 1093, col: 74, offset: 41074},
-																exprs: []interface{}{
-																	&notExpr{
-																		pos: position{line: 1093, col: 74, offset: 41074},
-																		expr: &ruleRefExpr{
-																			pos:  position{line: 1093, col: 75, offset: 41075},
-																			name: "QuotedTextPrefix",
-																		},
-																	},
-																	&anyMatcher{
-																		line: 1093, col: 92, offset: 41092,
-																	},
-																},
-															},
-														},
-													},
-												},
-											},
-										},
+									pos:   position{line: 1480, col: 31, offset: 56704},
+									label: "term",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1480, col: 37, offset: 56710},
+										name: "IndexTermContent",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1095, col: 11, offset: 41161},
-									val:        "\"",
+									pos:        position{line: 1480, col: 55, offset: 56728},
+									val:        "))",
 									ignoreCase: false,
 								},
-								&zeroOrMoreExpr{
-									pos: position{line: 1095, col: 16, offset: 41166},
-									expr: &ruleRefExpr{
-										pos:  position{line: 1095, col: 16, offset: 41166},
-										name: "Spaces",
-									},
-								},
-								&andExpr{
-									pos: position{line: 1095, col: 24, offset: 41174},
-									expr: &notExpr{
-										pos: position{line: 1095, col: 26, offset: 41176},
-										expr: &litMatcher{
-											pos:        position{line: 1095, col: 27, offset: 41177},
-											val:        "=",
-											ignoreCase: false,
-										},
-									},
+								&andCodeExpr{
+									pos: position{line: 1480, col: 60, offset: 56733},
+									run: (*parser).callonVisibleIndexTerm10,
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1099, col: 5, offset: 41302},
-						run: (*parser).callonLinkTextWithCommaAttribute29,
+						pos: position{line: 1484, col: 5, offset: 56844},
+						run: (*parser).callonVisibleIndexTerm11,
 						expr: &seqExpr{
-							pos: position{line: 1099, col: 5, offset: 41302},
+							pos: position{line: 1484, col: 5, offset: 56844},
 							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 1484, col: 5, offset: 56844},
+									val:        "indexterm2:[",
+									ignoreCase: false,
+								},
 								&labeledExpr{
-									pos:   position{line: 1099, col: 5, offset: 41302},
-									label: "elements",
-									expr: &oneOrMoreExpr{
-										pos: position{line: 1099, col: 14, offset: 41311},
-										expr: &seqExpr{
-											pos: position{line: 1099, col: 15, offset: 41312},
-											exprs: []interface{}{
-												&notExpr{
-													pos: position{line: 1099, col: 15, offset: 41312},
-													expr: &litMatcher{
-														pos:        position{line: 1099, col: 16, offset: 41313},
-														val:        "=",
-														ignoreCase: false,
-													},
-												},
-												&notExpr{
-													pos: position{line: 1099, col: 20, offset: 41317},
-													expr: &litMatcher{
-														pos:        position{line: 1099, col: 21, offset: 41318},
-														val:        "]",
-														ignoreCase: false,
-													},
-												},
-												&choiceExpr{
-													pos: position{line: 1099, col: 26, offset: 41323},
-													alternatives: []interface{}{
-														&ruleRefExpr{
-															pos:  position{line: 1099, col: 26, offset: 41323},
-															name: "QuotedText",
-														},
-														&ruleRefExpr{
-															pos:  position{line: 1099, col: 39, offset: 41336},
-															name: "SimpleWord",
-														},
-														&ruleRefExpr{
-															pos:  position{line: 1099, col: 52, offset: 41349},
-															name: "Spaces",
-														},
-														&actionExpr{
-															pos: position{line: 1099, col: 62, offset: 41359},
-															run: (*parser).callonLinkTextWithCommaAttribute42,
-															expr: &seqExpr{
-																pos: position{line: 1099, col: 63, offset: 41360},
-																exprs: []interface{}{
-																	&notExpr{
-																		pos: position{line: 1099, col: 63, offset: 41360},
-																		expr: &ruleRefExpr{
-																			pos:  position{line: 1099, col: 64, offset: 41361},
-																			name: "QuotedTextPrefix",
-																		},
-																	},
-																	&anyMatcher{
-																		line: 1099, col: 81, offset: 41378,
-																	},
-																},
-															},
-														},
-													},
-												},
-											},
-										},
+									pos:   position{line: 1484, col: 20, offset: 56859},
+									label: "term",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1484, col: 26, offset: 56865},
+										name: "IndexTermContent",
 									},
 								},
-								&andExpr{
-									pos: position{line: 1101, col: 11, offset: 41447},
-									expr: &notExpr{
-										pos: position{line: 1101, col: 13, offset: 41449},
-										expr: &litMatcher{
-											pos:        position{line: 1101, col: 14, offset: 41450},
-											val:        "=",
-											ignoreCase: false,
-										},
+								&litMatcher{
+									pos:        position{line: 1484, col: 44, offset: 56883},
+									val:        "]",
+									ignoreCase: false,
+								},
+								&andCodeExpr{
+									pos: position{line: 1484, col: 48, offset: 56887},
+									run: (*parser).callonVisibleIndexTerm17,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "IndexTermContent",
+			pos:  position{line: 1490, col: 1, offset: 56997},
+			expr: &actionExpr{
+				pos: position{line: 1490, col: 21, offset: 57017},
+				run: (*parser).callonIndexTermContent1,
+				expr: &oneOrMoreExpr{
+					pos: position{line: 1490, col: 21, offset: 57017},
+					expr: &seqExpr{
+						pos: position{line: 1490, col: 22, offset: 57018},
+						exprs: []interface{}{
+							&notExpr{
+								pos: position{line: 1490, col: 22, offset: 57018},
+								expr: &litMatcher{
+									pos:        position{line: 1490, col: 23, offset: 57019},
+									val:        "))",
+									ignoreCase: false,
+								},
+							},
+							&notExpr{
+								pos: position{line: 1490, col: 28, offset: 57024},
+								expr: &litMatcher{
+									pos:        position{line: 1490, col: 29, offset: 57025},
+									val:        "]",
+									ignoreCase: false,
+								},
+							},
+							&notExpr{
+								pos: position{line: 1490, col: 33, offset: 57029},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1490, col: 34, offset: 57030},
+									name: "NEWLINE",
+								},
+							},
+							&anyMatcher{
+								line: 1490, col: 42, offset: 57038,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "DelimitedBlock",
+			pos:  position{line: 1497, col: 1, offset: 57318},
+			expr: &actionExpr{
+				pos: position{line: 1497, col: 19, offset: 57336},
+				run: (*parser).callonDelimitedBlock1,
+				expr: &seqExpr{
+					pos: position{line: 1497, col: 19, offset: 57336},
+					exprs: []interface{}{
+						&notExpr{
+							pos: position{line: 1497, col: 19, offset: 57336},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1497, col: 20, offset: 57337},
+								name: "Alphanum",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1498, col: 5, offset: 57366},
+							label: "block",
+							expr: &choiceExpr{
+								pos: position{line: 1498, col: 12, offset: 57373},
+								alternatives: []interface{}{
+									&ruleRefExpr{
+										pos:  position{line: 1498, col: 12, offset: 57373},
+										name: "FencedBlock",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1499, col: 11, offset: 57396},
+										name: "ListingBlock",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1500, col: 11, offset: 57420},
+										name: "ExampleBlock",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1501, col: 11, offset: 57444},
+										name: "VerseBlock",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1502, col: 11, offset: 57466},
+										name: "QuoteBlock",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1503, col: 11, offset: 57488},
+										name: "MarkdownQuoteBlock",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1504, col: 11, offset: 57517},
+										name: "SidebarBlock",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1505, col: 11, offset: 57540},
+										name: "OpenBlock",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1506, col: 11, offset: 57560},
+										name: "SingleLineComment",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1507, col: 11, offset: 57588},
+										name: "Table",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1508, col: 11, offset: 57604},
+										name: "CommentBlock",
 									},
 								},
 							},
@@ -8664,360 +12258,189 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "TextAndMoreLinkAttributes",
-			pos:  position{line: 1106, col: 1, offset: 41530},
+			name: "BlockDelimiter",
+			pos:  position{line: 1512, col: 1, offset: 57645},
+			expr: &choiceExpr{
+				pos: position{line: 1512, col: 19, offset: 57663},
+				alternatives: []interface{}{
+					&ruleRefExpr{
+						pos:  position{line: 1512, col: 19, offset: 57663},
+						name: "LiteralBlockDelimiter",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1513, col: 19, offset: 57704},
+						name: "FencedBlockDelimiter",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1514, col: 19, offset: 57744},
+						name: "ListingBlockDelimiter",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1515, col: 19, offset: 57785},
+						name: "ExampleBlockDelimiter",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1516, col: 19, offset: 57826},
+						name: "CommentBlockDelimiter",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1517, col: 19, offset: 57867},
+						name: "QuoteBlockDelimiter",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1518, col: 19, offset: 57905},
+						name: "SidebarBlockDelimiter",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1519, col: 19, offset: 57945},
+						name: "OpenBlockDelimiter",
+					},
+				},
+			},
+		},
+		{
+			name: "FencedBlockDelimiter",
+			pos:  position{line: 1525, col: 1, offset: 58161},
+			expr: &seqExpr{
+				pos: position{line: 1525, col: 25, offset: 58185},
+				exprs: []interface{}{
+					&litMatcher{
+						pos:        position{line: 1525, col: 25, offset: 58185},
+						val:        "```",
+						ignoreCase: false,
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1525, col: 31, offset: 58191},
+						name: "EOLS",
+					},
+				},
+			},
+		},
+		{
+			name: "FencedBlock",
+			pos:  position{line: 1527, col: 1, offset: 58197},
 			expr: &actionExpr{
-				pos: position{line: 1106, col: 30, offset: 41559},
-				run: (*parser).callonTextAndMoreLinkAttributes1,
+				pos: position{line: 1527, col: 16, offset: 58212},
+				run: (*parser).callonFencedBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1106, col: 30, offset: 41559},
+					pos: position{line: 1527, col: 16, offset: 58212},
 					exprs: []interface{}{
-						&litMatcher{
-							pos:        position{line: 1106, col: 30, offset: 41559},
-							val:        "[",
-							ignoreCase: false,
-						},
 						&labeledExpr{
-							pos:   position{line: 1106, col: 34, offset: 41563},
-							label: "text",
+							pos:   position{line: 1527, col: 16, offset: 58212},
+							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1106, col: 39, offset: 41568},
+								pos: position{line: 1527, col: 27, offset: 58223},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1106, col: 40, offset: 41569},
-									name: "LinkTextAttribute",
+									pos:  position{line: 1527, col: 28, offset: 58224},
+									name: "ElementAttributes",
 								},
 							},
 						},
-						&zeroOrOneExpr{
-							pos: position{line: 1106, col: 60, offset: 41589},
-							expr: &litMatcher{
-								pos:        position{line: 1106, col: 60, offset: 41589},
-								val:        ",",
-								ignoreCase: false,
-							},
-						},
-						&zeroOrMoreExpr{
-							pos: position{line: 1106, col: 65, offset: 41594},
-							expr: &ruleRefExpr{
-								pos:  position{line: 1106, col: 65, offset: 41594},
-								name: "WS",
-							},
+						&ruleRefExpr{
+							pos:  position{line: 1527, col: 48, offset: 58244},
+							name: "FencedBlockDelimiter",
 						},
 						&labeledExpr{
-							pos:   position{line: 1106, col: 69, offset: 41598},
-							label: "otherattrs",
+							pos:   position{line: 1527, col: 69, offset: 58265},
+							label: "content",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1106, col: 80, offset: 41609},
+								pos: position{line: 1527, col: 77, offset: 58273},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1106, col: 81, offset: 41610},
-									name: "GenericAttribute",
+									pos:  position{line: 1527, col: 78, offset: 58274},
+									name: "FencedBlockContent",
 								},
 							},
 						},
-						&litMatcher{
-							pos:        position{line: 1106, col: 100, offset: 41629},
-							val:        "]",
-							ignoreCase: false,
+						&choiceExpr{
+							pos: position{line: 1527, col: 100, offset: 58296},
+							alternatives: []interface{}{
+								&ruleRefExpr{
+									pos:  position{line: 1527, col: 100, offset: 58296},
+									name: "FencedBlockDelimiter",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1527, col: 123, offset: 58319},
+									name: "EOF",
+								},
+							},
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "LinkTextAttribute",
-			pos:  position{line: 1110, col: 1, offset: 41714},
+			name: "FencedBlockContent",
+			pos:  position{line: 1531, col: 1, offset: 58427},
 			expr: &choiceExpr{
-				pos: position{line: 1112, col: 5, offset: 41777},
+				pos: position{line: 1531, col: 23, offset: 58449},
 				alternatives: []interface{}{
-					&actionExpr{
-						pos: position{line: 1112, col: 5, offset: 41777},
-						run: (*parser).callonLinkTextAttribute2,
-						expr: &seqExpr{
-							pos: position{line: 1112, col: 5, offset: 41777},
-							exprs: []interface{}{
-								&litMatcher{
-									pos:        position{line: 1112, col: 5, offset: 41777},
-									val:        "\"",
-									ignoreCase: false,
-								},
-								&labeledExpr{
-									pos:   position{line: 1112, col: 10, offset: 41782},
-									label: "elements",
-									expr: &oneOrMoreExpr{
-										pos: position{line: 1112, col: 19, offset: 41791},
-										expr: &seqExpr{
-											pos: position{line: 1112, col: 20, offset: 41792},
-											exprs: []interface{}{
-												&notExpr{
-													pos: position{line: 1112, col: 20, offset: 41792},
-													expr: &litMatcher{
-														pos:        position{line: 1112, col: 21, offset: 41793},
-														val:        "=",
-														ignoreCase: false,
-													},
-												},
-												&notExpr{
-													pos: position{line: 1112, col: 25, offset: 41797},
-													expr: &litMatcher{
-														pos:        position{line: 1112, col: 26, offset: 41798},
-														val:        "]",
-														ignoreCase: false,
-													},
-												},
-												&notExpr{
-													pos: position{line: 1112, col: 30, offset: 41802},
-													expr: &litMatcher{
-														pos:        position{line: 1112, col: 31, offset: 41803},
-														val:        "\"",
-														ignoreCase: false,
-													},
-												},
-												&choiceExpr{
-													pos: position{line: 1112, col: 37, offset: 41809},
-													alternatives: []interface{}{
-														&ruleRefExpr{
-															pos:  position{line: 1112, col: 37, offset: 41809},
-															name: "QuotedText",
-														},
-														&ruleRefExpr{
-															pos:  position{line: 1112, col: 50, offset: 41822},
-															name: "SimpleWord",
-														},
-														&ruleRefExpr{
-															pos:  position{line: 1112, col: 63, offset: 41835},
-															name: "Spaces",
-														},
-														&actionExpr{
-															pos: position{line: 1112, col: 73, offset: 41845},
-															run: (*parser).callonLinkTextAttribute18,
-															expr: &seqExpr{
-																pos: position{line: 1112, col: 74, offset: 41846},
-																exprs: []interface{}{
-																	&notExpr{
-																		pos: position{line: 1112, col: 74, offset: 41846},
-																		expr: &ruleRefExpr{
-																			pos:  position{line: 1112, col: 75, offset: 41847},
-																			name: "QuotedTextPrefix",
-																		},
-																	},
-																	&anyMatcher{
-																		line: 1112, col: 92, offset: 41864,
-																	},
-																},
-															},
-														},
-													},
-												},
-											},
-										},
-									},
-								},
-								&litMatcher{
-									pos:        position{line: 1114, col: 11, offset: 41933},
-									val:        "\"",
-									ignoreCase: false,
-								},
-								&zeroOrMoreExpr{
-									pos: position{line: 1114, col: 16, offset: 41938},
-									expr: &ruleRefExpr{
-										pos:  position{line: 1114, col: 16, offset: 41938},
-										name: "Spaces",
-									},
-								},
-								&andExpr{
-									pos: position{line: 1114, col: 24, offset: 41946},
-									expr: &notExpr{
-										pos: position{line: 1114, col: 26, offset: 41948},
-										expr: &litMatcher{
-											pos:        position{line: 1114, col: 27, offset: 41949},
-											val:        "=",
-											ignoreCase: false,
-										},
-									},
-								},
-							},
-						},
+					&ruleRefExpr{
+						pos:  position{line: 1531, col: 23, offset: 58449},
+						name: "BlankLine",
 					},
-					&actionExpr{
-						pos: position{line: 1118, col: 5, offset: 42074},
-						run: (*parser).callonLinkTextAttribute29,
-						expr: &seqExpr{
-							pos: position{line: 1118, col: 5, offset: 42074},
-							exprs: []interface{}{
-								&labeledExpr{
-									pos:   position{line: 1118, col: 5, offset: 42074},
-									label: "elements",
-									expr: &oneOrMoreExpr{
-										pos: position{line: 1118, col: 14, offset: 42083},
-										expr: &seqExpr{
-											pos: position{line: 1118, col: 15, offset: 42084},
-											exprs: []interface{}{
-												&notExpr{
-													pos: position{line: 1118, col: 15, offset: 42084},
-													expr: &litMatcher{
-														pos:        position{line: 1118, col: 16, offset: 42085},
-														val:        "=",
-														ignoreCase: false,
-													},
-												},
-												&notExpr{
-													pos: position{line: 1118, col: 20, offset: 42089},
-													expr: &litMatcher{
-														pos:        position{line: 1118, col: 21, offset: 42090},
-														val:        ",",
-														ignoreCase: false,
-													},
-												},
-												&notExpr{
-													pos: position{line: 1118, col: 25, offset: 42094},
-													expr: &litMatcher{
-														pos:        position{line: 1118, col: 26, offset: 42095},
-														val:        "]",
-														ignoreCase: false,
-													},
-												},
-												&choiceExpr{
-													pos: position{line: 1118, col: 31, offset: 42100},
-													alternatives: []interface{}{
-														&ruleRefExpr{
-															pos:  position{line: 1118, col: 31, offset: 42100},
-															name: "QuotedText",
-														},
-														&ruleRefExpr{
-															pos:  position{line: 1118, col: 44, offset: 42113},
-															name: "SimpleWord",
-														},
-														&ruleRefExpr{
-															pos:  position{line: 1118, col: 57, offset: 42126},
-															name: "Spaces",
-														},
-														&actionExpr{
-															pos: position{line: 1118, col: 67, offset: 42136},
-															run: (*parser).callonLinkTextAttribute44,
-															expr: &seqExpr{
-																pos: position{line: 1118, col: 68, offset: 42137},
-																exprs: []interface{}{
-																	&notExpr{
-																		pos: position{line: 1118, col: 68, offset: 42137},
-																		expr: &ruleRefExpr{
-																			pos:  position{line: 1118, col: 69, offset: 42138},
-																			name: "QuotedTextPrefix",
-																		},
-																	},
-																	&anyMatcher{
-																		line: 1118, col: 86, offset: 42155,
-																	},
-																},
-															},
-														},
-													},
-												},
-											},
-										},
-									},
-								},
-								&andExpr{
-									pos: position{line: 1120, col: 11, offset: 42224},
-									expr: &notExpr{
-										pos: position{line: 1120, col: 13, offset: 42226},
-										expr: &litMatcher{
-											pos:        position{line: 1120, col: 14, offset: 42227},
-											val:        "=",
-											ignoreCase: false,
-										},
-									},
-								},
-							},
-						},
+					&ruleRefExpr{
+						pos:  position{line: 1531, col: 35, offset: 58461},
+						name: "FileInclusion",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1531, col: 51, offset: 58477},
+						name: "ListItem",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1531, col: 62, offset: 58488},
+						name: "FencedBlockParagraph",
 					},
 				},
 			},
 		},
 		{
-			name: "ImageBlock",
-			pos:  position{line: 1127, col: 1, offset: 42408},
+			name: "FencedBlockParagraph",
+			pos:  position{line: 1534, col: 1, offset: 58528},
 			expr: &actionExpr{
-				pos: position{line: 1127, col: 15, offset: 42422},
-				run: (*parser).callonImageBlock1,
-				expr: &seqExpr{
-					pos: position{line: 1127, col: 15, offset: 42422},
-					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 1127, col: 15, offset: 42422},
-							label: "attributes",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 1127, col: 26, offset: 42433},
-								expr: &ruleRefExpr{
-									pos:  position{line: 1127, col: 27, offset: 42434},
-									name: "ElementAttributes",
-								},
-							},
-						},
-						&litMatcher{
-							pos:        position{line: 1127, col: 47, offset: 42454},
-							val:        "image::",
-							ignoreCase: false,
-						},
-						&labeledExpr{
-							pos:   position{line: 1127, col: 57, offset: 42464},
-							label: "path",
-							expr: &ruleRefExpr{
-								pos:  position{line: 1127, col: 63, offset: 42470},
-								name: "URL",
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 1127, col: 68, offset: 42475},
-							label: "inlineAttributes",
-							expr: &ruleRefExpr{
-								pos:  position{line: 1127, col: 86, offset: 42493},
-								name: "ImageAttributes",
-							},
-						},
-						&ruleRefExpr{
-							pos:  position{line: 1127, col: 103, offset: 42510},
-							name: "EOLS",
+				pos: position{line: 1534, col: 25, offset: 58552},
+				run: (*parser).callonFencedBlockParagraph1,
+				expr: &labeledExpr{
+					pos:   position{line: 1534, col: 25, offset: 58552},
+					label: "lines",
+					expr: &oneOrMoreExpr{
+						pos: position{line: 1534, col: 31, offset: 58558},
+						expr: &ruleRefExpr{
+							pos:  position{line: 1534, col: 32, offset: 58559},
+							name: "FencedBlockParagraphLine",
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "InlineImage",
-			pos:  position{line: 1131, col: 1, offset: 42622},
+			name: "FencedBlockParagraphLine",
+			pos:  position{line: 1538, col: 1, offset: 58672},
 			expr: &actionExpr{
-				pos: position{line: 1131, col: 16, offset: 42637},
-				run: (*parser).callonInlineImage1,
-				expr: &seqExpr{
-					pos: position{line: 1131, col: 16, offset: 42637},
-					exprs: []interface{}{
-						&litMatcher{
-							pos:        position{line: 1131, col: 16, offset: 42637},
-							val:        "image:",
-							ignoreCase: false,
-						},
+				pos: position{line: 1538, col: 29, offset: 58700},
+				run: (*parser).callonFencedBlockParagraphLine1,
+				expr: &seqExpr{
+					pos: position{line: 1538, col: 29, offset: 58700},
+					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1131, col: 25, offset: 42646},
-							expr: &litMatcher{
-								pos:        position{line: 1131, col: 26, offset: 42647},
-								val:        ":",
-								ignoreCase: false,
+							pos: position{line: 1538, col: 29, offset: 58700},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1538, col: 30, offset: 58701},
+								name: "FencedBlockDelimiter",
 							},
 						},
-						&labeledExpr{
-							pos:   position{line: 1131, col: 30, offset: 42651},
-							label: "path",
+						&notExpr{
+							pos: position{line: 1538, col: 51, offset: 58722},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1131, col: 36, offset: 42657},
-								name: "URL",
+								pos:  position{line: 1538, col: 52, offset: 58723},
+								name: "BlankLine",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1131, col: 41, offset: 42662},
-							label: "inlineAttributes",
+							pos:   position{line: 1538, col: 62, offset: 58733},
+							label: "line",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1131, col: 59, offset: 42680},
-								name: "ImageAttributes",
+								pos:  position{line: 1538, col: 68, offset: 58739},
+								name: "InlineElements",
 							},
 						},
 					},
@@ -9025,199 +12448,277 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "ImageAttributes",
-			pos:  position{line: 1135, col: 1, offset: 42793},
+			name: "ListingBlockDelimiter",
+			pos:  position{line: 1545, col: 1, offset: 58977},
+			expr: &seqExpr{
+				pos: position{line: 1545, col: 26, offset: 59002},
+				exprs: []interface{}{
+					&litMatcher{
+						pos:        position{line: 1545, col: 26, offset: 59002},
+						val:        "----",
+						ignoreCase: false,
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1545, col: 33, offset: 59009},
+						name: "EOLS",
+					},
+				},
+			},
+		},
+		{
+			name: "ListingBlock",
+			pos:  position{line: 1548, col: 1, offset: 59050},
 			expr: &actionExpr{
-				pos: position{line: 1135, col: 20, offset: 42812},
-				run: (*parser).callonImageAttributes1,
+				pos: position{line: 1548, col: 17, offset: 59066},
+				run: (*parser).callonListingBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1135, col: 20, offset: 42812},
+					pos: position{line: 1548, col: 17, offset: 59066},
 					exprs: []interface{}{
-						&litMatcher{
-							pos:        position{line: 1135, col: 20, offset: 42812},
-							val:        "[",
-							ignoreCase: false,
-						},
 						&labeledExpr{
-							pos:   position{line: 1135, col: 24, offset: 42816},
-							label: "alt",
+							pos:   position{line: 1548, col: 17, offset: 59066},
+							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1135, col: 28, offset: 42820},
+								pos: position{line: 1548, col: 28, offset: 59077},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1135, col: 29, offset: 42821},
-									name: "AttributeValue",
+									pos:  position{line: 1548, col: 29, offset: 59078},
+									name: "ElementAttributes",
 								},
 							},
 						},
-						&zeroOrOneExpr{
-							pos: position{line: 1135, col: 46, offset: 42838},
-							expr: &litMatcher{
-								pos:        position{line: 1135, col: 46, offset: 42838},
-								val:        ",",
-								ignoreCase: false,
-							},
+						&ruleRefExpr{
+							pos:  position{line: 1548, col: 49, offset: 59098},
+							name: "ListingBlockDelimiter",
 						},
 						&labeledExpr{
-							pos:   position{line: 1135, col: 51, offset: 42843},
-							label: "width",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 1135, col: 57, offset: 42849},
+							pos:   position{line: 1548, col: 71, offset: 59120},
+							label: "content",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 1548, col: 79, offset: 59128},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1135, col: 58, offset: 42850},
-									name: "AttributeValue",
+									pos:  position{line: 1548, col: 80, offset: 59129},
+									name: "ListingBlockElement",
 								},
 							},
 						},
-						&zeroOrOneExpr{
-							pos: position{line: 1135, col: 75, offset: 42867},
-							expr: &litMatcher{
-								pos:        position{line: 1135, col: 75, offset: 42867},
-								val:        ",",
-								ignoreCase: false,
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 1135, col: 80, offset: 42872},
-							label: "height",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 1135, col: 87, offset: 42879},
-								expr: &ruleRefExpr{
-									pos:  position{line: 1135, col: 88, offset: 42880},
-									name: "AttributeValue",
+						&choiceExpr{
+							pos: position{line: 1548, col: 103, offset: 59152},
+							alternatives: []interface{}{
+								&ruleRefExpr{
+									pos:  position{line: 1548, col: 103, offset: 59152},
+									name: "ListingBlockDelimiter",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1548, col: 127, offset: 59176},
+									name: "EOF",
 								},
 							},
 						},
-						&zeroOrOneExpr{
-							pos: position{line: 1135, col: 105, offset: 42897},
-							expr: &litMatcher{
-								pos:        position{line: 1135, col: 105, offset: 42897},
-								val:        ",",
-								ignoreCase: false,
+					},
+				},
+			},
+		},
+		{
+			name: "ListingBlockElement",
+			pos:  position{line: 1552, col: 1, offset: 59285},
+			expr: &choiceExpr{
+				pos: position{line: 1552, col: 24, offset: 59308},
+				alternatives: []interface{}{
+					&ruleRefExpr{
+						pos:  position{line: 1552, col: 24, offset: 59308},
+						name: "FileInclusion",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1552, col: 40, offset: 59324},
+						name: "ListingBlockParagraph",
+					},
+				},
+			},
+		},
+		{
+			name: "ListingBlockParagraph",
+			pos:  position{line: 1554, col: 1, offset: 59347},
+			expr: &actionExpr{
+				pos: position{line: 1554, col: 26, offset: 59372},
+				run: (*parser).callonListingBlockParagraph1,
+				expr: &labeledExpr{
+					pos:   position{line: 1554, col: 26, offset: 59372},
+					label: "lines",
+					expr: &oneOrMoreExpr{
+						pos: position{line: 1554, col: 32, offset: 59378},
+						expr: &ruleRefExpr{
+							pos:  position{line: 1554, col: 33, offset: 59379},
+							name: "ListingBlockParagraphLine",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ListingBlockParagraphLine",
+			pos:  position{line: 1558, col: 1, offset: 59498},
+			expr: &actionExpr{
+				pos: position{line: 1558, col: 30, offset: 59527},
+				run: (*parser).callonListingBlockParagraphLine1,
+				expr: &seqExpr{
+					pos: position{line: 1558, col: 30, offset: 59527},
+					exprs: []interface{}{
+						&notExpr{
+							pos: position{line: 1558, col: 30, offset: 59527},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1558, col: 31, offset: 59528},
+								name: "ListingBlockDelimiter",
 							},
 						},
-						&zeroOrMoreExpr{
-							pos: position{line: 1135, col: 110, offset: 42902},
+						&notExpr{
+							pos: position{line: 1558, col: 53, offset: 59550},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1135, col: 110, offset: 42902},
-								name: "WS",
+								pos:  position{line: 1558, col: 54, offset: 59551},
+								name: "FileInclusion",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1135, col: 114, offset: 42906},
-							label: "otherattrs",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 1135, col: 125, offset: 42917},
-								expr: &ruleRefExpr{
-									pos:  position{line: 1135, col: 126, offset: 42918},
-									name: "GenericAttribute",
+							pos:   position{line: 1558, col: 68, offset: 59565},
+							label: "line",
+							expr: &actionExpr{
+								pos: position{line: 1558, col: 74, offset: 59571},
+								run: (*parser).callonListingBlockParagraphLine8,
+								expr: &seqExpr{
+									pos: position{line: 1558, col: 74, offset: 59571},
+									exprs: []interface{}{
+										&notExpr{
+											pos: position{line: 1558, col: 74, offset: 59571},
+											expr: &ruleRefExpr{
+												pos:  position{line: 1558, col: 75, offset: 59572},
+												name: "EOF",
+											},
+										},
+										&zeroOrMoreExpr{
+											pos: position{line: 1558, col: 79, offset: 59576},
+											expr: &choiceExpr{
+												pos: position{line: 1558, col: 80, offset: 59577},
+												alternatives: []interface{}{
+													&ruleRefExpr{
+														pos:  position{line: 1558, col: 80, offset: 59577},
+														name: "Alphanums",
+													},
+													&ruleRefExpr{
+														pos:  position{line: 1558, col: 92, offset: 59589},
+														name: "Spaces",
+													},
+													&seqExpr{
+														pos: position{line: 1558, col: 102, offset: 59599},
+														exprs: []interface{}{
+															&notExpr{
+																pos: position{line: 1558, col: 102, offset: 59599},
+																expr: &ruleRefExpr{
+																	pos:  position{line: 1558, col: 103, offset: 59600},
+																	name: "EOL",
+																},
+															},
+															&anyMatcher{
+																line: 1558, col: 107, offset: 59604,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
 								},
 							},
 						},
-						&litMatcher{
-							pos:        position{line: 1135, col: 145, offset: 42937},
-							val:        "]",
-							ignoreCase: false,
+						&ruleRefExpr{
+							pos:  position{line: 1558, col: 143, offset: 59640},
+							name: "EOL",
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "InlineFootnote",
-			pos:  position{line: 1142, col: 1, offset: 43227},
-			expr: &choiceExpr{
-				pos: position{line: 1142, col: 19, offset: 43245},
-				alternatives: []interface{}{
-					&actionExpr{
-						pos: position{line: 1142, col: 19, offset: 43245},
-						run: (*parser).callonInlineFootnote2,
-						expr: &seqExpr{
-							pos: position{line: 1142, col: 19, offset: 43245},
-							exprs: []interface{}{
-								&litMatcher{
-									pos:        position{line: 1142, col: 19, offset: 43245},
-									val:        "footnote:[",
-									ignoreCase: false,
-								},
-								&labeledExpr{
-									pos:   position{line: 1142, col: 32, offset: 43258},
-									label: "content",
-									expr: &ruleRefExpr{
-										pos:  position{line: 1142, col: 41, offset: 43267},
-										name: "FootnoteContent",
-									},
-								},
-								&litMatcher{
-									pos:        position{line: 1142, col: 58, offset: 43284},
-									val:        "]",
-									ignoreCase: false,
+			name: "ExampleBlockDelimiter",
+			pos:  position{line: 1565, col: 1, offset: 59966},
+			expr: &seqExpr{
+				pos: position{line: 1565, col: 26, offset: 59991},
+				exprs: []interface{}{
+					&litMatcher{
+						pos:        position{line: 1565, col: 26, offset: 59991},
+						val:        "====",
+						ignoreCase: false,
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1565, col: 33, offset: 59998},
+						name: "EOLS",
+					},
+				},
+			},
+		},
+		{
+			name: "ExampleBlock",
+			pos:  position{line: 1567, col: 1, offset: 60004},
+			expr: &actionExpr{
+				pos: position{line: 1567, col: 17, offset: 60020},
+				run: (*parser).callonExampleBlock1,
+				expr: &seqExpr{
+					pos: position{line: 1567, col: 17, offset: 60020},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 1567, col: 17, offset: 60020},
+							label: "attributes",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 1567, col: 28, offset: 60031},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1567, col: 29, offset: 60032},
+									name: "ElementAttributes",
 								},
 							},
 						},
-					},
-					&actionExpr{
-						pos: position{line: 1144, col: 5, offset: 43359},
-						run: (*parser).callonInlineFootnote8,
-						expr: &seqExpr{
-							pos: position{line: 1144, col: 5, offset: 43359},
-							exprs: []interface{}{
-								&litMatcher{
-									pos:        position{line: 1144, col: 5, offset: 43359},
-									val:        "footnoteref:[",
-									ignoreCase: false,
-								},
-								&labeledExpr{
-									pos:   position{line: 1144, col: 21, offset: 43375},
-									label: "ref",
-									expr: &ruleRefExpr{
-										pos:  position{line: 1144, col: 26, offset: 43380},
-										name: "FootnoteRef",
-									},
-								},
-								&litMatcher{
-									pos:        position{line: 1144, col: 39, offset: 43393},
-									val:        ",",
-									ignoreCase: false,
-								},
-								&labeledExpr{
-									pos:   position{line: 1144, col: 43, offset: 43397},
-									label: "content",
-									expr: &ruleRefExpr{
-										pos:  position{line: 1144, col: 52, offset: 43406},
-										name: "FootnoteContent",
-									},
-								},
-								&litMatcher{
-									pos:        position{line: 1144, col: 69, offset: 43423},
-									val:        "]",
-									ignoreCase: false,
+						&ruleRefExpr{
+							pos:  position{line: 1567, col: 49, offset: 60052},
+							name: "ExampleBlockDelimiter",
+						},
+						&labeledExpr{
+							pos:   position{line: 1567, col: 71, offset: 60074},
+							label: "content",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 1567, col: 79, offset: 60082},
+								expr: &choiceExpr{
+									pos: position{line: 1567, col: 80, offset: 60083},
+									alternatives: []interface{}{
+										&ruleRefExpr{
+											pos:  position{line: 1567, col: 80, offset: 60083},
+											name: "BlankLine",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1567, col: 92, offset: 60095},
+											name: "FileInclusion",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1567, col: 108, offset: 60111},
+											name: "DiscreteHeading",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1567, col: 126, offset: 60129},
+											name: "ListItem",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1567, col: 137, offset: 60140},
+											name: "ExampleBlockParagraph",
+										},
+									},
 								},
 							},
 						},
-					},
-					&actionExpr{
-						pos: position{line: 1146, col: 5, offset: 43508},
-						run: (*parser).callonInlineFootnote17,
-						expr: &seqExpr{
-							pos: position{line: 1146, col: 5, offset: 43508},
-							exprs: []interface{}{
-								&litMatcher{
-									pos:        position{line: 1146, col: 5, offset: 43508},
-									val:        "footnoteref:[",
-									ignoreCase: false,
-								},
-								&labeledExpr{
-									pos:   position{line: 1146, col: 21, offset: 43524},
-									label: "ref",
-									expr: &ruleRefExpr{
-										pos:  position{line: 1146, col: 26, offset: 43529},
-										name: "FootnoteRef",
-									},
+						&choiceExpr{
+							pos: position{line: 1567, col: 163, offset: 60166},
+							alternatives: []interface{}{
+								&ruleRefExpr{
+									pos:  position{line: 1567, col: 163, offset: 60166},
+									name: "ExampleBlockDelimiter",
 								},
-								&litMatcher{
-									pos:        position{line: 1146, col: 39, offset: 43542},
-									val:        "]",
-									ignoreCase: false,
+								&ruleRefExpr{
+									pos:  position{line: 1567, col: 187, offset: 60190},
+									name: "EOF",
 								},
 							},
 						},
@@ -9226,54 +12727,53 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "FootnoteRef",
-			pos:  position{line: 1150, col: 1, offset: 43657},
+			name: "ExampleBlockParagraph",
+			pos:  position{line: 1572, col: 1, offset: 60317},
 			expr: &actionExpr{
-				pos: position{line: 1150, col: 16, offset: 43672},
-				run: (*parser).callonFootnoteRef1,
-				expr: &zeroOrMoreExpr{
-					pos: position{line: 1150, col: 16, offset: 43672},
-					expr: &choiceExpr{
-						pos: position{line: 1150, col: 17, offset: 43673},
-						alternatives: []interface{}{
-							&ruleRefExpr{
-								pos:  position{line: 1150, col: 17, offset: 43673},
-								name: "Alphanums",
+				pos: position{line: 1572, col: 26, offset: 60342},
+				run: (*parser).callonExampleBlockParagraph1,
+				expr: &labeledExpr{
+					pos:   position{line: 1572, col: 26, offset: 60342},
+					label: "lines",
+					expr: &oneOrMoreExpr{
+						pos: position{line: 1572, col: 32, offset: 60348},
+						expr: &ruleRefExpr{
+							pos:  position{line: 1572, col: 33, offset: 60349},
+							name: "ExampleBlockParagraphLine",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ExampleBlockParagraphLine",
+			pos:  position{line: 1576, col: 1, offset: 60463},
+			expr: &actionExpr{
+				pos: position{line: 1576, col: 30, offset: 60492},
+				run: (*parser).callonExampleBlockParagraphLine1,
+				expr: &seqExpr{
+					pos: position{line: 1576, col: 30, offset: 60492},
+					exprs: []interface{}{
+						&notExpr{
+							pos: position{line: 1576, col: 30, offset: 60492},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1576, col: 31, offset: 60493},
+								name: "ExampleBlockDelimiter",
 							},
-							&ruleRefExpr{
-								pos:  position{line: 1150, col: 29, offset: 43685},
-								name: "Spaces",
+						},
+						&notExpr{
+							pos: position{line: 1576, col: 53, offset: 60515},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1576, col: 54, offset: 60516},
+								name: "BlankLine",
 							},
-							&seqExpr{
-								pos: position{line: 1150, col: 39, offset: 43695},
-								exprs: []interface{}{
-									&notExpr{
-										pos: position{line: 1150, col: 39, offset: 43695},
-										expr: &litMatcher{
-											pos:        position{line: 1150, col: 40, offset: 43696},
-											val:        ",",
-											ignoreCase: false,
-										},
-									},
-									&notExpr{
-										pos: position{line: 1150, col: 44, offset: 43700},
-										expr: &litMatcher{
-											pos:        position{line: 1150, col: 45, offset: 43701},
-											val:        "]",
-											ignoreCase: false,
-										},
-									},
-									&notExpr{
-										pos: position{line: 1150, col: 49, offset: 43705},
-										expr: &ruleRefExpr{
-											pos:  position{line: 1150, col: 50, offset: 43706},
-											name: "EOL",
-										},
-									},
-									&anyMatcher{
-										line: 1150, col: 55, offset: 43711,
-									},
-								},
+						},
+						&labeledExpr{
+							pos:   position{line: 1576, col: 64, offset: 60526},
+							label: "line",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1576, col: 70, offset: 60532},
+								name: "InlineElements",
 							},
 						},
 					},
@@ -9281,58 +12781,68 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "FootnoteContent",
-			pos:  position{line: 1154, col: 1, offset: 43796},
+			name: "QuoteBlockDelimiter",
+			pos:  position{line: 1583, col: 1, offset: 60768},
+			expr: &seqExpr{
+				pos: position{line: 1583, col: 24, offset: 60791},
+				exprs: []interface{}{
+					&litMatcher{
+						pos:        position{line: 1583, col: 24, offset: 60791},
+						val:        "____",
+						ignoreCase: false,
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1583, col: 31, offset: 60798},
+						name: "EOLS",
+					},
+				},
+			},
+		},
+		{
+			name: "QuoteBlock",
+			pos:  position{line: 1585, col: 1, offset: 60829},
 			expr: &actionExpr{
-				pos: position{line: 1154, col: 20, offset: 43815},
-				run: (*parser).callonFootnoteContent1,
-				expr: &labeledExpr{
-					pos:   position{line: 1154, col: 20, offset: 43815},
-					label: "elements",
-					expr: &oneOrMoreExpr{
-						pos: position{line: 1154, col: 29, offset: 43824},
-						expr: &seqExpr{
-							pos: position{line: 1154, col: 30, offset: 43825},
-							exprs: []interface{}{
-								&notExpr{
-									pos: position{line: 1154, col: 30, offset: 43825},
-									expr: &litMatcher{
-										pos:        position{line: 1154, col: 31, offset: 43826},
-										val:        "]",
-										ignoreCase: false,
-									},
-								},
-								&notExpr{
-									pos: position{line: 1154, col: 35, offset: 43830},
-									expr: &ruleRefExpr{
-										pos:  position{line: 1154, col: 36, offset: 43831},
-										name: "EOL",
-									},
-								},
-								&zeroOrMoreExpr{
-									pos: position{line: 1154, col: 40, offset: 43835},
-									expr: &ruleRefExpr{
-										pos:  position{line: 1154, col: 40, offset: 43835},
-										name: "WS",
-									},
+				pos: position{line: 1585, col: 15, offset: 60843},
+				run: (*parser).callonQuoteBlock1,
+				expr: &seqExpr{
+					pos: position{line: 1585, col: 15, offset: 60843},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 1585, col: 15, offset: 60843},
+							label: "attributes",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 1585, col: 26, offset: 60854},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1585, col: 27, offset: 60855},
+									name: "ElementAttributes",
 								},
-								&notExpr{
-									pos: position{line: 1154, col: 44, offset: 43839},
-									expr: &ruleRefExpr{
-										pos:  position{line: 1154, col: 45, offset: 43840},
-										name: "InlineElementID",
-									},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1585, col: 47, offset: 60875},
+							name: "QuoteBlockDelimiter",
+						},
+						&labeledExpr{
+							pos:   position{line: 1585, col: 67, offset: 60895},
+							label: "content",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 1585, col: 75, offset: 60903},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1585, col: 76, offset: 60904},
+									name: "QuoteBlockElement",
 								},
+							},
+						},
+						&choiceExpr{
+							pos: position{line: 1585, col: 97, offset: 60925},
+							alternatives: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 1154, col: 61, offset: 43856},
-									name: "InlineElement",
+									pos:  position{line: 1585, col: 97, offset: 60925},
+									name: "QuoteBlockDelimiter",
 								},
-								&zeroOrMoreExpr{
-									pos: position{line: 1154, col: 75, offset: 43870},
-									expr: &ruleRefExpr{
-										pos:  position{line: 1154, col: 75, offset: 43870},
-										name: "WS",
-									},
+								&ruleRefExpr{
+									pos:  position{line: 1585, col: 119, offset: 60947},
+									name: "EOF",
 								},
 							},
 						},
@@ -9341,62 +12851,113 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "DelimitedBlock",
-			pos:  position{line: 1161, col: 1, offset: 44184},
+			name: "QuoteBlockElement",
+			pos:  position{line: 1589, col: 1, offset: 61054},
 			expr: &actionExpr{
-				pos: position{line: 1161, col: 19, offset: 44202},
-				run: (*parser).callonDelimitedBlock1,
+				pos: position{line: 1590, col: 5, offset: 61080},
+				run: (*parser).callonQuoteBlockElement1,
 				expr: &seqExpr{
-					pos: position{line: 1161, col: 19, offset: 44202},
+					pos: position{line: 1590, col: 5, offset: 61080},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1161, col: 19, offset: 44202},
+							pos: position{line: 1590, col: 5, offset: 61080},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1161, col: 20, offset: 44203},
-								name: "Alphanum",
+								pos:  position{line: 1590, col: 6, offset: 61081},
+								name: "QuoteBlockDelimiter",
+							},
+						},
+						&notExpr{
+							pos: position{line: 1590, col: 26, offset: 61101},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1590, col: 27, offset: 61102},
+								name: "EOF",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1162, col: 5, offset: 44232},
-							label: "block",
+							pos:   position{line: 1590, col: 31, offset: 61106},
+							label: "element",
 							expr: &choiceExpr{
-								pos: position{line: 1162, col: 12, offset: 44239},
+								pos: position{line: 1590, col: 40, offset: 61115},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 1162, col: 12, offset: 44239},
+										pos:  position{line: 1590, col: 40, offset: 61115},
+										name: "BlankLine",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1591, col: 15, offset: 61140},
+										name: "FileInclusion",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1592, col: 15, offset: 61168},
+										name: "DiscreteHeading",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1593, col: 15, offset: 61198},
+										name: "ImageBlock",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1594, col: 15, offset: 61224},
+										name: "VideoBlock",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1595, col: 15, offset: 61249},
+										name: "AudioBlock",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1596, col: 15, offset: 61274},
+										name: "ListItem",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1597, col: 15, offset: 61297},
 										name: "FencedBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1163, col: 11, offset: 44262},
+										pos:  position{line: 1598, col: 15, offset: 61323},
 										name: "ListingBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1164, col: 11, offset: 44286},
+										pos:  position{line: 1599, col: 15, offset: 61350},
 										name: "ExampleBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1165, col: 11, offset: 44310},
-										name: "VerseBlock",
+										pos:  position{line: 1600, col: 15, offset: 61377},
+										name: "CommentBlock",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1601, col: 15, offset: 61404},
+										name: "SingleLineComment",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1166, col: 11, offset: 44332},
+										pos:  position{line: 1602, col: 15, offset: 61436},
 										name: "QuoteBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1167, col: 11, offset: 44354},
+										pos:  position{line: 1603, col: 15, offset: 61462},
 										name: "SidebarBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1168, col: 11, offset: 44377},
-										name: "SingleLineComment",
+										pos:  position{line: 1604, col: 15, offset: 61489},
+										name: "Table",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1169, col: 11, offset: 44405},
-										name: "Table",
+										pos:  position{line: 1605, col: 15, offset: 61510},
+										name: "LiteralBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1170, col: 11, offset: 44421},
-										name: "CommentBlock",
+										pos:  position{line: 1606, col: 15, offset: 61538},
+										name: "DocumentAttributeDeclaration",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1607, col: 15, offset: 61582},
+										name: "DocumentAttributeReset",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1608, col: 15, offset: 61620},
+										name: "TableOfContentsMacro",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1609, col: 15, offset: 61655},
+										name: "QuoteBlockParagraph",
 									},
 								},
 							},
@@ -9406,105 +12967,63 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "BlockDelimiter",
-			pos:  position{line: 1174, col: 1, offset: 44462},
-			expr: &choiceExpr{
-				pos: position{line: 1174, col: 19, offset: 44480},
-				alternatives: []interface{}{
-					&ruleRefExpr{
-						pos:  position{line: 1174, col: 19, offset: 44480},
-						name: "LiteralBlockDelimiter",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 1175, col: 19, offset: 44521},
-						name: "FencedBlockDelimiter",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 1176, col: 19, offset: 44561},
-						name: "ListingBlockDelimiter",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 1177, col: 19, offset: 44602},
-						name: "ExampleBlockDelimiter",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 1178, col: 19, offset: 44643},
-						name: "CommentBlockDelimiter",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 1179, col: 19, offset: 44684},
-						name: "QuoteBlockDelimiter",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 1180, col: 19, offset: 44722},
-						name: "SidebarBlockDelimiter",
-					},
-				},
-			},
-		},
-		{
-			name: "FencedBlockDelimiter",
-			pos:  position{line: 1186, col: 1, offset: 44941},
-			expr: &seqExpr{
-				pos: position{line: 1186, col: 25, offset: 44965},
-				exprs: []interface{}{
-					&litMatcher{
-						pos:        position{line: 1186, col: 25, offset: 44965},
-						val:        "```",
-						ignoreCase: false,
-					},
-					&ruleRefExpr{
-						pos:  position{line: 1186, col: 31, offset: 44971},
-						name: "EOLS",
+			name: "QuoteBlockParagraph",
+			pos:  position{line: 1613, col: 1, offset: 61714},
+			expr: &actionExpr{
+				pos: position{line: 1613, col: 24, offset: 61737},
+				run: (*parser).callonQuoteBlockParagraph1,
+				expr: &labeledExpr{
+					pos:   position{line: 1613, col: 24, offset: 61737},
+					label: "lines",
+					expr: &oneOrMoreExpr{
+						pos: position{line: 1613, col: 30, offset: 61743},
+						expr: &ruleRefExpr{
+							pos:  position{line: 1613, col: 31, offset: 61744},
+							name: "InlineElements",
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "FencedBlock",
-			pos:  position{line: 1188, col: 1, offset: 44977},
+			name: "MarkdownQuoteBlock",
+			pos:  position{line: 1621, col: 1, offset: 62151},
 			expr: &actionExpr{
-				pos: position{line: 1188, col: 16, offset: 44992},
-				run: (*parser).callonFencedBlock1,
+				pos: position{line: 1621, col: 23, offset: 62173},
+				run: (*parser).callonMarkdownQuoteBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1188, col: 16, offset: 44992},
+					pos: position{line: 1621, col: 23, offset: 62173},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1188, col: 16, offset: 44992},
+							pos:   position{line: 1621, col: 23, offset: 62173},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1188, col: 27, offset: 45003},
+								pos: position{line: 1621, col: 34, offset: 62184},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1188, col: 28, offset: 45004},
+									pos:  position{line: 1621, col: 35, offset: 62185},
 									name: "ElementAttributes",
 								},
 							},
 						},
-						&ruleRefExpr{
-							pos:  position{line: 1188, col: 48, offset: 45024},
-							name: "FencedBlockDelimiter",
-						},
 						&labeledExpr{
-							pos:   position{line: 1188, col: 69, offset: 45045},
+							pos:   position{line: 1621, col: 55, offset: 62205},
 							label: "content",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 1188, col: 77, offset: 45053},
+							expr: &oneOrMoreExpr{
+								pos: position{line: 1621, col: 63, offset: 62213},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1188, col: 78, offset: 45054},
-									name: "FencedBlockContent",
+									pos:  position{line: 1621, col: 64, offset: 62214},
+									name: "MarkdownQuoteBlockElement",
 								},
 							},
 						},
-						&choiceExpr{
-							pos: position{line: 1188, col: 100, offset: 45076},
-							alternatives: []interface{}{
-								&ruleRefExpr{
-									pos:  position{line: 1188, col: 100, offset: 45076},
-									name: "FencedBlockDelimiter",
-								},
-								&ruleRefExpr{
-									pos:  position{line: 1188, col: 123, offset: 45099},
-									name: "EOF",
+						&labeledExpr{
+							pos:   position{line: 1621, col: 92, offset: 62242},
+							label: "attribution",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 1621, col: 104, offset: 62254},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1621, col: 105, offset: 62255},
+									name: "MarkdownQuoteBlockAttribution",
 								},
 							},
 						},
@@ -9513,77 +13032,96 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "FencedBlockContent",
-			pos:  position{line: 1192, col: 1, offset: 45207},
+			name: "MarkdownQuoteBlockElement",
+			pos:  position{line: 1625, col: 1, offset: 62381},
 			expr: &choiceExpr{
-				pos: position{line: 1192, col: 23, offset: 45229},
+				pos: position{line: 1625, col: 30, offset: 62410},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1192, col: 23, offset: 45229},
-						name: "BlankLine",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 1192, col: 35, offset: 45241},
-						name: "FileInclusion",
+						pos:  position{line: 1625, col: 30, offset: 62410},
+						name: "MarkdownQuoteBlankLine",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1192, col: 51, offset: 45257},
-						name: "ListItem",
+						pos:  position{line: 1625, col: 55, offset: 62435},
+						name: "MarkdownQuoteBlockParagraph",
 					},
-					&ruleRefExpr{
-						pos:  position{line: 1192, col: 62, offset: 45268},
-						name: "FencedBlockParagraph",
+				},
+			},
+		},
+		{
+			name: "MarkdownQuoteBlankLine",
+			pos:  position{line: 1627, col: 1, offset: 62464},
+			expr: &actionExpr{
+				pos: position{line: 1627, col: 27, offset: 62490},
+				run: (*parser).callonMarkdownQuoteBlankLine1,
+				expr: &seqExpr{
+					pos: position{line: 1627, col: 27, offset: 62490},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 1627, col: 27, offset: 62490},
+							val:        ">",
+							ignoreCase: false,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1627, col: 31, offset: 62494},
+							name: "EOLS",
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "FencedBlockParagraph",
-			pos:  position{line: 1195, col: 1, offset: 45308},
+			name: "MarkdownQuoteBlockParagraph",
+			pos:  position{line: 1631, col: 1, offset: 62536},
 			expr: &actionExpr{
-				pos: position{line: 1195, col: 25, offset: 45332},
-				run: (*parser).callonFencedBlockParagraph1,
+				pos: position{line: 1631, col: 32, offset: 62567},
+				run: (*parser).callonMarkdownQuoteBlockParagraph1,
 				expr: &labeledExpr{
-					pos:   position{line: 1195, col: 25, offset: 45332},
+					pos:   position{line: 1631, col: 32, offset: 62567},
 					label: "lines",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 1195, col: 31, offset: 45338},
+						pos: position{line: 1631, col: 38, offset: 62573},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1195, col: 32, offset: 45339},
-							name: "FencedBlockParagraphLine",
+							pos:  position{line: 1631, col: 39, offset: 62574},
+							name: "MarkdownQuoteBlockParagraphLine",
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "FencedBlockParagraphLine",
-			pos:  position{line: 1199, col: 1, offset: 45452},
+			name: "MarkdownQuoteBlockParagraphLine",
+			pos:  position{line: 1635, col: 1, offset: 62693},
 			expr: &actionExpr{
-				pos: position{line: 1199, col: 29, offset: 45480},
-				run: (*parser).callonFencedBlockParagraphLine1,
+				pos: position{line: 1635, col: 36, offset: 62728},
+				run: (*parser).callonMarkdownQuoteBlockParagraphLine1,
 				expr: &seqExpr{
-					pos: position{line: 1199, col: 29, offset: 45480},
+					pos: position{line: 1635, col: 36, offset: 62728},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1199, col: 29, offset: 45480},
+							pos: position{line: 1635, col: 36, offset: 62728},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1199, col: 30, offset: 45481},
-								name: "FencedBlockDelimiter",
+								pos:  position{line: 1635, col: 37, offset: 62729},
+								name: "MarkdownQuoteBlockAttribution",
 							},
 						},
-						&notExpr{
-							pos: position{line: 1199, col: 51, offset: 45502},
+						&litMatcher{
+							pos:        position{line: 1635, col: 67, offset: 62759},
+							val:        "> ",
+							ignoreCase: false,
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 1635, col: 72, offset: 62764},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1199, col: 52, offset: 45503},
-								name: "BlankLine",
+								pos:  position{line: 1635, col: 72, offset: 62764},
+								name: "WS",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1199, col: 62, offset: 45513},
+							pos:   position{line: 1635, col: 76, offset: 62768},
 							label: "line",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1199, col: 68, offset: 45519},
+								pos:  position{line: 1635, col: 82, offset: 62774},
 								name: "InlineElements",
 							},
 						},
@@ -9592,180 +13130,55 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "ListingBlockDelimiter",
-			pos:  position{line: 1206, col: 1, offset: 45757},
-			expr: &seqExpr{
-				pos: position{line: 1206, col: 26, offset: 45782},
-				exprs: []interface{}{
-					&litMatcher{
-						pos:        position{line: 1206, col: 26, offset: 45782},
-						val:        "----",
-						ignoreCase: false,
-					},
-					&ruleRefExpr{
-						pos:  position{line: 1206, col: 33, offset: 45789},
-						name: "EOLS",
-					},
-				},
-			},
-		},
-		{
-			name: "ListingBlock",
-			pos:  position{line: 1209, col: 1, offset: 45830},
+			name: "MarkdownQuoteBlockAttribution",
+			pos:  position{line: 1639, col: 1, offset: 62816},
 			expr: &actionExpr{
-				pos: position{line: 1209, col: 17, offset: 45846},
-				run: (*parser).callonListingBlock1,
+				pos: position{line: 1639, col: 34, offset: 62849},
+				run: (*parser).callonMarkdownQuoteBlockAttribution1,
 				expr: &seqExpr{
-					pos: position{line: 1209, col: 17, offset: 45846},
+					pos: position{line: 1639, col: 34, offset: 62849},
 					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 1209, col: 17, offset: 45846},
-							label: "attributes",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 1209, col: 28, offset: 45857},
-								expr: &ruleRefExpr{
-									pos:  position{line: 1209, col: 29, offset: 45858},
-									name: "ElementAttributes",
-								},
-							},
-						},
-						&ruleRefExpr{
-							pos:  position{line: 1209, col: 49, offset: 45878},
-							name: "ListingBlockDelimiter",
+						&litMatcher{
+							pos:        position{line: 1639, col: 34, offset: 62849},
+							val:        "> -- ",
+							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 1209, col: 71, offset: 45900},
-							label: "content",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 1209, col: 79, offset: 45908},
-								expr: &ruleRefExpr{
-									pos:  position{line: 1209, col: 80, offset: 45909},
-									name: "ListingBlockElement",
-								},
+							pos:   position{line: 1639, col: 42, offset: 62857},
+							label: "author",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1639, col: 50, offset: 62865},
+								name: "QuoteAttribute",
 							},
 						},
-						&choiceExpr{
-							pos: position{line: 1209, col: 103, offset: 45932},
-							alternatives: []interface{}{
-								&ruleRefExpr{
-									pos:  position{line: 1209, col: 103, offset: 45932},
-									name: "ListingBlockDelimiter",
-								},
-								&ruleRefExpr{
-									pos:  position{line: 1209, col: 127, offset: 45956},
-									name: "EOF",
-								},
+						&zeroOrOneExpr{
+							pos: position{line: 1639, col: 66, offset: 62881},
+							expr: &litMatcher{
+								pos:        position{line: 1639, col: 66, offset: 62881},
+								val:        ",",
+								ignoreCase: false,
 							},
 						},
-					},
-				},
-			},
-		},
-		{
-			name: "ListingBlockElement",
-			pos:  position{line: 1213, col: 1, offset: 46065},
-			expr: &choiceExpr{
-				pos: position{line: 1213, col: 24, offset: 46088},
-				alternatives: []interface{}{
-					&ruleRefExpr{
-						pos:  position{line: 1213, col: 24, offset: 46088},
-						name: "FileInclusion",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 1213, col: 40, offset: 46104},
-						name: "ListingBlockParagraph",
-					},
-				},
-			},
-		},
-		{
-			name: "ListingBlockParagraph",
-			pos:  position{line: 1215, col: 1, offset: 46127},
-			expr: &actionExpr{
-				pos: position{line: 1215, col: 26, offset: 46152},
-				run: (*parser).callonListingBlockParagraph1,
-				expr: &labeledExpr{
-					pos:   position{line: 1215, col: 26, offset: 46152},
-					label: "lines",
-					expr: &oneOrMoreExpr{
-						pos: position{line: 1215, col: 32, offset: 46158},
-						expr: &ruleRefExpr{
-							pos:  position{line: 1215, col: 33, offset: 46159},
-							name: "ListingBlockParagraphLine",
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "ListingBlockParagraphLine",
-			pos:  position{line: 1219, col: 1, offset: 46278},
-			expr: &actionExpr{
-				pos: position{line: 1219, col: 30, offset: 46307},
-				run: (*parser).callonListingBlockParagraphLine1,
-				expr: &seqExpr{
-					pos: position{line: 1219, col: 30, offset: 46307},
-					exprs: []interface{}{
-						&notExpr{
-							pos: position{line: 1219, col: 30, offset: 46307},
+						&zeroOrMoreExpr{
+							pos: position{line: 1639, col: 71, offset: 62886},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1219, col: 31, offset: 46308},
-								name: "ListingBlockDelimiter",
+								pos:  position{line: 1639, col: 71, offset: 62886},
+								name: "WS",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1219, col: 53, offset: 46330},
-							label: "line",
-							expr: &actionExpr{
-								pos: position{line: 1219, col: 59, offset: 46336},
-								run: (*parser).callonListingBlockParagraphLine6,
-								expr: &seqExpr{
-									pos: position{line: 1219, col: 59, offset: 46336},
-									exprs: []interface{}{
-										&notExpr{
-											pos: position{line: 1219, col: 59, offset: 46336},
-											expr: &ruleRefExpr{
-												pos:  position{line: 1219, col: 60, offset: 46337},
-												name: "EOF",
-											},
-										},
-										&zeroOrMoreExpr{
-											pos: position{line: 1219, col: 64, offset: 46341},
-											expr: &choiceExpr{
-												pos: position{line: 1219, col: 65, offset: 46342},
-												alternatives: []interface{}{
-													&ruleRefExpr{
-														pos:  position{line: 1219, col: 65, offset: 46342},
-														name: "Alphanums",
-													},
-													&ruleRefExpr{
-														pos:  position{line: 1219, col: 77, offset: 46354},
-														name: "Spaces",
-													},
-													&seqExpr{
-														pos: position{line: 1219, col: 87, offset: 46364},
-														exprs: []interface{}{
-															&notExpr{
-																pos: position{line: 1219, col: 87, offset: 46364},
-																expr: &ruleRefExpr{
-																	pos:  position{line: 1219, col: 88, offset: 46365},
-																	name: "EOL",
-																},
-															},
-															&anyMatcher{
-																line: 1219, col: 92, offset: 46369,
-															},
-														},
-													},
-												},
-											},
-										},
-									},
+							pos:   position{line: 1639, col: 75, offset: 62890},
+							label: "title",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 1639, col: 81, offset: 62896},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1639, col: 82, offset: 62897},
+									name: "QuoteAttribute",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1219, col: 128, offset: 46405},
+							pos:  position{line: 1639, col: 99, offset: 62914},
 							name: "EOL",
 						},
 					},
@@ -9773,84 +13186,50 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "ExampleBlockDelimiter",
-			pos:  position{line: 1226, col: 1, offset: 46731},
-			expr: &seqExpr{
-				pos: position{line: 1226, col: 26, offset: 46756},
-				exprs: []interface{}{
-					&litMatcher{
-						pos:        position{line: 1226, col: 26, offset: 46756},
-						val:        "====",
-						ignoreCase: false,
-					},
-					&ruleRefExpr{
-						pos:  position{line: 1226, col: 33, offset: 46763},
-						name: "EOLS",
-					},
-				},
-			},
-		},
-		{
-			name: "ExampleBlock",
-			pos:  position{line: 1228, col: 1, offset: 46769},
+			name: "VerseBlock",
+			pos:  position{line: 1648, col: 1, offset: 63226},
 			expr: &actionExpr{
-				pos: position{line: 1228, col: 17, offset: 46785},
-				run: (*parser).callonExampleBlock1,
+				pos: position{line: 1648, col: 15, offset: 63240},
+				run: (*parser).callonVerseBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1228, col: 17, offset: 46785},
+					pos: position{line: 1648, col: 15, offset: 63240},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1228, col: 17, offset: 46785},
+							pos:   position{line: 1648, col: 15, offset: 63240},
 							label: "attributes",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 1228, col: 28, offset: 46796},
-								expr: &ruleRefExpr{
-									pos:  position{line: 1228, col: 29, offset: 46797},
-									name: "ElementAttributes",
-								},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1648, col: 27, offset: 63252},
+								name: "ElementAttributes",
 							},
 						},
+						&andCodeExpr{
+							pos: position{line: 1649, col: 5, offset: 63276},
+							run: (*parser).callonVerseBlock5,
+						},
 						&ruleRefExpr{
-							pos:  position{line: 1228, col: 49, offset: 46817},
-							name: "ExampleBlockDelimiter",
+							pos:  position{line: 1653, col: 5, offset: 63462},
+							name: "QuoteBlockDelimiter",
 						},
 						&labeledExpr{
-							pos:   position{line: 1228, col: 71, offset: 46839},
+							pos:   position{line: 1653, col: 25, offset: 63482},
 							label: "content",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1228, col: 79, offset: 46847},
-								expr: &choiceExpr{
-									pos: position{line: 1228, col: 80, offset: 46848},
-									alternatives: []interface{}{
-										&ruleRefExpr{
-											pos:  position{line: 1228, col: 80, offset: 46848},
-											name: "BlankLine",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 1228, col: 92, offset: 46860},
-											name: "FileInclusion",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 1228, col: 108, offset: 46876},
-											name: "ListItem",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 1228, col: 119, offset: 46887},
-											name: "ExampleBlockParagraph",
-										},
-									},
+								pos: position{line: 1653, col: 33, offset: 63490},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1653, col: 34, offset: 63491},
+									name: "VerseBlockElement",
 								},
 							},
 						},
 						&choiceExpr{
-							pos: position{line: 1228, col: 145, offset: 46913},
+							pos: position{line: 1653, col: 55, offset: 63512},
 							alternatives: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 1228, col: 145, offset: 46913},
-									name: "ExampleBlockDelimiter",
+									pos:  position{line: 1653, col: 55, offset: 63512},
+									name: "QuoteBlockDelimiter",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1228, col: 169, offset: 46937},
+									pos:  position{line: 1653, col: 77, offset: 63534},
 									name: "EOF",
 								},
 							},
@@ -9860,53 +13239,48 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "ExampleBlockParagraph",
-			pos:  position{line: 1233, col: 1, offset: 47064},
-			expr: &actionExpr{
-				pos: position{line: 1233, col: 26, offset: 47089},
-				run: (*parser).callonExampleBlockParagraph1,
-				expr: &labeledExpr{
-					pos:   position{line: 1233, col: 26, offset: 47089},
-					label: "lines",
-					expr: &oneOrMoreExpr{
-						pos: position{line: 1233, col: 32, offset: 47095},
-						expr: &ruleRefExpr{
-							pos:  position{line: 1233, col: 33, offset: 47096},
-							name: "ExampleBlockParagraphLine",
-						},
+			name: "VerseBlockElement",
+			pos:  position{line: 1657, col: 1, offset: 63649},
+			expr: &choiceExpr{
+				pos: position{line: 1657, col: 22, offset: 63670},
+				alternatives: []interface{}{
+					&ruleRefExpr{
+						pos:  position{line: 1657, col: 22, offset: 63670},
+						name: "VerseFileInclude",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1657, col: 41, offset: 63689},
+						name: "BlankLine",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1657, col: 53, offset: 63701},
+						name: "VerseBlockParagraph",
 					},
 				},
 			},
 		},
 		{
-			name: "ExampleBlockParagraphLine",
-			pos:  position{line: 1237, col: 1, offset: 47210},
-			expr: &actionExpr{
-				pos: position{line: 1237, col: 30, offset: 47239},
-				run: (*parser).callonExampleBlockParagraphLine1,
-				expr: &seqExpr{
-					pos: position{line: 1237, col: 30, offset: 47239},
-					exprs: []interface{}{
-						&notExpr{
-							pos: position{line: 1237, col: 30, offset: 47239},
-							expr: &ruleRefExpr{
-								pos:  position{line: 1237, col: 31, offset: 47240},
-								name: "ExampleBlockDelimiter",
-							},
-						},
+			name: "VerseFileInclude",
+			pos:  position{line: 1659, col: 1, offset: 63722},
+			expr: &actionExpr{
+				pos: position{line: 1659, col: 21, offset: 63742},
+				run: (*parser).callonVerseFileInclude1,
+				expr: &seqExpr{
+					pos: position{line: 1659, col: 21, offset: 63742},
+					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1237, col: 53, offset: 47262},
+							pos: position{line: 1659, col: 21, offset: 63742},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1237, col: 54, offset: 47263},
-								name: "BlankLine",
+								pos:  position{line: 1659, col: 22, offset: 63743},
+								name: "QuoteBlockDelimiter",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1237, col: 64, offset: 47273},
-							label: "line",
+							pos:   position{line: 1659, col: 42, offset: 63763},
+							label: "include",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1237, col: 70, offset: 47279},
-								name: "InlineElements",
+								pos:  position{line: 1659, col: 51, offset: 63772},
+								name: "FileInclusion",
 							},
 						},
 					},
@@ -9914,68 +13288,72 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "QuoteBlockDelimiter",
-			pos:  position{line: 1244, col: 1, offset: 47515},
-			expr: &seqExpr{
-				pos: position{line: 1244, col: 24, offset: 47538},
-				exprs: []interface{}{
-					&litMatcher{
-						pos:        position{line: 1244, col: 24, offset: 47538},
-						val:        "____",
-						ignoreCase: false,
-					},
-					&ruleRefExpr{
-						pos:  position{line: 1244, col: 31, offset: 47545},
-						name: "EOLS",
+			name: "VerseBlockParagraph",
+			pos:  position{line: 1664, col: 1, offset: 63834},
+			expr: &actionExpr{
+				pos: position{line: 1664, col: 24, offset: 63857},
+				run: (*parser).callonVerseBlockParagraph1,
+				expr: &labeledExpr{
+					pos:   position{line: 1664, col: 24, offset: 63857},
+					label: "lines",
+					expr: &oneOrMoreExpr{
+						pos: position{line: 1664, col: 30, offset: 63863},
+						expr: &ruleRefExpr{
+							pos:  position{line: 1664, col: 31, offset: 63864},
+							name: "VerseBlockParagraphLine",
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "QuoteBlock",
-			pos:  position{line: 1246, col: 1, offset: 47576},
+			name: "VerseBlockParagraphLine",
+			pos:  position{line: 1668, col: 1, offset: 63954},
 			expr: &actionExpr{
-				pos: position{line: 1246, col: 15, offset: 47590},
-				run: (*parser).callonQuoteBlock1,
+				pos: position{line: 1668, col: 28, offset: 63981},
+				run: (*parser).callonVerseBlockParagraphLine1,
 				expr: &seqExpr{
-					pos: position{line: 1246, col: 15, offset: 47590},
+					pos: position{line: 1668, col: 28, offset: 63981},
 					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 1246, col: 15, offset: 47590},
-							label: "attributes",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 1246, col: 26, offset: 47601},
-								expr: &ruleRefExpr{
-									pos:  position{line: 1246, col: 27, offset: 47602},
-									name: "ElementAttributes",
-								},
+						&notExpr{
+							pos: position{line: 1668, col: 28, offset: 63981},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1668, col: 29, offset: 63982},
+								name: "QuoteBlockDelimiter",
 							},
 						},
-						&ruleRefExpr{
-							pos:  position{line: 1246, col: 47, offset: 47622},
-							name: "QuoteBlockDelimiter",
-						},
-						&labeledExpr{
-							pos:   position{line: 1246, col: 67, offset: 47642},
-							label: "content",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 1246, col: 75, offset: 47650},
-								expr: &ruleRefExpr{
-									pos:  position{line: 1246, col: 76, offset: 47651},
-									name: "QuoteBlockElement",
-								},
+						&notExpr{
+							pos: position{line: 1668, col: 49, offset: 64002},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1668, col: 50, offset: 64003},
+								name: "BlankLine",
 							},
 						},
-						&choiceExpr{
-							pos: position{line: 1246, col: 97, offset: 47672},
-							alternatives: []interface{}{
-								&ruleRefExpr{
-									pos:  position{line: 1246, col: 97, offset: 47672},
-									name: "QuoteBlockDelimiter",
-								},
-								&ruleRefExpr{
-									pos:  position{line: 1246, col: 119, offset: 47694},
-									name: "EOF",
+						&labeledExpr{
+							pos:   position{line: 1668, col: 60, offset: 64013},
+							label: "line",
+							expr: &actionExpr{
+								pos: position{line: 1668, col: 66, offset: 64019},
+								run: (*parser).callonVerseBlockParagraphLine8,
+								expr: &seqExpr{
+									pos: position{line: 1668, col: 66, offset: 64019},
+									exprs: []interface{}{
+										&labeledExpr{
+											pos:   position{line: 1668, col: 66, offset: 64019},
+											label: "elements",
+											expr: &oneOrMoreExpr{
+												pos: position{line: 1668, col: 75, offset: 64028},
+												expr: &ruleRefExpr{
+													pos:  position{line: 1668, col: 76, offset: 64029},
+													name: "VerseBlockParagraphLineElement",
+												},
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1668, col: 109, offset: 64062},
+											name: "EOL",
+										},
+									},
 								},
 							},
 						},
@@ -9984,101 +13362,81 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "QuoteBlockElement",
-			pos:  position{line: 1250, col: 1, offset: 47801},
+			name: "VerseBlockParagraphLineElement",
+			pos:  position{line: 1674, col: 1, offset: 64158},
 			expr: &actionExpr{
-				pos: position{line: 1251, col: 5, offset: 47827},
-				run: (*parser).callonQuoteBlockElement1,
+				pos: position{line: 1674, col: 35, offset: 64192},
+				run: (*parser).callonVerseBlockParagraphLineElement1,
 				expr: &seqExpr{
-					pos: position{line: 1251, col: 5, offset: 47827},
+					pos: position{line: 1674, col: 35, offset: 64192},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1251, col: 5, offset: 47827},
+							pos: position{line: 1674, col: 35, offset: 64192},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1251, col: 6, offset: 47828},
-								name: "QuoteBlockDelimiter",
+								pos:  position{line: 1674, col: 36, offset: 64193},
+								name: "EOL",
 							},
 						},
 						&notExpr{
-							pos: position{line: 1251, col: 26, offset: 47848},
+							pos: position{line: 1674, col: 40, offset: 64197},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1251, col: 27, offset: 47849},
-								name: "EOF",
+								pos:  position{line: 1674, col: 41, offset: 64198},
+								name: "LineBreak",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1251, col: 31, offset: 47853},
+							pos:   position{line: 1675, col: 5, offset: 64213},
 							label: "element",
 							expr: &choiceExpr{
-								pos: position{line: 1251, col: 40, offset: 47862},
+								pos: position{line: 1675, col: 14, offset: 64222},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 1251, col: 40, offset: 47862},
-										name: "BlankLine",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 1252, col: 15, offset: 47887},
-										name: "FileInclusion",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 1253, col: 15, offset: 47915},
-										name: "ImageBlock",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 1254, col: 15, offset: 47941},
-										name: "ListItem",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 1255, col: 15, offset: 47964},
-										name: "FencedBlock",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 1256, col: 15, offset: 47990},
-										name: "ListingBlock",
+										pos:  position{line: 1675, col: 14, offset: 64222},
+										name: "Spaces",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1257, col: 15, offset: 48017},
-										name: "ExampleBlock",
+										pos:  position{line: 1676, col: 11, offset: 64240},
+										name: "InlineImage",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1258, col: 15, offset: 48044},
-										name: "CommentBlock",
+										pos:  position{line: 1677, col: 11, offset: 64263},
+										name: "Link",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1259, col: 15, offset: 48071},
-										name: "SingleLineComment",
+										pos:  position{line: 1678, col: 11, offset: 64279},
+										name: "Passthrough",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1260, col: 15, offset: 48103},
-										name: "QuoteBlock",
+										pos:  position{line: 1679, col: 11, offset: 64302},
+										name: "InlineFootnote",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1261, col: 15, offset: 48129},
-										name: "SidebarBlock",
+										pos:  position{line: 1680, col: 11, offset: 64328},
+										name: "IndexTerm",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1262, col: 15, offset: 48156},
-										name: "Table",
+										pos:  position{line: 1681, col: 11, offset: 64348},
+										name: "InlineUserMacro",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1263, col: 15, offset: 48177},
-										name: "LiteralBlock",
+										pos:  position{line: 1682, col: 11, offset: 64375},
+										name: "QuotedText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1264, col: 15, offset: 48205},
-										name: "DocumentAttributeDeclaration",
+										pos:  position{line: 1683, col: 11, offset: 64397},
+										name: "CrossReference",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1265, col: 15, offset: 48249},
-										name: "DocumentAttributeReset",
+										pos:  position{line: 1684, col: 11, offset: 64423},
+										name: "DocumentAttributeSubstitution",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1266, col: 15, offset: 48287},
-										name: "TableOfContentsMacro",
+										pos:  position{line: 1685, col: 11, offset: 64464},
+										name: "InlineElementID",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1267, col: 15, offset: 48322},
-										name: "QuoteBlockParagraph",
+										pos:  position{line: 1686, col: 11, offset: 64491},
+										name: "OtherWord",
 									},
 								},
 							},
@@ -10088,69 +13446,67 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "QuoteBlockParagraph",
-			pos:  position{line: 1271, col: 1, offset: 48381},
-			expr: &actionExpr{
-				pos: position{line: 1271, col: 24, offset: 48404},
-				run: (*parser).callonQuoteBlockParagraph1,
-				expr: &labeledExpr{
-					pos:   position{line: 1271, col: 24, offset: 48404},
-					label: "lines",
-					expr: &oneOrMoreExpr{
-						pos: position{line: 1271, col: 30, offset: 48410},
-						expr: &ruleRefExpr{
-							pos:  position{line: 1271, col: 31, offset: 48411},
-							name: "InlineElements",
-						},
+			name: "SidebarBlockDelimiter",
+			pos:  position{line: 1693, col: 1, offset: 64721},
+			expr: &seqExpr{
+				pos: position{line: 1693, col: 26, offset: 64746},
+				exprs: []interface{}{
+					&litMatcher{
+						pos:        position{line: 1693, col: 26, offset: 64746},
+						val:        "****",
+						ignoreCase: false,
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1693, col: 33, offset: 64753},
+						name: "EOLS",
 					},
 				},
 			},
 		},
 		{
-			name: "VerseBlock",
-			pos:  position{line: 1280, col: 1, offset: 48757},
+			name: "SidebarBlock",
+			pos:  position{line: 1695, col: 1, offset: 64759},
 			expr: &actionExpr{
-				pos: position{line: 1280, col: 15, offset: 48771},
-				run: (*parser).callonVerseBlock1,
+				pos: position{line: 1695, col: 17, offset: 64775},
+				run: (*parser).callonSidebarBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1280, col: 15, offset: 48771},
+					pos: position{line: 1695, col: 17, offset: 64775},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1280, col: 15, offset: 48771},
+							pos:   position{line: 1695, col: 17, offset: 64775},
 							label: "attributes",
-							expr: &ruleRefExpr{
-								pos:  position{line: 1280, col: 27, offset: 48783},
-								name: "ElementAttributes",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 1695, col: 28, offset: 64786},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1695, col: 29, offset: 64787},
+									name: "ElementAttributes",
+								},
 							},
 						},
-						&andCodeExpr{
-							pos: position{line: 1281, col: 5, offset: 48807},
-							run: (*parser).callonVerseBlock5,
-						},
 						&ruleRefExpr{
-							pos:  position{line: 1285, col: 5, offset: 48993},
-							name: "QuoteBlockDelimiter",
+							pos:  position{line: 1695, col: 49, offset: 64807},
+							name: "SidebarBlockDelimiter",
 						},
 						&labeledExpr{
-							pos:   position{line: 1285, col: 25, offset: 49013},
+							pos:   position{line: 1695, col: 71, offset: 64829},
 							label: "content",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1285, col: 33, offset: 49021},
+								pos: position{line: 1695, col: 79, offset: 64837},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1285, col: 34, offset: 49022},
-									name: "VerseBlockElement",
+									pos:  position{line: 1695, col: 80, offset: 64838},
+									name: "SidebarBlockContent",
 								},
 							},
 						},
 						&choiceExpr{
-							pos: position{line: 1285, col: 55, offset: 49043},
+							pos: position{line: 1695, col: 104, offset: 64862},
 							alternatives: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 1285, col: 55, offset: 49043},
-									name: "QuoteBlockDelimiter",
+									pos:  position{line: 1695, col: 104, offset: 64862},
+									name: "SidebarBlockDelimiter",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1285, col: 77, offset: 49065},
+									pos:  position{line: 1695, col: 128, offset: 64886},
 									name: "EOF",
 								},
 							},
@@ -10160,48 +13516,60 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "VerseBlockElement",
-			pos:  position{line: 1289, col: 1, offset: 49180},
+			name: "SidebarBlockContent",
+			pos:  position{line: 1699, col: 1, offset: 64995},
 			expr: &choiceExpr{
-				pos: position{line: 1289, col: 22, offset: 49201},
+				pos: position{line: 1699, col: 24, offset: 65018},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1289, col: 22, offset: 49201},
-						name: "VerseFileInclude",
+						pos:  position{line: 1699, col: 24, offset: 65018},
+						name: "BlankLine",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1289, col: 41, offset: 49220},
-						name: "BlankLine",
+						pos:  position{line: 1699, col: 36, offset: 65030},
+						name: "FileInclusion",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1289, col: 53, offset: 49232},
-						name: "VerseBlockParagraph",
+						pos:  position{line: 1699, col: 52, offset: 65046},
+						name: "DiscreteHeading",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1699, col: 70, offset: 65064},
+						name: "ListItem",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1699, col: 81, offset: 65075},
+						name: "NonSidebarBlock",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1699, col: 99, offset: 65093},
+						name: "SidebarBlockParagraph",
 					},
 				},
 			},
 		},
 		{
-			name: "VerseFileInclude",
-			pos:  position{line: 1291, col: 1, offset: 49253},
+			name: "NonSidebarBlock",
+			pos:  position{line: 1701, col: 1, offset: 65116},
 			expr: &actionExpr{
-				pos: position{line: 1291, col: 21, offset: 49273},
-				run: (*parser).callonVerseFileInclude1,
+				pos: position{line: 1701, col: 20, offset: 65135},
+				run: (*parser).callonNonSidebarBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1291, col: 21, offset: 49273},
+					pos: position{line: 1701, col: 20, offset: 65135},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1291, col: 21, offset: 49273},
+							pos: position{line: 1701, col: 20, offset: 65135},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1291, col: 22, offset: 49274},
-								name: "QuoteBlockDelimiter",
+								pos:  position{line: 1701, col: 21, offset: 65136},
+								name: "SidebarBlock",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1291, col: 42, offset: 49294},
-							label: "include",
+							pos:   position{line: 1701, col: 34, offset: 65149},
+							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1291, col: 51, offset: 49303},
-								name: "FileInclusion",
+								pos:  position{line: 1701, col: 43, offset: 65158},
+								name: "DelimitedBlock",
 							},
 						},
 					},
@@ -10209,70 +13577,229 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "VerseBlockParagraph",
-			pos:  position{line: 1296, col: 1, offset: 49365},
+			name: "SidebarBlockParagraph",
+			pos:  position{line: 1706, col: 1, offset: 65221},
 			expr: &actionExpr{
-				pos: position{line: 1296, col: 24, offset: 49388},
-				run: (*parser).callonVerseBlockParagraph1,
+				pos: position{line: 1706, col: 26, offset: 65246},
+				run: (*parser).callonSidebarBlockParagraph1,
 				expr: &labeledExpr{
-					pos:   position{line: 1296, col: 24, offset: 49388},
+					pos:   position{line: 1706, col: 26, offset: 65246},
 					label: "lines",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 1296, col: 30, offset: 49394},
+						pos: position{line: 1706, col: 32, offset: 65252},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1296, col: 31, offset: 49395},
-							name: "VerseBlockParagraphLine",
+							pos:  position{line: 1706, col: 33, offset: 65253},
+							name: "SidebarBlockParagraphLine",
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "VerseBlockParagraphLine",
-			pos:  position{line: 1300, col: 1, offset: 49485},
+			name: "SidebarBlockParagraphLine",
+			pos:  position{line: 1710, col: 1, offset: 65367},
 			expr: &actionExpr{
-				pos: position{line: 1300, col: 28, offset: 49512},
-				run: (*parser).callonVerseBlockParagraphLine1,
+				pos: position{line: 1710, col: 30, offset: 65396},
+				run: (*parser).callonSidebarBlockParagraphLine1,
 				expr: &seqExpr{
-					pos: position{line: 1300, col: 28, offset: 49512},
+					pos: position{line: 1710, col: 30, offset: 65396},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1300, col: 28, offset: 49512},
+							pos: position{line: 1710, col: 30, offset: 65396},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1300, col: 29, offset: 49513},
-								name: "QuoteBlockDelimiter",
+								pos:  position{line: 1710, col: 31, offset: 65397},
+								name: "SidebarBlockDelimiter",
+							},
+						},
+						&notExpr{
+							pos: position{line: 1710, col: 53, offset: 65419},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1710, col: 54, offset: 65420},
+								name: "BlankLine",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1710, col: 64, offset: 65430},
+							label: "line",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1710, col: 70, offset: 65436},
+								name: "InlineElements",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "OpenBlockDelimiter",
+			pos:  position{line: 1718, col: 1, offset: 65672},
+			expr: &seqExpr{
+				pos: position{line: 1718, col: 23, offset: 65694},
+				exprs: []interface{}{
+					&litMatcher{
+						pos:        position{line: 1718, col: 23, offset: 65694},
+						val:        "--",
+						ignoreCase: false,
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1718, col: 28, offset: 65699},
+						name: "EOLS",
+					},
+				},
+			},
+		},
+		{
+			name: "OpenBlock",
+			pos:  position{line: 1720, col: 1, offset: 65705},
+			expr: &choiceExpr{
+				pos: position{line: 1722, col: 5, offset: 65773},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 1722, col: 5, offset: 65773},
+						run: (*parser).callonOpenBlock2,
+						expr: &seqExpr{
+							pos: position{line: 1722, col: 5, offset: 65773},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 1722, col: 5, offset: 65773},
+									label: "attributes",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 1722, col: 16, offset: 65784},
+										expr: &ruleRefExpr{
+											pos:  position{line: 1722, col: 17, offset: 65785},
+											name: "ElementAttributes",
+										},
+									},
+								},
+								&andCodeExpr{
+									pos: position{line: 1723, col: 5, offset: 65809},
+									run: (*parser).callonOpenBlock7,
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1729, col: 5, offset: 65988},
+									name: "OpenBlockDelimiter",
+								},
+								&labeledExpr{
+									pos:   position{line: 1729, col: 24, offset: 66007},
+									label: "lines",
+									expr: &zeroOrMoreExpr{
+										pos: position{line: 1729, col: 30, offset: 66013},
+										expr: &ruleRefExpr{
+											pos:  position{line: 1729, col: 31, offset: 66014},
+											name: "OpenBlockVerbatimLine",
+										},
+									},
+								},
+								&choiceExpr{
+									pos: position{line: 1729, col: 56, offset: 66039},
+									alternatives: []interface{}{
+										&ruleRefExpr{
+											pos:  position{line: 1729, col: 56, offset: 66039},
+											name: "OpenBlockDelimiter",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1729, col: 77, offset: 66060},
+											name: "EOF",
+										},
+									},
+								},
 							},
 						},
-						&notExpr{
-							pos: position{line: 1300, col: 49, offset: 49533},
-							expr: &ruleRefExpr{
-								pos:  position{line: 1300, col: 50, offset: 49534},
-								name: "BlankLine",
+					},
+					&actionExpr{
+						pos: position{line: 1733, col: 5, offset: 66272},
+						run: (*parser).callonOpenBlock15,
+						expr: &seqExpr{
+							pos: position{line: 1733, col: 5, offset: 66272},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 1733, col: 5, offset: 66272},
+									label: "attributes",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 1733, col: 16, offset: 66283},
+										expr: &ruleRefExpr{
+											pos:  position{line: 1733, col: 17, offset: 66284},
+											name: "ElementAttributes",
+										},
+									},
+								},
+								&andCodeExpr{
+									pos: position{line: 1734, col: 5, offset: 66308},
+									run: (*parser).callonOpenBlock20,
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1743, col: 5, offset: 66575},
+									name: "OpenBlockDelimiter",
+								},
+								&labeledExpr{
+									pos:   position{line: 1743, col: 24, offset: 66594},
+									label: "content",
+									expr: &zeroOrMoreExpr{
+										pos: position{line: 1743, col: 32, offset: 66602},
+										expr: &ruleRefExpr{
+											pos:  position{line: 1743, col: 33, offset: 66603},
+											name: "OpenBlockVerbatimElement",
+										},
+									},
+								},
+								&choiceExpr{
+									pos: position{line: 1743, col: 61, offset: 66631},
+									alternatives: []interface{}{
+										&ruleRefExpr{
+											pos:  position{line: 1743, col: 61, offset: 66631},
+											name: "OpenBlockDelimiter",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1743, col: 82, offset: 66652},
+											name: "EOF",
+										},
+									},
+								},
 							},
 						},
-						&labeledExpr{
-							pos:   position{line: 1300, col: 60, offset: 49544},
-							label: "line",
-							expr: &actionExpr{
-								pos: position{line: 1300, col: 66, offset: 49550},
-								run: (*parser).callonVerseBlockParagraphLine8,
-								expr: &seqExpr{
-									pos: position{line: 1300, col: 66, offset: 49550},
-									exprs: []interface{}{
-										&labeledExpr{
-											pos:   position{line: 1300, col: 66, offset: 49550},
-											label: "elements",
-											expr: &oneOrMoreExpr{
-												pos: position{line: 1300, col: 75, offset: 49559},
-												expr: &ruleRefExpr{
-													pos:  position{line: 1300, col: 76, offset: 49560},
-													name: "VerseBlockParagraphLineElement",
-												},
-											},
+					},
+					&actionExpr{
+						pos: position{line: 1747, col: 5, offset: 66877},
+						run: (*parser).callonOpenBlock28,
+						expr: &seqExpr{
+							pos: position{line: 1747, col: 5, offset: 66877},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 1747, col: 5, offset: 66877},
+									label: "attributes",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 1747, col: 16, offset: 66888},
+										expr: &ruleRefExpr{
+											pos:  position{line: 1747, col: 17, offset: 66889},
+											name: "ElementAttributes",
+										},
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1747, col: 37, offset: 66909},
+									name: "OpenBlockDelimiter",
+								},
+								&labeledExpr{
+									pos:   position{line: 1747, col: 56, offset: 66928},
+									label: "content",
+									expr: &zeroOrMoreExpr{
+										pos: position{line: 1747, col: 64, offset: 66936},
+										expr: &ruleRefExpr{
+											pos:  position{line: 1747, col: 65, offset: 66937},
+											name: "OpenBlockElement",
 										},
+									},
+								},
+								&choiceExpr{
+									pos: position{line: 1747, col: 85, offset: 66957},
+									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 1300, col: 109, offset: 49593},
-											name: "EOL",
+											pos:  position{line: 1747, col: 85, offset: 66957},
+											name: "OpenBlockDelimiter",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1747, col: 106, offset: 66978},
+											name: "EOF",
 										},
 									},
 								},
@@ -10283,77 +13810,93 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "VerseBlockParagraphLineElement",
-			pos:  position{line: 1306, col: 1, offset: 49689},
+			name: "OpenBlockElement",
+			pos:  position{line: 1751, col: 1, offset: 67092},
 			expr: &actionExpr{
-				pos: position{line: 1306, col: 35, offset: 49723},
-				run: (*parser).callonVerseBlockParagraphLineElement1,
+				pos: position{line: 1752, col: 5, offset: 67117},
+				run: (*parser).callonOpenBlockElement1,
 				expr: &seqExpr{
-					pos: position{line: 1306, col: 35, offset: 49723},
+					pos: position{line: 1752, col: 5, offset: 67117},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1306, col: 35, offset: 49723},
+							pos: position{line: 1752, col: 5, offset: 67117},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1306, col: 36, offset: 49724},
-								name: "EOL",
+								pos:  position{line: 1752, col: 6, offset: 67118},
+								name: "OpenBlockDelimiter",
 							},
 						},
 						&notExpr{
-							pos: position{line: 1306, col: 40, offset: 49728},
+							pos: position{line: 1752, col: 25, offset: 67137},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1306, col: 41, offset: 49729},
-								name: "LineBreak",
+								pos:  position{line: 1752, col: 26, offset: 67138},
+								name: "EOF",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1307, col: 5, offset: 49744},
+							pos:   position{line: 1752, col: 30, offset: 67142},
 							label: "element",
 							expr: &choiceExpr{
-								pos: position{line: 1307, col: 14, offset: 49753},
+								pos: position{line: 1752, col: 39, offset: 67151},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 1307, col: 14, offset: 49753},
-										name: "Spaces",
+										pos:  position{line: 1752, col: 39, offset: 67151},
+										name: "BlankLine",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1308, col: 11, offset: 49771},
-										name: "InlineImage",
+										pos:  position{line: 1753, col: 15, offset: 67176},
+										name: "FileInclusion",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1309, col: 11, offset: 49794},
-										name: "Link",
+										pos:  position{line: 1754, col: 15, offset: 67204},
+										name: "DiscreteHeading",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1310, col: 11, offset: 49810},
-										name: "Passthrough",
+										pos:  position{line: 1755, col: 15, offset: 67234},
+										name: "ImageBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1311, col: 11, offset: 49833},
-										name: "InlineFootnote",
+										pos:  position{line: 1756, col: 15, offset: 67260},
+										name: "VideoBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1312, col: 11, offset: 49859},
-										name: "InlineUserMacro",
+										pos:  position{line: 1757, col: 15, offset: 67285},
+										name: "AudioBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1313, col: 11, offset: 49886},
-										name: "QuotedText",
+										pos:  position{line: 1758, col: 15, offset: 67310},
+										name: "ListItem",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1314, col: 11, offset: 49908},
-										name: "CrossReference",
+										pos:  position{line: 1759, col: 15, offset: 67333},
+										name: "ThematicBreak",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1315, col: 11, offset: 49934},
-										name: "DocumentAttributeSubstitution",
+										pos:  position{line: 1760, col: 15, offset: 67361},
+										name: "PageBreak",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1316, col: 11, offset: 49975},
-										name: "InlineElementID",
+										pos:  position{line: 1761, col: 15, offset: 67385},
+										name: "NonOpenBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1317, col: 11, offset: 50002},
-										name: "OtherWord",
+										pos:  position{line: 1762, col: 15, offset: 67412},
+										name: "LiteralBlock",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1763, col: 15, offset: 67440},
+										name: "DocumentAttributeDeclaration",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1764, col: 15, offset: 67484},
+										name: "DocumentAttributeReset",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1765, col: 15, offset: 67522},
+										name: "ParagraphWithSubstitutions",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1766, col: 15, offset: 67563},
+										name: "OpenBlockParagraph",
 									},
 								},
 							},
@@ -10363,69 +13906,97 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "SidebarBlockDelimiter",
-			pos:  position{line: 1324, col: 1, offset: 50232},
-			expr: &seqExpr{
-				pos: position{line: 1324, col: 26, offset: 50257},
-				exprs: []interface{}{
-					&litMatcher{
-						pos:        position{line: 1324, col: 26, offset: 50257},
-						val:        "****",
-						ignoreCase: false,
-					},
-					&ruleRefExpr{
-						pos:  position{line: 1324, col: 33, offset: 50264},
-						name: "EOLS",
+			name: "NonOpenBlock",
+			pos:  position{line: 1770, col: 1, offset: 67621},
+			expr: &actionExpr{
+				pos: position{line: 1770, col: 17, offset: 67637},
+				run: (*parser).callonNonOpenBlock1,
+				expr: &seqExpr{
+					pos: position{line: 1770, col: 17, offset: 67637},
+					exprs: []interface{}{
+						&notExpr{
+							pos: position{line: 1770, col: 17, offset: 67637},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1770, col: 18, offset: 67638},
+								name: "OpenBlock",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1770, col: 28, offset: 67648},
+							label: "content",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1770, col: 37, offset: 67657},
+								name: "DelimitedBlock",
+							},
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "SidebarBlock",
-			pos:  position{line: 1326, col: 1, offset: 50270},
+			name: "OpenBlockParagraph",
+			pos:  position{line: 1774, col: 1, offset: 67702},
 			expr: &actionExpr{
-				pos: position{line: 1326, col: 17, offset: 50286},
-				run: (*parser).callonSidebarBlock1,
+				pos: position{line: 1774, col: 23, offset: 67724},
+				run: (*parser).callonOpenBlockParagraph1,
 				expr: &seqExpr{
-					pos: position{line: 1326, col: 17, offset: 50286},
+					pos: position{line: 1774, col: 23, offset: 67724},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1326, col: 17, offset: 50286},
+							pos:   position{line: 1774, col: 23, offset: 67724},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1326, col: 28, offset: 50297},
+								pos: position{line: 1774, col: 34, offset: 67735},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1326, col: 29, offset: 50298},
+									pos:  position{line: 1774, col: 35, offset: 67736},
 									name: "ElementAttributes",
 								},
 							},
 						},
-						&ruleRefExpr{
-							pos:  position{line: 1326, col: 49, offset: 50318},
-							name: "SidebarBlockDelimiter",
-						},
 						&labeledExpr{
-							pos:   position{line: 1326, col: 71, offset: 50340},
-							label: "content",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 1326, col: 79, offset: 50348},
+							pos:   position{line: 1774, col: 55, offset: 67756},
+							label: "lines",
+							expr: &oneOrMoreExpr{
+								pos: position{line: 1774, col: 61, offset: 67762},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1326, col: 80, offset: 50349},
-									name: "SidebarBlockContent",
+									pos:  position{line: 1774, col: 62, offset: 67763},
+									name: "OpenBlockParagraphLine",
 								},
 							},
 						},
-						&choiceExpr{
-							pos: position{line: 1326, col: 104, offset: 50373},
-							alternatives: []interface{}{
-								&ruleRefExpr{
-									pos:  position{line: 1326, col: 104, offset: 50373},
-									name: "SidebarBlockDelimiter",
-								},
-								&ruleRefExpr{
-									pos:  position{line: 1326, col: 128, offset: 50397},
-									name: "EOF",
-								},
+					},
+				},
+			},
+		},
+		{
+			name: "OpenBlockParagraphLine",
+			pos:  position{line: 1778, col: 1, offset: 67858},
+			expr: &actionExpr{
+				pos: position{line: 1778, col: 27, offset: 67884},
+				run: (*parser).callonOpenBlockParagraphLine1,
+				expr: &seqExpr{
+					pos: position{line: 1778, col: 27, offset: 67884},
+					exprs: []interface{}{
+						&notExpr{
+							pos: position{line: 1778, col: 27, offset: 67884},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1778, col: 28, offset: 67885},
+								name: "OpenBlockDelimiter",
+							},
+						},
+						&notExpr{
+							pos: position{line: 1778, col: 47, offset: 67904},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1778, col: 48, offset: 67905},
+								name: "BlankLine",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1778, col: 58, offset: 67915},
+							label: "line",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1778, col: 64, offset: 67921},
+								name: "InlineElements",
 							},
 						},
 					},
@@ -10433,111 +14004,201 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "SidebarBlockContent",
-			pos:  position{line: 1330, col: 1, offset: 50506},
+			name: "OpenBlockVerbatimElement",
+			pos:  position{line: 1782, col: 1, offset: 67963},
 			expr: &choiceExpr{
-				pos: position{line: 1330, col: 24, offset: 50529},
+				pos: position{line: 1782, col: 29, offset: 67991},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1330, col: 24, offset: 50529},
-						name: "BlankLine",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 1330, col: 36, offset: 50541},
+						pos:  position{line: 1782, col: 29, offset: 67991},
 						name: "FileInclusion",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1330, col: 52, offset: 50557},
-						name: "ListItem",
+						pos:  position{line: 1782, col: 45, offset: 68007},
+						name: "OpenBlockVerbatimParagraph",
 					},
-					&ruleRefExpr{
-						pos:  position{line: 1330, col: 63, offset: 50568},
-						name: "NonSidebarBlock",
+				},
+			},
+		},
+		{
+			name: "OpenBlockVerbatimParagraph",
+			pos:  position{line: 1784, col: 1, offset: 68035},
+			expr: &actionExpr{
+				pos: position{line: 1784, col: 31, offset: 68065},
+				run: (*parser).callonOpenBlockVerbatimParagraph1,
+				expr: &labeledExpr{
+					pos:   position{line: 1784, col: 31, offset: 68065},
+					label: "lines",
+					expr: &oneOrMoreExpr{
+						pos: position{line: 1784, col: 37, offset: 68071},
+						expr: &ruleRefExpr{
+							pos:  position{line: 1784, col: 38, offset: 68072},
+							name: "OpenBlockVerbatimParagraphLine",
+						},
 					},
-					&ruleRefExpr{
-						pos:  position{line: 1330, col: 81, offset: 50586},
-						name: "SidebarBlockParagraph",
+				},
+			},
+		},
+		{
+			name: "OpenBlockVerbatimParagraphLine",
+			pos:  position{line: 1788, col: 1, offset: 68196},
+			expr: &actionExpr{
+				pos: position{line: 1788, col: 35, offset: 68230},
+				run: (*parser).callonOpenBlockVerbatimParagraphLine1,
+				expr: &labeledExpr{
+					pos:   position{line: 1788, col: 35, offset: 68230},
+					label: "line",
+					expr: &ruleRefExpr{
+						pos:  position{line: 1788, col: 41, offset: 68236},
+						name: "OpenBlockVerbatimLine",
 					},
 				},
 			},
 		},
 		{
-			name: "NonSidebarBlock",
-			pos:  position{line: 1332, col: 1, offset: 50609},
+			name: "OpenBlockVerbatimLine",
+			pos:  position{line: 1792, col: 1, offset: 68305},
 			expr: &actionExpr{
-				pos: position{line: 1332, col: 20, offset: 50628},
-				run: (*parser).callonNonSidebarBlock1,
+				pos: position{line: 1792, col: 26, offset: 68330},
+				run: (*parser).callonOpenBlockVerbatimLine1,
 				expr: &seqExpr{
-					pos: position{line: 1332, col: 20, offset: 50628},
+					pos: position{line: 1792, col: 26, offset: 68330},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1332, col: 20, offset: 50628},
+							pos: position{line: 1792, col: 26, offset: 68330},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1332, col: 21, offset: 50629},
-								name: "SidebarBlock",
+								pos:  position{line: 1792, col: 27, offset: 68331},
+								name: "OpenBlockDelimiter",
 							},
 						},
-						&labeledExpr{
-							pos:   position{line: 1332, col: 34, offset: 50642},
-							label: "content",
+						&notExpr{
+							pos: position{line: 1792, col: 46, offset: 68350},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1332, col: 43, offset: 50651},
-								name: "DelimitedBlock",
+								pos:  position{line: 1792, col: 47, offset: 68351},
+								name: "EOF",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1792, col: 51, offset: 68355},
+							label: "line",
+							expr: &actionExpr{
+								pos: position{line: 1792, col: 57, offset: 68361},
+								run: (*parser).callonOpenBlockVerbatimLine8,
+								expr: &zeroOrMoreExpr{
+									pos: position{line: 1792, col: 57, offset: 68361},
+									expr: &choiceExpr{
+										pos: position{line: 1792, col: 58, offset: 68362},
+										alternatives: []interface{}{
+											&ruleRefExpr{
+												pos:  position{line: 1792, col: 58, offset: 68362},
+												name: "Alphanums",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 1792, col: 70, offset: 68374},
+												name: "Spaces",
+											},
+											&seqExpr{
+												pos: position{line: 1792, col: 80, offset: 68384},
+												exprs: []interface{}{
+													&notExpr{
+														pos: position{line: 1792, col: 80, offset: 68384},
+														expr: &ruleRefExpr{
+															pos:  position{line: 1792, col: 81, offset: 68385},
+															name: "EOL",
+														},
+													},
+													&anyMatcher{
+														line: 1792, col: 85, offset: 68389,
+													},
+												},
+											},
+										},
+									},
+								},
 							},
 						},
+						&ruleRefExpr{
+							pos:  position{line: 1794, col: 4, offset: 68430},
+							name: "EOL",
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "SidebarBlockParagraph",
-			pos:  position{line: 1337, col: 1, offset: 50714},
+			name: "ThematicBreak",
+			pos:  position{line: 1802, col: 1, offset: 68772},
 			expr: &actionExpr{
-				pos: position{line: 1337, col: 26, offset: 50739},
-				run: (*parser).callonSidebarBlockParagraph1,
-				expr: &labeledExpr{
-					pos:   position{line: 1337, col: 26, offset: 50739},
-					label: "lines",
-					expr: &oneOrMoreExpr{
-						pos: position{line: 1337, col: 32, offset: 50745},
-						expr: &ruleRefExpr{
-							pos:  position{line: 1337, col: 33, offset: 50746},
-							name: "SidebarBlockParagraphLine",
+				pos: position{line: 1802, col: 18, offset: 68789},
+				run: (*parser).callonThematicBreak1,
+				expr: &seqExpr{
+					pos: position{line: 1802, col: 18, offset: 68789},
+					exprs: []interface{}{
+						&choiceExpr{
+							pos: position{line: 1802, col: 19, offset: 68790},
+							alternatives: []interface{}{
+								&litMatcher{
+									pos:        position{line: 1802, col: 19, offset: 68790},
+									val:        "'''",
+									ignoreCase: false,
+								},
+								&litMatcher{
+									pos:        position{line: 1802, col: 27, offset: 68798},
+									val:        "***",
+									ignoreCase: false,
+								},
+								&litMatcher{
+									pos:        position{line: 1802, col: 35, offset: 68806},
+									val:        "* * *",
+									ignoreCase: false,
+								},
+								&litMatcher{
+									pos:        position{line: 1802, col: 45, offset: 68816},
+									val:        "---",
+									ignoreCase: false,
+								},
+								&litMatcher{
+									pos:        position{line: 1802, col: 53, offset: 68824},
+									val:        "- - -",
+									ignoreCase: false,
+								},
+								&litMatcher{
+									pos:        position{line: 1802, col: 63, offset: 68834},
+									val:        "___",
+									ignoreCase: false,
+								},
+								&litMatcher{
+									pos:        position{line: 1802, col: 71, offset: 68842},
+									val:        "_ _ _",
+									ignoreCase: false,
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1802, col: 80, offset: 68851},
+							name: "EOLS",
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "SidebarBlockParagraphLine",
-			pos:  position{line: 1341, col: 1, offset: 50860},
+			name: "PageBreak",
+			pos:  position{line: 1809, col: 1, offset: 69090},
 			expr: &actionExpr{
-				pos: position{line: 1341, col: 30, offset: 50889},
-				run: (*parser).callonSidebarBlockParagraphLine1,
+				pos: position{line: 1809, col: 14, offset: 69103},
+				run: (*parser).callonPageBreak1,
 				expr: &seqExpr{
-					pos: position{line: 1341, col: 30, offset: 50889},
+					pos: position{line: 1809, col: 14, offset: 69103},
 					exprs: []interface{}{
-						&notExpr{
-							pos: position{line: 1341, col: 30, offset: 50889},
-							expr: &ruleRefExpr{
-								pos:  position{line: 1341, col: 31, offset: 50890},
-								name: "SidebarBlockDelimiter",
-							},
-						},
-						&notExpr{
-							pos: position{line: 1341, col: 53, offset: 50912},
-							expr: &ruleRefExpr{
-								pos:  position{line: 1341, col: 54, offset: 50913},
-								name: "BlankLine",
-							},
+						&litMatcher{
+							pos:        position{line: 1809, col: 14, offset: 69103},
+							val:        "<<<",
+							ignoreCase: false,
 						},
-						&labeledExpr{
-							pos:   position{line: 1341, col: 64, offset: 50923},
-							label: "line",
-							expr: &ruleRefExpr{
-								pos:  position{line: 1341, col: 70, offset: 50929},
-								name: "InlineElements",
-							},
+						&ruleRefExpr{
+							pos:  position{line: 1809, col: 20, offset: 69109},
+							name: "EOLS",
 						},
 					},
 				},
@@ -10545,59 +14206,59 @@ var g = &grammar{
 		},
 		{
 			name: "Table",
-			pos:  position{line: 1349, col: 1, offset: 51160},
+			pos:  position{line: 1816, col: 1, offset: 69339},
 			expr: &actionExpr{
-				pos: position{line: 1349, col: 10, offset: 51169},
+				pos: position{line: 1816, col: 10, offset: 69348},
 				run: (*parser).callonTable1,
 				expr: &seqExpr{
-					pos: position{line: 1349, col: 10, offset: 51169},
+					pos: position{line: 1816, col: 10, offset: 69348},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1349, col: 10, offset: 51169},
+							pos:   position{line: 1816, col: 10, offset: 69348},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1349, col: 21, offset: 51180},
+								pos: position{line: 1816, col: 21, offset: 69359},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1349, col: 22, offset: 51181},
+									pos:  position{line: 1816, col: 22, offset: 69360},
 									name: "ElementAttributes",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1349, col: 42, offset: 51201},
+							pos:  position{line: 1816, col: 42, offset: 69380},
 							name: "TableDelimiter",
 						},
 						&labeledExpr{
-							pos:   position{line: 1350, col: 5, offset: 51220},
+							pos:   position{line: 1817, col: 5, offset: 69399},
 							label: "header",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1350, col: 12, offset: 51227},
+								pos: position{line: 1817, col: 12, offset: 69406},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1350, col: 13, offset: 51228},
+									pos:  position{line: 1817, col: 13, offset: 69407},
 									name: "TableLineHeader",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1351, col: 5, offset: 51250},
+							pos:   position{line: 1818, col: 5, offset: 69429},
 							label: "lines",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1351, col: 11, offset: 51256},
+								pos: position{line: 1818, col: 11, offset: 69435},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1351, col: 12, offset: 51257},
+									pos:  position{line: 1818, col: 12, offset: 69436},
 									name: "TableLine",
 								},
 							},
 						},
 						&choiceExpr{
-							pos: position{line: 1352, col: 6, offset: 51274},
+							pos: position{line: 1819, col: 6, offset: 69453},
 							alternatives: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 1352, col: 6, offset: 51274},
+									pos:  position{line: 1819, col: 6, offset: 69453},
 									name: "TableDelimiter",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1352, col: 23, offset: 51291},
+									pos:  position{line: 1819, col: 23, offset: 69470},
 									name: "EOF",
 								},
 							},
@@ -10608,19 +14269,19 @@ var g = &grammar{
 		},
 		{
 			name: "TableCellSeparator",
-			pos:  position{line: 1356, col: 1, offset: 51406},
+			pos:  position{line: 1823, col: 1, offset: 69585},
 			expr: &seqExpr{
-				pos: position{line: 1356, col: 23, offset: 51428},
+				pos: position{line: 1823, col: 23, offset: 69607},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1356, col: 23, offset: 51428},
+						pos:        position{line: 1823, col: 23, offset: 69607},
 						val:        "|",
 						ignoreCase: false,
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1356, col: 27, offset: 51432},
+						pos: position{line: 1823, col: 27, offset: 69611},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1356, col: 27, offset: 51432},
+							pos:  position{line: 1823, col: 27, offset: 69611},
 							name: "WS",
 						},
 					},
@@ -10629,17 +14290,17 @@ var g = &grammar{
 		},
 		{
 			name: "TableDelimiter",
-			pos:  position{line: 1358, col: 1, offset: 51437},
+			pos:  position{line: 1825, col: 1, offset: 69616},
 			expr: &seqExpr{
-				pos: position{line: 1358, col: 19, offset: 51455},
+				pos: position{line: 1825, col: 19, offset: 69634},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1358, col: 19, offset: 51455},
+						pos:        position{line: 1825, col: 19, offset: 69634},
 						val:        "|===",
 						ignoreCase: false,
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1358, col: 26, offset: 51462},
+						pos:  position{line: 1825, col: 26, offset: 69641},
 						name: "EOLS",
 					},
 				},
@@ -10647,37 +14308,37 @@ var g = &grammar{
 		},
 		{
 			name: "TableLineHeader",
-			pos:  position{line: 1361, col: 1, offset: 51531},
+			pos:  position{line: 1828, col: 1, offset: 69710},
 			expr: &actionExpr{
-				pos: position{line: 1361, col: 20, offset: 51550},
+				pos: position{line: 1828, col: 20, offset: 69729},
 				run: (*parser).callonTableLineHeader1,
 				expr: &seqExpr{
-					pos: position{line: 1361, col: 20, offset: 51550},
+					pos: position{line: 1828, col: 20, offset: 69729},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1361, col: 20, offset: 51550},
+							pos: position{line: 1828, col: 20, offset: 69729},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1361, col: 21, offset: 51551},
+								pos:  position{line: 1828, col: 21, offset: 69730},
 								name: "TableDelimiter",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1361, col: 36, offset: 51566},
+							pos:   position{line: 1828, col: 36, offset: 69745},
 							label: "cells",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 1361, col: 42, offset: 51572},
+								pos: position{line: 1828, col: 42, offset: 69751},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1361, col: 43, offset: 51573},
+									pos:  position{line: 1828, col: 43, offset: 69752},
 									name: "TableCell",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1361, col: 55, offset: 51585},
+							pos:  position{line: 1828, col: 55, offset: 69764},
 							name: "EOL",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1361, col: 59, offset: 51589},
+							pos:  position{line: 1828, col: 59, offset: 69768},
 							name: "BlankLine",
 						},
 					},
@@ -10686,39 +14347,39 @@ var g = &grammar{
 		},
 		{
 			name: "TableLine",
-			pos:  position{line: 1365, col: 1, offset: 51657},
+			pos:  position{line: 1832, col: 1, offset: 69836},
 			expr: &actionExpr{
-				pos: position{line: 1365, col: 14, offset: 51670},
+				pos: position{line: 1832, col: 14, offset: 69849},
 				run: (*parser).callonTableLine1,
 				expr: &seqExpr{
-					pos: position{line: 1365, col: 14, offset: 51670},
+					pos: position{line: 1832, col: 14, offset: 69849},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1365, col: 14, offset: 51670},
+							pos: position{line: 1832, col: 14, offset: 69849},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1365, col: 15, offset: 51671},
+								pos:  position{line: 1832, col: 15, offset: 69850},
 								name: "TableDelimiter",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1365, col: 30, offset: 51686},
+							pos:   position{line: 1832, col: 30, offset: 69865},
 							label: "cells",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 1365, col: 36, offset: 51692},
+								pos: position{line: 1832, col: 36, offset: 69871},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1365, col: 37, offset: 51693},
+									pos:  position{line: 1832, col: 37, offset: 69872},
 									name: "TableCell",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1365, col: 49, offset: 51705},
+							pos:  position{line: 1832, col: 49, offset: 69884},
 							name: "EOL",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 1365, col: 53, offset: 51709},
+							pos: position{line: 1832, col: 53, offset: 69888},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1365, col: 53, offset: 51709},
+								pos:  position{line: 1832, col: 53, offset: 69888},
 								name: "BlankLine",
 							},
 						},
@@ -10728,54 +14389,54 @@ var g = &grammar{
 		},
 		{
 			name: "TableCell",
-			pos:  position{line: 1369, col: 1, offset: 51778},
+			pos:  position{line: 1836, col: 1, offset: 69957},
 			expr: &actionExpr{
-				pos: position{line: 1369, col: 14, offset: 51791},
+				pos: position{line: 1836, col: 14, offset: 69970},
 				run: (*parser).callonTableCell1,
 				expr: &seqExpr{
-					pos: position{line: 1369, col: 14, offset: 51791},
+					pos: position{line: 1836, col: 14, offset: 69970},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 1369, col: 14, offset: 51791},
+							pos:  position{line: 1836, col: 14, offset: 69970},
 							name: "TableCellSeparator",
 						},
 						&labeledExpr{
-							pos:   position{line: 1369, col: 33, offset: 51810},
+							pos:   position{line: 1836, col: 33, offset: 69989},
 							label: "elements",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 1369, col: 42, offset: 51819},
+								pos: position{line: 1836, col: 42, offset: 69998},
 								expr: &seqExpr{
-									pos: position{line: 1369, col: 43, offset: 51820},
+									pos: position{line: 1836, col: 43, offset: 69999},
 									exprs: []interface{}{
 										&notExpr{
-											pos: position{line: 1369, col: 43, offset: 51820},
+											pos: position{line: 1836, col: 43, offset: 69999},
 											expr: &ruleRefExpr{
-												pos:  position{line: 1369, col: 44, offset: 51821},
+												pos:  position{line: 1836, col: 44, offset: 70000},
 												name: "TableCellSeparator",
 											},
 										},
 										&notExpr{
-											pos: position{line: 1369, col: 63, offset: 51840},
+											pos: position{line: 1836, col: 63, offset: 70019},
 											expr: &ruleRefExpr{
-												pos:  position{line: 1369, col: 64, offset: 51841},
+												pos:  position{line: 1836, col: 64, offset: 70020},
 												name: "EOL",
 											},
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 1369, col: 68, offset: 51845},
+											pos: position{line: 1836, col: 68, offset: 70024},
 											expr: &ruleRefExpr{
-												pos:  position{line: 1369, col: 68, offset: 51845},
+												pos:  position{line: 1836, col: 68, offset: 70024},
 												name: "WS",
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1369, col: 72, offset: 51849},
+											pos:  position{line: 1836, col: 72, offset: 70028},
 											name: "InlineElement",
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 1369, col: 86, offset: 51863},
+											pos: position{line: 1836, col: 86, offset: 70042},
 											expr: &ruleRefExpr{
-												pos:  position{line: 1369, col: 86, offset: 51863},
+												pos:  position{line: 1836, col: 86, offset: 70042},
 												name: "WS",
 											},
 										},
@@ -10789,66 +14450,66 @@ var g = &grammar{
 		},
 		{
 			name: "CommentBlockDelimiter",
-			pos:  position{line: 1376, col: 1, offset: 52109},
+			pos:  position{line: 1843, col: 1, offset: 70288},
 			expr: &litMatcher{
-				pos:        position{line: 1376, col: 26, offset: 52134},
+				pos:        position{line: 1843, col: 26, offset: 70313},
 				val:        "////",
 				ignoreCase: false,
 			},
 		},
 		{
 			name: "CommentBlock",
-			pos:  position{line: 1378, col: 1, offset: 52142},
+			pos:  position{line: 1845, col: 1, offset: 70321},
 			expr: &actionExpr{
-				pos: position{line: 1378, col: 17, offset: 52158},
+				pos: position{line: 1845, col: 17, offset: 70337},
 				run: (*parser).callonCommentBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1378, col: 17, offset: 52158},
+					pos: position{line: 1845, col: 17, offset: 70337},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 1378, col: 17, offset: 52158},
+							pos:  position{line: 1845, col: 17, offset: 70337},
 							name: "CommentBlockDelimiter",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 1378, col: 39, offset: 52180},
+							pos: position{line: 1845, col: 39, offset: 70359},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1378, col: 39, offset: 52180},
+								pos:  position{line: 1845, col: 39, offset: 70359},
 								name: "WS",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1378, col: 43, offset: 52184},
+							pos:  position{line: 1845, col: 43, offset: 70363},
 							name: "NEWLINE",
 						},
 						&labeledExpr{
-							pos:   position{line: 1378, col: 51, offset: 52192},
+							pos:   position{line: 1845, col: 51, offset: 70371},
 							label: "content",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1378, col: 59, offset: 52200},
+								pos: position{line: 1845, col: 59, offset: 70379},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1378, col: 60, offset: 52201},
+									pos:  position{line: 1845, col: 60, offset: 70380},
 									name: "CommentBlockLine",
 								},
 							},
 						},
 						&choiceExpr{
-							pos: position{line: 1378, col: 81, offset: 52222},
+							pos: position{line: 1845, col: 81, offset: 70401},
 							alternatives: []interface{}{
 								&seqExpr{
-									pos: position{line: 1378, col: 82, offset: 52223},
+									pos: position{line: 1845, col: 82, offset: 70402},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 1378, col: 82, offset: 52223},
+											pos:  position{line: 1845, col: 82, offset: 70402},
 											name: "CommentBlockDelimiter",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1378, col: 104, offset: 52245},
+											pos:  position{line: 1845, col: 104, offset: 70424},
 											name: "EOLS",
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1378, col: 112, offset: 52253},
+									pos:  position{line: 1845, col: 112, offset: 70432},
 									name: "EOF",
 								},
 							},
@@ -10859,45 +14520,45 @@ var g = &grammar{
 		},
 		{
 			name: "CommentBlockLine",
-			pos:  position{line: 1382, col: 1, offset: 52359},
+			pos:  position{line: 1849, col: 1, offset: 70538},
 			expr: &actionExpr{
-				pos: position{line: 1382, col: 21, offset: 52379},
+				pos: position{line: 1849, col: 21, offset: 70558},
 				run: (*parser).callonCommentBlockLine1,
 				expr: &seqExpr{
-					pos: position{line: 1382, col: 21, offset: 52379},
+					pos: position{line: 1849, col: 21, offset: 70558},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 1382, col: 21, offset: 52379},
+							pos: position{line: 1849, col: 21, offset: 70558},
 							expr: &choiceExpr{
-								pos: position{line: 1382, col: 22, offset: 52380},
+								pos: position{line: 1849, col: 22, offset: 70559},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 1382, col: 22, offset: 52380},
+										pos:  position{line: 1849, col: 22, offset: 70559},
 										name: "Alphanums",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1382, col: 34, offset: 52392},
+										pos:  position{line: 1849, col: 34, offset: 70571},
 										name: "Spaces",
 									},
 									&seqExpr{
-										pos: position{line: 1382, col: 44, offset: 52402},
+										pos: position{line: 1849, col: 44, offset: 70581},
 										exprs: []interface{}{
 											&notExpr{
-												pos: position{line: 1382, col: 44, offset: 52402},
+												pos: position{line: 1849, col: 44, offset: 70581},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1382, col: 45, offset: 52403},
+													pos:  position{line: 1849, col: 45, offset: 70582},
 													name: "CommentBlockDelimiter",
 												},
 											},
 											&notExpr{
-												pos: position{line: 1382, col: 67, offset: 52425},
+												pos: position{line: 1849, col: 67, offset: 70604},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1382, col: 68, offset: 52426},
+													pos:  position{line: 1849, col: 68, offset: 70605},
 													name: "EOL",
 												},
 											},
 											&anyMatcher{
-												line: 1382, col: 73, offset: 52431,
+												line: 1849, col: 73, offset: 70610,
 											},
 										},
 									},
@@ -10905,7 +14566,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1382, col: 78, offset: 52436},
+							pos:  position{line: 1849, col: 78, offset: 70615},
 							name: "EOL",
 						},
 					},
@@ -10914,42 +14575,42 @@ var g = &grammar{
 		},
 		{
 			name: "SingleLineComment",
-			pos:  position{line: 1386, col: 1, offset: 52476},
+			pos:  position{line: 1853, col: 1, offset: 70655},
 			expr: &actionExpr{
-				pos: position{line: 1386, col: 22, offset: 52497},
+				pos: position{line: 1853, col: 22, offset: 70676},
 				run: (*parser).callonSingleLineComment1,
 				expr: &seqExpr{
-					pos: position{line: 1386, col: 22, offset: 52497},
+					pos: position{line: 1853, col: 22, offset: 70676},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1386, col: 22, offset: 52497},
+							pos: position{line: 1853, col: 22, offset: 70676},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1386, col: 23, offset: 52498},
+								pos:  position{line: 1853, col: 23, offset: 70677},
 								name: "CommentBlockDelimiter",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 1386, col: 45, offset: 52520},
+							pos: position{line: 1853, col: 45, offset: 70699},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1386, col: 45, offset: 52520},
+								pos:  position{line: 1853, col: 45, offset: 70699},
 								name: "WS",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1386, col: 49, offset: 52524},
+							pos:        position{line: 1853, col: 49, offset: 70703},
 							val:        "//",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 1386, col: 54, offset: 52529},
+							pos:   position{line: 1853, col: 54, offset: 70708},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1386, col: 63, offset: 52538},
+								pos:  position{line: 1853, col: 63, offset: 70717},
 								name: "SingleLineCommentContent",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1386, col: 89, offset: 52564},
+							pos:  position{line: 1853, col: 89, offset: 70743},
 							name: "EOL",
 						},
 					},
@@ -10958,35 +14619,35 @@ var g = &grammar{
 		},
 		{
 			name: "SingleLineCommentContent",
-			pos:  position{line: 1390, col: 1, offset: 52629},
+			pos:  position{line: 1857, col: 1, offset: 70808},
 			expr: &actionExpr{
-				pos: position{line: 1390, col: 29, offset: 52657},
+				pos: position{line: 1857, col: 29, offset: 70836},
 				run: (*parser).callonSingleLineCommentContent1,
 				expr: &zeroOrMoreExpr{
-					pos: position{line: 1390, col: 29, offset: 52657},
+					pos: position{line: 1857, col: 29, offset: 70836},
 					expr: &choiceExpr{
-						pos: position{line: 1390, col: 30, offset: 52658},
+						pos: position{line: 1857, col: 30, offset: 70837},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 1390, col: 30, offset: 52658},
+								pos:  position{line: 1857, col: 30, offset: 70837},
 								name: "Alphanums",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1390, col: 42, offset: 52670},
+								pos:  position{line: 1857, col: 42, offset: 70849},
 								name: "Spaces",
 							},
 							&seqExpr{
-								pos: position{line: 1390, col: 52, offset: 52680},
+								pos: position{line: 1857, col: 52, offset: 70859},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 1390, col: 52, offset: 52680},
+										pos: position{line: 1857, col: 52, offset: 70859},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1390, col: 53, offset: 52681},
+											pos:  position{line: 1857, col: 53, offset: 70860},
 											name: "EOL",
 										},
 									},
 									&anyMatcher{
-										line: 1390, col: 58, offset: 52686,
+										line: 1857, col: 58, offset: 70865,
 									},
 								},
 							},
@@ -10997,20 +14658,20 @@ var g = &grammar{
 		},
 		{
 			name: "LiteralBlock",
-			pos:  position{line: 1398, col: 1, offset: 52995},
+			pos:  position{line: 1865, col: 1, offset: 71174},
 			expr: &choiceExpr{
-				pos: position{line: 1398, col: 17, offset: 53011},
+				pos: position{line: 1865, col: 17, offset: 71190},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1398, col: 17, offset: 53011},
+						pos:  position{line: 1865, col: 17, offset: 71190},
 						name: "ParagraphWithLiteralAttribute",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1398, col: 49, offset: 53043},
+						pos:  position{line: 1865, col: 49, offset: 71222},
 						name: "ParagraphWithHeadingSpaces",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1398, col: 78, offset: 53072},
+						pos:  position{line: 1865, col: 78, offset: 71251},
 						name: "ParagraphWithLiteralBlockDelimiter",
 					},
 				},
@@ -11018,38 +14679,38 @@ var g = &grammar{
 		},
 		{
 			name: "LiteralBlockDelimiter",
-			pos:  position{line: 1400, col: 1, offset: 53108},
+			pos:  position{line: 1867, col: 1, offset: 71287},
 			expr: &litMatcher{
-				pos:        position{line: 1400, col: 26, offset: 53133},
+				pos:        position{line: 1867, col: 26, offset: 71312},
 				val:        "....",
 				ignoreCase: false,
 			},
 		},
 		{
 			name: "ParagraphWithHeadingSpaces",
-			pos:  position{line: 1403, col: 1, offset: 53205},
+			pos:  position{line: 1870, col: 1, offset: 71384},
 			expr: &actionExpr{
-				pos: position{line: 1403, col: 31, offset: 53235},
+				pos: position{line: 1870, col: 31, offset: 71414},
 				run: (*parser).callonParagraphWithHeadingSpaces1,
 				expr: &seqExpr{
-					pos: position{line: 1403, col: 31, offset: 53235},
+					pos: position{line: 1870, col: 31, offset: 71414},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1403, col: 31, offset: 53235},
+							pos:   position{line: 1870, col: 31, offset: 71414},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1403, col: 42, offset: 53246},
+								pos: position{line: 1870, col: 42, offset: 71425},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1403, col: 43, offset: 53247},
+									pos:  position{line: 1870, col: 43, offset: 71426},
 									name: "ElementAttributes",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1403, col: 63, offset: 53267},
+							pos:   position{line: 1870, col: 63, offset: 71446},
 							label: "lines",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1403, col: 70, offset: 53274},
+								pos:  position{line: 1870, col: 70, offset: 71453},
 								name: "ParagraphWithHeadingSpacesLines",
 							},
 						},
@@ -11059,54 +14720,54 @@ var g = &grammar{
 		},
 		{
 			name: "ParagraphWithHeadingSpacesLines",
-			pos:  position{line: 1408, col: 1, offset: 53504},
+			pos:  position{line: 1875, col: 1, offset: 71683},
 			expr: &actionExpr{
-				pos: position{line: 1409, col: 5, offset: 53544},
+				pos: position{line: 1876, col: 5, offset: 71723},
 				run: (*parser).callonParagraphWithHeadingSpacesLines1,
 				expr: &seqExpr{
-					pos: position{line: 1409, col: 5, offset: 53544},
+					pos: position{line: 1876, col: 5, offset: 71723},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1409, col: 5, offset: 53544},
+							pos:   position{line: 1876, col: 5, offset: 71723},
 							label: "firstLine",
 							expr: &actionExpr{
-								pos: position{line: 1409, col: 16, offset: 53555},
+								pos: position{line: 1876, col: 16, offset: 71734},
 								run: (*parser).callonParagraphWithHeadingSpacesLines4,
 								expr: &seqExpr{
-									pos: position{line: 1409, col: 16, offset: 53555},
+									pos: position{line: 1876, col: 16, offset: 71734},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 1409, col: 16, offset: 53555},
+											pos:  position{line: 1876, col: 16, offset: 71734},
 											name: "WS",
 										},
 										&oneOrMoreExpr{
-											pos: position{line: 1409, col: 19, offset: 53558},
+											pos: position{line: 1876, col: 19, offset: 71737},
 											expr: &choiceExpr{
-												pos: position{line: 1409, col: 20, offset: 53559},
+												pos: position{line: 1876, col: 20, offset: 71738},
 												alternatives: []interface{}{
 													&ruleRefExpr{
-														pos:  position{line: 1409, col: 20, offset: 53559},
+														pos:  position{line: 1876, col: 20, offset: 71738},
 														name: "Alphanums",
 													},
 													&ruleRefExpr{
-														pos:  position{line: 1409, col: 32, offset: 53571},
+														pos:  position{line: 1876, col: 32, offset: 71750},
 														name: "Spaces",
 													},
 													&actionExpr{
-														pos: position{line: 1409, col: 41, offset: 53580},
+														pos: position{line: 1876, col: 41, offset: 71759},
 														run: (*parser).callonParagraphWithHeadingSpacesLines11,
 														expr: &seqExpr{
-															pos: position{line: 1409, col: 42, offset: 53581},
+															pos: position{line: 1876, col: 42, offset: 71760},
 															exprs: []interface{}{
 																&notExpr{
-																	pos: position{line: 1409, col: 42, offset: 53581},
+																	pos: position{line: 1876, col: 42, offset: 71760},
 																	expr: &ruleRefExpr{
-																		pos:  position{line: 1409, col: 43, offset: 53582},
+																		pos:  position{line: 1876, col: 43, offset: 71761},
 																		name: "EOL",
 																	},
 																},
 																&anyMatcher{
-																	line: 1409, col: 48, offset: 53587,
+																	line: 1876, col: 48, offset: 71766,
 																},
 															},
 														},
@@ -11119,58 +14780,58 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1413, col: 8, offset: 53678},
+							pos:  position{line: 1880, col: 8, offset: 71857},
 							name: "EOL",
 						},
 						&labeledExpr{
-							pos:   position{line: 1414, col: 5, offset: 53741},
+							pos:   position{line: 1881, col: 5, offset: 71920},
 							label: "otherLines",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1414, col: 16, offset: 53752},
+								pos: position{line: 1881, col: 16, offset: 71931},
 								expr: &actionExpr{
-									pos: position{line: 1415, col: 9, offset: 53762},
+									pos: position{line: 1882, col: 9, offset: 71941},
 									run: (*parser).callonParagraphWithHeadingSpacesLines19,
 									expr: &seqExpr{
-										pos: position{line: 1415, col: 9, offset: 53762},
+										pos: position{line: 1882, col: 9, offset: 71941},
 										exprs: []interface{}{
 											&notExpr{
-												pos: position{line: 1415, col: 9, offset: 53762},
+												pos: position{line: 1882, col: 9, offset: 71941},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1415, col: 10, offset: 53763},
+													pos:  position{line: 1882, col: 10, offset: 71942},
 													name: "BlankLine",
 												},
 											},
 											&labeledExpr{
-												pos:   position{line: 1416, col: 9, offset: 53782},
+												pos:   position{line: 1883, col: 9, offset: 71961},
 												label: "otherLine",
 												expr: &actionExpr{
-													pos: position{line: 1416, col: 20, offset: 53793},
+													pos: position{line: 1883, col: 20, offset: 71972},
 													run: (*parser).callonParagraphWithHeadingSpacesLines24,
 													expr: &oneOrMoreExpr{
-														pos: position{line: 1416, col: 20, offset: 53793},
+														pos: position{line: 1883, col: 20, offset: 71972},
 														expr: &choiceExpr{
-															pos: position{line: 1416, col: 21, offset: 53794},
+															pos: position{line: 1883, col: 21, offset: 71973},
 															alternatives: []interface{}{
 																&ruleRefExpr{
-																	pos:  position{line: 1416, col: 21, offset: 53794},
+																	pos:  position{line: 1883, col: 21, offset: 71973},
 																	name: "Alphanums",
 																},
 																&ruleRefExpr{
-																	pos:  position{line: 1416, col: 33, offset: 53806},
+																	pos:  position{line: 1883, col: 33, offset: 71985},
 																	name: "Spaces",
 																},
 																&seqExpr{
-																	pos: position{line: 1416, col: 43, offset: 53816},
+																	pos: position{line: 1883, col: 43, offset: 71995},
 																	exprs: []interface{}{
 																		&notExpr{
-																			pos: position{line: 1416, col: 43, offset: 53816},
+																			pos: position{line: 1883, col: 43, offset: 71995},
 																			expr: &ruleRefExpr{
-																				pos:  position{line: 1416, col: 44, offset: 53817},
+																				pos:  position{line: 1883, col: 44, offset: 71996},
 																				name: "EOL",
 																			},
 																		},
 																		&anyMatcher{
-																			line: 1416, col: 49, offset: 53822,
+																			line: 1883, col: 49, offset: 72001,
 																		},
 																	},
 																},
@@ -11180,7 +14841,7 @@ var g = &grammar{
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1418, col: 12, offset: 53879},
+												pos:  position{line: 1885, col: 12, offset: 72058},
 												name: "EOL",
 											},
 										},
@@ -11194,65 +14855,65 @@ var g = &grammar{
 		},
 		{
 			name: "ParagraphWithLiteralBlockDelimiter",
-			pos:  position{line: 1425, col: 1, offset: 54109},
+			pos:  position{line: 1892, col: 1, offset: 72288},
 			expr: &actionExpr{
-				pos: position{line: 1425, col: 39, offset: 54147},
+				pos: position{line: 1892, col: 39, offset: 72326},
 				run: (*parser).callonParagraphWithLiteralBlockDelimiter1,
 				expr: &seqExpr{
-					pos: position{line: 1425, col: 39, offset: 54147},
+					pos: position{line: 1892, col: 39, offset: 72326},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1425, col: 39, offset: 54147},
+							pos:   position{line: 1892, col: 39, offset: 72326},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1425, col: 50, offset: 54158},
+								pos: position{line: 1892, col: 50, offset: 72337},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1425, col: 51, offset: 54159},
+									pos:  position{line: 1892, col: 51, offset: 72338},
 									name: "ElementAttributes",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1426, col: 9, offset: 54187},
+							pos:  position{line: 1893, col: 9, offset: 72366},
 							name: "LiteralBlockDelimiter",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 1426, col: 31, offset: 54209},
+							pos: position{line: 1893, col: 31, offset: 72388},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1426, col: 31, offset: 54209},
+								pos:  position{line: 1893, col: 31, offset: 72388},
 								name: "WS",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1426, col: 35, offset: 54213},
+							pos:  position{line: 1893, col: 35, offset: 72392},
 							name: "NEWLINE",
 						},
 						&labeledExpr{
-							pos:   position{line: 1426, col: 43, offset: 54221},
+							pos:   position{line: 1893, col: 43, offset: 72400},
 							label: "lines",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1426, col: 50, offset: 54228},
+								pos:  position{line: 1893, col: 50, offset: 72407},
 								name: "ParagraphWithLiteralBlockDelimiterLines",
 							},
 						},
 						&choiceExpr{
-							pos: position{line: 1426, col: 92, offset: 54270},
+							pos: position{line: 1893, col: 92, offset: 72449},
 							alternatives: []interface{}{
 								&seqExpr{
-									pos: position{line: 1426, col: 93, offset: 54271},
+									pos: position{line: 1893, col: 93, offset: 72450},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 1426, col: 93, offset: 54271},
+											pos:  position{line: 1893, col: 93, offset: 72450},
 											name: "LiteralBlockDelimiter",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1426, col: 115, offset: 54293},
+											pos:  position{line: 1893, col: 115, offset: 72472},
 											name: "EOLS",
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1426, col: 123, offset: 54301},
+									pos:  position{line: 1893, col: 123, offset: 72480},
 									name: "EOF",
 								},
 							},
@@ -11263,17 +14924,17 @@ var g = &grammar{
 		},
 		{
 			name: "ParagraphWithLiteralBlockDelimiterLines",
-			pos:  position{line: 1431, col: 1, offset: 54460},
+			pos:  position{line: 1898, col: 1, offset: 72639},
 			expr: &actionExpr{
-				pos: position{line: 1431, col: 44, offset: 54503},
+				pos: position{line: 1898, col: 44, offset: 72682},
 				run: (*parser).callonParagraphWithLiteralBlockDelimiterLines1,
 				expr: &labeledExpr{
-					pos:   position{line: 1431, col: 44, offset: 54503},
+					pos:   position{line: 1898, col: 44, offset: 72682},
 					label: "lines",
 					expr: &zeroOrMoreExpr{
-						pos: position{line: 1431, col: 50, offset: 54509},
+						pos: position{line: 1898, col: 50, offset: 72688},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1431, col: 51, offset: 54510},
+							pos:  position{line: 1898, col: 51, offset: 72689},
 							name: "ParagraphWithLiteralBlockDelimiterLine",
 						},
 					},
@@ -11282,51 +14943,51 @@ var g = &grammar{
 		},
 		{
 			name: "ParagraphWithLiteralBlockDelimiterLine",
-			pos:  position{line: 1435, col: 1, offset: 54594},
+			pos:  position{line: 1902, col: 1, offset: 72773},
 			expr: &actionExpr{
-				pos: position{line: 1436, col: 5, offset: 54649},
+				pos: position{line: 1903, col: 5, offset: 72828},
 				run: (*parser).callonParagraphWithLiteralBlockDelimiterLine1,
 				expr: &seqExpr{
-					pos: position{line: 1436, col: 5, offset: 54649},
+					pos: position{line: 1903, col: 5, offset: 72828},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1436, col: 5, offset: 54649},
+							pos:   position{line: 1903, col: 5, offset: 72828},
 							label: "line",
 							expr: &actionExpr{
-								pos: position{line: 1436, col: 11, offset: 54655},
+								pos: position{line: 1903, col: 11, offset: 72834},
 								run: (*parser).callonParagraphWithLiteralBlockDelimiterLine4,
 								expr: &zeroOrMoreExpr{
-									pos: position{line: 1436, col: 11, offset: 54655},
+									pos: position{line: 1903, col: 11, offset: 72834},
 									expr: &choiceExpr{
-										pos: position{line: 1436, col: 12, offset: 54656},
+										pos: position{line: 1903, col: 12, offset: 72835},
 										alternatives: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 1436, col: 12, offset: 54656},
+												pos:  position{line: 1903, col: 12, offset: 72835},
 												name: "Alphanums",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1436, col: 24, offset: 54668},
+												pos:  position{line: 1903, col: 24, offset: 72847},
 												name: "Spaces",
 											},
 											&seqExpr{
-												pos: position{line: 1436, col: 34, offset: 54678},
+												pos: position{line: 1903, col: 34, offset: 72857},
 												exprs: []interface{}{
 													&notExpr{
-														pos: position{line: 1436, col: 34, offset: 54678},
+														pos: position{line: 1903, col: 34, offset: 72857},
 														expr: &ruleRefExpr{
-															pos:  position{line: 1436, col: 35, offset: 54679},
+															pos:  position{line: 1903, col: 35, offset: 72858},
 															name: "LiteralBlockDelimiter",
 														},
 													},
 													&notExpr{
-														pos: position{line: 1436, col: 57, offset: 54701},
+														pos: position{line: 1903, col: 57, offset: 72880},
 														expr: &ruleRefExpr{
-															pos:  position{line: 1436, col: 58, offset: 54702},
+															pos:  position{line: 1903, col: 58, offset: 72881},
 															name: "EOL",
 														},
 													},
 													&anyMatcher{
-														line: 1436, col: 62, offset: 54706,
+														line: 1903, col: 62, offset: 72885,
 													},
 												},
 											},
@@ -11336,7 +14997,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1438, col: 8, offset: 54755},
+							pos:  position{line: 1905, col: 8, offset: 72934},
 							name: "EOL",
 						},
 					},
@@ -11345,33 +15006,33 @@ var g = &grammar{
 		},
 		{
 			name: "ParagraphWithLiteralAttribute",
-			pos:  position{line: 1443, col: 1, offset: 54881},
+			pos:  position{line: 1910, col: 1, offset: 73060},
 			expr: &actionExpr{
-				pos: position{line: 1444, col: 5, offset: 54919},
+				pos: position{line: 1911, col: 5, offset: 73098},
 				run: (*parser).callonParagraphWithLiteralAttribute1,
 				expr: &seqExpr{
-					pos: position{line: 1444, col: 5, offset: 54919},
+					pos: position{line: 1911, col: 5, offset: 73098},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1444, col: 5, offset: 54919},
+							pos:   position{line: 1911, col: 5, offset: 73098},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1444, col: 16, offset: 54930},
+								pos: position{line: 1911, col: 16, offset: 73109},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1444, col: 17, offset: 54931},
+									pos:  position{line: 1911, col: 17, offset: 73110},
 									name: "ElementAttributes",
 								},
 							},
 						},
 						&andCodeExpr{
-							pos: position{line: 1445, col: 5, offset: 54955},
+							pos: position{line: 1912, col: 5, offset: 73134},
 							run: (*parser).callonParagraphWithLiteralAttribute6,
 						},
 						&labeledExpr{
-							pos:   position{line: 1452, col: 5, offset: 55169},
+							pos:   position{line: 1919, col: 5, offset: 73348},
 							label: "lines",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1452, col: 12, offset: 55176},
+								pos:  position{line: 1919, col: 12, offset: 73355},
 								name: "ParagraphWithLiteralAttributeLines",
 							},
 						},
@@ -11381,12 +15042,12 @@ var g = &grammar{
 		},
 		{
 			name: "LiteralKind",
-			pos:  position{line: 1456, col: 1, offset: 55326},
+			pos:  position{line: 1923, col: 1, offset: 73505},
 			expr: &actionExpr{
-				pos: position{line: 1456, col: 16, offset: 55341},
+				pos: position{line: 1923, col: 16, offset: 73520},
 				run: (*parser).callonLiteralKind1,
 				expr: &litMatcher{
-					pos:        position{line: 1456, col: 16, offset: 55341},
+					pos:        position{line: 1923, col: 16, offset: 73520},
 					val:        "literal",
 					ignoreCase: false,
 				},
@@ -11394,17 +15055,17 @@ var g = &grammar{
 		},
 		{
 			name: "ParagraphWithLiteralAttributeLines",
-			pos:  position{line: 1461, col: 1, offset: 55424},
+			pos:  position{line: 1928, col: 1, offset: 73603},
 			expr: &actionExpr{
-				pos: position{line: 1461, col: 39, offset: 55462},
+				pos: position{line: 1928, col: 39, offset: 73641},
 				run: (*parser).callonParagraphWithLiteralAttributeLines1,
 				expr: &labeledExpr{
-					pos:   position{line: 1461, col: 39, offset: 55462},
+					pos:   position{line: 1928, col: 39, offset: 73641},
 					label: "lines",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 1461, col: 45, offset: 55468},
+						pos: position{line: 1928, col: 45, offset: 73647},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1461, col: 46, offset: 55469},
+							pos:  position{line: 1928, col: 46, offset: 73648},
 							name: "ParagraphWithLiteralAttributeLine",
 						},
 					},
@@ -11413,54 +15074,54 @@ var g = &grammar{
 		},
 		{
 			name: "ParagraphWithLiteralAttributeLine",
-			pos:  position{line: 1465, col: 1, offset: 55549},
+			pos:  position{line: 1932, col: 1, offset: 73728},
 			expr: &actionExpr{
-				pos: position{line: 1465, col: 38, offset: 55586},
+				pos: position{line: 1932, col: 38, offset: 73765},
 				run: (*parser).callonParagraphWithLiteralAttributeLine1,
 				expr: &seqExpr{
-					pos: position{line: 1465, col: 38, offset: 55586},
+					pos: position{line: 1932, col: 38, offset: 73765},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1465, col: 38, offset: 55586},
+							pos:   position{line: 1932, col: 38, offset: 73765},
 							label: "line",
 							expr: &actionExpr{
-								pos: position{line: 1465, col: 44, offset: 55592},
+								pos: position{line: 1932, col: 44, offset: 73771},
 								run: (*parser).callonParagraphWithLiteralAttributeLine4,
 								expr: &seqExpr{
-									pos: position{line: 1465, col: 44, offset: 55592},
+									pos: position{line: 1932, col: 44, offset: 73771},
 									exprs: []interface{}{
 										&notExpr{
-											pos: position{line: 1465, col: 44, offset: 55592},
+											pos: position{line: 1932, col: 44, offset: 73771},
 											expr: &ruleRefExpr{
-												pos:  position{line: 1465, col: 46, offset: 55594},
+												pos:  position{line: 1932, col: 46, offset: 73773},
 												name: "BlankLine",
 											},
 										},
 										&oneOrMoreExpr{
-											pos: position{line: 1465, col: 57, offset: 55605},
+											pos: position{line: 1932, col: 57, offset: 73784},
 											expr: &choiceExpr{
-												pos: position{line: 1465, col: 58, offset: 55606},
+												pos: position{line: 1932, col: 58, offset: 73785},
 												alternatives: []interface{}{
 													&ruleRefExpr{
-														pos:  position{line: 1465, col: 58, offset: 55606},
+														pos:  position{line: 1932, col: 58, offset: 73785},
 														name: "Alphanums",
 													},
 													&ruleRefExpr{
-														pos:  position{line: 1465, col: 70, offset: 55618},
+														pos:  position{line: 1932, col: 70, offset: 73797},
 														name: "Spaces",
 													},
 													&seqExpr{
-														pos: position{line: 1465, col: 80, offset: 55628},
+														pos: position{line: 1932, col: 80, offset: 73807},
 														exprs: []interface{}{
 															&notExpr{
-																pos: position{line: 1465, col: 80, offset: 55628},
+																pos: position{line: 1932, col: 80, offset: 73807},
 																expr: &ruleRefExpr{
-																	pos:  position{line: 1465, col: 81, offset: 55629},
+																	pos:  position{line: 1932, col: 81, offset: 73808},
 																	name: "EOL",
 																},
 															},
 															&anyMatcher{
-																line: 1465, col: 86, offset: 55634,
+																line: 1932, col: 86, offset: 73813,
 															},
 														},
 													},
@@ -11472,7 +15133,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1467, col: 4, offset: 55675},
+							pos:  position{line: 1934, col: 4, offset: 73854},
 							name: "EOL",
 						},
 					},
@@ -11481,22 +15142,22 @@ var g = &grammar{
 		},
 		{
 			name: "BlankLine",
-			pos:  position{line: 1474, col: 1, offset: 55847},
+			pos:  position{line: 1941, col: 1, offset: 74026},
 			expr: &actionExpr{
-				pos: position{line: 1474, col: 14, offset: 55860},
+				pos: position{line: 1941, col: 14, offset: 74039},
 				run: (*parser).callonBlankLine1,
 				expr: &seqExpr{
-					pos: position{line: 1474, col: 14, offset: 55860},
+					pos: position{line: 1941, col: 14, offset: 74039},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1474, col: 14, offset: 55860},
+							pos: position{line: 1941, col: 14, offset: 74039},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1474, col: 15, offset: 55861},
+								pos:  position{line: 1941, col: 15, offset: 74040},
 								name: "EOF",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1474, col: 19, offset: 55865},
+							pos:  position{line: 1941, col: 19, offset: 74044},
 							name: "EOLS",
 						},
 					},
@@ -11505,9 +15166,9 @@ var g = &grammar{
 		},
 		{
 			name: "Alphanum",
-			pos:  position{line: 1481, col: 1, offset: 56013},
+			pos:  position{line: 1948, col: 1, offset: 74192},
 			expr: &charClassMatcher{
-				pos:        position{line: 1481, col: 13, offset: 56025},
+				pos:        position{line: 1948, col: 13, offset: 74204},
 				val:        "[\\pL0-9]",
 				ranges:     []rune{'0', '9'},
 				classes:    []*unicode.RangeTable{rangeTable("L")},
@@ -11517,27 +15178,27 @@ var g = &grammar{
 		},
 		{
 			name: "Parenthesis",
-			pos:  position{line: 1483, col: 1, offset: 56035},
+			pos:  position{line: 1950, col: 1, offset: 74214},
 			expr: &choiceExpr{
-				pos: position{line: 1483, col: 16, offset: 56050},
+				pos: position{line: 1950, col: 16, offset: 74229},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1483, col: 16, offset: 56050},
+						pos:        position{line: 1950, col: 16, offset: 74229},
 						val:        "(",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1483, col: 22, offset: 56056},
+						pos:        position{line: 1950, col: 22, offset: 74235},
 						val:        ")",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1483, col: 28, offset: 56062},
+						pos:        position{line: 1950, col: 28, offset: 74241},
 						val:        "[",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1483, col: 34, offset: 56068},
+						pos:        position{line: 1950, col: 34, offset: 74247},
 						val:        "]",
 						ignoreCase: false,
 					},
@@ -11546,11 +15207,11 @@ var g = &grammar{
 		},
 		{
 			name: "Alphanums",
-			pos:  position{line: 1485, col: 1, offset: 56073},
+			pos:  position{line: 1952, col: 1, offset: 74252},
 			expr: &oneOrMoreExpr{
-				pos: position{line: 1485, col: 14, offset: 56086},
+				pos: position{line: 1952, col: 14, offset: 74265},
 				expr: &charClassMatcher{
-					pos:        position{line: 1485, col: 14, offset: 56086},
+					pos:        position{line: 1952, col: 14, offset: 74265},
 					val:        "[\\pL0-9]",
 					ranges:     []rune{'0', '9'},
 					classes:    []*unicode.RangeTable{rangeTable("L")},
@@ -11561,37 +15222,37 @@ var g = &grammar{
 		},
 		{
 			name: "Dot",
-			pos:  position{line: 1487, col: 1, offset: 56097},
+			pos:  position{line: 1954, col: 1, offset: 74276},
 			expr: &litMatcher{
-				pos:        position{line: 1487, col: 8, offset: 56104},
+				pos:        position{line: 1954, col: 8, offset: 74283},
 				val:        ".",
 				ignoreCase: false,
 			},
 		},
 		{
 			name: "SimpleWord",
-			pos:  position{line: 1489, col: 1, offset: 56109},
+			pos:  position{line: 1956, col: 1, offset: 74288},
 			expr: &actionExpr{
-				pos: position{line: 1489, col: 15, offset: 56123},
+				pos: position{line: 1956, col: 15, offset: 74302},
 				run: (*parser).callonSimpleWord1,
 				expr: &seqExpr{
-					pos: position{line: 1489, col: 15, offset: 56123},
+					pos: position{line: 1956, col: 15, offset: 74302},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 1489, col: 15, offset: 56123},
+							pos:  position{line: 1956, col: 15, offset: 74302},
 							name: "Alphanums",
 						},
 						&andExpr{
-							pos: position{line: 1489, col: 25, offset: 56133},
+							pos: position{line: 1956, col: 25, offset: 74312},
 							expr: &choiceExpr{
-								pos: position{line: 1489, col: 27, offset: 56135},
+								pos: position{line: 1956, col: 27, offset: 74314},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 1489, col: 27, offset: 56135},
+										pos:  position{line: 1956, col: 27, offset: 74314},
 										name: "WS",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1489, col: 32, offset: 56140},
+										pos:  position{line: 1956, col: 32, offset: 74319},
 										name: "EOL",
 									},
 								},
@@ -11603,73 +15264,73 @@ var g = &grammar{
 		},
 		{
 			name: "OtherWord",
-			pos:  position{line: 1494, col: 1, offset: 56405},
+			pos:  position{line: 1961, col: 1, offset: 74584},
 			expr: &actionExpr{
-				pos: position{line: 1494, col: 14, offset: 56418},
+				pos: position{line: 1961, col: 14, offset: 74597},
 				run: (*parser).callonOtherWord1,
 				expr: &choiceExpr{
-					pos: position{line: 1494, col: 15, offset: 56419},
+					pos: position{line: 1961, col: 15, offset: 74598},
 					alternatives: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 1494, col: 15, offset: 56419},
+							pos:  position{line: 1961, col: 15, offset: 74598},
 							name: "Alphanums",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1494, col: 27, offset: 56431},
+							pos:  position{line: 1961, col: 27, offset: 74610},
 							name: "QuotedTextPrefix",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1494, col: 46, offset: 56450},
+							pos:  position{line: 1961, col: 46, offset: 74629},
 							name: "Parenthesis",
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 1494, col: 60, offset: 56464},
+							pos: position{line: 1961, col: 60, offset: 74643},
 							expr: &actionExpr{
-								pos: position{line: 1494, col: 61, offset: 56465},
+								pos: position{line: 1961, col: 61, offset: 74644},
 								run: (*parser).callonOtherWord7,
 								expr: &seqExpr{
-									pos: position{line: 1494, col: 61, offset: 56465},
+									pos: position{line: 1961, col: 61, offset: 74644},
 									exprs: []interface{}{
 										&seqExpr{
-											pos: position{line: 1494, col: 62, offset: 56466},
+											pos: position{line: 1961, col: 62, offset: 74645},
 											exprs: []interface{}{
 												&notExpr{
-													pos: position{line: 1494, col: 62, offset: 56466},
+													pos: position{line: 1961, col: 62, offset: 74645},
 													expr: &ruleRefExpr{
-														pos:  position{line: 1494, col: 63, offset: 56467},
+														pos:  position{line: 1961, col: 63, offset: 74646},
 														name: "NEWLINE",
 													},
 												},
 												&notExpr{
-													pos: position{line: 1494, col: 71, offset: 56475},
+													pos: position{line: 1961, col: 71, offset: 74654},
 													expr: &ruleRefExpr{
-														pos:  position{line: 1494, col: 72, offset: 56476},
+														pos:  position{line: 1961, col: 72, offset: 74655},
 														name: "WS",
 													},
 												},
 												&notExpr{
-													pos: position{line: 1494, col: 75, offset: 56479},
+													pos: position{line: 1961, col: 75, offset: 74658},
 													expr: &ruleRefExpr{
-														pos:  position{line: 1494, col: 76, offset: 56480},
+														pos:  position{line: 1961, col: 76, offset: 74659},
 														name: "Dot",
 													},
 												},
 												&notExpr{
-													pos: position{line: 1494, col: 80, offset: 56484},
+													pos: position{line: 1961, col: 80, offset: 74663},
 													expr: &ruleRefExpr{
-														pos:  position{line: 1494, col: 81, offset: 56485},
+														pos:  position{line: 1961, col: 81, offset: 74664},
 														name: "QuotedTextPrefix",
 													},
 												},
 												&anyMatcher{
-													line: 1494, col: 98, offset: 56502,
+													line: 1961, col: 98, offset: 74681,
 												},
 											},
 										},
 										&zeroOrOneExpr{
-											pos: position{line: 1494, col: 101, offset: 56505},
+											pos: position{line: 1961, col: 101, offset: 74684},
 											expr: &ruleRefExpr{
-												pos:  position{line: 1494, col: 101, offset: 56505},
+												pos:  position{line: 1961, col: 101, offset: 74684},
 												name: "Dot",
 											},
 										},
@@ -11678,9 +15339,9 @@ var g = &grammar{
 							},
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 1496, col: 7, offset: 56614},
+							pos: position{line: 1963, col: 7, offset: 74793},
 							expr: &litMatcher{
-								pos:        position{line: 1496, col: 7, offset: 56614},
+								pos:        position{line: 1963, col: 7, offset: 74793},
 								val:        ".",
 								ignoreCase: false,
 							},
@@ -11691,35 +15352,35 @@ var g = &grammar{
 		},
 		{
 			name: "Spaces",
-			pos:  position{line: 1500, col: 1, offset: 56795},
+			pos:  position{line: 1967, col: 1, offset: 74974},
 			expr: &oneOrMoreExpr{
-				pos: position{line: 1500, col: 11, offset: 56805},
+				pos: position{line: 1967, col: 11, offset: 74984},
 				expr: &ruleRefExpr{
-					pos:  position{line: 1500, col: 11, offset: 56805},
+					pos:  position{line: 1967, col: 11, offset: 74984},
 					name: "WS",
 				},
 			},
 		},
 		{
 			name: "FileLocation",
-			pos:  position{line: 1502, col: 1, offset: 56811},
+			pos:  position{line: 1969, col: 1, offset: 74990},
 			expr: &actionExpr{
-				pos: position{line: 1502, col: 17, offset: 56827},
+				pos: position{line: 1969, col: 17, offset: 75006},
 				run: (*parser).callonFileLocation1,
 				expr: &labeledExpr{
-					pos:   position{line: 1502, col: 17, offset: 56827},
+					pos:   position{line: 1969, col: 17, offset: 75006},
 					label: "elements",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 1502, col: 26, offset: 56836},
+						pos: position{line: 1969, col: 26, offset: 75015},
 						expr: &choiceExpr{
-							pos: position{line: 1502, col: 27, offset: 56837},
+							pos: position{line: 1969, col: 27, offset: 75016},
 							alternatives: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 1502, col: 27, offset: 56837},
+									pos:  position{line: 1969, col: 27, offset: 75016},
 									name: "FILENAME",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1502, col: 38, offset: 56848},
+									pos:  position{line: 1969, col: 38, offset: 75027},
 									name: "DocumentAttributeSubstitution",
 								},
 							},
@@ -11730,53 +15391,53 @@ var g = &grammar{
 		},
 		{
 			name: "Location",
-			pos:  position{line: 1506, col: 1, offset: 56940},
+			pos:  position{line: 1973, col: 1, offset: 75119},
 			expr: &actionExpr{
-				pos: position{line: 1506, col: 13, offset: 56952},
+				pos: position{line: 1973, col: 13, offset: 75131},
 				run: (*parser).callonLocation1,
 				expr: &labeledExpr{
-					pos:   position{line: 1506, col: 13, offset: 56952},
+					pos:   position{line: 1973, col: 13, offset: 75131},
 					label: "elements",
 					expr: &seqExpr{
-						pos: position{line: 1506, col: 23, offset: 56962},
+						pos: position{line: 1973, col: 23, offset: 75141},
 						exprs: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 1506, col: 23, offset: 56962},
+								pos:  position{line: 1973, col: 23, offset: 75141},
 								name: "URL_SCHEME",
 							},
 							&oneOrMoreExpr{
-								pos: position{line: 1506, col: 34, offset: 56973},
+								pos: position{line: 1973, col: 34, offset: 75152},
 								expr: &choiceExpr{
-									pos: position{line: 1506, col: 35, offset: 56974},
+									pos: position{line: 1973, col: 35, offset: 75153},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 1506, col: 35, offset: 56974},
+											pos:  position{line: 1973, col: 35, offset: 75153},
 											name: "FILENAME",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1506, col: 46, offset: 56985},
+											pos:  position{line: 1973, col: 46, offset: 75164},
 											name: "DocumentAttributeSubstitution",
 										},
 										&seqExpr{
-											pos: position{line: 1506, col: 78, offset: 57017},
+											pos: position{line: 1973, col: 78, offset: 75196},
 											exprs: []interface{}{
 												&notExpr{
-													pos: position{line: 1506, col: 78, offset: 57017},
+													pos: position{line: 1973, col: 78, offset: 75196},
 													expr: &ruleRefExpr{
-														pos:  position{line: 1506, col: 79, offset: 57018},
+														pos:  position{line: 1973, col: 79, offset: 75197},
 														name: "EOL",
 													},
 												},
 												&notExpr{
-													pos: position{line: 1506, col: 83, offset: 57022},
+													pos: position{line: 1973, col: 83, offset: 75201},
 													expr: &litMatcher{
-														pos:        position{line: 1506, col: 84, offset: 57023},
+														pos:        position{line: 1973, col: 84, offset: 75202},
 														val:        "[",
 														ignoreCase: false,
 													},
 												},
 												&anyMatcher{
-													line: 1506, col: 88, offset: 57027,
+													line: 1973, col: 88, offset: 75206,
 												},
 											},
 										},
@@ -11790,26 +15451,26 @@ var g = &grammar{
 		},
 		{
 			name: "FILENAME",
-			pos:  position{line: 1510, col: 1, offset: 57092},
+			pos:  position{line: 1977, col: 1, offset: 75271},
 			expr: &oneOrMoreExpr{
-				pos: position{line: 1510, col: 13, offset: 57104},
+				pos: position{line: 1977, col: 13, offset: 75283},
 				expr: &choiceExpr{
-					pos: position{line: 1510, col: 14, offset: 57105},
+					pos: position{line: 1977, col: 14, offset: 75284},
 					alternatives: []interface{}{
 						&charClassMatcher{
-							pos:        position{line: 1510, col: 14, offset: 57105},
+							pos:        position{line: 1977, col: 14, offset: 75284},
 							val:        "[ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789~:/?#@!$&;=()*+,_]",
 							chars:      []rune{'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z', 'a', 'b', 'c', 'd', 'e', 'f', 'g', 'h', 'i', 'j', 'k', 'l', 'm', 'n', 'o', 'p', 'q', 'r', 's', 't', 'u', 'v', 'w', 'x', 'y', 'z', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9', '~', ':', '/', '?', '#', '@', '!', '$', '&', ';', '=', '(', ')', '*', '+', ',', '_'},
 							ignoreCase: false,
 							inverted:   false,
 						},
 						&litMatcher{
-							pos:        position{line: 1510, col: 98, offset: 57189},
+							pos:        position{line: 1977, col: 98, offset: 75368},
 							val:        "-",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 1510, col: 104, offset: 57195},
+							pos:        position{line: 1977, col: 104, offset: 75374},
 							val:        ".",
 							ignoreCase: false,
 						},
@@ -11819,54 +15480,54 @@ var g = &grammar{
 		},
 		{
 			name: "URL",
-			pos:  position{line: 1512, col: 1, offset: 57236},
+			pos:  position{line: 1979, col: 1, offset: 75415},
 			expr: &actionExpr{
-				pos: position{line: 1512, col: 8, offset: 57243},
+				pos: position{line: 1979, col: 8, offset: 75422},
 				run: (*parser).callonURL1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1512, col: 8, offset: 57243},
+					pos: position{line: 1979, col: 8, offset: 75422},
 					expr: &choiceExpr{
-						pos: position{line: 1512, col: 9, offset: 57244},
+						pos: position{line: 1979, col: 9, offset: 75423},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 1512, col: 9, offset: 57244},
+								pos:  position{line: 1979, col: 9, offset: 75423},
 								name: "Alphanums",
 							},
 							&seqExpr{
-								pos: position{line: 1512, col: 22, offset: 57257},
+								pos: position{line: 1979, col: 22, offset: 75436},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 1512, col: 22, offset: 57257},
+										pos: position{line: 1979, col: 22, offset: 75436},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1512, col: 23, offset: 57258},
+											pos:  position{line: 1979, col: 23, offset: 75437},
 											name: "NEWLINE",
 										},
 									},
 									&notExpr{
-										pos: position{line: 1512, col: 31, offset: 57266},
+										pos: position{line: 1979, col: 31, offset: 75445},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1512, col: 32, offset: 57267},
+											pos:  position{line: 1979, col: 32, offset: 75446},
 											name: "WS",
 										},
 									},
 									&notExpr{
-										pos: position{line: 1512, col: 35, offset: 57270},
+										pos: position{line: 1979, col: 35, offset: 75449},
 										expr: &litMatcher{
-											pos:        position{line: 1512, col: 36, offset: 57271},
+											pos:        position{line: 1979, col: 36, offset: 75450},
 											val:        "[",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 1512, col: 40, offset: 57275},
+										pos: position{line: 1979, col: 40, offset: 75454},
 										expr: &litMatcher{
-											pos:        position{line: 1512, col: 41, offset: 57276},
+											pos:        position{line: 1979, col: 41, offset: 75455},
 											val:        "]",
 											ignoreCase: false,
 										},
 									},
 									&anyMatcher{
-										line: 1512, col: 46, offset: 57281,
+										line: 1979, col: 46, offset: 75460,
 									},
 								},
 							},
@@ -11877,32 +15538,32 @@ var g = &grammar{
 		},
 		{
 			name: "URL_SCHEME",
-			pos:  position{line: 1516, col: 1, offset: 57322},
+			pos:  position{line: 1983, col: 1, offset: 75501},
 			expr: &choiceExpr{
-				pos: position{line: 1516, col: 15, offset: 57336},
+				pos: position{line: 1983, col: 15, offset: 75515},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1516, col: 15, offset: 57336},
+						pos:        position{line: 1983, col: 15, offset: 75515},
 						val:        "http://",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1516, col: 27, offset: 57348},
+						pos:        position{line: 1983, col: 27, offset: 75527},
 						val:        "https://",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1516, col: 40, offset: 57361},
+						pos:        position{line: 1983, col: 40, offset: 75540},
 						val:        "ftp://",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1516, col: 51, offset: 57372},
+						pos:        position{line: 1983, col: 51, offset: 75551},
 						val:        "irc://",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1516, col: 62, offset: 57383},
+						pos:        position{line: 1983, col: 62, offset: 75562},
 						val:        "mailto:",
 						ignoreCase: false,
 					},
@@ -11911,78 +15572,78 @@ var g = &grammar{
 		},
 		{
 			name: "ID",
-			pos:  position{line: 1518, col: 1, offset: 57394},
+			pos:  position{line: 1985, col: 1, offset: 75573},
 			expr: &actionExpr{
-				pos: position{line: 1518, col: 7, offset: 57400},
+				pos: position{line: 1985, col: 7, offset: 75579},
 				run: (*parser).callonID1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1518, col: 7, offset: 57400},
+					pos: position{line: 1985, col: 7, offset: 75579},
 					expr: &choiceExpr{
-						pos: position{line: 1518, col: 8, offset: 57401},
+						pos: position{line: 1985, col: 8, offset: 75580},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 1518, col: 8, offset: 57401},
+								pos:  position{line: 1985, col: 8, offset: 75580},
 								name: "Alphanums",
 							},
 							&seqExpr{
-								pos: position{line: 1518, col: 21, offset: 57414},
+								pos: position{line: 1985, col: 21, offset: 75593},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 1518, col: 21, offset: 57414},
+										pos: position{line: 1985, col: 21, offset: 75593},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1518, col: 22, offset: 57415},
+											pos:  position{line: 1985, col: 22, offset: 75594},
 											name: "NEWLINE",
 										},
 									},
 									&notExpr{
-										pos: position{line: 1518, col: 30, offset: 57423},
+										pos: position{line: 1985, col: 30, offset: 75602},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1518, col: 31, offset: 57424},
+											pos:  position{line: 1985, col: 31, offset: 75603},
 											name: "WS",
 										},
 									},
 									&notExpr{
-										pos: position{line: 1518, col: 34, offset: 57427},
+										pos: position{line: 1985, col: 34, offset: 75606},
 										expr: &litMatcher{
-											pos:        position{line: 1518, col: 35, offset: 57428},
+											pos:        position{line: 1985, col: 35, offset: 75607},
 											val:        "[",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 1518, col: 39, offset: 57432},
+										pos: position{line: 1985, col: 39, offset: 75611},
 										expr: &litMatcher{
-											pos:        position{line: 1518, col: 40, offset: 57433},
+											pos:        position{line: 1985, col: 40, offset: 75612},
 											val:        "]",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 1518, col: 44, offset: 57437},
+										pos: position{line: 1985, col: 44, offset: 75616},
 										expr: &litMatcher{
-											pos:        position{line: 1518, col: 45, offset: 57438},
+											pos:        position{line: 1985, col: 45, offset: 75617},
 											val:        "<<",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 1518, col: 50, offset: 57443},
+										pos: position{line: 1985, col: 50, offset: 75622},
 										expr: &litMatcher{
-											pos:        position{line: 1518, col: 51, offset: 57444},
+											pos:        position{line: 1985, col: 51, offset: 75623},
 											val:        ">>",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 1518, col: 56, offset: 57449},
+										pos: position{line: 1985, col: 56, offset: 75628},
 										expr: &litMatcher{
-											pos:        position{line: 1518, col: 57, offset: 57450},
+											pos:        position{line: 1985, col: 57, offset: 75629},
 											val:        ",",
 											ignoreCase: false,
 										},
 									},
 									&anyMatcher{
-										line: 1518, col: 62, offset: 57455,
+										line: 1985, col: 62, offset: 75634,
 									},
 								},
 							},
@@ -11993,12 +15654,12 @@ var g = &grammar{
 		},
 		{
 			name: "DIGIT",
-			pos:  position{line: 1522, col: 1, offset: 57496},
+			pos:  position{line: 1989, col: 1, offset: 75675},
 			expr: &actionExpr{
-				pos: position{line: 1522, col: 10, offset: 57505},
+				pos: position{line: 1989, col: 10, offset: 75684},
 				run: (*parser).callonDIGIT1,
 				expr: &charClassMatcher{
-					pos:        position{line: 1522, col: 10, offset: 57505},
+					pos:        position{line: 1989, col: 10, offset: 75684},
 					val:        "[0-9]",
 					ranges:     []rune{'0', '9'},
 					ignoreCase: false,
@@ -12008,25 +15669,25 @@ var g = &grammar{
 		},
 		{
 			name: "NUMBER",
-			pos:  position{line: 1526, col: 1, offset: 57547},
+			pos:  position{line: 1993, col: 1, offset: 75726},
 			expr: &actionExpr{
-				pos: position{line: 1526, col: 11, offset: 57557},
+				pos: position{line: 1993, col: 11, offset: 75736},
 				run: (*parser).callonNUMBER1,
 				expr: &seqExpr{
-					pos: position{line: 1526, col: 11, offset: 57557},
+					pos: position{line: 1993, col: 11, offset: 75736},
 					exprs: []interface{}{
 						&zeroOrOneExpr{
-							pos: position{line: 1526, col: 11, offset: 57557},
+							pos: position{line: 1993, col: 11, offset: 75736},
 							expr: &litMatcher{
-								pos:        position{line: 1526, col: 11, offset: 57557},
+								pos:        position{line: 1993, col: 11, offset: 75736},
 								val:        "-",
 								ignoreCase: false,
 							},
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 1526, col: 16, offset: 57562},
+							pos: position{line: 1993, col: 16, offset: 75741},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1526, col: 16, offset: 57562},
+								pos:  position{line: 1993, col: 16, offset: 75741},
 								name: "DIGIT",
 							},
 						},
@@ -12036,20 +15697,20 @@ var g = &grammar{
 		},
 		{
 			name: "WS",
-			pos:  position{line: 1530, col: 1, offset: 57614},
+			pos:  position{line: 1997, col: 1, offset: 75793},
 			expr: &choiceExpr{
-				pos: position{line: 1530, col: 7, offset: 57620},
+				pos: position{line: 1997, col: 7, offset: 75799},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1530, col: 7, offset: 57620},
+						pos:        position{line: 1997, col: 7, offset: 75799},
 						val:        " ",
 						ignoreCase: false,
 					},
 					&actionExpr{
-						pos: position{line: 1530, col: 13, offset: 57626},
+						pos: position{line: 1997, col: 13, offset: 75805},
 						run: (*parser).callonWS3,
 						expr: &litMatcher{
-							pos:        position{line: 1530, col: 13, offset: 57626},
+							pos:        position{line: 1997, col: 13, offset: 75805},
 							val:        "\t",
 							ignoreCase: false,
 						},
@@ -12059,22 +15720,22 @@ var g = &grammar{
 		},
 		{
 			name: "NEWLINE",
-			pos:  position{line: 1534, col: 1, offset: 57667},
+			pos:  position{line: 2001, col: 1, offset: 75846},
 			expr: &choiceExpr{
-				pos: position{line: 1534, col: 12, offset: 57678},
+				pos: position{line: 2001, col: 12, offset: 75857},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1534, col: 12, offset: 57678},
+						pos:        position{line: 2001, col: 12, offset: 75857},
 						val:        "\r\n",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1534, col: 21, offset: 57687},
+						pos:        position{line: 2001, col: 21, offset: 75866},
 						val:        "\r",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1534, col: 28, offset: 57694},
+						pos:        position{line: 2001, col: 28, offset: 75873},
 						val:        "\n",
 						ignoreCase: false,
 					},
@@ -12083,26 +15744,26 @@ var g = &grammar{
 		},
 		{
 			name: "EOF",
-			pos:  position{line: 1536, col: 1, offset: 57700},
+			pos:  position{line: 2003, col: 1, offset: 75879},
 			expr: &notExpr{
-				pos: position{line: 1536, col: 8, offset: 57707},
+				pos: position{line: 2003, col: 8, offset: 75886},
 				expr: &anyMatcher{
-					line: 1536, col: 9, offset: 57708,
+					line: 2003, col: 9, offset: 75887,
 				},
 			},
 		},
 		{
 			name: "EOL",
-			pos:  position{line: 1538, col: 1, offset: 57711},
+			pos:  position{line: 2005, col: 1, offset: 75890},
 			expr: &choiceExpr{
-				pos: position{line: 1538, col: 8, offset: 57718},
+				pos: position{line: 2005, col: 8, offset: 75897},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1538, col: 8, offset: 57718},
+						pos:  position{line: 2005, col: 8, offset: 75897},
 						name: "NEWLINE",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1538, col: 18, offset: 57728},
+						pos:  position{line: 2005, col: 18, offset: 75907},
 						name: "EOF",
 					},
 				},
@@ -12110,19 +15771,19 @@ var g = &grammar{
 		},
 		{
 			name: "EOLS",
-			pos:  position{line: 1540, col: 1, offset: 57733},
+			pos:  position{line: 2007, col: 1, offset: 75912},
 			expr: &seqExpr{
-				pos: position{line: 1540, col: 9, offset: 57741},
+				pos: position{line: 2007, col: 9, offset: 75920},
 				exprs: []interface{}{
 					&zeroOrMoreExpr{
-						pos: position{line: 1540, col: 9, offset: 57741},
+						pos: position{line: 2007, col: 9, offset: 75920},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1540, col: 9, offset: 57741},
+							pos:  position{line: 2007, col: 9, offset: 75920},
 							name: "WS",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1540, col: 13, offset: 57745},
+						pos:  position{line: 2007, col: 13, offset: 75924},
 						name: "EOL",
 					},
 				},
@@ -12408,14 +16069,104 @@ func (p *parser) callonDocumentAttributeReset9() (interface{}, error) {
 	return p.cur.onDocumentAttributeReset9(stack["name"])
 }
 
-func (c *current) onDocumentAttributeSubstitution1(name interface{}) (interface{}, error) {
-	return types.NewDocumentAttributeSubstitution(name.(string))
+func (c *current) onDocumentAttributeSubstitution4(name interface{}) (interface{}, error) {
+	return types.NewDocumentAttributeSubstitution(name.(string), positionOf(c))
+}
+
+func (p *parser) callonDocumentAttributeSubstitution4() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDocumentAttributeSubstitution4(stack["name"])
+}
+
+func (c *current) onInlineAttributeEntry2(name interface{}) (interface{}, error) {
+	return types.NewInlineAttributeEntry(name.(string), nil, true)
+}
+
+func (p *parser) callonInlineAttributeEntry2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onInlineAttributeEntry2(stack["name"])
+}
+
+func (c *current) onInlineAttributeEntry19() (interface{}, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonInlineAttributeEntry19() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onInlineAttributeEntry19()
+}
+
+func (c *current) onInlineAttributeEntry15(value interface{}) (interface{}, error) {
+	return value, nil
+}
+
+func (p *parser) callonInlineAttributeEntry15() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onInlineAttributeEntry15(stack["value"])
+}
+
+func (c *current) onInlineAttributeEntry8(name, value interface{}) (interface{}, error) {
+	return types.NewInlineAttributeEntry(name.(string), value, false)
+}
+
+func (p *parser) callonInlineAttributeEntry8() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onInlineAttributeEntry8(stack["name"], stack["value"])
+}
+
+func (c *current) onCounterSubstitution2(name, start interface{}) (interface{}, error) {
+	return types.NewCounterSubstitution(name.(string), false, start)
+}
+
+func (p *parser) callonCounterSubstitution2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onCounterSubstitution2(stack["name"], stack["start"])
+}
+
+func (c *current) onCounterSubstitution11(name, start interface{}) (interface{}, error) {
+	return types.NewCounterSubstitution(name.(string), true, start)
+}
+
+func (p *parser) callonCounterSubstitution11() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onCounterSubstitution11(stack["name"], stack["start"])
+}
+
+func (c *current) onCounterStart6() (interface{}, error) {
+	return rune(c.text[0]), nil
+}
+
+func (p *parser) callonCounterStart6() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onCounterStart6()
+}
+
+func (c *current) onCounterStart8() (interface{}, error) {
+	return strconv.Atoi(string(c.text))
+}
+
+func (p *parser) callonCounterStart8() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onCounterStart8()
+}
+
+func (c *current) onCounterStart1(start interface{}) (interface{}, error) {
+	return start, nil
 }
 
-func (p *parser) callonDocumentAttributeSubstitution1() (interface{}, error) {
+func (p *parser) callonCounterStart1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onDocumentAttributeSubstitution1(stack["name"])
+	return p.cur.onCounterStart1(stack["start"])
 }
 
 func (c *current) onElementAttributes1(attrs interface{}) (interface{}, error) {
@@ -12438,6 +16189,26 @@ func (p *parser) callonElementAttribute1() (interface{}, error) {
 	return p.cur.onElementAttribute1(stack["attr"])
 }
 
+func (c *current) onBlockStyleAttribute5() (interface{}, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonBlockStyleAttribute5() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onBlockStyleAttribute5()
+}
+
+func (c *current) onBlockStyleAttribute1(kind interface{}) (interface{}, error) {
+	return types.NewBlockStyleAttribute(kind.(string))
+}
+
+func (p *parser) callonBlockStyleAttribute1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onBlockStyleAttribute1(stack["kind"])
+}
+
 func (c *current) onElementID2(id interface{}) (interface{}, error) {
 	return types.NewElementID(id.(string))
 }
@@ -12480,7 +16251,7 @@ func (p *parser) callonElementTitle5() (interface{}, error) {
 }
 
 func (c *current) onElementTitle1(title interface{}) (interface{}, error) {
-	return types.NewElementTitle(title.(string))
+	return types.NewElementTitle(title.(string), positionOf(c))
 }
 
 func (p *parser) callonElementTitle1() (interface{}, error) {
@@ -12529,25 +16300,24 @@ func (p *parser) callonAdmonitionMarkerAttribute1() (interface{}, error) {
 	return p.cur.onAdmonitionMarkerAttribute1(stack["k"])
 }
 
-func (c *current) onSourceAttributes8() (interface{}, error) {
-	return string(c.text), nil
-
+func (c *current) onSourceAttributes1(language, others interface{}) (interface{}, error) {
+	return types.NewSourceAttributes(language, others.([]interface{}))
 }
 
-func (p *parser) callonSourceAttributes8() (interface{}, error) {
+func (p *parser) callonSourceAttributes1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSourceAttributes8()
+	return p.cur.onSourceAttributes1(stack["language"], stack["others"])
 }
 
-func (c *current) onSourceAttributes1(language interface{}) (interface{}, error) {
-	return types.NewSourceAttributes(language)
+func (c *current) onSourceLanguage1() (interface{}, error) {
+	return string(c.text), nil
 }
 
-func (p *parser) callonSourceAttributes1() (interface{}, error) {
+func (p *parser) callonSourceLanguage1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSourceAttributes1(stack["language"])
+	return p.cur.onSourceLanguage1()
 }
 
 func (c *current) onAttributeGroup1(attributes interface{}) (interface{}, error) {
@@ -12572,16 +16342,16 @@ func (p *parser) callonGenericAttribute2() (interface{}, error) {
 	return p.cur.onGenericAttribute2(stack["key"], stack["value"])
 }
 
-func (c *current) onGenericAttribute14(key interface{}) (interface{}, error) {
+func (c *current) onGenericAttribute16(key interface{}) (interface{}, error) {
 	// value is not set
 	return types.NewGenericAttribute(key.(string), nil)
 
 }
 
-func (p *parser) callonGenericAttribute14() (interface{}, error) {
+func (p *parser) callonGenericAttribute16() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onGenericAttribute14(stack["key"])
+	return p.cur.onGenericAttribute16(stack["key"])
 }
 
 func (c *current) onAttributeKey1(key interface{}) (interface{}, error) {
@@ -12605,6 +16375,26 @@ func (p *parser) callonAttributeValue1() (interface{}, error) {
 	return p.cur.onAttributeValue1(stack["value"])
 }
 
+func (c *current) onQuotedAttributeValue7() (interface{}, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonQuotedAttributeValue7() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onQuotedAttributeValue7()
+}
+
+func (c *current) onQuotedAttributeValue1(value interface{}) (interface{}, error) {
+	return value, nil
+}
+
+func (p *parser) callonQuotedAttributeValue1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onQuotedAttributeValue1(stack["value"])
+}
+
 func (c *current) onHorizontalLayout1() (interface{}, error) {
 	return types.ElementAttributes{"layout": "horizontal"}, nil
 }
@@ -12657,7 +16447,7 @@ func (p *parser) callonInlineAttributes1() (interface{}, error) {
 
 func (c *current) onSection7() (interface{}, error) {
 
-	// `=` is level 0, etc.
+	// `=` (or the Markdown-style `#`) is level 0, etc.
 	return (len(c.text) - 1), nil
 
 }
@@ -12668,17 +16458,17 @@ func (p *parser) callonSection7() (interface{}, error) {
 	return p.cur.onSection7()
 }
 
-func (c *current) onSection10(attributes, level interface{}) (bool, error) {
+func (c *current) onSection13(attributes, level interface{}) (bool, error) {
 
 	// use a predicate to make sure that only `=` (level 0) to `======` (level 5) are allowed
 	return level.(int) <= 5, nil
 
 }
 
-func (p *parser) callonSection10() (bool, error) {
+func (p *parser) callonSection13() (bool, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSection10(stack["attributes"], stack["level"])
+	return p.cur.onSection13(stack["attributes"], stack["level"])
 }
 
 func (c *current) onSection1(attributes, level, title, id interface{}) (interface{}, error) {
@@ -12691,6 +16481,52 @@ func (p *parser) callonSection1() (interface{}, error) {
 	return p.cur.onSection1(stack["attributes"], stack["level"], stack["title"], stack["id"])
 }
 
+func (c *current) onDiscreteHeading5(attributes interface{}) (bool, error) {
+	attrs := attributes.(types.ElementAttributes)
+	return attrs.Has(types.AttrDiscrete) || attrs.Has(types.AttrFloatingTitle), nil
+
+}
+
+func (p *parser) callonDiscreteHeading5() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDiscreteHeading5(stack["attributes"])
+}
+
+func (c *current) onDiscreteHeading7() (interface{}, error) {
+
+	return (len(c.text) - 1), nil
+
+}
+
+func (p *parser) callonDiscreteHeading7() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDiscreteHeading7()
+}
+
+func (c *current) onDiscreteHeading13(attributes, level interface{}) (bool, error) {
+
+	return level.(int) <= 5, nil
+
+}
+
+func (p *parser) callonDiscreteHeading13() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDiscreteHeading13(stack["attributes"], stack["level"])
+}
+
+func (c *current) onDiscreteHeading1(attributes, level, title, id interface{}) (interface{}, error) {
+	return types.NewDiscreteHeading(level.(int), title.(types.InlineElements), id.([]interface{}), attributes)
+}
+
+func (p *parser) callonDiscreteHeading1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDiscreteHeading1(stack["attributes"], stack["level"], stack["title"], stack["id"])
+}
+
 func (c *current) onTitleElements1(elements interface{}) (interface{}, error) {
 	// absorbs heading and trailing spaces
 	return types.NewInlineElements(elements.([]interface{}))
@@ -12712,6 +16548,16 @@ func (p *parser) callonTitleElement1() (interface{}, error) {
 	return p.cur.onTitleElement1(stack["element"])
 }
 
+func (c *current) onTableOfContentsMacro1() (interface{}, error) {
+	return types.NewTableOfContentsMacro()
+}
+
+func (p *parser) callonTableOfContentsMacro1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onTableOfContentsMacro1()
+}
+
 func (c *current) onUserMacroBlock1(name, value, attrs interface{}) (interface{}, error) {
 	return types.NewUserMacroBlock(name.(string), value.(string), attrs.(types.ElementAttributes), string(c.text))
 }
@@ -12934,48 +16780,37 @@ func (p *parser) callonTagRangesAttributeValue1() (interface{}, error) {
 	return p.cur.onTagRangesAttributeValue1(stack["value"])
 }
 
-func (c *current) onMultipleTagRanges4() (interface{}, error) {
-	return string(c.text), nil
-
-}
-
-func (p *parser) callonMultipleTagRanges4() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onMultipleTagRanges4()
-}
-
-func (c *current) onMultipleTagRanges12() (interface{}, error) {
-	return string(c.text), nil
+func (c *current) onMultipleTagRanges7(other interface{}) (interface{}, error) {
+	return other, nil
 
 }
 
-func (p *parser) callonMultipleTagRanges12() (interface{}, error) {
+func (p *parser) callonMultipleTagRanges7() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onMultipleTagRanges12()
+	return p.cur.onMultipleTagRanges7(stack["other"])
 }
 
-func (c *current) onMultipleTagRanges8(other interface{}) (interface{}, error) {
-	return other, nil
+func (c *current) onMultipleTagRanges1(first, others interface{}) (interface{}, error) {
+	return append([]interface{}{first}, others.([]interface{})...), nil
 
 }
 
-func (p *parser) callonMultipleTagRanges8() (interface{}, error) {
+func (p *parser) callonMultipleTagRanges1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onMultipleTagRanges8(stack["other"])
+	return p.cur.onMultipleTagRanges1(stack["first"], stack["others"])
 }
 
-func (c *current) onMultipleTagRanges1(first, others interface{}) (interface{}, error) {
-	return append([]interface{}{first}, others.([]interface{})...), nil
+func (c *current) onTagRange1() (interface{}, error) {
+	return string(c.text), nil
 
 }
 
-func (p *parser) callonMultipleTagRanges1() (interface{}, error) {
+func (p *parser) callonTagRange1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onMultipleTagRanges1(stack["first"], stack["others"])
+	return p.cur.onTagRange1()
 }
 
 func (c *current) onIncludedFileLine8() (interface{}, error) {
@@ -13445,65 +17280,151 @@ func (c *current) onAdmonitionKind4() (interface{}, error) {
 
 }
 
-func (p *parser) callonAdmonitionKind4() (interface{}, error) {
+func (p *parser) callonAdmonitionKind4() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAdmonitionKind4()
+}
+
+func (c *current) onAdmonitionKind6() (interface{}, error) {
+	return types.Important, nil
+
+}
+
+func (p *parser) callonAdmonitionKind6() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAdmonitionKind6()
+}
+
+func (c *current) onAdmonitionKind8() (interface{}, error) {
+	return types.Warning, nil
+
+}
+
+func (p *parser) callonAdmonitionKind8() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAdmonitionKind8()
+}
+
+func (c *current) onAdmonitionKind10() (interface{}, error) {
+	return types.Caution, nil
+}
+
+func (p *parser) callonAdmonitionKind10() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAdmonitionKind10()
+}
+
+func (c *current) onParagraph2(attributes, t, lines interface{}) (interface{}, error) {
+
+	return types.NewAdmonitionParagraph(lines.([]interface{}), t.(types.AdmonitionKind), attributes)
+
+}
+
+func (p *parser) callonParagraph2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onParagraph2(stack["attributes"], stack["t"], stack["lines"])
+}
+
+func (c *current) onParagraph13(attributes, lines interface{}) (interface{}, error) {
+
+	return types.NewParagraph(lines.([]interface{}), attributes)
+}
+
+func (p *parser) callonParagraph13() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onParagraph13(stack["attributes"], stack["lines"])
+}
+
+func (c *current) onParagraphWithSubstitutions6(attributes interface{}) (bool, error) {
+	return attributes.(types.ElementAttributes).Has(types.AttrSubstitutions), nil
+
+}
+
+func (p *parser) callonParagraphWithSubstitutions6() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onParagraphWithSubstitutions6(stack["attributes"])
+}
+
+func (c *current) onParagraphWithSubstitutions2(attributes, t, lines interface{}) (interface{}, error) {
+	return types.NewAdmonitionParagraph(lines.([]interface{}), t.(types.AdmonitionKind), attributes)
+
+}
+
+func (p *parser) callonParagraphWithSubstitutions2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onParagraphWithSubstitutions2(stack["attributes"], stack["t"], stack["lines"])
+}
+
+func (c *current) onParagraphWithSubstitutions17(attributes interface{}) (bool, error) {
+	return attributes.(types.ElementAttributes).Has(types.AttrSubstitutions), nil
+
+}
+
+func (p *parser) callonParagraphWithSubstitutions17() (bool, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onAdmonitionKind4()
+	return p.cur.onParagraphWithSubstitutions17(stack["attributes"])
 }
 
-func (c *current) onAdmonitionKind6() (interface{}, error) {
-	return types.Important, nil
-
+func (c *current) onParagraphWithSubstitutions13(attributes, lines interface{}) (interface{}, error) {
+	return types.NewParagraph(lines.([]interface{}), attributes)
 }
 
-func (p *parser) callonAdmonitionKind6() (interface{}, error) {
+func (p *parser) callonParagraphWithSubstitutions13() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onAdmonitionKind6()
+	return p.cur.onParagraphWithSubstitutions13(stack["attributes"], stack["lines"])
 }
 
-func (c *current) onAdmonitionKind8() (interface{}, error) {
-	return types.Warning, nil
+func (c *current) onParagraphWithSubstitutionsLine11(comment interface{}) (interface{}, error) {
+	return types.NewInlineElements(comment)
 
 }
 
-func (p *parser) callonAdmonitionKind8() (interface{}, error) {
+func (p *parser) callonParagraphWithSubstitutionsLine11() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onAdmonitionKind8()
+	return p.cur.onParagraphWithSubstitutionsLine11(stack["comment"])
 }
 
-func (c *current) onAdmonitionKind10() (interface{}, error) {
-	return types.Caution, nil
+func (c *current) onParagraphWithSubstitutionsLine17() (interface{}, error) {
+	return string(c.text), nil
+
 }
 
-func (p *parser) callonAdmonitionKind10() (interface{}, error) {
+func (p *parser) callonParagraphWithSubstitutionsLine17() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onAdmonitionKind10()
+	return p.cur.onParagraphWithSubstitutionsLine17()
 }
 
-func (c *current) onParagraph2(attributes, t, lines interface{}) (interface{}, error) {
-
-	return types.NewAdmonitionParagraph(lines.([]interface{}), t.(types.AdmonitionKind), attributes)
+func (c *current) onParagraphWithSubstitutionsLine14(content interface{}) (interface{}, error) {
+	return types.NewInlineElements(content)
 
 }
 
-func (p *parser) callonParagraph2() (interface{}, error) {
+func (p *parser) callonParagraphWithSubstitutionsLine14() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onParagraph2(stack["attributes"], stack["t"], stack["lines"])
+	return p.cur.onParagraphWithSubstitutionsLine14(stack["content"])
 }
 
-func (c *current) onParagraph13(attributes, lines interface{}) (interface{}, error) {
-
-	return types.NewParagraph(lines.([]interface{}), attributes)
+func (c *current) onParagraphWithSubstitutionsLine1(line interface{}) (interface{}, error) {
+	return line, nil
 }
 
-func (p *parser) callonParagraph13() (interface{}, error) {
+func (p *parser) callonParagraphWithSubstitutionsLine1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onParagraph13(stack["attributes"], stack["lines"])
+	return p.cur.onParagraphWithSubstitutionsLine1(stack["line"])
 }
 
 func (c *current) onSimpleParagraph6(attributes interface{}) (bool, error) {
@@ -13683,6 +17604,136 @@ func (p *parser) callonInlineElementWithoutSubtitution1() (interface{}, error) {
 	return p.cur.onInlineElementWithoutSubtitution1(stack["element"])
 }
 
+func (c *current) onInlineElementsWithSubstitutions1(elements interface{}) (interface{}, error) {
+	return types.NewInlineElements(elements.([]interface{}))
+}
+
+func (p *parser) callonInlineElementsWithSubstitutions1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onInlineElementsWithSubstitutions1(stack["elements"])
+}
+
+func (c *current) onInlineElementWithSubstitutions9() (bool, error) {
+	return isSubstitutionEnabled(c, types.PostReplacementsSubstitution), nil
+
+}
+
+func (p *parser) callonInlineElementWithSubstitutions9() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onInlineElementWithSubstitutions9()
+}
+
+func (c *current) onInlineElementWithSubstitutions7(linebreak interface{}) (interface{}, error) {
+	return linebreak, nil
+
+}
+
+func (p *parser) callonInlineElementWithSubstitutions7() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onInlineElementWithSubstitutions7(stack["linebreak"])
+}
+
+func (c *current) onInlineElementWithSubstitutions16() (bool, error) {
+	return isIndexTermsEnabled(c), nil
+
+}
+
+func (p *parser) callonInlineElementWithSubstitutions16() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onInlineElementWithSubstitutions16()
+}
+
+func (c *current) onInlineElementWithSubstitutions14(term interface{}) (interface{}, error) {
+	return term, nil
+
+}
+
+func (p *parser) callonInlineElementWithSubstitutions14() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onInlineElementWithSubstitutions14(stack["term"])
+}
+
+func (c *current) onInlineElementWithSubstitutions21() (bool, error) {
+	return isSubstitutionEnabled(c, types.MacrosSubstitution), nil
+
+}
+
+func (p *parser) callonInlineElementWithSubstitutions21() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onInlineElementWithSubstitutions21()
+}
+
+func (c *current) onInlineElementWithSubstitutions19(macro interface{}) (interface{}, error) {
+	return macro, nil
+
+}
+
+func (p *parser) callonInlineElementWithSubstitutions19() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onInlineElementWithSubstitutions19(stack["macro"])
+}
+
+func (c *current) onInlineElementWithSubstitutions33() (bool, error) {
+	return isSubstitutionEnabled(c, types.QuotesSubstitution), nil
+
+}
+
+func (p *parser) callonInlineElementWithSubstitutions33() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onInlineElementWithSubstitutions33()
+}
+
+func (c *current) onInlineElementWithSubstitutions31(quotedText interface{}) (interface{}, error) {
+	return quotedText, nil
+
+}
+
+func (p *parser) callonInlineElementWithSubstitutions31() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onInlineElementWithSubstitutions31(stack["quotedText"])
+}
+
+func (c *current) onInlineElementWithSubstitutions38() (bool, error) {
+	return isSubstitutionEnabled(c, types.AttributesSubstitution), nil
+
+}
+
+func (p *parser) callonInlineElementWithSubstitutions38() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onInlineElementWithSubstitutions38()
+}
+
+func (c *current) onInlineElementWithSubstitutions36(substitution interface{}) (interface{}, error) {
+	return substitution, nil
+
+}
+
+func (p *parser) callonInlineElementWithSubstitutions36() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onInlineElementWithSubstitutions36(stack["substitution"])
+}
+
+func (c *current) onInlineElementWithSubstitutions1(element interface{}) (interface{}, error) {
+	return element, nil
+}
+
+func (p *parser) callonInlineElementWithSubstitutions1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onInlineElementWithSubstitutions1(stack["element"])
+}
+
 func (c *current) onVerbatimBlock1(elements interface{}) (interface{}, error) {
 	return elements, nil
 }
@@ -13755,6 +17806,36 @@ func (p *parser) callonQuotedText1() (interface{}, error) {
 	return p.cur.onQuotedText1(stack["text"])
 }
 
+func (c *current) onRoleQuotedText1(role, text interface{}) (interface{}, error) {
+	return types.NewQuotedTextWithRole(text.(types.QuotedText), role)
+}
+
+func (p *parser) callonRoleQuotedText1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRoleQuotedText1(stack["role"], stack["text"])
+}
+
+func (c *current) onQuotedTextRole5() (interface{}, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonQuotedTextRole5() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onQuotedTextRole5()
+}
+
+func (c *current) onQuotedTextRole1(role interface{}) (interface{}, error) {
+	return role, nil
+}
+
+func (p *parser) callonQuotedTextRole1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onQuotedTextRole1(stack["role"])
+}
+
 func (c *current) onSubscriptOrSuperscriptPrefix3() (interface{}, error) {
 	// rule used withn `words` to detect superscript or subscript portions, eg in math formulae.
 	return string(c.text), nil
@@ -14182,10 +18263,195 @@ func (c *current) onEscapedMonospaceText18(backslashes, content interface{}) (in
 	return types.NewEscapedQuotedText(backslashes.(string), "`", content.([]interface{}))
 }
 
-func (p *parser) callonEscapedMonospaceText18() (interface{}, error) {
+func (p *parser) callonEscapedMonospaceText18() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onEscapedMonospaceText18(stack["backslashes"], stack["content"])
+}
+
+func (c *current) onDoubleQuoteMarkedText1(content interface{}) (interface{}, error) {
+	// double punctuation must be evaluated first
+	return types.NewQuotedText(types.Marked, content.([]interface{}))
+}
+
+func (p *parser) callonDoubleQuoteMarkedText1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDoubleQuoteMarkedText1(stack["content"])
+}
+
+func (c *current) onDoubleQuoteMarkedTextContent4(element interface{}) (interface{}, error) {
+	// may start and end with spaces
+	return element, nil
+}
+
+func (p *parser) callonDoubleQuoteMarkedTextContent4() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDoubleQuoteMarkedTextContent4(stack["element"])
+}
+
+func (c *current) onDoubleQuoteMarkedTextElement1(element interface{}) (interface{}, error) {
+	// word with quote punctuation is only accepted if nothing matched before, so we have a chance to stop
+	return element, nil
+}
+
+func (p *parser) callonDoubleQuoteMarkedTextElement1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDoubleQuoteMarkedTextElement1(stack["element"])
+}
+
+func (c *current) onNonDoubleQuoteMarkedText1() (interface{}, error) {
+
+	return types.NewStringElement(string(c.text))
+}
+
+func (p *parser) callonNonDoubleQuoteMarkedText1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNonDoubleQuoteMarkedText1()
+}
+
+func (c *current) onSingleQuoteMarkedText2(content interface{}) (interface{}, error) {
+	// single punctuation cannot be followed by a character (needs '##' to mark a portion of a word)
+	return types.NewQuotedText(types.Marked, content.([]interface{}))
+}
+
+func (p *parser) callonSingleQuoteMarkedText2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSingleQuoteMarkedText2(stack["content"])
+}
+
+func (c *current) onSingleQuoteMarkedText12(content interface{}) (interface{}, error) {
+	// unbalanced `##` vs `#` punctuation.
+	return types.NewQuotedText(types.Marked, append([]interface{}{types.StringElement{Content: "#"}}, content.([]interface{})...)) // include the second heading `#` as a regular StringElement in the marked content
+}
+
+func (p *parser) callonSingleQuoteMarkedText12() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSingleQuoteMarkedText12(stack["content"])
+}
+
+func (c *current) onSingleQuoteMarkedTextContent6(spaces, element interface{}) (interface{}, error) {
+	return append(spaces.([]interface{}), element), nil
+}
+
+func (p *parser) callonSingleQuoteMarkedTextContent6() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSingleQuoteMarkedTextContent6(stack["spaces"], stack["element"])
+}
+
+func (c *current) onSingleQuoteMarkedTextElement1(element interface{}) (interface{}, error) {
+	// word with quote punctuation is only accepted if nothing matched before, so we have a chance to stop
+	return element, nil
+}
+
+func (p *parser) callonSingleQuoteMarkedTextElement1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSingleQuoteMarkedTextElement1(stack["element"])
+}
+
+func (c *current) onNonSingleQuoteMarkedText1() (interface{}, error) {
+
+	return types.NewStringElement(string(c.text))
+}
+
+func (p *parser) callonNonSingleQuoteMarkedText1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNonSingleQuoteMarkedText1()
+}
+
+func (c *current) onEscapedMarkedText2(backslashes, content interface{}) (interface{}, error) {
+	// double punctuation must be evaluated first
+	return types.NewEscapedQuotedText(backslashes.(string), "##", content.([]interface{}))
+
+}
+
+func (p *parser) callonEscapedMarkedText2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onEscapedMarkedText2(stack["backslashes"], stack["content"])
+}
+
+func (c *current) onEscapedMarkedText10(backslashes, content interface{}) (interface{}, error) {
+	// unbalanced `##` vs `#` punctuation
+	result := append([]interface{}{"#"}, content.([]interface{}))
+	return types.NewEscapedQuotedText(backslashes.(string), "#", result)
+
+}
+
+func (p *parser) callonEscapedMarkedText10() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onEscapedMarkedText10(stack["backslashes"], stack["content"])
+}
+
+func (c *current) onEscapedMarkedText18(backslashes, content interface{}) (interface{}, error) {
+	// simple punctuation must be evaluated last
+	return types.NewEscapedQuotedText(backslashes.(string), "#", content.([]interface{}))
+}
+
+func (p *parser) callonEscapedMarkedText18() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onEscapedMarkedText18(stack["backslashes"], stack["content"])
+}
+
+func (c *current) onDoubleCurvedQuoteText1(content interface{}) (interface{}, error) {
+	return types.NewQuotedText(types.DoubleCurvedQuote, content.([]interface{}))
+}
+
+func (p *parser) callonDoubleCurvedQuoteText1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDoubleCurvedQuoteText1(stack["content"])
+}
+
+func (c *current) onSingleCurvedQuoteText1(content interface{}) (interface{}, error) {
+	return types.NewQuotedText(types.SingleCurvedQuote, content.([]interface{}))
+}
+
+func (p *parser) callonSingleCurvedQuoteText1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSingleCurvedQuoteText1(stack["content"])
+}
+
+func (c *current) onCurvedQuoteTextContent5(element interface{}) (interface{}, error) {
+	return element, nil
+}
+
+func (p *parser) callonCurvedQuoteTextContent5() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onCurvedQuoteTextContent5(stack["element"])
+}
+
+func (c *current) onCurvedQuoteTextElement1(element interface{}) (interface{}, error) {
+	return element, nil
+}
+
+func (p *parser) callonCurvedQuoteTextElement1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onCurvedQuoteTextElement1(stack["element"])
+}
+
+func (c *current) onNonCurvedQuoteText1() (interface{}, error) {
+
+	return types.NewStringElement(string(c.text))
+}
+
+func (p *parser) callonNonCurvedQuoteText1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onEscapedMonospaceText18(stack["backslashes"], stack["content"])
+	return p.cur.onNonCurvedQuoteText1()
 }
 
 func (c *current) onSubscriptText1(content interface{}) (interface{}, error) {
@@ -14338,6 +18604,36 @@ func (p *parser) callonPassthroughMacro9() (interface{}, error) {
 	return p.cur.onPassthroughMacro9(stack["content"])
 }
 
+func (c *current) onPassthroughMacro25() (interface{}, error) {
+	return types.NewStringElement(string(c.text))
+}
+
+func (p *parser) callonPassthroughMacro25() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPassthroughMacro25()
+}
+
+func (c *current) onPassthroughMacro18(subs, content interface{}) (interface{}, error) {
+	return types.NewPassthroughMacroWithSubstitutions(subs.(string), []interface{}{content})
+}
+
+func (p *parser) callonPassthroughMacro18() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPassthroughMacro18(stack["subs"], stack["content"])
+}
+
+func (c *current) onPassthroughMacroSubstitutions1() (interface{}, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonPassthroughMacroSubstitutions1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPassthroughMacroSubstitutions1()
+}
+
 func (c *current) onPassthroughMacroCharacter4() (interface{}, error) {
 	return types.NewStringElement(string(c.text))
 }
@@ -14517,7 +18813,7 @@ func (p *parser) callonLinkTextAttribute29() (interface{}, error) {
 }
 
 func (c *current) onImageBlock1(attributes, path, inlineAttributes interface{}) (interface{}, error) {
-	return types.NewImageBlock(path.(string), inlineAttributes.(types.ElementAttributes), attributes)
+	return types.NewImageBlock(path.(string), inlineAttributes.(types.ElementAttributes), attributes, positionOf(c))
 }
 
 func (p *parser) callonImageBlock1() (interface{}, error) {
@@ -14527,7 +18823,7 @@ func (p *parser) callonImageBlock1() (interface{}, error) {
 }
 
 func (c *current) onInlineImage1(path, inlineAttributes interface{}) (interface{}, error) {
-	return types.NewInlineImage(path.(string), inlineAttributes.(types.ElementAttributes))
+	return types.NewInlineImage(path.(string), inlineAttributes.(types.ElementAttributes), positionOf(c))
 }
 
 func (p *parser) callonInlineImage1() (interface{}, error) {
@@ -14546,6 +18842,46 @@ func (p *parser) callonImageAttributes1() (interface{}, error) {
 	return p.cur.onImageAttributes1(stack["alt"], stack["width"], stack["height"], stack["otherattrs"])
 }
 
+func (c *current) onVideoBlock1(attributes, path, inlineAttributes interface{}) (interface{}, error) {
+	return types.NewVideoBlock(path.(string), inlineAttributes.(types.ElementAttributes), attributes)
+}
+
+func (p *parser) callonVideoBlock1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onVideoBlock1(stack["attributes"], stack["path"], stack["inlineAttributes"])
+}
+
+func (c *current) onVideoAttributes1(poster, width, height, otherattrs interface{}) (interface{}, error) {
+	return types.NewVideoAttributes(poster, width, height, otherattrs.([]interface{}))
+}
+
+func (p *parser) callonVideoAttributes1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onVideoAttributes1(stack["poster"], stack["width"], stack["height"], stack["otherattrs"])
+}
+
+func (c *current) onAudioBlock1(attributes, path, inlineAttributes interface{}) (interface{}, error) {
+	return types.NewAudioBlock(path.(string), inlineAttributes.(types.ElementAttributes), attributes)
+}
+
+func (p *parser) callonAudioBlock1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAudioBlock1(stack["attributes"], stack["path"], stack["inlineAttributes"])
+}
+
+func (c *current) onAudioAttributes1(attrs interface{}) (interface{}, error) {
+	return types.NewAudioAttributes(attrs.([]interface{}))
+}
+
+func (p *parser) callonAudioAttributes1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAudioAttributes1(stack["attrs"])
+}
+
 func (c *current) onInlineFootnote2(content interface{}) (interface{}, error) {
 	return types.NewFootnote("", content.(types.InlineElements))
 }
@@ -14598,6 +18934,107 @@ func (p *parser) callonFootnoteContent1() (interface{}, error) {
 	return p.cur.onFootnoteContent1(stack["elements"])
 }
 
+func (c *current) onConcealedIndexTerm8(terms interface{}) (bool, error) {
+	return types.HasIndexTerms(terms.(string)), nil
+}
+
+func (p *parser) callonConcealedIndexTerm8() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onConcealedIndexTerm8(stack["terms"])
+}
+
+func (c *current) onConcealedIndexTerm2(terms interface{}) (interface{}, error) {
+	return types.NewConcealedIndexTerm(terms.(string))
+}
+
+func (p *parser) callonConcealedIndexTerm2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onConcealedIndexTerm2(stack["terms"])
+}
+
+func (c *current) onConcealedIndexTerm15(terms interface{}) (bool, error) {
+	return types.HasIndexTerms(terms.(string)), nil
+}
+
+func (p *parser) callonConcealedIndexTerm15() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onConcealedIndexTerm15(stack["terms"])
+}
+
+func (c *current) onConcealedIndexTerm9(terms interface{}) (interface{}, error) {
+	return types.NewConcealedIndexTerm(terms.(string))
+}
+
+func (p *parser) callonConcealedIndexTerm9() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onConcealedIndexTerm9(stack["terms"])
+}
+
+func (c *current) onBlankConcealedIndexTerm1() (interface{}, error) {
+	return types.NewStringElement(string(c.text))
+}
+
+func (p *parser) callonBlankConcealedIndexTerm1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onBlankConcealedIndexTerm1()
+}
+
+func (c *current) onVisibleIndexTerm10(term interface{}) (bool, error) {
+	return strings.TrimSpace(term.(string)) != "", nil
+}
+
+func (p *parser) callonVisibleIndexTerm10() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onVisibleIndexTerm10(stack["term"])
+}
+
+func (c *current) onVisibleIndexTerm2(term interface{}) (interface{}, error) {
+	return types.NewIndexTerm(term.(string))
+}
+
+func (p *parser) callonVisibleIndexTerm2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onVisibleIndexTerm2(stack["term"])
+}
+
+func (c *current) onVisibleIndexTerm17(term interface{}) (bool, error) {
+	return strings.TrimSpace(term.(string)) != "", nil
+}
+
+func (p *parser) callonVisibleIndexTerm17() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onVisibleIndexTerm17(stack["term"])
+}
+
+func (c *current) onVisibleIndexTerm11(term interface{}) (interface{}, error) {
+	return types.NewIndexTerm(term.(string))
+}
+
+func (p *parser) callonVisibleIndexTerm11() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onVisibleIndexTerm11(stack["term"])
+}
+
+func (c *current) onIndexTermContent1() (interface{}, error) {
+	// index terms may not span multiple lines
+	return string(c.text), nil
+}
+
+func (p *parser) callonIndexTermContent1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onIndexTermContent1()
+}
+
 func (c *current) onDelimitedBlock1(block interface{}) (interface{}, error) {
 	return block, nil
 }
@@ -14659,14 +19096,14 @@ func (p *parser) callonListingBlockParagraph1() (interface{}, error) {
 	return p.cur.onListingBlockParagraph1(stack["lines"])
 }
 
-func (c *current) onListingBlockParagraphLine6() (interface{}, error) {
+func (c *current) onListingBlockParagraphLine8() (interface{}, error) {
 	return string(c.text), nil
 }
 
-func (p *parser) callonListingBlockParagraphLine6() (interface{}, error) {
+func (p *parser) callonListingBlockParagraphLine8() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onListingBlockParagraphLine6()
+	return p.cur.onListingBlockParagraphLine8()
 }
 
 func (c *current) onListingBlockParagraphLine1(line interface{}) (interface{}, error) {
@@ -14742,6 +19179,56 @@ func (p *parser) callonQuoteBlockParagraph1() (interface{}, error) {
 	return p.cur.onQuoteBlockParagraph1(stack["lines"])
 }
 
+func (c *current) onMarkdownQuoteBlock1(attributes, content, attribution interface{}) (interface{}, error) {
+	return types.NewMarkdownQuoteBlock(content.([]interface{}), attribution, attributes)
+}
+
+func (p *parser) callonMarkdownQuoteBlock1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onMarkdownQuoteBlock1(stack["attributes"], stack["content"], stack["attribution"])
+}
+
+func (c *current) onMarkdownQuoteBlankLine1() (interface{}, error) {
+	return types.NewBlankLine()
+}
+
+func (p *parser) callonMarkdownQuoteBlankLine1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onMarkdownQuoteBlankLine1()
+}
+
+func (c *current) onMarkdownQuoteBlockParagraph1(lines interface{}) (interface{}, error) {
+	return types.NewParagraph(lines.([]interface{}), types.ElementAttributes{})
+}
+
+func (p *parser) callonMarkdownQuoteBlockParagraph1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onMarkdownQuoteBlockParagraph1(stack["lines"])
+}
+
+func (c *current) onMarkdownQuoteBlockParagraphLine1(line interface{}) (interface{}, error) {
+	return line, nil
+}
+
+func (p *parser) callonMarkdownQuoteBlockParagraphLine1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onMarkdownQuoteBlockParagraphLine1(stack["line"])
+}
+
+func (c *current) onMarkdownQuoteBlockAttribution1(author, title interface{}) (interface{}, error) {
+	return types.NewQuoteAttributes("quote", author, title)
+}
+
+func (p *parser) callonMarkdownQuoteBlockAttribution1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onMarkdownQuoteBlockAttribution1(stack["author"], stack["title"])
+}
+
 func (c *current) onVerseBlock5(attributes interface{}) (bool, error) {
 
 	kind := attributes.(types.ElementAttributes).GetAsString("kind") // once we got the attributes, check that `[verse]`  was set
@@ -14856,6 +19343,173 @@ func (p *parser) callonSidebarBlockParagraphLine1() (interface{}, error) {
 	return p.cur.onSidebarBlockParagraphLine1(stack["line"])
 }
 
+func (c *current) onOpenBlock7(attributes interface{}) (bool, error) {
+	if attrs, ok := attributes.(types.ElementAttributes); ok {
+		return attrs[types.AttrKind] == types.Literal, nil
+	}
+	return false, nil
+
+}
+
+func (p *parser) callonOpenBlock7() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOpenBlock7(stack["attributes"])
+}
+
+func (c *current) onOpenBlock2(attributes, lines interface{}) (interface{}, error) {
+	return types.NewLiteralBlock(types.LiteralBlockWithDelimiter, lines.([]interface{}), attributes)
+
+}
+
+func (p *parser) callonOpenBlock2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOpenBlock2(stack["attributes"], stack["lines"])
+}
+
+func (c *current) onOpenBlock20(attributes interface{}) (bool, error) {
+	if attrs, ok := attributes.(types.ElementAttributes); ok {
+		switch attrs[types.AttrKind] {
+		case types.Listing, types.Source, types.Comment:
+			return true, nil
+		}
+	}
+	return false, nil
+
+}
+
+func (p *parser) callonOpenBlock20() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOpenBlock20(stack["attributes"])
+}
+
+func (c *current) onOpenBlock15(attributes, content interface{}) (interface{}, error) {
+	return types.NewDelimitedBlock(types.Open, content.([]interface{}), types.None, attributes)
+
+}
+
+func (p *parser) callonOpenBlock15() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOpenBlock15(stack["attributes"], stack["content"])
+}
+
+func (c *current) onOpenBlock28(attributes, content interface{}) (interface{}, error) {
+	return types.NewDelimitedBlock(types.Open, content.([]interface{}), types.None, attributes)
+
+}
+
+func (p *parser) callonOpenBlock28() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOpenBlock28(stack["attributes"], stack["content"])
+}
+
+func (c *current) onOpenBlockElement1(element interface{}) (interface{}, error) {
+	return element, nil
+
+}
+
+func (p *parser) callonOpenBlockElement1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOpenBlockElement1(stack["element"])
+}
+
+func (c *current) onNonOpenBlock1(content interface{}) (interface{}, error) {
+	return content, nil
+}
+
+func (p *parser) callonNonOpenBlock1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNonOpenBlock1(stack["content"])
+}
+
+func (c *current) onOpenBlockParagraph1(attributes, lines interface{}) (interface{}, error) {
+	return types.NewParagraph(lines.([]interface{}), attributes)
+}
+
+func (p *parser) callonOpenBlockParagraph1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOpenBlockParagraph1(stack["attributes"], stack["lines"])
+}
+
+func (c *current) onOpenBlockParagraphLine1(line interface{}) (interface{}, error) {
+	return line, nil
+}
+
+func (p *parser) callonOpenBlockParagraphLine1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOpenBlockParagraphLine1(stack["line"])
+}
+
+func (c *current) onOpenBlockVerbatimParagraph1(lines interface{}) (interface{}, error) {
+
+	return types.NewParagraph(lines.([]interface{}), nil) // no attributes supported
+}
+
+func (p *parser) callonOpenBlockVerbatimParagraph1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOpenBlockVerbatimParagraph1(stack["lines"])
+}
+
+func (c *current) onOpenBlockVerbatimParagraphLine1(line interface{}) (interface{}, error) {
+	return types.NewInlineElements(line)
+}
+
+func (p *parser) callonOpenBlockVerbatimParagraphLine1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOpenBlockVerbatimParagraphLine1(stack["line"])
+}
+
+func (c *current) onOpenBlockVerbatimLine8() (interface{}, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonOpenBlockVerbatimLine8() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOpenBlockVerbatimLine8()
+}
+
+func (c *current) onOpenBlockVerbatimLine1(line interface{}) (interface{}, error) {
+
+	return line, nil // do not include the trailing 'EOL'
+}
+
+func (p *parser) callonOpenBlockVerbatimLine1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOpenBlockVerbatimLine1(stack["line"])
+}
+
+func (c *current) onThematicBreak1() (interface{}, error) {
+	return types.NewThematicBreak()
+}
+
+func (p *parser) callonThematicBreak1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onThematicBreak1()
+}
+
+func (c *current) onPageBreak1() (interface{}, error) {
+	return types.NewPageBreak()
+}
+
+func (p *parser) callonPageBreak1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPageBreak1()
+}
+
 func (c *current) onTable1(attributes, header, lines interface{}) (interface{}, error) {
 	// end delimiter or end of file
 	return types.NewTable(header, lines.([]interface{}), attributes)
@@ -470,6 +470,61 @@ var _ = Describe("passthroughs - preflight", func() {
 				Expect(source).To(EqualDocumentBlock(expected))
 			})
 		})
+
+		Context("passthrough macro with substitutions", func() {
+
+			It("passthrough macro with shorthands", func() {
+				source := `pass:q,a[*<b>{foo}</b>*]`
+				expected := types.Paragraph{
+					Attributes: types.ElementAttributes{},
+					Lines: []types.InlineElements{
+						{
+							types.Passthrough{
+								Kind: types.PassthroughMacro,
+								Attributes: types.ElementAttributes{
+									types.AttrSubstitutions: "q,a",
+								},
+								Elements: types.InlineElements{
+									types.StringElement{
+										Content: "*<b>{foo}</b>*",
+									},
+								},
+							},
+						},
+					},
+				}
+				Expect(source).To(EqualDocumentBlock(expected))
+			})
+
+			It("passthrough macro with substitution names", func() {
+				source := `a pass:specialchars,macros[<b>] b`
+				expected := types.Paragraph{
+					Attributes: types.ElementAttributes{},
+					Lines: []types.InlineElements{
+						{
+							types.StringElement{
+								Content: "a ",
+							},
+							types.Passthrough{
+								Kind: types.PassthroughMacro,
+								Attributes: types.ElementAttributes{
+									types.AttrSubstitutions: "specialchars,macros",
+								},
+								Elements: types.InlineElements{
+									types.StringElement{
+										Content: "<b>",
+									},
+								},
+							},
+							types.StringElement{
+								Content: " b",
+							},
+						},
+					},
+				}
+				Expect(source).To(EqualDocumentBlock(expected))
+			})
+		})
 	})
 
 })
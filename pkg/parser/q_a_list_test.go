@@ -25,8 +25,9 @@ What is the answer to the Ultimate Question?:: 42`
 			Elements: []interface{}{
 				types.LabeledList{
 					Attributes: types.ElementAttributes{
-						types.AttrTitle: "Q&A",
-						types.AttrQandA: nil,
+						types.AttrTitle:         "Q&A",
+						types.AttrTitlePosition: types.Position{Line: 1, Column: 1},
+						types.AttrQandA:         nil,
 					},
 					Items: []types.LabeledListItem{
 						{
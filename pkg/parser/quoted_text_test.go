@@ -2199,4 +2199,215 @@ var _ = Describe("quoted texts - preflight", func() {
 			})
 		})
 	})
+
+	Context("marked text", func() {
+
+		It("marked text with single punctuation", func() {
+			source := "some #marked content#"
+			expected := types.Paragraph{
+				Attributes: types.ElementAttributes{},
+				Lines: []types.InlineElements{
+					{
+						types.StringElement{Content: "some "},
+						types.QuotedText{
+							Kind: types.Marked,
+							Elements: types.InlineElements{
+								types.StringElement{Content: "marked content"},
+							},
+						},
+					},
+				},
+			}
+			Expect(source).To(EqualDocumentBlock(expected))
+		})
+
+		It("marked text with double punctuation within a word", func() {
+			source := "some ##mark##ed content"
+			expected := types.Paragraph{
+				Attributes: types.ElementAttributes{},
+				Lines: []types.InlineElements{
+					{
+						types.StringElement{Content: "some "},
+						types.QuotedText{
+							Kind: types.Marked,
+							Elements: types.InlineElements{
+								types.StringElement{Content: "mark"},
+							},
+						},
+						types.StringElement{Content: "ed content"},
+					},
+				},
+			}
+			Expect(source).To(EqualDocumentBlock(expected))
+		})
+
+		It("bold text within marked text", func() {
+			source := "#some *bold* content#"
+			expected := types.Paragraph{
+				Attributes: types.ElementAttributes{},
+				Lines: []types.InlineElements{
+					{
+						types.QuotedText{
+							Kind: types.Marked,
+							Elements: types.InlineElements{
+								types.StringElement{Content: "some "},
+								types.QuotedText{
+									Kind: types.Bold,
+									Elements: types.InlineElements{
+										types.StringElement{Content: "bold"},
+									},
+								},
+								types.StringElement{Content: " content"},
+							},
+						},
+					},
+				},
+			}
+			Expect(source).To(EqualDocumentBlock(expected))
+		})
+
+		It("marked text with role", func() {
+			source := "some [.line-through]#deleted content#"
+			expected := types.Paragraph{
+				Attributes: types.ElementAttributes{},
+				Lines: []types.InlineElements{
+					{
+						types.StringElement{Content: "some "},
+						types.QuotedText{
+							Kind: types.Marked,
+							Attributes: types.ElementAttributes{
+								types.AttrRole: "line-through",
+							},
+							Elements: types.InlineElements{
+								types.StringElement{Content: "deleted content"},
+							},
+						},
+					},
+				},
+			}
+			Expect(source).To(EqualDocumentBlock(expected))
+		})
+
+		It("bold text with multiple roles", func() {
+			source := "[.big.red]*content*"
+			expected := types.Paragraph{
+				Attributes: types.ElementAttributes{},
+				Lines: []types.InlineElements{
+					{
+						types.QuotedText{
+							Kind: types.Bold,
+							Attributes: types.ElementAttributes{
+								types.AttrRole: "big red",
+							},
+							Elements: types.InlineElements{
+								types.StringElement{Content: "content"},
+							},
+						},
+					},
+				},
+			}
+			Expect(source).To(EqualDocumentBlock(expected))
+		})
+
+		It("escaped marked text", func() {
+			source := `\#not marked#`
+			expected := types.Paragraph{
+				Attributes: types.ElementAttributes{},
+				Lines: []types.InlineElements{
+					{
+						types.StringElement{Content: "#not marked#"},
+					},
+				},
+			}
+			Expect(source).To(EqualDocumentBlock(expected))
+		})
+
+		It("hash sign in words", func() {
+			source := "C# and issue #42"
+			expected := types.Paragraph{
+				Attributes: types.ElementAttributes{},
+				Lines: []types.InlineElements{
+					{
+						types.StringElement{Content: "C# and issue #42"},
+					},
+				},
+			}
+			Expect(source).To(EqualDocumentBlock(expected))
+		})
+	})
+
+	Context("curved quotes", func() {
+
+		It("double curved quotes", func() {
+			source := "a \"`double *curved* quote`\" here"
+			expected := types.Paragraph{
+				Attributes: types.ElementAttributes{},
+				Lines: []types.InlineElements{
+					{
+						types.StringElement{Content: "a "},
+						types.QuotedText{
+							Kind: types.DoubleCurvedQuote,
+							Elements: types.InlineElements{
+								types.StringElement{Content: "double "},
+								types.QuotedText{
+									Kind: types.Bold,
+									Elements: types.InlineElements{
+										types.StringElement{Content: "curved"},
+									},
+								},
+								types.StringElement{Content: " quote"},
+							},
+						},
+						types.StringElement{Content: " here"},
+					},
+				},
+			}
+			Expect(source).To(EqualDocumentBlock(expected))
+		})
+
+		It("single curved quotes", func() {
+			source := "a '`single`' quote"
+			expected := types.Paragraph{
+				Attributes: types.ElementAttributes{},
+				Lines: []types.InlineElements{
+					{
+						types.StringElement{Content: "a "},
+						types.QuotedText{
+							Kind: types.SingleCurvedQuote,
+							Elements: types.InlineElements{
+								types.StringElement{Content: "single"},
+							},
+						},
+						types.StringElement{Content: " quote"},
+					},
+				},
+			}
+			Expect(source).To(EqualDocumentBlock(expected))
+		})
+
+		It("monospace text within double curved quotes", func() {
+			source := "\"`a `code` sample`\""
+			expected := types.Paragraph{
+				Attributes: types.ElementAttributes{},
+				Lines: []types.InlineElements{
+					{
+						types.QuotedText{
+							Kind: types.DoubleCurvedQuote,
+							Elements: types.InlineElements{
+								types.StringElement{Content: "a "},
+								types.QuotedText{
+									Kind: types.Monospace,
+									Elements: types.InlineElements{
+										types.StringElement{Content: "code"},
+									},
+								},
+								types.StringElement{Content: " sample"},
+							},
+						},
+					},
+				},
+			}
+			Expect(source).To(EqualDocumentBlock(expected))
+		})
+	})
 })
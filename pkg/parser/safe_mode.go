@@ -0,0 +1,91 @@
+package parser
+
+import (
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+)
+
+// safeModeKey the key for the safe mode in the parser's global store
+const safeModeKey string = "safeMode"
+
+// baseDirKey the key for the base directory in the parser's global store
+const baseDirKey string = "baseDir"
+
+// SafeMode option to set the safe mode, which restricts the file inclusions (default is `types.SafeModeUnsafe`):
+// - in `safe` mode, the files to include must be in the base directory,
+// - in `server` mode, the remote files cannot be included, even if the `allow-uri-read` attribute is set,
+// - in `secure` mode, all file inclusions are replaced with links.
+func SafeMode(mode types.SafeMode) Option {
+	return GlobalStore(safeModeKey, mode)
+}
+
+// BaseDir option to set the base directory to which the file inclusions are restricted in `safe` mode and above
+// (default is the directory of the document, or the current working directory if the document has no filename).
+// When the files are read from a filesystem (see `FileSystem`), the base directory is a path in this filesystem.
+func BaseDir(dir string) Option {
+	return GlobalStore(baseDirKey, dir)
+}
+
+// safeMode returns the safe mode in the given options, or `types.SafeModeUnsafe` if none was set
+func safeMode(opts ...Option) types.SafeMode {
+	p := newParser("", nil, opts...)
+	if mode, ok := p.cur.globalStore[safeModeKey].(types.SafeMode); ok {
+		return mode
+	}
+	return types.SafeModeUnsafe
+}
+
+// baseDir returns the base directory in the given options, or the directory of the given
+// document (or the current working directory if the filename is empty) if none was set
+func baseDir(filename string, opts ...Option) (string, error) {
+	p := newParser("", nil, opts...)
+	if dir, ok := p.cur.globalStore[baseDirKey].(string); ok && dir != "" {
+		return filepath.Abs(dir)
+	}
+	if filename == "" {
+		return os.Getwd()
+	}
+	absPath, err := filepath.Abs(filename)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Dir(absPath), nil
+}
+
+// checkWithinBaseDir returns an error if the file at the given path is not in the given base directory
+func checkWithinBaseDir(path, dir string) error {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return err
+	}
+	rel, err := filepath.Rel(dir, absPath)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return errors.Errorf("'%s' is outside of the base directory '%s'", path, dir)
+	}
+	return nil
+}
+
+// fsBaseDir returns the base directory in the filesystem, as set in the given options,
+// or the directory of the given document in the filesystem if none was set
+func fsBaseDir(filename string, opts ...Option) string {
+	p := newParser("", nil, opts...)
+	if dir, ok := p.cur.globalStore[baseDirKey].(string); ok && dir != "" {
+		return path.Clean(strings.TrimPrefix(dir, "/"))
+	}
+	return path.Dir(strings.TrimPrefix(filename, "/"))
+}
+
+// checkWithinFSBaseDir returns an error if the file at the given (cleaned) path in the filesystem
+// is not in the given base directory of the filesystem
+func checkWithinFSBaseDir(p, dir string) error {
+	if dir == "." || p == dir || strings.HasPrefix(p, dir+"/") {
+		return nil
+	}
+	return errors.Errorf("'%s' is outside of the base directory '%s'", p, dir)
+}
@@ -545,6 +545,110 @@ a paragraph`
 		})
 	})
 
+	Context("Markdown-style sections", func() {
+
+		It("header and sections", func() {
+			source := `# a header
+
+## section 1
+
+### section 2`
+			expected := types.PreflightDocument{
+				Blocks: []interface{}{
+					types.Section{
+						Attributes: types.ElementAttributes{
+							types.AttrID:       "a_header",
+							types.AttrCustomID: false,
+						},
+						Level: 0,
+						Title: types.InlineElements{
+							types.StringElement{Content: "a header"},
+						},
+						Elements: []interface{}{},
+					},
+					types.BlankLine{},
+					types.Section{
+						Attributes: types.ElementAttributes{
+							types.AttrID:       "section_1",
+							types.AttrCustomID: false,
+						},
+						Level: 1,
+						Title: types.InlineElements{
+							types.StringElement{Content: "section 1"},
+						},
+						Elements: []interface{}{},
+					},
+					types.BlankLine{},
+					types.Section{
+						Attributes: types.ElementAttributes{
+							types.AttrID:       "section_2",
+							types.AttrCustomID: false,
+						},
+						Level: 2,
+						Title: types.InlineElements{
+							types.StringElement{Content: "section 2"},
+						},
+						Elements: []interface{}{},
+					},
+				},
+			}
+			Expect(source).To(BecomePreflightDocument(expected))
+		})
+
+		It("section with custom ID", func() {
+			source := `[[custom]]
+## a section`
+			expected := types.PreflightDocument{
+				Blocks: []interface{}{
+					types.Section{
+						Attributes: types.ElementAttributes{
+							types.AttrID:       "custom",
+							types.AttrCustomID: true,
+						},
+						Level: 1,
+						Title: types.InlineElements{
+							types.StringElement{Content: "a section"},
+						},
+						Elements: []interface{}{},
+					},
+				},
+			}
+			Expect(source).To(BecomePreflightDocument(expected))
+		})
+
+		It("header invalid - missing space", func() {
+			source := "#a header"
+			expected := types.PreflightDocument{
+				Blocks: []interface{}{
+					types.Paragraph{
+						Attributes: types.ElementAttributes{},
+						Lines: []types.InlineElements{
+							{
+								types.StringElement{Content: "#a header"},
+							},
+						},
+					},
+				}}
+			Expect(source).To(BecomePreflightDocument(expected))
+		})
+
+		It("section invalid - mixed markers", func() {
+			source := "=# a section"
+			expected := types.PreflightDocument{
+				Blocks: []interface{}{
+					types.Paragraph{
+						Attributes: types.ElementAttributes{},
+						Lines: []types.InlineElements{
+							{
+								types.StringElement{Content: "=# a section"},
+							},
+						},
+					},
+				}}
+			Expect(source).To(BecomePreflightDocument(expected))
+		})
+	})
+
 	Context("invalid sections", func() {
 		It("header invalid - missing space", func() {
 			source := "=a header"
@@ -1291,6 +1395,114 @@ a paragraph`
 		})
 	})
 
+	Context("discrete headings", func() {
+
+		It("discrete heading does not open a new section", func() {
+			source := `== section 1
+
+[discrete]
+== a discrete heading
+
+content
+
+== section 2`
+			section1Title := types.InlineElements{
+				types.StringElement{Content: "section 1"},
+			}
+			headingTitle := types.InlineElements{
+				types.StringElement{Content: "a discrete heading"},
+			}
+			section2Title := types.InlineElements{
+				types.StringElement{Content: "section 2"},
+			}
+			expected := types.Document{
+				Attributes: types.DocumentAttributes{},
+				ElementReferences: types.ElementReferences{
+					"section_1":          section1Title,
+					"a_discrete_heading": headingTitle,
+					"section_2":          section2Title,
+				},
+				Footnotes:          types.Footnotes{},
+				FootnoteReferences: types.FootnoteReferences{},
+				Elements: []interface{}{
+					types.Section{
+						Attributes: types.ElementAttributes{
+							types.AttrID:       "section_1",
+							types.AttrCustomID: false,
+						},
+						Level: 1,
+						Title: section1Title,
+						Elements: []interface{}{
+							types.DiscreteHeading{
+								Attributes: types.ElementAttributes{
+									types.AttrID:       "a_discrete_heading",
+									types.AttrCustomID: false,
+									types.AttrDiscrete: nil,
+								},
+								Level: 1,
+								Title: headingTitle,
+							},
+							types.Paragraph{
+								Attributes: types.ElementAttributes{},
+								Lines: []types.InlineElements{
+									{
+										types.StringElement{Content: "content"},
+									},
+								},
+							},
+						},
+					},
+					types.Section{
+						Attributes: types.ElementAttributes{
+							types.AttrID:       "section_2",
+							types.AttrCustomID: false,
+						},
+						Level:    1,
+						Title:    section2Title,
+						Elements: []interface{}{},
+					},
+				},
+			}
+			Expect(source).To(EqualDocument(expected))
+		})
+
+		It("floating title within a sidebar", func() {
+			source := `****
+[float]
+=== a floating title
+
+content
+****`
+			expected := types.DelimitedBlock{
+				Kind:       types.Sidebar,
+				Attributes: types.ElementAttributes{},
+				Elements: []interface{}{
+					types.DiscreteHeading{
+						Attributes: types.ElementAttributes{
+							types.AttrID:            "a_floating_title",
+							types.AttrCustomID:      false,
+							types.AttrFloatingTitle: nil,
+						},
+						Level: 2,
+						Title: types.InlineElements{
+							types.StringElement{Content: "a floating title"},
+						},
+					},
+					types.BlankLine{},
+					types.Paragraph{
+						Attributes: types.ElementAttributes{},
+						Lines: []types.InlineElements{
+							{
+								types.StringElement{Content: "content"},
+							},
+						},
+					},
+				},
+			}
+			Expect(source).To(EqualDocumentBlock(expected))
+		})
+	})
+
 	Context("invalid sections", func() {
 
 		It("header invalid - too many spaces", func() {
@@ -0,0 +1,57 @@
+package parser
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+)
+
+// the key in the parser's global store for the substitutions to apply
+const substitutionsKey = "substitutions"
+
+// the key in the parser's global store to parse the index terms, even if the `macros` substitution is not enabled
+const indexTermsKey = "indexTerms"
+
+// ParseInlineElementsWithSubstitutions parses the given (raw) line, but only retains the elements
+// whose substitution is enabled. For example, the quoted texts are parsed only if the `quotes`
+// substitution is part of the given `subs`, otherwise they remain plain strings.
+func ParseInlineElementsWithSubstitutions(line string, subs types.Substitutions) (types.InlineElements, error) {
+	return parseInlineElementsWithSubstitutions(line, GlobalStore(substitutionsKey, subs))
+}
+
+// ParseTitleWithSubstitutions parses the given (raw) title of a block, in which the index terms are also parsed,
+// along with the elements whose substitution is enabled (see `ParseInlineElementsWithSubstitutions`)
+func ParseTitleWithSubstitutions(title string, subs types.Substitutions) (types.InlineElements, error) {
+	return parseInlineElementsWithSubstitutions(title, GlobalStore(substitutionsKey, subs), GlobalStore(indexTermsKey, true))
+}
+
+// ParseBlockTitle parses the given (raw) title of a block (eg: an image or a table), with the substitutions which
+// apply to the titles of the blocks. The index terms of the title are also parsed. (The typographic replacements
+// apply on the text of the title when it is rendered.)
+func ParseBlockTitle(title string) (types.InlineElements, error) {
+	return ParseTitleWithSubstitutions(title, types.Substitutions{types.AttributesSubstitution, types.ReplacementsSubstitution})
+}
+
+func parseInlineElementsWithSubstitutions(line string, opts ...Option) (types.InlineElements, error) {
+	result, err := Parse("", []byte(line), append(opts, Entrypoint("InlineElementsWithSubstitutions"))...)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to parse line with substitutions")
+	}
+	elements, ok := result.(types.InlineElements)
+	if !ok {
+		return nil, errors.Errorf("unexpected type of content after parsing line with substitutions: '%T'", result)
+	}
+	return elements, nil
+}
+
+// isSubstitutionEnabled returns `true` if the given kind of substitution was set in the parser's global store
+func isSubstitutionEnabled(c *current, kind types.SubstitutionKind) bool {
+	subs, ok := c.globalStore[substitutionsKey].(types.Substitutions)
+	return ok && subs.Has(kind)
+}
+
+// isIndexTermsEnabled returns `true` if the index terms should be parsed, even if the `macros` substitution is not enabled
+func isIndexTermsEnabled(c *current) bool {
+	enabled, ok := c.globalStore[indexTermsKey].(bool)
+	return ok && enabled
+}
@@ -0,0 +1,49 @@
+package parser_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("table of contents macro - preflight", func() {
+
+	It("toc macro", func() {
+		source := `toc::[]`
+		Expect(source).To(EqualDocumentBlock(types.TableOfContentsMacro{}))
+	})
+
+	It("toc macro between 2 paragraphs", func() {
+		source := `first paragraph
+
+toc::[]
+
+second paragraph`
+		expected := types.PreflightDocument{
+			Blocks: []interface{}{
+				types.Paragraph{
+					Attributes: types.ElementAttributes{},
+					Lines: []types.InlineElements{
+						{
+							types.StringElement{Content: "first paragraph"},
+						},
+					},
+				},
+				types.BlankLine{},
+				types.TableOfContentsMacro{},
+				types.BlankLine{},
+				types.Paragraph{
+					Attributes: types.ElementAttributes{},
+					Lines: []types.InlineElements{
+						{
+							types.StringElement{Content: "second paragraph"},
+						},
+					},
+				},
+			},
+		}
+		Expect(source).To(BecomePreflightDocument(expected))
+	})
+})
@@ -46,10 +46,11 @@ var _ = Describe("unordered lists - preflight", func() {
 				Blocks: []interface{}{
 					types.UnorderedListItem{
 						Attributes: types.ElementAttributes{
-							types.AttrTitle:    "mytitle",
-							types.AttrID:       "listID",
-							types.AttrCustomID: true,
-							types.AttrRole:     "myrole",
+							types.AttrTitle:         "mytitle",
+							types.AttrTitlePosition: types.Position{Filename: "test.adoc", Line: 1, Column: 1},
+							types.AttrID:            "listID",
+							types.AttrCustomID:      true,
+							types.AttrRole:          "myrole",
 						},
 						Level:       1,
 						BulletStyle: types.OneAsterisk,
@@ -76,7 +77,8 @@ var _ = Describe("unordered lists - preflight", func() {
 				Blocks: []interface{}{
 					types.UnorderedListItem{
 						Attributes: types.ElementAttributes{
-							types.AttrTitle: "a title",
+							types.AttrTitle:         "a title",
+							types.AttrTitlePosition: types.Position{Filename: "test.adoc", Line: 1, Column: 1},
 						},
 						Level:       1,
 						BulletStyle: types.OneAsterisk,
@@ -159,7 +161,8 @@ var _ = Describe("unordered lists - preflight", func() {
 				Blocks: []interface{}{
 					types.UnorderedListItem{
 						Attributes: types.ElementAttributes{
-							types.AttrTitle: "Unordered list title",
+							types.AttrTitle:         "Unordered list title",
+							types.AttrTitlePosition: types.Position{Filename: "test.adoc", Line: 1, Column: 1},
 						},
 						Level:       1,
 						BulletStyle: types.OneAsterisk,
@@ -737,7 +740,8 @@ on 2 lines, too.`
 						BulletStyle: types.OneAsterisk,
 						CheckStyle:  types.NoCheck,
 						Attributes: types.ElementAttributes{
-							types.AttrTitle: "Unordered, max nesting",
+							types.AttrTitle:         "Unordered, max nesting",
+							types.AttrTitlePosition: types.Position{Filename: "test.adoc", Line: 1, Column: 1},
 						},
 						Elements: []interface{}{
 							types.Paragraph{
@@ -859,7 +863,8 @@ on 2 lines, too.`
 				Blocks: []interface{}{
 					types.UnorderedListItem{
 						Attributes: types.ElementAttributes{
-							types.AttrTitle: "Unordered, max nesting",
+							types.AttrTitle:         "Unordered, max nesting",
+							types.AttrTitlePosition: types.Position{Filename: "test.adoc", Line: 1, Column: 1},
 						},
 						Level:       1,
 						BulletStyle: types.OneAsterisk,
@@ -1196,7 +1201,8 @@ The {plus} symbol is on a new line.
 						BulletStyle: types.OneAsterisk,
 						CheckStyle:  types.NoCheck,
 						Attributes: types.ElementAttributes{
-							types.AttrTitle: "Unordered, complex",
+							types.AttrTitle:         "Unordered, complex",
+							types.AttrTitlePosition: types.Position{Filename: "test.adoc", Line: 1, Column: 1},
 						},
 						Elements: []interface{}{
 							types.Paragraph{
@@ -1249,6 +1255,11 @@ The {plus} symbol is on a new line.
 										},
 										types.DocumentAttributeSubstitution{
 											Name: "plus",
+											Position: types.Position{
+												Filename: "test.adoc",
+												Line:     5,
+												Column:   51,
+											},
 										},
 										types.StringElement{
 											Content: " symbol.",
@@ -1299,6 +1310,11 @@ The {plus} symbol is on a new line.
 									},
 									types.DocumentAttributeSubstitution{
 										Name: "plus",
+										Position: types.Position{
+											Filename: "test.adoc",
+											Line:     10,
+											Column:   5,
+										},
 									},
 									types.StringElement{
 										Content: " symbol is on a new line.",
@@ -1614,10 +1630,11 @@ var _ = Describe("unordered lists - document", func() {
 				Elements: []interface{}{
 					types.UnorderedList{
 						Attributes: types.ElementAttributes{
-							types.AttrID:       "listID",
-							types.AttrCustomID: true,
-							types.AttrTitle:    "mytitle",
-							types.AttrRole:     "myrole",
+							types.AttrID:            "listID",
+							types.AttrCustomID:      true,
+							types.AttrTitle:         "mytitle",
+							types.AttrTitlePosition: types.Position{Line: 1, Column: 1},
+							types.AttrRole:          "myrole",
 						},
 						Items: []types.UnorderedListItem{
 							{
@@ -1653,7 +1670,8 @@ var _ = Describe("unordered lists - document", func() {
 				Elements: []interface{}{
 					types.UnorderedList{
 						Attributes: types.ElementAttributes{
-							types.AttrTitle: "a title",
+							types.AttrTitle:         "a title",
+							types.AttrTitlePosition: types.Position{Line: 1, Column: 1},
 						},
 						Items: []types.UnorderedListItem{
 							{
@@ -1754,7 +1772,8 @@ var _ = Describe("unordered lists - document", func() {
 				Elements: []interface{}{
 					types.UnorderedList{
 						Attributes: types.ElementAttributes{
-							types.AttrTitle: "Unordered list title",
+							types.AttrTitle:         "Unordered list title",
+							types.AttrTitlePosition: types.Position{Line: 1, Column: 1},
 						},
 						Items: []types.UnorderedListItem{
 							{
@@ -2435,7 +2454,8 @@ on 2 lines, too.`
 				Elements: []interface{}{
 					types.UnorderedList{
 						Attributes: types.ElementAttributes{
-							types.AttrTitle: "Unordered, max nesting",
+							types.AttrTitle:         "Unordered, max nesting",
+							types.AttrTitlePosition: types.Position{Line: 1, Column: 1},
 						},
 						Items: []types.UnorderedListItem{
 							{
@@ -2589,7 +2609,8 @@ on 2 lines, too.`
 				Elements: []interface{}{
 					types.UnorderedList{
 						Attributes: types.ElementAttributes{
-							types.AttrTitle: "Unordered, max nesting",
+							types.AttrTitle:         "Unordered, max nesting",
+							types.AttrTitlePosition: types.Position{Line: 1, Column: 1},
 						},
 						Items: []types.UnorderedListItem{
 							{
@@ -2979,7 +3000,8 @@ The {plus} symbol is on a new line.
 				Elements: []interface{}{
 					types.UnorderedList{
 						Attributes: types.ElementAttributes{
-							types.AttrTitle: "Unordered, complex",
+							types.AttrTitle:         "Unordered, complex",
+							types.AttrTitlePosition: types.Position{Line: 1, Column: 1},
 						},
 						Items: []types.UnorderedListItem{
 							{
@@ -3040,6 +3062,10 @@ The {plus} symbol is on a new line.
 																				},
 																				types.DocumentAttributeSubstitution{
 																					Name: "plus",
+																					Position: types.Position{
+																						Line:   5,
+																						Column: 51,
+																					},
 																				},
 																				types.StringElement{
 																					Content: " symbol.",
@@ -3087,6 +3113,10 @@ The {plus} symbol is on a new line.
 																								},
 																								types.DocumentAttributeSubstitution{
 																									Name: "plus",
+																									Position: types.Position{
+																										Line:   10,
+																										Column: 5,
+																									},
 																								},
 																								types.StringElement{
 																									Content: " symbol is on a new line.",
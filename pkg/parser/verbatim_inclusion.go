@@ -0,0 +1,189 @@
+package parser
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// verbatimBlockKey the key for the enclosing verbatim block of the file inclusions, in the parser's global store
+const verbatimBlockKey string = "verbatimBlock"
+
+// verbatimBlock the verbatim block (listing, source, fenced or literal block) in which files are included
+type verbatimBlock struct {
+	attributes types.ElementAttributes
+}
+
+// withinVerbatimBlock returns an option to include the files (including the AsciiDoc files) as raw lines,
+// since they are included in a verbatim block with the given attributes
+func withinVerbatimBlock(attributes types.ElementAttributes) Option {
+	return GlobalStore(verbatimBlockKey, verbatimBlock{
+		attributes: attributes,
+	})
+}
+
+// enclosingVerbatimBlock returns the verbatim block in which the files are included, if any
+func enclosingVerbatimBlock(opts ...Option) (verbatimBlock, bool) {
+	p := newParser("", nil, opts...)
+	b, ok := p.cur.globalStore[verbatimBlockKey].(verbatimBlock)
+	return b, ok
+}
+
+// isVerbatim returns true if the content of the blocks of the given kind is not parsed
+func isVerbatim(kind types.BlockKind) bool {
+	switch kind {
+	case types.Listing, types.Source, types.Fenced, types.Literal:
+		return true
+	default:
+		return false
+	}
+}
+
+// rawLines returns the lines of the given content in a paragraph of verbatim lines, without any processing
+func rawLines(content *bytes.Buffer) types.PreflightDocument {
+	if content.Len() == 0 {
+		return types.PreflightDocument{
+			Blocks: []interface{}{},
+		}
+	}
+	lines := []types.InlineElements{}
+	for _, l := range strings.Split(strings.TrimSuffix(content.String(), "\n"), "\n") {
+		if l == "" {
+			lines = append(lines, types.InlineElements{})
+			continue
+		}
+		lines = append(lines, types.InlineElements{
+			types.VerbatimLine{
+				Content: l,
+			},
+		})
+	}
+	return types.PreflightDocument{
+		Blocks: []interface{}{
+			types.Paragraph{
+				Attributes: types.ElementAttributes{},
+				Lines:      lines,
+			},
+		},
+	}
+}
+
+// tabSize returns the value of the `tabsize` attribute of the given block, or of the document,
+// or 0 if it is not set (in which case the tabs are preserved)
+func tabSize(b verbatimBlock, attrs types.DocumentAttributes) int {
+	value := b.attributes.GetAsString(types.AttrTabSize)
+	if value == "" {
+		value, _ = attrs.GetAsString(types.AttrTabSize)
+	}
+	if value == "" {
+		return 0
+	}
+	size, err := strconv.Atoi(value)
+	if err != nil || size < 0 {
+		log.Warnf("invalid value for the '%s' attribute: '%s'", types.AttrTabSize, value)
+		return 0
+	}
+	return size
+}
+
+// expandTabs replaces the tabs in the lines of the given content with spaces, up to the next tab stop
+func expandTabs(content *bytes.Buffer, size int) *bytes.Buffer {
+	result := bytes.NewBuffer(nil)
+	for _, l := range strings.SplitAfter(content.String(), "\n") {
+		column := 0
+		for _, r := range l {
+			if r == '\t' {
+				spaces := size - column%size
+				result.WriteString(strings.Repeat(" ", spaces))
+				column += spaces
+				continue
+			}
+			result.WriteRune(r)
+			column++
+		}
+	}
+	return result
+}
+
+// mergeParagraphs merges the consecutive paragraphs of a verbatim block (eg: the raw lines of an included file and the
+// surrounding lines), so that they are rendered as if the lines of the included file had been written in the block
+func mergeParagraphs(elements []interface{}) []interface{} {
+	result := make([]interface{}, 0, len(elements))
+	for _, e := range elements {
+		if p, ok := e.(types.Paragraph); ok && len(result) > 0 {
+			if last, ok := result[len(result)-1].(types.Paragraph); ok {
+				lines := make([]types.InlineElements, 0, len(last.Lines)+len(p.Lines))
+				lines = append(lines, last.Lines...)
+				lines = append(lines, p.Lines...)
+				last.Lines = lines
+				result[len(result)-1] = last
+				continue
+			}
+		}
+		result = append(result, e)
+	}
+	return result
+}
+
+// parseLiteralBlock resolves the file inclusions in the lines of the given literal block, whose content is not parsed.
+// The files (including the AsciiDoc files) are included as raw lines.
+func parseLiteralBlock(filename string, b types.LiteralBlock, attrs types.DocumentAttributes, overrides types.DocumentAttributeOverrides, levelOffset int, opts ...Option) types.LiteralBlock {
+	b.Lines = includeRawLines(filename, b.Lines, attrs, overrides, levelOffset, append(opts, withinVerbatimBlock(b.Attributes))...)
+	return b
+}
+
+// includeRawLines replaces the file inclusions in the given lines with the raw lines of the files to include
+func includeRawLines(filename string, rawLines []string, attrs types.DocumentAttributes, overrides types.DocumentAttributeOverrides, levelOffset int, opts ...Option) []string {
+	lines := make([]string, 0, len(rawLines))
+	for _, l := range rawLines {
+		if !strings.HasPrefix(l, "include::") {
+			lines = append(lines, l)
+			continue
+		}
+		e, err := Parse(filename, []byte(l), append(opts, Entrypoint("DocumentBlock"))...)
+		incl, ok := e.(types.FileInclusion)
+		if err != nil || !ok {
+			lines = append(lines, l)
+			continue
+		}
+		embedded, err := parseFileToInclude(filename, incl, attrs, overrides, levelOffset, opts...)
+		if err != nil {
+			// do not fail, but instead report the error in the console
+			log.Errorf("failed to include file '%s': %v", incl.Location, err)
+		}
+		lines = append(lines, plainLines(embedded.Blocks, attrs)...)
+	}
+	return lines
+}
+
+// plainLines returns the content of the lines of the given paragraphs (eg: the raw lines of a file to include,
+// or the message or the link which replace it), with empty lines in place of the blank lines
+func plainLines(blocks []interface{}, attrs types.DocumentAttributes) []string {
+	result := []string{}
+	for _, b := range blocks {
+		switch b := b.(type) {
+		case types.Paragraph:
+			for _, l := range b.Lines {
+				line := strings.Builder{}
+				for _, e := range l {
+					switch e := e.(type) {
+					case types.StringElement:
+						line.WriteString(e.Content)
+					case types.VerbatimLine:
+						line.WriteString(e.Content)
+					case types.InlineLink:
+						line.WriteString(e.Location.Resolve(attrs))
+					}
+				}
+				result = append(result, line.String())
+			}
+		case types.BlankLine:
+			result = append(result, "")
+		}
+	}
+	return result
+}
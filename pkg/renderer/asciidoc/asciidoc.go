@@ -0,0 +1,151 @@
+// Package asciidoc renders a document back into a normalized Asciidoc form, with consistent
+// list markers and heading style, one sentence per line (where possible) and normalized attribute syntax.
+// File inclusions and comments are preserved in the output.
+package asciidoc
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// Render renders the given document in Asciidoc and writes the result in the given `writer`
+func Render(ctx *renderer.Context, output io.Writer) error {
+	result, err := renderElements(ctx, ctx.Document.Elements)
+	if err != nil {
+		return errors.Wrapf(err, "unable to render document")
+	}
+	if len(result) > 0 {
+		result = append(result, '\n')
+	}
+	_, err = output.Write(result)
+	return err
+}
+
+// renderElements renders the given elements, separated by a blank line.
+// Consecutive document attribute declarations and resets are grouped, ie, they are not separated by a blank line.
+func renderElements(ctx *renderer.Context, elements []interface{}) ([]byte, error) {
+	log.Debugf("rendering %d element(s)...", len(elements))
+	buff := bytes.NewBuffer(nil)
+	var previous interface{}
+	for _, element := range elements {
+		if _, ok := element.(types.BlankLine); ok {
+			continue
+		}
+		renderedElement, err := renderElement(ctx, element)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render an element")
+		}
+		if len(renderedElement) == 0 {
+			continue
+		}
+		if buff.Len() > 0 {
+			if isAttributeDeclaration(previous) && isAttributeDeclaration(element) {
+				buff.WriteString("\n")
+			} else {
+				buff.WriteString("\n\n")
+			}
+		}
+		buff.Write(renderedElement)
+		previous = element
+	}
+	return buff.Bytes(), nil
+}
+
+// renderVerbatimElements renders the given elements, separated by a single newline,
+// and where the blank lines are retained as-is
+func renderVerbatimElements(ctx *renderer.Context, elements []interface{}, renderElementFunc rendererFunc) ([]byte, error) {
+	buff := bytes.NewBuffer(nil)
+	for i, element := range elements {
+		if i > 0 {
+			buff.WriteString("\n")
+		}
+		if _, ok := element.(types.BlankLine); ok {
+			continue
+		}
+		renderedElement, err := renderElementFunc(ctx, element)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render an element")
+		}
+		buff.Write(renderedElement)
+	}
+	return bytes.TrimRight(buff.Bytes(), "\n"), nil
+}
+
+type rendererFunc func(*renderer.Context, interface{}) ([]byte, error)
+
+// nolint: gocyclo
+func renderElement(ctx *renderer.Context, element interface{}) ([]byte, error) {
+	switch e := element.(type) {
+	case []interface{}:
+		return renderElements(ctx, e)
+	case types.Section:
+		return renderSection(ctx, e)
+	case types.DiscreteHeading:
+		return renderDiscreteHeading(ctx, e)
+	case types.Preamble:
+		return renderElements(ctx, e.Elements)
+	case types.TableOfContentsMacro:
+		return []byte("toc::[]"), nil
+	case types.ThematicBreak:
+		return []byte("'''"), nil
+	case types.PageBreak:
+		return []byte("<<<"), nil
+	case types.LabeledList:
+		return renderLabeledList(ctx, e)
+	case types.OrderedList:
+		return renderOrderedList(ctx, e)
+	case types.UnorderedList:
+		return renderUnorderedList(ctx, e)
+	case types.Paragraph:
+		return renderParagraph(ctx, e)
+	case types.ImageBlock:
+		return renderImageBlock(ctx, e)
+	case types.VideoBlock:
+		return renderVideoBlock(ctx, e)
+	case types.AudioBlock:
+		return renderAudioBlock(ctx, e)
+	case types.DelimitedBlock:
+		return renderDelimitedBlock(ctx, e)
+	case types.Table:
+		return renderTable(ctx, e)
+	case types.LiteralBlock:
+		return renderLiteralBlock(ctx, e)
+	case types.FileInclusion:
+		return []byte(e.RawText), nil
+	case types.DocumentAttributeDeclaration:
+		ctx.Document.Attributes.AddDeclaration(e)
+		return renderAttributeDeclaration(e), nil
+	case types.DocumentAttributeReset:
+		ctx.Document.Attributes.Reset(e)
+		return []byte(":" + e.Name + "!:"), nil
+	case types.UserMacro:
+		return []byte(e.RawText), nil
+	case types.SingleLineComment:
+		return []byte("//" + e.Content), nil
+	case types.InlineElements:
+		return renderLine(ctx, e)
+	default:
+		return nil, errors.Errorf("unsupported type of element: %T", element)
+	}
+}
+
+func isAttributeDeclaration(element interface{}) bool {
+	switch element.(type) {
+	case types.DocumentAttributeDeclaration, types.DocumentAttributeReset:
+		return true
+	default:
+		return false
+	}
+}
+
+func renderAttributeDeclaration(attr types.DocumentAttributeDeclaration) []byte {
+	if attr.Value == "" {
+		return []byte(":" + attr.Name + ":")
+	}
+	return []byte(":" + attr.Name + ": " + attr.Value)
+}
@@ -0,0 +1,87 @@
+package asciidoc
+
+import (
+	"bytes"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+func renderDelimitedBlock(ctx *renderer.Context, b types.DelimitedBlock) ([]byte, error) {
+	log.Debugf("rendering delimited block of kind '%v'", b.Kind)
+	var delimiter, style string
+	var renderContent func(*renderer.Context, []interface{}) ([]byte, error)
+	switch b.Kind {
+	case types.Fenced:
+		delimiter = "```"
+		renderContent = renderVerbatimContent
+	case types.Listing:
+		delimiter = "----"
+		renderContent = renderVerbatimContent
+	case types.Source:
+		delimiter = "----"
+		style = renderSourceStyle(b.Attributes)
+		renderContent = renderVerbatimContent
+	case types.Verse:
+		delimiter = "____"
+		style = renderQuoteStyle(b.Kind, b.Attributes)
+		renderContent = renderVerbatimContent
+	case types.Quote:
+		delimiter = "____"
+		style = renderQuoteStyle(b.Kind, b.Attributes)
+		renderContent = renderElements
+	case types.Example:
+		delimiter = "===="
+		style = renderAdmonitionStyle(b.Attributes)
+		renderContent = renderElements
+	case types.Sidebar:
+		delimiter = "****"
+		renderContent = renderElements
+	case types.Comment:
+		delimiter = "////"
+		renderContent = renderVerbatimContent
+	case types.Open:
+		delimiter = "--"
+		style = renderAdmonitionStyle(b.Attributes)
+		renderContent = renderElements
+	case types.Abstract, types.PartIntro:
+		delimiter = "--"
+		style = string(b.Kind)
+		renderContent = renderElements
+	default:
+		return nil, errors.Errorf("unsupported kind of delimited block: '%v'", b.Kind)
+	}
+	content, err := renderContent(ctx, b.Elements)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render delimited block")
+	}
+	result := bytes.NewBuffer(nil)
+	result.WriteString(renderElementAttributes(b.Attributes, style))
+	result.WriteString(delimiter)
+	result.WriteString("\n")
+	if len(content) > 0 {
+		result.Write(content)
+		result.WriteString("\n")
+	}
+	result.WriteString(delimiter)
+	return result.Bytes(), nil
+}
+
+// renderVerbatimContent renders the elements of a listing, source, verse or comment block,
+// in which the line breaks and blank lines are significant
+func renderVerbatimContent(ctx *renderer.Context, elements []interface{}) ([]byte, error) {
+	return renderVerbatimElements(ctx, elements, renderVerbatimElement)
+}
+
+func renderVerbatimElement(ctx *renderer.Context, element interface{}) ([]byte, error) {
+	switch e := element.(type) {
+	case types.Paragraph:
+		return renderRawLines(ctx, e.Lines)
+	case types.StringElement:
+		return []byte(e.Content), nil
+	default:
+		return renderElement(ctx, element)
+	}
+}
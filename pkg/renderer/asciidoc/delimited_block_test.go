@@ -0,0 +1,201 @@
+package asciidoc_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("delimited blocks", func() {
+
+	It("source block with blank lines", func() {
+		source := `[source,go]
+----
+func main() {
+
+	fmt.Println("hello")   
+}
+----`
+		expected := `[source,go]
+----
+func main() {
+
+	fmt.Println("hello")
+}
+----
+`
+		Expect(source).To(RenderAsciidoc(expected))
+	})
+
+	It("admonition block", func() {
+		source := `[NOTE]
+====
+First sentence. Second sentence.
+====`
+		expected := `[NOTE]
+====
+First sentence.
+Second sentence.
+====
+`
+		Expect(source).To(RenderAsciidoc(expected))
+	})
+
+	It("quote block", func() {
+		source := `[quote, john doe, quote title]
+____
+some *content*
+____`
+		expected := `[quote, john doe, quote title]
+____
+some *content*
+____
+`
+		Expect(source).To(RenderAsciidoc(expected))
+	})
+
+	It("Markdown-style quote block and thematic break", func() {
+		source := `> some *content*
+> -- john doe, quote title
+
+***`
+		expected := `[quote, john doe, quote title]
+____
+some *content*
+____
+
+'''
+`
+		Expect(source).To(RenderAsciidoc(expected))
+	})
+
+	It("thematic and page breaks", func() {
+		source := `- - -
+
+<<<`
+		expected := `'''
+
+<<<
+`
+		Expect(source).To(RenderAsciidoc(expected))
+	})
+
+	It("open blocks", func() {
+		source := `--
+some content
+--
+
+[NOTE]
+--
+a note
+--
+
+[partintro]
+--
+an intro
+--
+
+[sidebar]
+--
+a sidebar
+--
+
+[listing]
+--
+some *listing*
+--`
+		expected := `--
+some content
+--
+
+[NOTE]
+--
+a note
+--
+
+[partintro]
+--
+an intro
+--
+
+****
+a sidebar
+****
+
+----
+some *listing*
+----
+`
+		Expect(source).To(RenderAsciidoc(expected))
+	})
+
+	It("comment block", func() {
+		source := `////
+a comment
+
+on 2 paragraphs
+////`
+		expected := `////
+a comment
+
+on 2 paragraphs
+////
+`
+		Expect(source).To(RenderAsciidoc(expected))
+	})
+
+	It("literal blocks", func() {
+		source := `....
+literal
+....
+
+  indented literal
+
+[literal]
+literal with attribute`
+		expected := `....
+literal
+....
+
+  indented literal
+
+[literal]
+literal with attribute
+`
+		Expect(source).To(RenderAsciidoc(expected))
+	})
+
+	It("table", func() {
+		source := `.a title
+|===
+|a | b
+
+|c|d
+|===`
+		expected := `.a title
+|===
+|a |b
+
+|c |d
+|===
+`
+		Expect(source).To(RenderAsciidoc(expected))
+	})
+
+	It("image block", func() {
+		source := `image::images/foo.png[]
+
+image::images/foo.png[foo, 100, 200, role=bar]
+
+image::images/foo.png[the foo image, 100]`
+		expected := `image::images/foo.png[]
+
+[.bar]
+image::images/foo.png[,100,200]
+
+image::images/foo.png[the foo image,100]
+`
+		Expect(source).To(RenderAsciidoc(expected))
+	})
+})
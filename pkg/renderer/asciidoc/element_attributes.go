@@ -0,0 +1,123 @@
+package asciidoc
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+)
+
+// attributes which are rendered with a dedicated syntax, or which are set by the parser
+// and do not need to be rendered back
+var nonGenericAttributes = map[string]bool{
+	types.AttrID:               true,
+	types.AttrCustomID:         true,
+	types.AttrTitle:            true,
+	types.AttrTitlePosition:    true,
+	types.AttrTitleElements:    true,
+	types.AttrRole:             true,
+	types.AttrKind:             true,
+	types.AttrLanguage:         true,
+	types.AttrQuoteAuthor:      true,
+	types.AttrQuoteTitle:       true,
+	types.AttrAdmonitionKind:   true,
+	types.AttrCheckStyle:       true,
+	types.AttrNumberingStyle:   true,
+	types.AttrLiteralBlockType: true,
+	types.AttrAuthors:          true,
+	types.AttrRevision:         true,
+	"layout":                   true,
+}
+
+// renderElementAttributes renders the given attributes in their normalized form, ie:
+// - the ID as `[#id]` (unless it was generated by the parser)
+// - the role as `[.role]`
+// - the title as `.title`
+// - the given style (eg: `source,go`, `quote,author,title`, `NOTE`, etc.) as `[style]`
+// - all other attributes as `[key1=value1,key2]`
+// Each group is rendered on its own line, and a trailing newline is included
+// if at least one attribute was rendered.
+func renderElementAttributes(attrs types.ElementAttributes, style string) string {
+	lines := []string{}
+	if id := attrs.GetAsString(types.AttrID); id != "" && attrs.GetAsBool(types.AttrCustomID) {
+		lines = append(lines, "[#"+id+"]")
+	}
+	if role := attrs.GetAsString(types.AttrRole); role != "" {
+		lines = append(lines, "[."+role+"]")
+	}
+	if title := attrs.GetAsString(types.AttrTitle); title != "" {
+		lines = append(lines, "."+strings.TrimSpace(title))
+	}
+	if style != "" {
+		lines = append(lines, "["+style+"]")
+	}
+	if generic := renderGenericAttributes(attrs); generic != "" {
+		lines = append(lines, "["+generic+"]")
+	}
+	if len(lines) == 0 {
+		return ""
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// renderGenericAttributes renders the attributes which have no dedicated syntax,
+// sorted by key, and separated by a comma.
+func renderGenericAttributes(attrs types.ElementAttributes, skip ...string) string {
+	keys := make([]string, 0, len(attrs))
+keys:
+	for k := range attrs {
+		if nonGenericAttributes[k] {
+			continue
+		}
+		for _, s := range skip {
+			if k == s {
+				continue keys
+			}
+		}
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	result := make([]string, len(keys))
+	for i, k := range keys {
+		result[i] = renderGenericAttribute(k, attrs[k])
+	}
+	return strings.Join(result, ",")
+}
+
+func renderGenericAttribute(key string, value interface{}) string {
+	if value == nil {
+		return key
+	}
+	v := fmt.Sprintf("%v", value)
+	if v == "" {
+		return key
+	}
+	if strings.ContainsAny(v, ", ") {
+		// quote the value, so its commas are not treated as attributes separators
+		return key + `="` + v + `"`
+	}
+	return key + "=" + v
+}
+
+// renderQuoteStyle renders the `quote` or `verse` style, along with the optional author and title
+func renderQuoteStyle(kind types.BlockKind, attrs types.ElementAttributes) string {
+	style := string(kind)
+	author := attrs.GetAsString(types.AttrQuoteAuthor)
+	title := attrs.GetAsString(types.AttrQuoteTitle)
+	if author != "" || title != "" {
+		style += ", " + author
+	}
+	if title != "" {
+		style += ", " + title
+	}
+	return style
+}
+
+// renderAdmonitionStyle renders the admonition kind in upper case (eg: `NOTE`)
+func renderAdmonitionStyle(attrs types.ElementAttributes) string {
+	if k, ok := attrs[types.AttrAdmonitionKind].(types.AdmonitionKind); ok {
+		return strings.ToUpper(string(k))
+	}
+	return ""
+}
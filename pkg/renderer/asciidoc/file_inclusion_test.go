@@ -0,0 +1,35 @@
+package asciidoc_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("file inclusions and comments", func() {
+
+	It("file inclusions and comments are retained", func() {
+		source := `// a comment
+include::includes/chapter-a.adoc[leveloffset=+1]
+
+some content // not a comment
+
+[source]
+----
+include::includes/hello_world.go.txt[]
+----`
+		expected := `// a comment
+
+include::includes/chapter-a.adoc[leveloffset=+1]
+
+some content // not a comment
+
+[source]
+----
+include::includes/hello_world.go.txt[]
+----
+`
+		Expect(source).To(RenderAsciidoc(expected))
+	})
+})
@@ -0,0 +1,35 @@
+package asciidoc_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/bytesparadise/libasciidoc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("formatting", func() {
+
+	It("formatting is idempotent on the bench documents", func() {
+		filenames, err := filepath.Glob("../../../test/bench/*.adoc")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filenames).NotTo(BeEmpty())
+		for _, filename := range filenames {
+			source, err := ioutil.ReadFile(filename)
+			Expect(err).NotTo(HaveOccurred())
+			formatted := format(source)
+			Expect(format(formatted)).To(Equal(formatted), "formatting of '%s' is not idempotent", filename)
+		}
+	})
+})
+
+func format(source []byte) []byte {
+	result := bytes.NewBuffer(nil)
+	err := libasciidoc.ConvertToAsciidoc(context.Background(), bytes.NewReader(source), result)
+	Expect(err).NotTo(HaveOccurred())
+	return result.Bytes()
+}
@@ -0,0 +1,61 @@
+package asciidoc
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+)
+
+func renderImageBlock(ctx *renderer.Context, img types.ImageBlock) ([]byte, error) {
+	return []byte(renderElementAttributesWithout(img.Attributes, types.AttrImageAlt, types.AttrImageWidth, types.AttrImageHeight) +
+		"image::" + img.Path + "[" + renderImageAttributes(img.Path, img.Attributes) + "]"), nil
+}
+
+// renderImageAttributes renders the positional `alt`, `width` and `height` attributes of an image,
+// followed by the other attributes. The `alt` attribute is omitted if it matches the default value
+// computed from the image path.
+func renderImageAttributes(path string, attrs types.ElementAttributes) string {
+	positionals := []string{}
+	alt := attrs.GetAsString(types.AttrImageAlt)
+	if alt == defaultImageAlt(path) {
+		alt = ""
+	}
+	width := attrs.GetAsString(types.AttrImageWidth)
+	height := attrs.GetAsString(types.AttrImageHeight)
+	switch {
+	case height != "":
+		positionals = append(positionals, alt, width, height)
+	case width != "":
+		positionals = append(positionals, alt, width)
+	case alt != "":
+		positionals = append(positionals, alt)
+	}
+	result := strings.Join(positionals, ",")
+	if others := renderGenericAttributes(attrs, types.AttrImageAlt, types.AttrImageWidth, types.AttrImageHeight); others != "" {
+		if result != "" {
+			result += ","
+		}
+		result += others
+	}
+	return result
+}
+
+func defaultImageAlt(path string) string {
+	_, filename := filepath.Split(path)
+	return strings.TrimSuffix(filename, filepath.Ext(filename))
+}
+
+// renderElementAttributesWithout renders the element attributes (see `renderElementAttributes`),
+// except the given keys
+func renderElementAttributesWithout(attrs types.ElementAttributes, skip ...string) string {
+	result := types.ElementAttributes{}
+	for k, v := range attrs {
+		result[k] = v
+	}
+	for _, k := range skip {
+		delete(result, k)
+	}
+	return renderElementAttributes(result, "")
+}
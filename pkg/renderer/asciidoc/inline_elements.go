@@ -0,0 +1,489 @@
+package asciidoc
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// renderLines renders all lines, separated by a newline character
+func renderLines(ctx *renderer.Context, lines []types.InlineElements) ([]byte, error) {
+	return renderLinesWithEscapes(ctx, lines, true)
+}
+
+// renderRawLines renders all lines, separated by a newline character, without escaping
+// the quote punctuation of their text (eg: in listing blocks)
+func renderRawLines(ctx *renderer.Context, lines []types.InlineElements) ([]byte, error) {
+	return renderLinesWithEscapes(ctx, lines, false)
+}
+
+func renderLinesWithEscapes(ctx *renderer.Context, lines []types.InlineElements, escape bool) ([]byte, error) {
+	buff := bytes.NewBuffer(nil)
+	for i, line := range lines {
+		if i > 0 {
+			buff.WriteString("\n")
+		}
+		renderedLine, err := renderLineWithEscapes(ctx, line, escape)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to render lines")
+		}
+		buff.Write(renderedLine)
+	}
+	return buff.Bytes(), nil
+}
+
+// renderLine renders all elements of the given line. Trailing spaces are removed.
+func renderLine(ctx *renderer.Context, elements types.InlineElements) ([]byte, error) {
+	return renderLineWithEscapes(ctx, elements, true)
+}
+
+// renderRawLine renders all elements of the given line, without escaping the quote punctuation of its text.
+// Trailing spaces are removed.
+func renderRawLine(ctx *renderer.Context, elements types.InlineElements) ([]byte, error) {
+	return renderLineWithEscapes(ctx, elements, false)
+}
+
+func renderLineWithEscapes(ctx *renderer.Context, elements types.InlineElements, escape bool) ([]byte, error) {
+	if escape {
+		elements = protectQuotedTextPunctuation(elements, "")
+	}
+	renderedElements, err := renderLineElements(ctx, elements, escape)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render line")
+	}
+	return []byte(strings.TrimRight(strings.Join(renderedElements, ""), " \t")), nil
+}
+
+// renderLineElements renders each element of the given line. If `escape` is true, then the quote punctuation
+// (eg: `*`) and the index term brackets (`((`) in the text of the line are escaped with a backslash when they
+// would otherwise be parsed as quoted text or an index term (ie, when the text was escaped in the source document)
+func renderLineElements(ctx *renderer.Context, elements types.InlineElements, escape bool) ([]string, error) {
+	result := make([]string, len(elements))
+	for i, element := range elements {
+		var renderedElement []byte
+		var err error
+		if t, ok := element.(types.QuotedText); ok {
+			renderedElement, err = renderQuotedText(ctx, t, isConstrained(elements, i))
+		} else if l, ok := element.(types.InlineLink); ok && isBareLink(l) && followedByContent(elements, i) {
+			renderedElement = []byte(renderLocation(l.Location) + "[]")
+		} else {
+			renderedElement, err = renderInlineElement(ctx, element)
+		}
+		if err != nil {
+			return nil, err
+		}
+		result[i] = string(renderedElement)
+	}
+	if !escape {
+		return result, nil
+	}
+	line := strings.Join(result, "")
+	offset := 0
+	for i, element := range elements {
+		end := offset + len(result[i])
+		if _, ok := element.(types.StringElement); ok {
+			result[i] = escapeQuotedTextPunctuation(line, offset, end)
+		}
+		offset = end
+	}
+	return result, nil
+}
+
+// escapeQuotedTextPunctuation returns the text of the given line between the `start` and `end` positions,
+// in which the quote punctuation and index term brackets are escaped if they would start quoted text
+// or an index term when the line is parsed
+func escapeQuotedTextPunctuation(line string, start, end int) string {
+	buff := bytes.NewBuffer(nil)
+	for i := start; i < end; i++ {
+		if (i == 0 || line[i-1] != '\\') && strings.IndexByte("*_`#^~(", line[i]) >= 0 && opensQuotedText(line, i) {
+			buff.WriteByte('\\')
+			if i+1 < end && line[i+1] == line[i] {
+				// double punctuation (eg: `**`) or index term brackets (`((`)
+				buff.WriteByte(line[i])
+				i++
+			}
+		}
+		buff.WriteByte(line[i])
+	}
+	return buff.String()
+}
+
+// opensQuotedText returns `true` if the parsing of the word in which the given position of the line is
+// results in quoted text or an index term which starts exactly at this position
+func opensQuotedText(line string, position int) bool {
+	if line[position] == '(' && !strings.HasPrefix(line[position:], "((") {
+		return false
+	}
+	wordStart := strings.LastIndexAny(line[:position], " \t\n") + 1
+	elements, err := parser.ParseInlineElementsWithSubstitutions(line[wordStart:], types.Substitutions{types.QuotesSubstitution, types.MacrosSubstitution})
+	if err != nil {
+		return false
+	}
+	offset := wordStart
+	for _, element := range elements {
+		if offset == position {
+			switch element.(type) {
+			case types.QuotedText, types.IndexTerm:
+				return true
+			}
+			return false
+		}
+		s, ok := element.(types.StringElement)
+		if !ok {
+			return false
+		}
+		offset += len(s.Content)
+		if offset > position {
+			return false
+		}
+	}
+	return false
+}
+
+// nolint: gocyclo
+func renderInlineElement(ctx *renderer.Context, element interface{}) ([]byte, error) {
+	switch e := element.(type) {
+	case types.StringElement:
+		return []byte(e.Content), nil
+	case types.VerbatimLine:
+		return []byte(e.Content), nil
+	case []interface{}:
+		return renderLine(ctx, types.InlineElements(e))
+	case types.InlineElements:
+		return renderLine(ctx, e)
+	case types.QuotedText:
+		return renderQuotedText(ctx, e, true)
+	case types.Passthrough:
+		return renderPassthrough(ctx, e)
+	case types.InlineImage:
+		return []byte("image:" + e.Path + "[" + renderImageAttributes(e.Path, e.Attributes) + "]"), nil
+	case types.InlineLink:
+		return renderLink(ctx, e)
+	case types.Footnote:
+		return renderFootnote(ctx, e)
+	case types.IndexTerm:
+		if len(e.Terms) == 0 {
+			return nil, nil
+		}
+		if e.Visible {
+			return []byte("((" + e.PrimaryTerm() + "))"), nil
+		}
+		return []byte("(((" + strings.Join(e.Terms, ",") + ")))"), nil
+	case types.CrossReference:
+		if e.Label != "" {
+			return []byte("<<" + e.ID + "," + e.Label + ">>"), nil
+		}
+		return []byte("<<" + e.ID + ">>"), nil
+	case types.DocumentAttributeSubstitution:
+		return []byte("{" + e.Name + "}"), nil
+	case types.CounterSubstitution:
+		return []byte(renderCounterSubstitution(e)), nil
+	case types.InlineAttributeEntry:
+		return []byte(renderInlineAttributeEntry(e)), nil
+	case types.ElementAttributes: // inline element ID
+		return []byte("[[" + e.GetAsString(types.AttrID) + "]]"), nil
+	case types.LineBreak:
+		return []byte(" +"), nil
+	case types.UserMacro:
+		return []byte(e.RawText), nil
+	case types.SingleLineComment:
+		return []byte("//" + e.Content), nil
+	default:
+		return nil, errors.Errorf("unsupported type of inline element: %T", element)
+	}
+}
+
+// isConstrained returns `true` if the element at the given index is not immediately
+// preceded or followed by a word character, in which case the single punctuation
+// can be used to render quoted text
+func isConstrained(elements types.InlineElements, index int) bool {
+	if index > 0 {
+		if s, ok := elements[index-1].(types.StringElement); ok {
+			if r, _ := utf8.DecodeLastRuneInString(s.Content); isWordChar(r) {
+				return false
+			}
+		}
+	}
+	if index < len(elements)-1 {
+		if s, ok := elements[index+1].(types.StringElement); ok {
+			if r, _ := utf8.DecodeRuneInString(s.Content); isWordChar(r) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// protectQuotedTextPunctuation returns the given elements in which the punctuation at the start (or end) of a string
+// which immediately follows (or precedes) quoted text with the same punctuation (eg: `##` before marked text), or at
+// the start (or end) of the content of quoted text with the same punctuation is wrapped in a passthrough, since it
+// would otherwise be parsed as part of the punctuation of the quoted text (eg: `##` + `#a#` would become `###a#`)
+func protectQuotedTextPunctuation(elements types.InlineElements, punctuation string) types.InlineElements {
+	result := make(types.InlineElements, 0, len(elements))
+	for i, element := range elements {
+		s, ok := element.(types.StringElement)
+		if !ok {
+			result = append(result, element)
+			continue
+		}
+		leading := punctuation
+		if i > 0 {
+			leading = adjacentPunctuation(elements[i-1])
+		} else if leading == "" {
+			result = append(result, splitPunctuation(s.Content, "", adjacentPunctuation(next(elements, i)))...)
+			continue
+		}
+		trailing := adjacentPunctuation(next(elements, i))
+		if i == len(elements)-1 && trailing == "" {
+			trailing = punctuation
+		}
+		result = append(result, splitPunctuation(s.Content, leading, trailing)...)
+	}
+	return result
+}
+
+// next returns the element following the given index, or `nil` if there is none
+func next(elements types.InlineElements, i int) interface{} {
+	if i+1 < len(elements) {
+		return elements[i+1]
+	}
+	return nil
+}
+
+// adjacentPunctuation returns the single punctuation of the given element if it is quoted text (eg: `#`)
+func adjacentPunctuation(element interface{}) string {
+	if t, ok := element.(types.QuotedText); ok {
+		return quotedTextPunctuation(t.Kind)
+	}
+	return ""
+}
+
+// splitPunctuation splits the given content so that the run of `leading` punctuation at its start
+// and the run of `trailing` punctuation at its end are in single plus passthroughs (eg: `+##+`)
+func splitPunctuation(content, leading, trailing string) types.InlineElements {
+	result := types.InlineElements{}
+	if leading != "" {
+		if rest := strings.TrimLeft(content, leading); len(rest) < len(content) {
+			result = append(result, punctuationPassthrough(content[:len(content)-len(rest)]))
+			content = rest
+		}
+	}
+	var suffix types.InlineElements
+	if trailing != "" {
+		if rest := strings.TrimRight(content, trailing); len(rest) < len(content) {
+			suffix = types.InlineElements{punctuationPassthrough(content[len(rest):])}
+			content = rest
+		}
+	}
+	if content != "" {
+		result = append(result, types.StringElement{Content: content})
+	}
+	return append(result, suffix...)
+}
+
+func punctuationPassthrough(punctuation string) types.Passthrough {
+	return types.Passthrough{
+		Kind:     types.SinglePlusPassthrough,
+		Elements: types.InlineElements{types.StringElement{Content: punctuation}},
+	}
+}
+
+// quotedTextPunctuation returns the single punctuation of the given kind of quoted text,
+// or an empty string if it has no constrained form
+func quotedTextPunctuation(kind types.QuotedTextKind) string {
+	switch kind {
+	case types.Bold:
+		return "*"
+	case types.Italic:
+		return "_"
+	case types.Monospace:
+		return "`"
+	case types.Marked:
+		return "#"
+	default:
+		return ""
+	}
+}
+
+func isWordChar(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+func renderQuotedText(ctx *renderer.Context, t types.QuotedText, constrained bool) ([]byte, error) {
+	content, err := renderLine(ctx, protectQuotedTextPunctuation(t.Elements, quotedTextPunctuation(t.Kind)))
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render quoted text")
+	}
+	role := ""
+	if t.Attributes.Has(types.AttrRole) {
+		role = "[." + strings.Replace(t.Attributes.GetAsString(types.AttrRole), " ", ".", -1) + "]"
+	}
+	switch t.Kind {
+	case types.Subscript:
+		return []byte(role + "~" + string(content) + "~"), nil
+	case types.Superscript:
+		return []byte(role + "^" + string(content) + "^"), nil
+	case types.DoubleCurvedQuote:
+		return []byte(role + "\"`" + string(content) + "`\""), nil
+	case types.SingleCurvedQuote:
+		return []byte(role + "'`" + string(content) + "`'"), nil
+	}
+	punctuation := quotedTextPunctuation(t.Kind)
+	if punctuation == "" {
+		return nil, errors.Errorf("unsupported quoted text kind: '%v'", t.Kind)
+	}
+	if !constrained || nestsQuotedText(t) {
+		punctuation = punctuation + punctuation
+	}
+	return []byte(role + punctuation + string(content) + punctuation), nil
+}
+
+// nestsQuotedText returns `true` if the content of the given quoted text starts or ends with
+// quoted text of the same kind (eg: `#` + `#a#` + `#`), in which case the single punctuation is ambiguous
+func nestsQuotedText(t types.QuotedText) bool {
+	if len(t.Elements) == 0 {
+		return false
+	}
+	for _, element := range []interface{}{t.Elements[0], t.Elements[len(t.Elements)-1]} {
+		if n, ok := element.(types.QuotedText); ok && n.Kind == t.Kind {
+			return true
+		}
+	}
+	return false
+}
+
+func renderCounterSubstitution(c types.CounterSubstitution) string {
+	name := "counter:" + c.Name
+	if c.Hidden {
+		name = "counter2:" + c.Name
+	}
+	switch v := c.Value.(type) {
+	case int:
+		name += ":" + strconv.Itoa(v)
+	case rune:
+		name += ":" + string(v)
+	}
+	return "{" + name + "}"
+}
+
+func renderInlineAttributeEntry(e types.InlineAttributeEntry) string {
+	switch {
+	case e.Unset:
+		return "{set:" + e.Name + "!}"
+	case e.Value != "":
+		return "{set:" + e.Name + ":" + e.Value + "}"
+	default:
+		return "{set:" + e.Name + "}"
+	}
+}
+
+func renderPassthrough(ctx *renderer.Context, p types.Passthrough) ([]byte, error) {
+	content, err := renderRawLine(ctx, p.Elements)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render passthrough")
+	}
+	switch p.Kind {
+	case types.SinglePlusPassthrough:
+		return []byte("+" + string(content) + "+"), nil
+	case types.TriplePlusPassthrough:
+		return []byte("+++" + string(content) + "+++"), nil
+	default:
+		if p.Attributes.Has(types.AttrSubstitutions) {
+			return []byte("pass:" + p.Attributes.GetAsString(types.AttrSubstitutions) + "[" + string(content) + "]"), nil
+		}
+		for _, e := range p.Elements {
+			if _, ok := e.(types.StringElement); !ok {
+				return []byte("pass:q[" + string(content) + "]"), nil
+			}
+		}
+		return []byte("pass:[" + string(content) + "]"), nil
+	}
+}
+
+func renderLink(ctx *renderer.Context, l types.InlineLink) ([]byte, error) {
+	location := renderLocation(l.Location)
+	text := ""
+	if t, ok := l.Attributes[types.AttrInlineLinkText].(types.InlineElements); ok {
+		renderedText, err := renderLine(ctx, t)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render link")
+		}
+		text = string(renderedText)
+	}
+	otherAttrs := renderGenericAttributes(l.Attributes, types.AttrInlineLinkText)
+	if otherAttrs != "" {
+		if strings.Contains(text, ",") {
+			text = `"` + text + `"`
+		}
+		text += "," + otherAttrs
+	}
+	if !hasURLScheme(location) {
+		return []byte("link:" + location + "[" + text + "]"), nil
+	}
+	if text == "" {
+		// bare URL
+		return []byte(location), nil
+	}
+	return []byte(location + "[" + text + "]"), nil
+}
+
+// isBareLink returns `true` if the given link is a URL without any text or attribute (eg: `https://example.com`),
+// which is parsed up to the end of its line
+func isBareLink(l types.InlineLink) bool {
+	return len(l.Attributes) == 0 && hasURLScheme(renderLocation(l.Location))
+}
+
+// followedByContent returns `true` if the element at the given index is followed by some content on the same line
+func followedByContent(elements types.InlineElements, i int) bool {
+	if i+1 >= len(elements) {
+		return false
+	}
+	if s, ok := elements[i+1].(types.StringElement); ok {
+		return strings.TrimLeft(s.Content, " \t") != "" && !strings.HasPrefix(s.Content, "\n")
+	}
+	return true
+}
+
+func renderLocation(l types.Location) string {
+	result := bytes.NewBuffer(nil)
+	for _, e := range l {
+		switch e := e.(type) {
+		case types.DocumentAttributeSubstitution:
+			result.WriteString("{" + e.Name + "}")
+		case types.StringElement:
+			result.WriteString(e.Content)
+		}
+	}
+	return result.String()
+}
+
+func hasURLScheme(location string) bool {
+	for _, scheme := range []string{"http://", "https://", "ftp://", "irc://", "mailto:"} {
+		if strings.HasPrefix(location, scheme) {
+			return true
+		}
+	}
+	return false
+}
+
+func renderFootnote(ctx *renderer.Context, f types.Footnote) ([]byte, error) {
+	content, err := renderLine(ctx, f.Elements)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render footnote")
+	}
+	switch {
+	case f.Ref == "":
+		return []byte("footnote:[" + string(content) + "]"), nil
+	case len(f.Elements) == 0:
+		return []byte("footnoteref:[" + f.Ref + "]"), nil
+	default:
+		return []byte("footnoteref:[" + f.Ref + "," + string(content) + "]"), nil
+	}
+}
@@ -0,0 +1,151 @@
+package asciidoc
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+func renderUnorderedList(ctx *renderer.Context, l types.UnorderedList) ([]byte, error) {
+	log.Debugf("rendering unordered list with %d item(s)", len(l.Items))
+	result := bytes.NewBuffer(nil)
+	result.WriteString(renderElementAttributes(l.Attributes, ""))
+	for i, item := range l.Items {
+		if i > 0 {
+			result.WriteString("\n")
+		}
+		marker := strings.Repeat("*", item.Level)
+		switch item.CheckStyle {
+		case types.Checked:
+			marker += " [x]"
+		case types.Unchecked:
+			marker += " [ ]"
+		}
+		renderedItem, err := renderListItem(ctx, marker+" ", item.Elements)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render unordered list")
+		}
+		result.Write(renderedItem)
+	}
+	return result.Bytes(), nil
+}
+
+func renderOrderedList(ctx *renderer.Context, l types.OrderedList) ([]byte, error) {
+	log.Debugf("rendering ordered list with %d item(s)", len(l.Items))
+	result := bytes.NewBuffer(nil)
+	var style string
+	if numberingStyle, ok := l.Attributes[types.AttrNumberingStyle].(string); ok {
+		style = numberingStyle
+	} else if len(l.Items) > 0 && l.Items[0].NumberingStyle != defaultNumberingStyle(l.Items[0].Level) {
+		style = string(l.Items[0].NumberingStyle)
+	}
+	result.WriteString(renderElementAttributes(l.Attributes, style))
+	for i, item := range l.Items {
+		if i > 0 {
+			result.WriteString("\n")
+		}
+		renderedItem, err := renderListItem(ctx, strings.Repeat(".", item.Level)+" ", item.Elements)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render ordered list")
+		}
+		result.Write(renderedItem)
+	}
+	return result.Bytes(), nil
+}
+
+// defaultNumberingStyle returns the numbering style which applies by default
+// to the items of an ordered list at the given level
+func defaultNumberingStyle(level int) types.NumberingStyle {
+	switch level {
+	case 1:
+		return types.Arabic
+	case 2:
+		return types.LowerAlpha
+	case 3:
+		return types.LowerRoman
+	case 4:
+		return types.UpperAlpha
+	default:
+		return types.UpperRoman
+	}
+}
+
+func renderLabeledList(ctx *renderer.Context, l types.LabeledList) ([]byte, error) {
+	log.Debugf("rendering labeled list with %d item(s)", len(l.Items))
+	result := bytes.NewBuffer(nil)
+	var style string
+	if layout, ok := l.Attributes["layout"].(string); ok {
+		style = layout
+	}
+	result.WriteString(renderElementAttributes(l.Attributes, style))
+	for i, item := range l.Items {
+		if i > 0 {
+			result.WriteString("\n")
+		}
+		renderedItem, err := renderListItem(ctx, strings.TrimSpace(item.Term)+strings.Repeat(":", item.Level+1)+" ", item.Elements)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render labeled list")
+		}
+		result.Write(bytes.TrimRight(renderedItem, " "))
+	}
+	return result.Bytes(), nil
+}
+
+// renderListItem renders the given marker followed by the elements of a list item:
+// the first paragraph is rendered on the same line as the marker, nested lists are
+// rendered on the following lines and all other elements are attached with a list item continuation (`+`)
+func renderListItem(ctx *renderer.Context, marker string, elements []interface{}) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	result.WriteString(marker)
+	attached := false // true if the last rendered element was attached with a list item continuation
+	for i, element := range elements {
+		switch element := element.(type) {
+		case types.BlankLine:
+			continue
+		case types.Paragraph:
+			if i == 0 {
+				// first paragraph is rendered on the same line as the marker
+				renderedElement, err := renderParagraph(ctx, element)
+				if err != nil {
+					return nil, errors.Wrapf(err, "unable to render list item")
+				}
+				result.Write(renderedElement)
+				continue
+			}
+		case types.UnorderedList, types.OrderedList, types.LabeledList:
+			renderedElement, err := renderElement(ctx, element)
+			if err != nil {
+				return nil, errors.Wrapf(err, "unable to render list item")
+			}
+			trimTrailingSpaces(result)
+			if attached {
+				// a blank line is needed, otherwise the nested list would be
+				// parsed as the continuation of the attached paragraph
+				result.WriteString("\n")
+			}
+			result.WriteString("\n")
+			result.Write(renderedElement)
+			attached = false
+			continue
+		}
+		renderedElement, err := renderElement(ctx, element)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render list item")
+		}
+		trimTrailingSpaces(result)
+		result.WriteString("\n+\n")
+		result.Write(renderedElement)
+		attached = true
+	}
+	return result.Bytes(), nil
+}
+
+// trimTrailingSpaces removes the trailing spaces of the given buffer (eg: after the marker
+// of a list item which is not followed by a paragraph on the same line)
+func trimTrailingSpaces(result *bytes.Buffer) {
+	result.Truncate(len(bytes.TrimRight(result.Bytes(), " ")))
+}
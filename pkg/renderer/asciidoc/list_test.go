@@ -0,0 +1,114 @@
+package asciidoc_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("lists", func() {
+
+	It("unordered list with normalized markers", func() {
+		source := `- item 1
+- [x] checked item
+- [ ] unchecked item
+** nested item
+*** nested item`
+		expected := `* item 1
+* [x] checked item
+* [ ] unchecked item
+** nested item
+*** nested item
+`
+		Expect(source).To(RenderAsciidoc(expected))
+	})
+
+	It("ordered list with custom numbering", func() {
+		source := `[upperroman]
+. item 1
+.. item 1.1
+. item 2`
+		expected := `[upperroman]
+. item 1
+.. item 1.1
+. item 2
+`
+		Expect(source).To(RenderAsciidoc(expected))
+	})
+
+	It("ordered list with explicit numbering", func() {
+		source := `a. item a
+b. item b`
+		expected := `[loweralpha]
+. item a
+. item b
+`
+		Expect(source).To(RenderAsciidoc(expected))
+	})
+
+	It("labeled list with horizontal layout", func() {
+		source := `[horizontal]
+item 1:: description 1
+item 2::
+description 2`
+		expected := `[horizontal]
+item 1:: description 1
+item 2:: description 2
+`
+		Expect(source).To(RenderAsciidoc(expected))
+	})
+
+	It("list item with continuation", func() {
+		source := `* item 1
++
+----
+some code
+----
+* item 2`
+		expected := `* item 1
++
+----
+some code
+----
+* item 2
+`
+		Expect(source).To(RenderAsciidoc(expected))
+	})
+
+	It("labeled list item without paragraph", func() {
+		source := `Term::
++
+----
+some code
+----
+Other term:: description`
+		expected := `Term::
++
+----
+some code
+----
+Other term:: description
+`
+		Expect(source).To(RenderAsciidoc(expected))
+	})
+
+	It("nested list after list item continuation", func() {
+		source := `* level 1
++
+continued para.
+
+** level 2
+* other`
+		expected := `* level 1
++
+continued para.
+
+** level 2
+* other
+`
+		Expect(source).To(RenderAsciidoc(expected))
+		// formatting the formatted content does not change it
+		Expect(expected).To(RenderAsciidoc(expected))
+	})
+})
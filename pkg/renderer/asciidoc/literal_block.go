@@ -0,0 +1,30 @@
+package asciidoc
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+)
+
+func renderLiteralBlock(ctx *renderer.Context, b types.LiteralBlock) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	switch b.Attributes.GetAsString(types.AttrLiteralBlockType) {
+	case types.LiteralBlockWithSpacesOnFirstLine:
+		result.WriteString(renderElementAttributes(b.Attributes, ""))
+		result.WriteString(strings.Join(b.Lines, "\n"))
+	case types.LiteralBlockWithAttribute:
+		result.WriteString(renderElementAttributes(b.Attributes, "literal"))
+		result.WriteString(strings.Join(b.Lines, "\n"))
+	default:
+		result.WriteString(renderElementAttributes(b.Attributes, ""))
+		result.WriteString("....\n")
+		if len(b.Lines) > 0 {
+			result.WriteString(strings.Join(b.Lines, "\n"))
+			result.WriteString("\n")
+		}
+		result.WriteString("....")
+	}
+	return result.Bytes(), nil
+}
@@ -0,0 +1,76 @@
+package asciidoc
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+)
+
+func renderVideoBlock(ctx *renderer.Context, v types.VideoBlock) ([]byte, error) {
+	return []byte(renderElementAttributesWithout(v.Attributes, mediaAttributeKeys(v.Attributes, types.AttrVideoPoster, types.AttrVideoWidth, types.AttrVideoHeight)...) +
+		"video::" + v.Path + "[" + renderVideoAttributes(v.Attributes) + "]"), nil
+}
+
+func renderAudioBlock(ctx *renderer.Context, a types.AudioBlock) ([]byte, error) {
+	return []byte(renderElementAttributesWithout(a.Attributes, mediaAttributeKeys(a.Attributes)...) +
+		"audio::" + a.Path + "[" + renderMediaAttributes(a.Attributes) + "]"), nil
+}
+
+// renderVideoAttributes renders the positional `poster`, `width` and `height` attributes of a video,
+// followed by the other attributes.
+func renderVideoAttributes(attrs types.ElementAttributes) string {
+	positionals := []string{}
+	poster := attrs.GetAsString(types.AttrVideoPoster)
+	width := attrs.GetAsString(types.AttrVideoWidth)
+	height := attrs.GetAsString(types.AttrVideoHeight)
+	switch {
+	case height != "":
+		positionals = append(positionals, poster, width, height)
+	case width != "":
+		positionals = append(positionals, poster, width)
+	case poster != "":
+		positionals = append(positionals, poster)
+	}
+	result := strings.Join(positionals, ",")
+	if others := renderMediaAttributes(attrs, types.AttrVideoPoster, types.AttrVideoWidth, types.AttrVideoHeight); others != "" {
+		if result != "" {
+			result += ","
+		}
+		result += others
+	}
+	return result
+}
+
+// renderMediaAttributes renders the generic attributes of a video or an audio within its macro,
+// with its options (eg: `%autoplay`) grouped in an `opts` attribute
+func renderMediaAttributes(attrs types.ElementAttributes, skip ...string) string {
+	opts := []string{}
+	for k := range attrs {
+		if strings.HasPrefix(k, "%") {
+			opts = append(opts, strings.TrimPrefix(k, "%"))
+			skip = append(skip, k)
+		}
+	}
+	result := renderGenericAttributes(attrs, skip...)
+	if len(opts) > 0 {
+		sort.Strings(opts)
+		if result != "" {
+			result += ","
+		}
+		result += renderGenericAttribute(types.AttrOpts, strings.Join(opts, ","))
+	}
+	return result
+}
+
+// mediaAttributeKeys returns the keys of the attributes which are rendered within the macro of a video
+// or an audio, ie, all attributes except the ID, role and title, which are rendered above the macro
+func mediaAttributeKeys(attrs types.ElementAttributes, keys ...string) []string {
+	for k := range attrs {
+		if !nonGenericAttributes[k] {
+			keys = append(keys, k)
+		}
+	}
+	return keys
+}
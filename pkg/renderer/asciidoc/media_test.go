@@ -0,0 +1,29 @@
+package asciidoc_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("videos and audios", func() {
+
+	It("video with positional attributes and options", func() {
+		source := `[#demo]
+.Product demo
+video::rPQoq7ThGAU[youtube, 640, 360, start=10,options="loop,autoplay"]`
+		expected := `[#demo]
+.Product demo
+video::rPQoq7ThGAU[youtube,640,360,start=10,opts="autoplay,loop"]
+`
+		Expect(source).To(RenderAsciidoc(expected))
+	})
+
+	It("audio with options", func() {
+		source := `audio::clip.mp3[opts=nocontrols]`
+		expected := `audio::clip.mp3[opts=nocontrols]
+`
+		Expect(source).To(RenderAsciidoc(expected))
+	})
+})
@@ -0,0 +1,213 @@
+package asciidoc
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+func renderParagraph(ctx *renderer.Context, p types.Paragraph) ([]byte, error) {
+	if len(p.Lines) == 0 {
+		return []byte{}, nil
+	}
+	result := bytes.NewBuffer(nil)
+	kind, _ := p.Attributes[types.AttrKind].(types.BlockKind)
+	var style string
+	switch kind {
+	case types.Source:
+		style = renderSourceStyle(p.Attributes)
+	case types.Quote, types.Verse:
+		style = renderQuoteStyle(kind, p.Attributes)
+	case types.Listing, types.Comment, types.Example, types.Sidebar, types.Abstract, types.PartIntro, types.Open:
+		style = string(kind)
+	}
+	result.WriteString(renderElementAttributes(p.Attributes, style))
+	if k := renderAdmonitionStyle(p.Attributes); k != "" {
+		result.WriteString(k + ": ")
+	}
+	lines, err := renderParagraphLines(ctx, p)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render paragraph")
+	}
+	result.Write(lines)
+	return result.Bytes(), nil
+}
+
+// renderParagraphLines renders the lines of the given paragraph, with one sentence per line,
+// unless the line breaks are significant (eg: in verse and source paragraphs, or in paragraphs with hard breaks)
+func renderParagraphLines(ctx *renderer.Context, p types.Paragraph) ([]byte, error) {
+	if !canReflow(ctx, p) {
+		switch p.Attributes[types.AttrKind] {
+		case types.Source, types.Listing, types.Comment:
+			return renderRawLines(ctx, p.Lines)
+		}
+		if p.Attributes.Has(types.AttrSubstitutions) {
+			return renderRawLines(ctx, p.Lines)
+		}
+		lines, err := renderLines(ctx, p.Lines)
+		if err != nil {
+			return nil, err
+		}
+		return escapeHeadingLines(lines), nil
+	}
+	// join all lines into a single one, then render it with one sentence per line
+	// (but a line ending with a bare URL is never joined with the next one, since the URL would include it)
+	elements := []interface{}{}
+	for i, line := range p.Lines {
+		line = trimLine(line)
+		if i > 0 {
+			if endsWithBareLink(elements) {
+				elements = append(elements, types.StringElement{Content: "\n"})
+			} else {
+				elements = append(elements, types.StringElement{Content: " "})
+			}
+		}
+		elements = append(elements, line...)
+	}
+	joined, err := types.NewInlineElements(elements...)
+	if err != nil {
+		return nil, err
+	}
+	joined = protectQuotedTextPunctuation(joined, "")
+	renderedElements, err := renderLineElements(ctx, joined, true)
+	if err != nil {
+		return nil, err
+	}
+	buff := bytes.NewBuffer(nil)
+	for i, element := range joined {
+		if _, ok := element.(types.StringElement); ok {
+			buff.WriteString(splitSentences(renderedElements[i], i > 0))
+			continue
+		}
+		buff.WriteString(renderedElements[i])
+	}
+	return escapeHeadingLines([]byte(strings.TrimRight(buff.String(), " \t"))), nil
+}
+
+// a line which would be parsed as a Markdown-style heading (eg: `## title`)
+var headingLine = regexp.MustCompile(`(?m)^(#{1,6}[ \t])`)
+
+// escapeHeadingLines prefixes the lines which start with marked text (or with `#` characters) and which would
+// be parsed as a Markdown-style heading with an `{empty}` attribute, so they remain in the paragraph
+func escapeHeadingLines(lines []byte) []byte {
+	return headingLine.ReplaceAll(lines, []byte("{empty}$1"))
+}
+
+func canReflow(ctx *renderer.Context, p types.Paragraph) bool {
+	if ctx.HardBreaks(p.Attributes) || p.Attributes.Has(types.AttrSubstitutions) {
+		// lines of a paragraph with custom substitutions are kept as-is
+		return false
+	}
+	switch p.Attributes[types.AttrKind] {
+	case types.Source, types.Verse, types.Listing, types.Comment:
+		return false
+	}
+	for _, line := range p.Lines {
+		for _, element := range line {
+			switch element.(type) {
+			case types.LineBreak, types.SingleLineComment:
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// endsWithBareLink returns `true` if the last of the given elements is a bare URL
+func endsWithBareLink(elements []interface{}) bool {
+	if len(elements) == 0 {
+		return false
+	}
+	l, ok := elements[len(elements)-1].(types.InlineLink)
+	return ok && isBareLink(l)
+}
+
+// trimLine removes the heading and trailing spaces of the given line
+func trimLine(line types.InlineElements) types.InlineElements {
+	if len(line) == 0 {
+		return line
+	}
+	result := make(types.InlineElements, len(line))
+	copy(result, line)
+	if s, ok := result[0].(types.StringElement); ok {
+		result[0] = types.StringElement{Content: strings.TrimLeft(s.Content, " \t")}
+	}
+	if s, ok := result[len(result)-1].(types.StringElement); ok {
+		result[len(result)-1] = types.StringElement{Content: strings.TrimRight(s.Content, " \t")}
+	}
+	return result
+}
+
+// the start of a line which would be parsed as something else than a paragraph line,
+// such as an ordered list item (eg: `A.` or `IV)`) or an admonition paragraph (eg: `NOTE:`)
+var unsafeLineStart = regexp.MustCompile(`^(([A-Z]\.)|([A-Z]+\))|(TIP|NOTE|IMPORTANT|WARNING|CAUTION):)(\s|$)`)
+
+// splitSentences replaces the spaces between the end of a sentence (`.`, `?` or `!`) and the beginning
+// of the next one (an upper case letter) with a newline, unless the last word before the punctuation is
+// a single letter (eg: an initial) or an abbreviation, or if the new line would not be parsed as part of
+// the same paragraph. If `afterElement` is true, then the content immediately follows another inline
+// element (eg: quoted text), which is considered as the last word if the content starts with a punctuation.
+func splitSentences(content string, afterElement bool) string {
+	buff := bytes.NewBuffer(nil)
+	wordStart := 0
+	for i := 0; i < len(content); {
+		r, size := utf8.DecodeRuneInString(content[i:])
+		if r == ' ' || r == '\t' {
+			wordStart = i + size
+		}
+		buff.WriteRune(r)
+		i += size
+		if r != '.' && r != '?' && r != '!' {
+			continue
+		}
+		// look-up the following spaces and next character
+		j := i
+		for j < len(content) && (content[j] == ' ' || content[j] == '\t') {
+			j++
+		}
+		if j == i || j == len(content) {
+			continue
+		}
+		next, _ := utf8.DecodeRuneInString(content[j:])
+		word := content[wordStart : i-1]
+		if !unicode.IsUpper(next) || (isAbbreviation(word) && !(afterElement && wordStart == 0 && word == "")) || unsafeLineStart.MatchString(content[j:]) {
+			continue
+		}
+		buff.WriteString("\n")
+		i = j
+		wordStart = j
+	}
+	return buff.String()
+}
+
+// common abbreviations which are followed by a period but which do not end a sentence
+var abbreviations = map[string]bool{
+	"Mr":   true,
+	"Mrs":  true,
+	"Ms":   true,
+	"Dr":   true,
+	"Prof": true,
+	"Sr":   true,
+	"Jr":   true,
+	"St":   true,
+	"vs":   true,
+}
+
+// isAbbreviation returns `true` if the given word is a single letter (eg: an initial),
+// contains a period (eg: `e.g`) or is a known abbreviation
+func isAbbreviation(word string) bool {
+	return utf8.RuneCountInString(word) < 2 || strings.Contains(word, ".") || abbreviations[word]
+}
+
+func renderSourceStyle(attrs types.ElementAttributes) string {
+	if language := attrs.GetAsString(types.AttrLanguage); language != "" {
+		return "source," + language
+	}
+	return "source"
+}
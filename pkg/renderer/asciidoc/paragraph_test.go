@@ -0,0 +1,231 @@
+package asciidoc_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("paragraphs", func() {
+
+	It("one sentence per line", func() {
+		source := `First sentence with *bold content*. Second sentence!   Third
+sentence? fourth part.`
+		expected := `First sentence with *bold content*.
+Second sentence!
+Third sentence? fourth part.
+`
+		Expect(source).To(RenderAsciidoc(expected))
+	})
+
+	It("no line break after initials and abbreviations", func() {
+		source := `Mr. John F. Doe wrote e.g. this. Then he left.`
+		expected := `Mr. John F. Doe wrote e.g. this.
+Then he left.
+`
+		Expect(source).To(RenderAsciidoc(expected))
+	})
+
+	It("no line break before an ordered list item or admonition marker", func() {
+		source := `Look at section A. B. is next. NOTE: this is not an admonition.`
+		expected := `Look at section A. B. is next. NOTE: this is not an admonition.
+`
+		Expect(source).To(RenderAsciidoc(expected))
+	})
+
+	It("with normalized constrained and unconstrained quoted text", func() {
+		source := `some **bold** and __italic__ and **b**old and H~2~O`
+		expected := `some *bold* and _italic_ and **b**old and H~2~O
+`
+		Expect(source).To(RenderAsciidoc(expected))
+	})
+
+	It("with marked text, roles and curved quotes", func() {
+		source := `some ##marked## and ##mark##ed, [.line-through]#deleted# and [.big.red]*bold* with "` + "`double`" + `" and '` + "`single`" + `' quotes`
+		expected := `some #marked# and ##mark##ed, [.line-through]#deleted# and [.big.red]*bold* with "` + "`double`" + `" and '` + "`single`" + `' quotes
+`
+		Expect(source).To(RenderAsciidoc(expected))
+	})
+
+	It("with counters", func() {
+		source := `step {counter:step}, {counter2:letter:A}{counter:letter} and {counter:index:10}`
+		expected := `step {counter:step}, {counter2:letter:A}{counter:letter} and {counter:index:10}
+`
+		Expect(source).To(RenderAsciidoc(expected))
+	})
+
+	It("with hard breaks", func() {
+		source := `first line. +
+Second line. Same line.`
+		expected := `first line. +
+Second line. Same line.
+`
+		Expect(source).To(RenderAsciidoc(expected))
+	})
+
+	It("with hardbreaks option and document attribute", func() {
+		source := `[options="hardbreaks"]
+first line. Same line.
+second line.
+
+:hardbreaks:
+
+third line. Same line.
+fourth line.`
+		expected := `[%hardbreaks]
+first line. Same line.
+second line.
+
+:hardbreaks:
+
+third line. Same line.
+fourth line.
+`
+		Expect(source).To(RenderAsciidoc(expected))
+	})
+
+	It("with id, title and role", func() {
+		source := `[#foo]
+[.bar]
+.a title
+some content.`
+		expected := `[#foo]
+[.bar]
+.a title
+some content.
+`
+		Expect(source).To(RenderAsciidoc(expected))
+	})
+
+	It("admonition paragraph", func() {
+		source := `[NOTE]
+some content`
+		expected := `NOTE: some content
+`
+		Expect(source).To(RenderAsciidoc(expected))
+	})
+
+	It("verse paragraph", func() {
+		source := `[verse,john doe,verse title]
+I am a verse paragraph. With
+two lines`
+		expected := `[verse, john doe, verse title]
+I am a verse paragraph. With
+two lines
+`
+		Expect(source).To(RenderAsciidoc(expected))
+	})
+
+	It("with links, cross-references and footnotes", func() {
+		source := `see link:foo.html[the docs], https://example.com and https://example.com[example, window=_blank] or <<foo,here>> footnote:[a note]`
+		expected := `see link:foo.html[the docs], https://example.com and https://example.com[example,window=_blank] or <<foo,here>> footnote:[a note]
+`
+		Expect(source).To(RenderAsciidoc(expected))
+	})
+
+	It("not joined after a bare URL at the end of a line", func() {
+		source := `See https://example.com
+for details. Another sentence.`
+		expected := `See https://example.com
+for details.
+Another sentence.
+`
+		Expect(source).To(RenderAsciidoc(expected))
+	})
+
+	It("with a link without text followed by other content", func() {
+		source := `Point it at link:http://localhost:8080[]. Requests are handled.`
+		expected := `Point it at http://localhost:8080[].
+Requests are handled.
+`
+		Expect(source).To(RenderAsciidoc(expected))
+	})
+
+	It("with escaped quoted text and index terms", func() {
+		source := "\\*not bold* and \\**not bold** and \\_not italic_ and \\`not mono` and \\#not marked# and \\((not a term)) but *bold*"
+		expected := "\\*not bold* and \\**not bold** and \\_not italic_ and \\`not mono` and \\#not marked# and \\((not a term)) but *bold*\n"
+		Expect(source).To(RenderAsciidoc(expected))
+	})
+
+	It("with escaped quoted text containing quoted text", func() {
+		source := `\*not bold but _italic_*`
+		expected := `\*not bold but _italic_*
+`
+		Expect(source).To(RenderAsciidoc(expected))
+	})
+
+	It("with quote punctuation which does not need to be escaped", func() {
+		source := `a * b * c and 1 _ 2`
+		expected := `a * b * c and 1 _ 2
+`
+		Expect(source).To(RenderAsciidoc(expected))
+	})
+
+	It("with marked text surrounded by hash characters at the start of a line", func() {
+		source := `####### too many`
+		expected := `+##+#+#+# too many
+`
+		Expect(source).To(RenderAsciidoc(expected))
+	})
+
+	It("with nested marked text at the start of a line", func() {
+		source := `######## too many`
+		expected := `###+##+### too many
+`
+		Expect(source).To(RenderAsciidoc(expected))
+	})
+
+	It("with hard breaks and a line starting with hash characters", func() {
+		source := `[%hardbreaks]
+some text
+## too many`
+		expected := `[%hardbreaks]
+some text
+{empty}## too many
+`
+		Expect(source).To(RenderAsciidoc(expected))
+	})
+
+	It("paragraph with custom substitutions", func() {
+		source := `[subs="-quotes, +macros"]
+some *content* with pass:q,a[*{foo}*]. Another sentence. +
+on 2 lines`
+		expected := `[subs="-quotes, +macros"]
+some *content* with pass:q,a[*{foo}*]. Another sentence. +
+on 2 lines
+`
+		Expect(source).To(RenderAsciidoc(expected))
+	})
+
+	It("source paragraph with custom substitutions", func() {
+		source := `[source,go,subs="verbatim,quotes"]
+*package* main`
+		expected := `[source,go]
+[subs="verbatim,quotes"]
+*package* main
+`
+		Expect(source).To(RenderAsciidoc(expected))
+	})
+
+	It("with inline attribute entries", func() {
+		source := `{set:foo:bar}{set:baz}{set:foo!}a {foo} b`
+		expected := `{set:foo:bar}{set:baz}{set:foo!}a {foo} b
+`
+		Expect(source).To(RenderAsciidoc(expected))
+	})
+
+	It("with index terms", func() {
+		source := `some ((big cats)) and indexterm2:[lions] indexterm:[tigers, big cats]here`
+		expected := `some ((big cats)) and ((lions)) (((tigers,big cats)))here
+`
+		Expect(source).To(RenderAsciidoc(expected))
+	})
+
+	It("with blank index terms", func() {
+		source := `some (((,))) and ((( , ))) and indexterm:[,] here`
+		expected := `some (((,))) and ((( , ))) and indexterm:[,] here
+`
+		Expect(source).To(RenderAsciidoc(expected))
+	})
+})
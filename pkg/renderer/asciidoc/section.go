@@ -0,0 +1,99 @@
+package asciidoc
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+func renderSection(ctx *renderer.Context, s types.Section) ([]byte, error) {
+	log.Debugf("rendering section level %d", s.Level)
+	result := bytes.NewBuffer(nil)
+	result.WriteString(renderElementAttributes(s.Attributes, ""))
+	title, err := renderLine(ctx, s.Title)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render section title")
+	}
+	result.WriteString(strings.Repeat("=", s.Level+1))
+	result.WriteString(" ")
+	result.Write(bytes.TrimSpace(title))
+	elements := s.Elements
+	if s.Level == 0 {
+		// the authors, revision and attribute declarations which immediately
+		// follow the title belong to the document header, so they must
+		// not be separated by a blank line
+		if authors, ok := s.Attributes[types.AttrAuthors].([]types.DocumentAuthor); ok && len(authors) > 0 {
+			result.WriteString("\n")
+			result.WriteString(renderAuthors(authors))
+		}
+		if revision, ok := s.Attributes[types.AttrRevision].(types.DocumentRevision); ok {
+			result.WriteString("\n")
+			result.WriteString(renderRevision(revision))
+		}
+		for len(elements) > 0 && isAttributeDeclaration(elements[0]) {
+			renderedAttribute, err := renderElement(ctx, elements[0])
+			if err != nil {
+				return nil, errors.Wrapf(err, "unable to render document header")
+			}
+			result.WriteString("\n")
+			result.Write(renderedAttribute)
+			elements = elements[1:]
+		}
+	}
+	renderedElements, err := renderElements(ctx, elements)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render section elements")
+	}
+	if len(renderedElements) > 0 {
+		result.WriteString("\n\n")
+		result.Write(renderedElements)
+	}
+	return result.Bytes(), nil
+}
+
+func renderDiscreteHeading(ctx *renderer.Context, h types.DiscreteHeading) ([]byte, error) {
+	log.Debugf("rendering discrete heading level %d", h.Level)
+	result := bytes.NewBuffer(nil)
+	// the `discrete` (or `float`) style is rendered along with the other attributes
+	result.WriteString(renderElementAttributes(h.Attributes, ""))
+	title, err := renderLine(ctx, h.Title)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render discrete heading title")
+	}
+	result.WriteString(strings.Repeat("=", h.Level+1))
+	result.WriteString(" ")
+	result.Write(bytes.TrimSpace(title))
+	return result.Bytes(), nil
+}
+
+func renderAuthors(authors []types.DocumentAuthor) string {
+	result := make([]string, len(authors))
+	for i, author := range authors {
+		result[i] = strings.TrimSpace(author.FullName)
+		if author.Email != "" {
+			result[i] += " <" + strings.TrimSpace(author.Email) + ">"
+		}
+	}
+	return strings.Join(result, "; ")
+}
+
+func renderRevision(revision types.DocumentRevision) string {
+	result := ""
+	if revision.Revnumber != "" {
+		result = "v" + revision.Revnumber
+	}
+	if revision.Revdate != "" {
+		if result != "" {
+			result += ", "
+		}
+		result += revision.Revdate
+	}
+	if revision.Revremark != "" {
+		result += ": " + revision.Revremark
+	}
+	return result
+}
@@ -0,0 +1,70 @@
+package asciidoc_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("sections", func() {
+
+	It("document header and sections", func() {
+		source := `= a document title
+John Doe <john@example.com>; Jane Doe
+v1.0, 2019-01-01: a remark
+:toc:
+:author-note: some note
+
+
+==   section 1
+content
+
+
+[#custom-id]
+=== section 1.1
+
+content`
+		expected := `= a document title
+John Doe <john@example.com>; Jane Doe
+v1.0, 2019-01-01: a remark
+:toc:
+:author-note: some note
+
+== section 1
+
+content
+
+[#custom-id]
+=== section 1.1
+
+content
+`
+		Expect(source).To(RenderAsciidoc(expected))
+	})
+
+	It("discrete headings", func() {
+		source := `== section 1
+
+[discrete]
+=== a discrete heading
+
+content
+
+[.role]
+[float]
+== a floating title`
+		expected := `== section 1
+
+[discrete]
+=== a discrete heading
+
+content
+
+[.role]
+[float]
+== a floating title
+`
+		Expect(source).To(RenderAsciidoc(expected))
+	})
+})
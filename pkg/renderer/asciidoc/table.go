@@ -0,0 +1,49 @@
+package asciidoc
+
+import (
+	"bytes"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+func renderTable(ctx *renderer.Context, t types.Table) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	result.WriteString(renderElementAttributes(t.Attributes, ""))
+	result.WriteString("|===\n")
+	if len(t.Header.Cells) > 0 {
+		header, err := renderTableLine(ctx, t.Header)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render table header")
+		}
+		result.Write(header)
+		result.WriteString("\n\n")
+	}
+	for _, line := range t.Lines {
+		renderedLine, err := renderTableLine(ctx, line)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render table")
+		}
+		result.Write(renderedLine)
+		result.WriteString("\n")
+	}
+	result.WriteString("|===")
+	return result.Bytes(), nil
+}
+
+func renderTableLine(ctx *renderer.Context, l types.TableLine) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	for i, cell := range l.Cells {
+		if i > 0 {
+			result.WriteString(" ")
+		}
+		renderedCell, err := renderLine(ctx, cell)
+		if err != nil {
+			return nil, err
+		}
+		result.WriteString("|")
+		result.Write(bytes.TrimSpace(renderedCell))
+	}
+	return result.Bytes(), nil
+}
@@ -0,0 +1,70 @@
+package renderer
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// AttributeMissingSkip the `attribute-missing` value to leave the references to missing attributes as-is (default)
+	AttributeMissingSkip = "skip"
+	// AttributeMissingDrop the `attribute-missing` value to drop the references to missing attributes
+	AttributeMissingDrop = "drop"
+	// AttributeMissingDropLine the `attribute-missing` value to drop the lines which contain a reference to a missing attribute
+	AttributeMissingDropLine = "drop-line"
+	// AttributeMissingWarn the `attribute-missing` value to leave the references to missing attributes as-is, with a warning
+	AttributeMissingWarn = "warn"
+	// AttributeUndefinedDrop the `attribute-undefined` value to drop the inline attribute entries which unset an attribute (eg: `{set:name!}`)
+	AttributeUndefinedDrop = "drop"
+	// AttributeUndefinedDropLine the `attribute-undefined` value to drop the lines which contain an inline attribute entry which unsets an attribute (default)
+	AttributeUndefinedDropLine = "drop-line"
+)
+
+// AttributeValue returns the value of the attribute referenced by the given substitution, in the document attributes
+// or in the predefined attributes. If the attribute is missing, the result depends on the `attribute-missing` document
+// attribute: the reference is kept as-is (`skip` and `warn`) or dropped (`drop`), or the whole line is dropped (`drop-line`).
+// Each reference to a missing attribute is reported in the logs, along with its position in the document.
+func AttributeValue(ctx *Context, attr types.DocumentAttributeSubstitution) string {
+	if value, found := ctx.Document.Attributes.GetAsString(attr.Name); found {
+		return value
+	} else if value, found := Predefined.GetAsString(attr.Name); found {
+		return value
+	}
+	mode, found := ctx.Document.Attributes.GetAsString(types.AttrAttributeMissing)
+	if !found {
+		mode = AttributeMissingSkip
+	}
+	switch mode {
+	case AttributeMissingDrop:
+		log.Warnf("dropping reference to missing attribute '%s' (%s)", attr.Name, attr.Position)
+		return ""
+	case AttributeMissingDropLine:
+		log.Warnf("dropping line containing reference to missing attribute '%s' (%s)", attr.Name, attr.Position)
+		ctx.SetDropLine(true)
+		return ""
+	case AttributeMissingWarn:
+		log.Warnf("skipping reference to missing attribute '%s' (%s)", attr.Name, attr.Position)
+	default:
+		log.Warnf("skipping reference to missing attribute '%s' (%s)", attr.Name, attr.Position)
+	}
+	return "{" + attr.Name + "}"
+}
+
+// ApplyInlineAttributeEntry sets or unsets the attribute of the given inline entry (eg: `{set:name:value}`).
+// When the attribute is unset, the whole line is dropped, unless the `attribute-undefined` document attribute is `drop`.
+func ApplyInlineAttributeEntry(ctx *Context, entry types.InlineAttributeEntry) {
+	if !entry.Unset {
+		ctx.AddAttributeDeclaration(types.DocumentAttributeDeclaration{
+			Name:  entry.Name,
+			Value: entry.Value,
+		})
+		return
+	}
+	ctx.ResetAttribute(types.DocumentAttributeReset{
+		Name: entry.Name,
+	})
+	if mode, found := ctx.Document.Attributes.GetAsString(types.AttrAttributeUndefined); !found || mode == AttributeUndefinedDropLine {
+		log.Infof("dropping line containing an entry which unsets attribute '%s'", entry.Name)
+		ctx.SetDropLine(true)
+	}
+}
@@ -0,0 +1,129 @@
+package renderer
+
+import (
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	log "github.com/sirupsen/logrus"
+)
+
+// CaptionAttribute returns the name of the document attribute which specifies the caption label
+// of the given element (eg: `figure-caption` for an image block), or an empty string
+// if the element cannot have a numbered caption
+func CaptionAttribute(element interface{}) string {
+	switch e := element.(type) {
+	case types.ImageBlock:
+		return types.AttrFigureCaption
+	case types.Table:
+		return types.AttrTableCaption
+	case types.DelimitedBlock:
+		switch e.Kind {
+		case types.Example:
+			if !e.Attributes.Has(types.AttrAdmonitionKind) {
+				return types.AttrExampleCaption
+			}
+		case types.Listing, types.Source, types.Fenced:
+			return types.AttrListingCaption
+		}
+	}
+	return ""
+}
+
+// Caption returns the caption label (eg: `Figure`) of the element with the given attributes,
+// given the document attributes and the name of the caption attribute of the element,
+// along with a flag indicating if the caption is numbered (ie, it was not overridden by
+// the `caption` attribute of the element).
+// The last value is `false` if the element has no title, if its caption was disabled with an
+// empty `caption` attribute, or if the caption attribute is not set in the document.
+func Caption(attrs types.ElementAttributes, docAttrs types.DocumentAttributes, captionAttr string) (string, bool, bool) {
+	if !attrs.Has(types.AttrTitle) || captionAttr == "" {
+		return "", false, false
+	}
+	if attrs.Has(types.AttrCaption) {
+		if caption, ok := attrs[types.AttrCaption].(string); ok && caption != "" {
+			return caption, false, true
+		}
+		return "", false, false
+	}
+	if label, found := docAttrs.GetAsString(captionAttr); found {
+		return label, true, true
+	}
+	return "", false, false
+}
+
+// NumberCaptionedElements assigns a number to each element of the document with a numbered caption
+// (eg: `Figure 1. A title`), and registers the elements which also have an ID in the document's element
+// references, so that the cross-references to these elements can use their caption
+func NumberCaptionedElements(ctx *Context) {
+	// copy the document attributes, since their declarations and resets are processed while traversing the document
+	attrs := types.DocumentAttributes{}
+	attrs.AddAll(ctx.Document.Attributes)
+	if ctx.Document.ElementReferences == nil {
+		ctx.Document.ElementReferences = types.ElementReferences{}
+	}
+	numberCaptionedElements(ctx.Document.Elements, attrs, ctx.AttributeOverrides(), ctx.Document.ElementReferences, map[string]int{})
+}
+
+func numberCaptionedElements(elements []interface{}, attrs types.DocumentAttributes, overrides types.DocumentAttributeOverrides, refs types.ElementReferences, counters map[string]int) {
+	for _, element := range elements {
+		switch e := element.(type) {
+		case types.DocumentAttributeDeclaration:
+			if !overrides.Locked(e.Name) {
+				attrs.AddDeclaration(e)
+			}
+		case types.DocumentAttributeReset:
+			if !overrides.Locked(e.Name) {
+				attrs.Reset(e)
+			}
+		case types.Section:
+			numberCaptionedElements(e.Elements, attrs, overrides, refs, counters)
+		case types.Preamble:
+			numberCaptionedElements(e.Elements, attrs, overrides, refs, counters)
+		case types.DelimitedBlock:
+			numberCaptionedElement(e, e.Attributes, attrs, refs, counters)
+			numberCaptionedElements(e.Elements, attrs, overrides, refs, counters)
+		case types.ImageBlock:
+			numberCaptionedElement(e, e.Attributes, attrs, refs, counters)
+		case types.Table:
+			numberCaptionedElement(e, e.Attributes, attrs, refs, counters)
+		case types.OrderedList:
+			for _, item := range e.Items {
+				numberCaptionedElements(item.Elements, attrs, overrides, refs, counters)
+			}
+		case types.UnorderedList:
+			for _, item := range e.Items {
+				numberCaptionedElements(item.Elements, attrs, overrides, refs, counters)
+			}
+		case types.LabeledList:
+			for _, item := range e.Items {
+				numberCaptionedElements(item.Elements, attrs, overrides, refs, counters)
+			}
+		}
+	}
+}
+
+// numberCaptionedElement assigns a number to the given element if it has a numbered caption, and registers
+// the element in the document's element references if it has an ID and a title (even if it has no caption,
+// in which case the cross-references to the element use its title)
+func numberCaptionedElement(element interface{}, attrs types.ElementAttributes, docAttrs types.DocumentAttributes, refs types.ElementReferences, counters map[string]int) {
+	if !attrs.Has(types.AttrTitle) {
+		return
+	}
+	captionAttr := CaptionAttribute(element)
+	label, numbered, _ := Caption(attrs, docAttrs, captionAttr)
+	ref := types.CaptionReference{
+		Title: strings.TrimSpace(attrs.GetAsString(types.AttrTitle)),
+	}
+	if position, ok := attrs[types.AttrTitlePosition].(types.Position); ok {
+		ref.TitlePosition = position
+	}
+	if numbered {
+		counters[captionAttr]++
+		ref.Label = label
+		ref.Number = counters[captionAttr]
+	}
+	if id := attrs.GetAsString(types.AttrID); id != "" {
+		log.Debugf("registering caption reference for element with id '%s': %v", id, ref)
+		refs[id] = ref
+	}
+}
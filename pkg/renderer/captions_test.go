@@ -0,0 +1,54 @@
+package renderer_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("captions", func() {
+
+	DescribeTable("caption attributes",
+		func(element interface{}, expected string) {
+			Expect(renderer.CaptionAttribute(element)).To(Equal(expected))
+		},
+		Entry("image block", types.ImageBlock{}, types.AttrFigureCaption),
+		Entry("table", types.Table{}, types.AttrTableCaption),
+		Entry("example block", types.DelimitedBlock{Kind: types.Example}, types.AttrExampleCaption),
+		Entry("admonition block", types.DelimitedBlock{
+			Kind: types.Example,
+			Attributes: types.ElementAttributes{
+				types.AttrAdmonitionKind: types.Note,
+			},
+		}, ""),
+		Entry("source block", types.DelimitedBlock{Kind: types.Source}, types.AttrListingCaption),
+		Entry("paragraph", types.Paragraph{}, ""),
+	)
+
+	DescribeTable("captions",
+		func(attrs types.ElementAttributes, expectedLabel string, expectedNumbered, expectedOK bool) {
+			docAttrs := types.DocumentAttributes{
+				types.AttrFigureCaption: "Figure",
+			}
+			label, numbered, ok := renderer.Caption(attrs, docAttrs, types.AttrFigureCaption)
+			Expect(label).To(Equal(expectedLabel))
+			Expect(numbered).To(Equal(expectedNumbered))
+			Expect(ok).To(Equal(expectedOK))
+		},
+		Entry("without title", types.ElementAttributes{}, "", false, false),
+		Entry("with title", types.ElementAttributes{
+			types.AttrTitle: "foo",
+		}, "Figure", true, true),
+		Entry("with custom caption", types.ElementAttributes{
+			types.AttrTitle:   "foo",
+			types.AttrCaption: "Fig A.",
+		}, "Fig A.", false, true),
+		Entry("with empty caption", types.ElementAttributes{
+			types.AttrTitle:   "foo",
+			types.AttrCaption: nil,
+		}, "", false, false),
+	)
+})
@@ -4,6 +4,12 @@ import (
 	"context"
 	"errors"
 	"io"
+	"io/fs"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/bytesparadise/libasciidoc/pkg/types"
@@ -38,6 +44,57 @@ func Wrap(ctx context.Context, document types.Document, options ...Option) *Cont
 	return result
 }
 
+// AddAttributeDeclaration adds the given attribute declaration in the document attributes,
+// unless the attribute was locked from the API or the command line
+func (ctx *Context) AddAttributeDeclaration(attr types.DocumentAttributeDeclaration) {
+	if ctx.AttributeOverrides().Locked(attr.Name) {
+		log.Debugf("ignoring declaration of locked attribute '%s'", attr.Name)
+		return
+	}
+	ctx.Document.Attributes.AddDeclaration(attr)
+}
+
+// ResetAttribute resets the given attribute in the document attributes,
+// unless the attribute was locked from the API or the command line
+func (ctx *Context) ResetAttribute(attr types.DocumentAttributeReset) {
+	if ctx.AttributeOverrides().Locked(attr.Name) {
+		log.Debugf("ignoring reset of locked attribute '%s'", attr.Name)
+		return
+	}
+	ctx.Document.Attributes.Reset(attr)
+}
+
+// DeclaredAttribute returns the value of the attribute with the given name as declared in the document,
+// unless it was overridden from the API or the command line, along with `true` if the attribute is set
+func (ctx *Context) DeclaredAttribute(name string) (string, bool) {
+	d, declared := types.SearchAttributeDeclaration(ctx.Document.Elements, name)
+	if o, found := ctx.AttributeOverrides()[name]; found && !(o.Soft && declared) {
+		return o.Value, !o.Unset
+	}
+	return d.Value, declared
+}
+
+const dropLine string = "dropLine"
+
+// SetDropLine sets the rendering context to drop (or not) the line being rendered, for example
+// because it contains a reference to a missing attribute. Returns the previous value.
+func (ctx *Context) SetDropLine(b bool) bool {
+	var oldvalue bool
+	if v, ok := ctx.options[dropLine].(bool); ok {
+		oldvalue = v
+	}
+	ctx.options[dropLine] = b
+	return oldvalue
+}
+
+// DropLine indicates if the line being rendered should be dropped (default false)
+func (ctx *Context) DropLine() bool {
+	if b, found := ctx.options[dropLine].(bool); found {
+		return b
+	}
+	return false
+}
+
 const includeBlankLine string = "includeBlankLine"
 
 // SetIncludeBlankLine sets the rendering context to include (or not) the blank lines
@@ -140,6 +197,13 @@ func (ctx *Context) GetAndIncrementExampleBlockCounter() int {
 	return ctx.getAndIncrementCounter(exampleBlockCounter)
 }
 
+const listingBlockCounter = "listingBlockCounter"
+
+// GetAndIncrementListingBlockCounter returns the current value for the listing block counter after internally incrementing it.
+func (ctx *Context) GetAndIncrementListingBlockCounter() int {
+	return ctx.getAndIncrementCounter(listingBlockCounter)
+}
+
 // getAndIncrementCounter returns the current value for the  counter after internally incrementing it.
 func (ctx *Context) getAndIncrementCounter(counter string) int {
 	if _, found := ctx.options[counter]; !found {
@@ -154,6 +218,60 @@ func (ctx *Context) getAndIncrementCounter(counter string) int {
 	return 1
 }
 
+const renderedTitle = "renderedTitle"
+
+// the title being rendered, with the values of the counters it contains
+type titleCounters struct {
+	id    string
+	index int
+}
+
+const titleCounterValues = "titleCounterValues"
+
+// SetRenderedTitle sets the ID of the element whose title is being rendered (or an empty ID once the title was rendered),
+// so that the counters in this title are incremented only once, even if the title is rendered several times (eg: in the
+// table of contents and in the section itself). Returns the previous ID.
+func (ctx *Context) SetRenderedTitle(id string) string {
+	var oldvalue string
+	if t, ok := ctx.options[renderedTitle].(*titleCounters); ok {
+		oldvalue = t.id
+	}
+	ctx.options[renderedTitle] = &titleCounters{
+		id: id,
+	}
+	return oldvalue
+}
+
+// IncrementCounter increments the counter with the given name and returns its new value. Within the title of an element
+// which was already rendered, the value obtained during the first rendering is returned instead, without incrementing the counter.
+func (ctx *Context) IncrementCounter(name string, initial interface{}) string {
+	t, ok := ctx.options[renderedTitle].(*titleCounters)
+	if !ok || t.id == "" {
+		return ctx.Document.Attributes.IncrementCounter(name, initial)
+	}
+	values, ok := ctx.options[titleCounterValues].(map[string][]string)
+	if !ok {
+		values = map[string][]string{}
+		ctx.options[titleCounterValues] = values
+	}
+	defer func() {
+		t.index++
+	}()
+	if t.index < len(values[t.id]) {
+		return values[t.id][t.index]
+	}
+	value := ctx.Document.Attributes.IncrementCounter(name, initial)
+	values[t.id] = append(values[t.id], value)
+	return value
+}
+
+// HardBreaks returns `true` if the lines of the element with the given attributes should be rendered with
+// hard breaks, either because the `hardbreaks` option was set on the element, or because the `hardbreaks`
+// attribute was set at the document level
+func (ctx *Context) HardBreaks(attrs types.ElementAttributes) bool {
+	return attrs.Has(types.AttrHardBreaks) || ctx.Document.Attributes.Has(types.DocumentAttrHardBreaks)
+}
+
 const imagesdir = "imagesdir"
 
 // GetImagesDir returns the value of the `imagesdir` attribute if it was set (as a string), empty string otherwise
@@ -164,6 +282,96 @@ func (ctx *Context) GetImagesDir() string {
 	return ""
 }
 
+// ImagePath returns the path of the image at the given location. If the location is relative,
+// then it is prefixed with the `imagesdir` attribute (if it is set). If the location is
+// absolute or is a URL, then it is returned as-is
+func (ctx *Context) ImagePath(l string) string {
+	if _, err := url.ParseRequestURI(l); err == nil {
+		// location is a valid URL, so return it as-is
+		return l
+	}
+	if filepath.IsAbs(l) {
+		return l
+	}
+	// use `imagesdir` attribute if it is set
+	if imagesdir := ctx.GetImagesDir(); imagesdir != "" {
+		return strings.TrimSuffix(imagesdir, "/") + "/" + l
+	}
+	return l
+}
+
+// OpenFile opens the file with the given name (eg: an image), from the filesystem set in the 'FileSystem' Option if it
+// was present, or from the OS filesystem otherwise. A relative name is resolved against the directory of the document file,
+// whereas an absolute name in the filesystem set in the 'FileSystem' Option is resolved against the root of this filesystem.
+// In `safe` and `server` modes, the file must be in the base directory, and in `secure` mode, no file can be opened.
+func (ctx *Context) OpenFile(name string) (io.ReadCloser, error) {
+	if mode := ctx.SafeMode(); mode >= types.SafeModeSecure {
+		return nil, errors.New("cannot open file '" + name + "' in " + mode.String() + " mode")
+	}
+	if fsys, found := ctx.FileSystem(); found {
+		var p string
+		if strings.HasPrefix(name, "/") {
+			p = strings.TrimPrefix(path.Clean(name), "/")
+		} else {
+			p = path.Join(path.Dir(strings.TrimPrefix(ctx.Filename(), "/")), name)
+		}
+		if !fs.ValidPath(p) {
+			return nil, errors.New("invalid path in filesystem: " + name)
+		}
+		if ctx.SafeMode() >= types.SafeModeSafe {
+			if err := ctx.checkWithinFSBaseDir(p); err != nil {
+				return nil, err
+			}
+		}
+		return fsys.Open(p)
+	}
+	if !filepath.IsAbs(name) && ctx.Filename() != "" {
+		name = filepath.Join(filepath.Dir(ctx.Filename()), name)
+	}
+	if ctx.SafeMode() >= types.SafeModeSafe {
+		if err := ctx.checkWithinBaseDir(name); err != nil {
+			return nil, err
+		}
+	}
+	return os.Open(name)
+}
+
+// checkWithinBaseDir returns an error if the file with the given name is not in the base directory
+// set in the 'BaseDir' Option, or in the directory of the document file (or the current working directory)
+func (ctx *Context) checkWithinBaseDir(name string) error {
+	dir, found := ctx.options[keyBaseDir].(string)
+	if !found || dir == "" {
+		dir = filepath.Dir(ctx.Filename())
+	}
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return err
+	}
+	absPath, err := filepath.Abs(name)
+	if err != nil {
+		return err
+	}
+	if rel, err := filepath.Rel(dir, absPath); err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return errors.New("'" + name + "' is outside of the base directory '" + dir + "'")
+	}
+	return nil
+}
+
+// checkWithinFSBaseDir returns an error if the file at the given path in the filesystem is not in the base directory
+// set in the 'BaseDir' Option (as a path in the filesystem), or in the directory of the document in the filesystem
+func (ctx *Context) checkWithinFSBaseDir(p string) error {
+	dir, found := ctx.options[keyBaseDir].(string)
+	if found && dir != "" {
+		dir = path.Clean(strings.TrimPrefix(dir, "/"))
+	} else {
+		dir = path.Dir(strings.TrimPrefix(ctx.Filename(), "/"))
+	}
+	if dir == "." || p == dir || strings.HasPrefix(p, dir+"/") {
+		return nil
+	}
+	return errors.New("'" + p + "' is outside of the base directory '" + dir + "'")
+}
+
 // MacroTemplate finds and returns a user macro function by specified name.
 func (ctx *Context) MacroTemplate(name string) (MacroTemplate, error) {
 	macro, ok := ctx.macros[name]
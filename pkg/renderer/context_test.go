@@ -0,0 +1,98 @@
+package renderer_test
+
+import (
+	"context"
+	"io/ioutil"
+	"testing/fstest"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("renderer context", func() {
+
+	DescribeTable("image paths",
+		func(imagesdir, location, expected string) {
+			doc := types.Document{
+				Attributes: types.DocumentAttributes{},
+			}
+			if imagesdir != "" {
+				doc.Attributes["imagesdir"] = imagesdir
+			}
+			ctx := renderer.Wrap(context.Background(), doc)
+			Expect(ctx.ImagePath(location)).To(Equal(expected))
+		},
+		Entry("relative location without imagesdir", "", "foo.png", "foo.png"),
+		Entry("relative location with imagesdir", "images", "foo.png", "images/foo.png"),
+		Entry("relative location with imagesdir ending with slash", "images/", "foo.png", "images/foo.png"),
+		Entry("absolute location with imagesdir", "images", "/assets/foo.png", "/assets/foo.png"),
+		Entry("URL with imagesdir", "images", "https://example.com/foo.png", "https://example.com/foo.png"),
+	)
+
+	Context("open files", func() {
+
+		fsys := fstest.MapFS{
+			"docs/images/foo.png": &fstest.MapFile{
+				Data: []byte("docs foo"),
+			},
+			"images/foo.png": &fstest.MapFile{
+				Data: []byte("root foo"),
+			},
+		}
+
+		DescribeTable("from filesystem",
+			func(filename, name, expected string) {
+				ctx := renderer.Wrap(context.Background(), types.Document{}, renderer.Filename(filename), renderer.FileSystem(fsys))
+				f, err := ctx.OpenFile(name)
+				Expect(err).ToNot(HaveOccurred())
+				defer f.Close()
+				content, err := ioutil.ReadAll(f)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(content)).To(Equal(expected))
+			},
+			Entry("relative to document", "docs/index.adoc", "images/foo.png", "docs foo"),
+			Entry("relative to parent of document", "docs/index.adoc", "../images/foo.png", "root foo"),
+			Entry("absolute", "docs/index.adoc", "/images/foo.png", "root foo"),
+			Entry("relative without document", "", "images/foo.png", "root foo"),
+		)
+
+		It("should not open file outside of filesystem", func() {
+			ctx := renderer.Wrap(context.Background(), types.Document{}, renderer.Filename("index.adoc"), renderer.FileSystem(fsys))
+			_, err := ctx.OpenFile("../foo.png")
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should open file relative to document in OS filesystem", func() {
+			ctx := renderer.Wrap(context.Background(), types.Document{}, renderer.Filename("../../test/includes/chapter-a.adoc"))
+			f, err := ctx.OpenFile("hello_world.go.txt")
+			Expect(err).ToNot(HaveOccurred())
+			defer f.Close()
+			content, err := ioutil.ReadAll(f)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(content)).To(ContainSubstring("package includes"))
+		})
+
+		It("should not open file outside of base directory in safe mode", func() {
+			ctx := renderer.Wrap(context.Background(), types.Document{}, renderer.Filename("../../test/includes/chapter-a.adoc"), renderer.SafeMode(types.SafeModeSafe))
+			_, err := ctx.OpenFile("../../README.adoc")
+			Expect(err).To(MatchError(ContainSubstring("is outside of the base directory")))
+		})
+
+		It("should open file in base directory in safe mode", func() {
+			ctx := renderer.Wrap(context.Background(), types.Document{}, renderer.Filename("../../test/includes/chapter-a.adoc"), renderer.SafeMode(types.SafeModeSafe), renderer.BaseDir("../../test"))
+			f, err := ctx.OpenFile("hello_world.go.txt")
+			Expect(err).ToNot(HaveOccurred())
+			f.Close()
+		})
+
+		It("should not open file in secure mode", func() {
+			ctx := renderer.Wrap(context.Background(), types.Document{}, renderer.Filename("index.adoc"), renderer.FileSystem(fsys), renderer.SafeMode(types.SafeModeSecure))
+			_, err := ctx.OpenFile("images/foo.png")
+			Expect(err).To(MatchError("cannot open file 'images/foo.png' in secure mode"))
+		})
+	})
+})
@@ -0,0 +1,55 @@
+package html5
+
+import (
+	"bytes"
+	"html"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+var audioBlockTmpl texttemplate.Template
+
+// initializes the templates
+func init() {
+	audioBlockTmpl = newTextTemplate("audio block", `<div{{ if .ID }} id="{{ .ID }}"{{ end }} class="audioblock{{ if .Role }} {{ .Role }}{{ end }}">{{ if .Title }}
+<div class="title">{{ .Title }}</div>{{ end }}
+<div class="content">
+<audio src="{{ escape .Src }}"{{ if .Autoplay }} autoplay{{ end }}{{ if .Controls }} controls{{ end }}{{ if .Loop }} loop{{ end }}{{ if .Muted }} muted{{ end }}>
+Your browser does not support the audio tag.
+</audio>
+</div>
+</div>`,
+		texttemplate.FuncMap{
+			"escape": html.EscapeString,
+		})
+}
+
+func renderAudioBlock(ctx *renderer.Context, a types.AudioBlock) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	err := audioBlockTmpl.Execute(result, struct {
+		ID       string
+		Title    string
+		Role     string
+		Src      string
+		Autoplay bool
+		Controls bool
+		Loop     bool
+		Muted    bool
+	}{
+		ID:       a.Attributes.GetAsString(types.AttrID),
+		Title:    getTitle(ctx, a.Attributes),
+		Role:     a.Attributes.GetAsString(types.AttrRole),
+		Src:      ctx.ImagePath(a.Path) + timeAnchor(a.Attributes),
+		Autoplay: a.Attributes.Has(types.AttrMediaAutoplay),
+		Controls: !a.Attributes.Has(types.AttrMediaNoControls),
+		Loop:     a.Attributes.Has(types.AttrMediaLoop),
+		Muted:    a.Attributes.Has(types.AttrMediaMuted),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render audio block")
+	}
+	return result.Bytes(), nil
+}
@@ -0,0 +1,14 @@
+package html5
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+)
+
+func renderThematicBreak(ctx *renderer.Context, b types.ThematicBreak) ([]byte, error) { //nolint:unparam
+	return []byte("<hr>"), nil
+}
+
+func renderPageBreak(ctx *renderer.Context, b types.PageBreak) ([]byte, error) { //nolint:unparam
+	return []byte(`<div style="page-break-after: always;"></div>`), nil
+}
@@ -0,0 +1,62 @@
+package html5_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("thematic breaks", func() {
+
+	It("Markdown-style thematic break between 2 paragraphs", func() {
+		source := `first paragraph
+
+* * *
+
+second paragraph`
+		expected := `<div class="paragraph">
+<p>first paragraph</p>
+</div>
+<hr>
+<div class="paragraph">
+<p>second paragraph</p>
+</div>`
+		Expect(source).To(RenderHTML5Element(expected))
+	})
+
+	It("thematic break between 2 paragraphs", func() {
+		source := `first paragraph
+
+'''
+
+second paragraph`
+		expected := `<div class="paragraph">
+<p>first paragraph</p>
+</div>
+<hr>
+<div class="paragraph">
+<p>second paragraph</p>
+</div>`
+		Expect(source).To(RenderHTML5Element(expected))
+	})
+})
+
+var _ = Describe("page breaks", func() {
+
+	It("page break between 2 paragraphs", func() {
+		source := `first paragraph
+
+<<<
+
+second paragraph`
+		expected := `<div class="paragraph">
+<p>first paragraph</p>
+</div>
+<div style="page-break-after: always;"></div>
+<div class="paragraph">
+<p>second paragraph</p>
+</div>`
+		Expect(source).To(RenderHTML5Element(expected))
+	})
+})
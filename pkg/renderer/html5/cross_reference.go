@@ -2,6 +2,8 @@ package html5
 
 import (
 	"bytes"
+	"html"
+	"strconv"
 	texttemplate "text/template"
 
 	"github.com/bytesparadise/libasciidoc/pkg/renderer"
@@ -24,13 +26,16 @@ func renderCrossReference(ctx *renderer.Context, xref types.CrossReference) ([]b
 	if xref.Label != "" {
 		label = xref.Label
 	} else if target, found := ctx.Document.ElementReferences[xref.ID]; found {
-		if t, ok := target.(types.InlineElements); ok {
-			renderedContent, err := renderElement(ctx, t)
+		switch t := target.(type) {
+		case types.InlineElements:
+			renderedContent, err := renderTitleElements(ctx, xref.ID, withoutIndexTerms(t))
 			if err != nil {
 				return nil, errors.Wrapf(err, "error while rendering sectionTitle content")
 			}
 			label = string(renderedContent)
-		} else {
+		case types.CaptionReference:
+			label = renderCaptionReference(ctx, xref.ID, t)
+		default:
 			return nil, errors.Errorf("unable to process cross-reference to element of type %T", target)
 		}
 	} else {
@@ -48,3 +53,26 @@ func renderCrossReference(ctx *renderer.Context, xref types.CrossReference) ([]b
 	}
 	return result.Bytes(), nil
 }
+
+// renderCaptionReference renders the label of a cross-reference to an element with a numbered caption,
+// according to the `xrefstyle` document attribute:
+// - `full`: the caption and the title (eg: `Figure 1, “A title”`)
+// - `short`: the caption only (eg: `Figure 1`)
+// - `basic` (default): the title only, which is also used when the caption is not numbered
+func renderCaptionReference(ctx *renderer.Context, id string, ref types.CaptionReference) string {
+	// same substitutions as in the title of the element (with the same values for the counters)
+	title := substituteTitle(ctx, id, ref.Title, ref.TitlePosition)
+	style, _ := ctx.Document.Attributes.GetAsString(types.AttrXRefStyle)
+	if ref.Number == 0 {
+		// caption is not numbered
+		style = "basic"
+	}
+	switch style {
+	case "full":
+		return ref.Label + " " + strconv.Itoa(ref.Number) + ", &#8220;" + replace(html.EscapeString(title)) + "&#8221;"
+	case "short":
+		return ref.Label + " " + strconv.Itoa(ref.Number)
+	default:
+		return replace(html.EscapeString(title))
+	}
+}
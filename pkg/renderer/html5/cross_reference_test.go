@@ -57,6 +57,228 @@ with some content linked to <<thewrongtitle>>!`
 <p>with some content linked to <a href="#thewrongtitle">[thewrongtitle]</a>!</p>
 </div>
 </div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+	})
+
+	Context("references to blocks with a caption", func() {
+
+		source := `See <<arch>> and <<results>>.
+
+[#arch]
+.Architecture
+image::arch.png[]
+
+[#results]
+.Results
+|===
+| foo
+|===`
+
+		It("cross-references with default style", func() {
+			expected := `<div class="paragraph">
+<p>See <a href="#arch">Architecture</a> and <a href="#results">Results</a>.</p>
+</div>
+<div id="arch" class="imageblock">
+<div class="content">
+<img src="arch.png" alt="arch">
+</div>
+<div class="title">Figure 1. Architecture</div>
+</div>
+<table id="results" class="tableblock frame-all grid-all stretch">
+<caption class="title">Table 1. Results</caption>
+<colgroup>
+<col style="width: 100%;">
+</colgroup>
+<tbody>
+<tr>
+<td class="tableblock halign-left valign-top"><p class="tableblock">foo</p></td>
+</tr>
+</tbody>
+</table>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("cross-references with short style", func() {
+			expected := `<div class="paragraph">
+<p>See <a href="#arch">Figure 1</a> and <a href="#results">Table 1</a>.</p>
+</div>
+<div id="arch" class="imageblock">
+<div class="content">
+<img src="arch.png" alt="arch">
+</div>
+<div class="title">Figure 1. Architecture</div>
+</div>
+<table id="results" class="tableblock frame-all grid-all stretch">
+<caption class="title">Table 1. Results</caption>
+<colgroup>
+<col style="width: 100%;">
+</colgroup>
+<tbody>
+<tr>
+<td class="tableblock halign-left valign-top"><p class="tableblock">foo</p></td>
+</tr>
+</tbody>
+</table>`
+			Expect(":xrefstyle: short\n\n" + source).To(RenderHTML5Element(expected))
+		})
+
+		It("cross-references with full style", func() {
+			expected := `<div class="paragraph">
+<p>See <a href="#arch">Figure 1, &#8220;Architecture&#8221;</a> and <a href="#results">Table 1, &#8220;Results&#8221;</a>.</p>
+</div>
+<div id="arch" class="imageblock">
+<div class="content">
+<img src="arch.png" alt="arch">
+</div>
+<div class="title">Figure 1. Architecture</div>
+</div>
+<table id="results" class="tableblock frame-all grid-all stretch">
+<caption class="title">Table 1. Results</caption>
+<colgroup>
+<col style="width: 100%;">
+</colgroup>
+<tbody>
+<tr>
+<td class="tableblock halign-left valign-top"><p class="tableblock">foo</p></td>
+</tr>
+</tbody>
+</table>`
+			Expect(":xrefstyle: full\n\n" + source).To(RenderHTML5Element(expected))
+		})
+
+		It("forward cross-reference to a listing block", func() {
+			source := `:listing-caption: Listing
+:xrefstyle: short
+
+See <<main>>.
+
+.Foo
+----
+foo
+----
+
+[#main]
+.Main
+----
+main()
+----`
+			expected := `<div class="paragraph">
+<p>See <a href="#main">Listing 2</a>.</p>
+</div>
+<div class="listingblock">
+<div class="title">Listing 1. Foo</div>
+<div class="content">
+<pre>foo</pre>
+</div>
+</div>
+<div id="main" class="listingblock">
+<div class="title">Listing 2. Main</div>
+<div class="content">
+<pre>main()</pre>
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("cross-reference to a block with attributes and counters in its title", func() {
+			source := `:xrefstyle: full
+:product: Acme
+
+[#tab]
+.Table of {product} {counter:tab}
+|===
+| foo
+|===
+
+See <<tab>>.`
+			expected := `<table id="tab" class="tableblock frame-all grid-all stretch">
+<caption class="title">Table 1. Table of Acme 1</caption>
+<colgroup>
+<col style="width: 100%;">
+</colgroup>
+<tbody>
+<tr>
+<td class="tableblock halign-left valign-top"><p class="tableblock">foo</p></td>
+</tr>
+</tbody>
+</table>
+<div class="paragraph">
+<p>See <a href="#tab">Table 1, &#8220;Table of Acme 1&#8221;</a>.</p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("cross-reference to a block with a custom caption", func() {
+			source := `:xrefstyle: short
+
+See <<custom>>.
+
+[#custom]
+[caption="Fig A."]
+.Custom
+image::custom.png[]
+
+[caption=""]
+.No caption
+image::none.png[]
+
+.Second
+image::second.png[]`
+			expected := `<div class="paragraph">
+<p>See <a href="#custom">Custom</a>.</p>
+</div>
+<div id="custom" class="imageblock">
+<div class="content">
+<img src="custom.png" alt="custom">
+</div>
+<div class="title">Fig A. Custom</div>
+</div>
+<div class="imageblock">
+<div class="content">
+<img src="none.png" alt="none">
+</div>
+<div class="title">No caption</div>
+</div>
+<div class="imageblock">
+<div class="content">
+<img src="second.png" alt="second">
+</div>
+<div class="title">Figure 1. Second</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("cross-references to blocks without caption", func() {
+			source := `:xrefstyle: full
+
+See <<none>> and <<code>>.
+
+[[none]]
+[caption=""]
+.No caption
+image::none.png[]
+
+[#code]
+.Some code
+----
+main()
+----`
+			expected := `<div class="paragraph">
+<p>See <a href="#none">No caption</a> and <a href="#code">Some code</a>.</p>
+</div>
+<div id="none" class="imageblock">
+<div class="content">
+<img src="none.png" alt="none">
+</div>
+<div class="title">No caption</div>
+</div>
+<div id="code" class="listingblock">
+<div class="title">Some code</div>
+<div class="content">
+<pre>main()</pre>
+</div>
 </div>`
 			Expect(source).To(RenderHTML5Element(expected))
 		})
@@ -3,7 +3,6 @@ package html5
 import (
 	"bytes"
 	"html"
-	"strconv"
 	texttemplate "text/template"
 
 	"github.com/bytesparadise/libasciidoc/pkg/renderer"
@@ -20,11 +19,13 @@ var admonitionBlockTmpl texttemplate.Template
 var quoteBlockTmpl texttemplate.Template
 var verseBlockTmpl texttemplate.Template
 var sidebarBlockTmpl texttemplate.Template
+var openBlockTmpl texttemplate.Template
+var abstractBlockTmpl texttemplate.Template
 
 // initializes the templates
 func init() {
 	fencedBlockTmpl = newTextTemplate("listing block", `{{ $ctx := .Context }}{{ with .Data }}<div {{ if .ID }}id="{{ .ID }}" {{ end }}class="listingblock">{{ if .Title }}
-<div class="title">{{ escape .Title }}</div>{{ end }}
+<div class="title">{{ .Title }}</div>{{ end }}
 <div class="content">
 <pre class="highlight"><code>{{ range $index, $element := .Elements }}{{ renderPlainString $ctx $element | printf "%s" }}{{ end }}</code></pre>
 </div>
@@ -35,9 +36,9 @@ func init() {
 		})
 
 	listingBlockTmpl = newTextTemplate("listing block", `{{ $ctx := .Context }}{{ with .Data }}<div {{ if .ID }}id="{{ .ID }}" {{ end }}class="listingblock">{{ if .Title }}
-<div class="title">{{ escape .Title }}</div>{{ end }}
+<div class="title">{{ .Title }}</div>{{ end }}
 <div class="content">
-<pre>{{ range $index, $element := .Elements }}{{ renderPlainString $ctx $element | printf "%s" | escape }}{{ end }}</pre>
+<pre>{{ range $index, $element := .Elements }}{{ renderPlainString $ctx $element | printf "%s" }}{{ end }}</pre>
 </div>
 </div>{{ end }}`,
 		texttemplate.FuncMap{
@@ -47,9 +48,9 @@ func init() {
 
 	sourceBlockTmpl = newTextTemplate("source block",
 		`{{ $ctx := .Context }}{{ with .Data }}<div {{ if .ID }}id="{{ .ID }}" {{ end }}class="listingblock">{{ if .Title }}
-<div class="title">{{ escape .Title }}</div>{{ end }}
+<div class="title">{{ .Title }}</div>{{ end }}
 <div class="content">
-<pre class="highlight"><code{{ if .Language}} class="language-{{ .Language}}" data-lang="{{ .Language}}"{{ end }}>{{ range $index, $element := .Elements }}{{ renderPlainString $ctx $element | printf "%s" | escape }}{{ end }}</code></pre>
+<pre class="highlight"><code{{ if .Language}} class="language-{{ .Language}}" data-lang="{{ .Language}}"{{ end }}>{{ range $index, $element := .Elements }}{{ renderPlainString $ctx $element | printf "%s" }}{{ end }}</code></pre>
 </div>
 </div>{{ end }}`,
 		texttemplate.FuncMap{
@@ -58,7 +59,7 @@ func init() {
 		})
 
 	exampleBlockTmpl = newTextTemplate("example block", `{{ $ctx := .Context }}{{ with .Data }}<div {{ if .ID }}id="{{ .ID }}" {{ end }}class="exampleblock">{{ if .Title }}
-<div class="title">{{ escape .Title }}</div>{{ end }}
+<div class="title">{{ .Title }}</div>{{ end }}
 <div class="content">
 {{ $elements := .Elements }}{{ renderElements $ctx $elements | printf "%s" }}
 </div>
@@ -69,7 +70,7 @@ func init() {
 		})
 
 	quoteBlockTmpl = newTextTemplate("quote block", `{{ $ctx := .Context }}{{ with .Data }}<div {{ if .ID }}id="{{ .ID }}" {{ end }}class="quoteblock">{{ if .Title }}
-<div class="title">{{ escape .Title }}</div>{{ end }}
+<div class="title">{{ .Title }}</div>{{ end }}
 <blockquote>
 {{ renderElements $ctx .Elements | printf "%s" }}
 </blockquote>{{ if .Attribution.First }}
@@ -84,7 +85,7 @@ func init() {
 		})
 
 	verseBlockTmpl = newTextTemplate("verse block", `{{ $ctx := .Context }}{{ with .Data }}<div {{ if .ID }}id="{{ .ID }}" {{ end }}class="verseblock">{{ if .Title }}
-<div class="title">{{ escape .Title }}</div>{{ end }}
+<div class="title">{{ .Title }}</div>{{ end }}
 <pre class="content">{{ renderElements $ctx .Elements | printf "%s" }}</pre>{{ if .Attribution.First }}
 <div class="attribution">
 &#8212; {{ .Attribution.First }}{{ if .Attribution.Second }}<br>
@@ -103,11 +104,33 @@ func init() {
 {{ if .IconClass }}<i class="fa icon-{{ .IconClass }}" title="{{ .IconTitle }}"></i>{{ else }}<div class="title">{{ .IconTitle }}</div>{{ end }}
 </td>
 <td class="content">
-{{ if .Title }}<div class="title">{{ escape .Title }}</div>
+{{ if .Title }}<div class="title">{{ .Title }}</div>
 {{ end }}{{ renderElements $ctx .Elements | printf "%s" }}
 </td>
 </tr>
 </table>
+</div>{{ end }}`,
+		texttemplate.FuncMap{
+			"renderElements": renderElements,
+			"escape":         html.EscapeString,
+		})
+
+	openBlockTmpl = newTextTemplate("open block", `{{ $ctx := .Context }}{{ with .Data }}<div {{ if .ID }}id="{{ .ID }}" {{ end }}class="openblock{{ if .Class }} {{ .Class }}{{ end }}">{{ if .Title }}
+<div class="title">{{ .Title }}</div>{{ end }}
+<div class="content">
+{{ renderElements $ctx .Elements | printf "%s" }}
+</div>
+</div>{{ end }}`,
+		texttemplate.FuncMap{
+			"renderElements": renderElements,
+			"escape":         html.EscapeString,
+		})
+
+	abstractBlockTmpl = newTextTemplate("abstract block", `{{ $ctx := .Context }}{{ with .Data }}<div {{ if .ID }}id="{{ .ID }}" {{ end }}class="quoteblock abstract">{{ if .Title }}
+<div class="title">{{ .Title }}</div>{{ end }}
+<blockquote>
+{{ renderElements $ctx .Elements | printf "%s" }}
+</blockquote>
 </div>{{ end }}`,
 		texttemplate.FuncMap{
 			"renderElements": renderElements,
@@ -116,7 +139,7 @@ func init() {
 
 	sidebarBlockTmpl = newTextTemplate("sidebar block", `{{ $ctx := .Context }}{{ with .Data }}<div {{ if .ID }}id="{{ .ID }}" {{ end }}class="sidebarblock">
 <div class="content">{{ if .Title }}
-<div class="title">{{ escape .Title }}</div>{{ end }}
+<div class="title">{{ .Title }}</div>{{ end }}
 {{ renderElements $ctx .Elements | printf "%s" }}
 </div>
 </div>{{ end }}`,
@@ -147,6 +170,10 @@ func renderDelimitedBlock(ctx *renderer.Context, b types.DelimitedBlock) ([]byte
 		return renderSidebarBlock(ctx, b)
 	case types.Comment:
 		return renderCommentBlock(ctx, b)
+	case types.Open, types.PartIntro:
+		return renderOpenBlock(ctx, b)
+	case types.Abstract:
+		return renderAbstractBlock(ctx, b)
 	default:
 		return nil, errors.Wrapf(err, "unable to render delimited block")
 	}
@@ -159,8 +186,13 @@ func renderFencedBlock(ctx *renderer.Context, b types.DelimitedBlock) ([]byte, e
 		ctx.SetWithinDelimitedBlock(previouslyWithin)
 		ctx.SetIncludeBlankLine(previouslyInclude)
 	}()
+	// same (verbatim) substitutions as in listing blocks, so the special characters are escaped
+	elements, err := applySubstitutionsOnElements(ctx, discardTrailingBlankLines(b.Elements), substitutionsOf(b.Attributes, types.DefaultSubstitutions(b.Kind)))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to render fenced block")
+	}
 	result := bytes.NewBuffer(nil)
-	err := fencedBlockTmpl.Execute(result, ContextualPipeline{
+	err = fencedBlockTmpl.Execute(result, ContextualPipeline{
 		Context: ctx,
 		Data: struct {
 			ID       string
@@ -168,8 +200,8 @@ func renderFencedBlock(ctx *renderer.Context, b types.DelimitedBlock) ([]byte, e
 			Elements []interface{}
 		}{
 			ID:       generateID(ctx, b.Attributes),
-			Title:    getTitle(b.Attributes),
-			Elements: discardTrailingBlankLines(b.Elements),
+			Title:    getCaptionedTitle(ctx, b, b.Attributes, ctx.GetAndIncrementListingBlockCounter),
+			Elements: elements,
 		},
 	})
 	return result.Bytes(), err
@@ -182,8 +214,12 @@ func renderListingBlock(ctx *renderer.Context, b types.DelimitedBlock) ([]byte,
 		ctx.SetWithinDelimitedBlock(previouslyWithin)
 		ctx.SetIncludeBlankLine(previouslyInclude)
 	}()
+	elements, err := applySubstitutionsOnElements(ctx, discardTrailingBlankLines(b.Elements), substitutionsOf(b.Attributes, types.DefaultSubstitutions(b.Kind)))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to render listing block")
+	}
 	result := bytes.NewBuffer(nil)
-	err := listingBlockTmpl.Execute(result, ContextualPipeline{
+	err = listingBlockTmpl.Execute(result, ContextualPipeline{
 		Context: ctx,
 		Data: struct {
 			ID       string
@@ -191,8 +227,8 @@ func renderListingBlock(ctx *renderer.Context, b types.DelimitedBlock) ([]byte,
 			Elements []interface{}
 		}{
 			ID:       generateID(ctx, b.Attributes),
-			Title:    getTitle(b.Attributes),
-			Elements: discardTrailingBlankLines(b.Elements),
+			Title:    getCaptionedTitle(ctx, b, b.Attributes, ctx.GetAndIncrementListingBlockCounter),
+			Elements: elements,
 		},
 	})
 	return result.Bytes(), err
@@ -206,8 +242,12 @@ func renderSourceBlock(ctx *renderer.Context, b types.DelimitedBlock) ([]byte, e
 		ctx.SetIncludeBlankLine(previouslyInclude)
 	}()
 	language := b.Attributes.GetAsString(types.AttrLanguage)
+	elements, err := applySubstitutionsOnElements(ctx, discardTrailingBlankLines(b.Elements), substitutionsOf(b.Attributes, types.DefaultSubstitutions(b.Kind)))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to render source block")
+	}
 	result := bytes.NewBuffer(nil)
-	err := sourceBlockTmpl.Execute(result, ContextualPipeline{
+	err = sourceBlockTmpl.Execute(result, ContextualPipeline{
 		Context: ctx,
 		Data: struct {
 			ID       string
@@ -216,42 +256,21 @@ func renderSourceBlock(ctx *renderer.Context, b types.DelimitedBlock) ([]byte, e
 			Elements []interface{}
 		}{
 			ID:       generateID(ctx, b.Attributes),
-			Title:    getTitle(b.Attributes),
+			Title:    getCaptionedTitle(ctx, b, b.Attributes, ctx.GetAndIncrementListingBlockCounter),
 			Language: language,
-			Elements: discardTrailingBlankLines(b.Elements),
+			Elements: elements,
 		},
 	})
 	return result.Bytes(), err
 }
 
 func renderExampleBlock(ctx *renderer.Context, b types.DelimitedBlock) ([]byte, error) {
-	result := bytes.NewBuffer(nil)
 	if k, ok := b.Attributes[types.AttrAdmonitionKind].(types.AdmonitionKind); ok {
-		err := admonitionBlockTmpl.Execute(result, ContextualPipeline{
-			Context: ctx,
-			Data: struct {
-				ID        string
-				Class     string
-				IconClass string
-				IconTitle string
-				Title     string
-				Elements  []interface{}
-			}{
-				ID:        generateID(ctx, b.Attributes),
-				Class:     getClass(k),
-				IconClass: getIconClass(ctx, k),
-				IconTitle: getIconTitle(k),
-				Title:     getTitle(b.Attributes),
-				Elements:  discardTrailingBlankLines(b.Elements),
-			},
-		})
-		return result.Bytes(), err
+		return renderAdmonitionBlock(ctx, b, k)
 	}
 	// default, example block
-	var title string
-	if b.Attributes.Has(types.AttrTitle) {
-		title = "Example " + strconv.Itoa(ctx.GetAndIncrementExampleBlockCounter()) + ". " + getTitle(b.Attributes)
-	}
+	result := bytes.NewBuffer(nil)
+	title := getCaptionedTitle(ctx, b, b.Attributes, ctx.GetAndIncrementExampleBlockCounter)
 	err := exampleBlockTmpl.Execute(result, ContextualPipeline{
 		Context: ctx,
 		Data: struct {
@@ -267,6 +286,29 @@ func renderExampleBlock(ctx *renderer.Context, b types.DelimitedBlock) ([]byte,
 	return result.Bytes(), err
 }
 
+func renderAdmonitionBlock(ctx *renderer.Context, b types.DelimitedBlock, k types.AdmonitionKind) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	err := admonitionBlockTmpl.Execute(result, ContextualPipeline{
+		Context: ctx,
+		Data: struct {
+			ID        string
+			Class     string
+			IconClass string
+			IconTitle string
+			Title     string
+			Elements  []interface{}
+		}{
+			ID:        generateID(ctx, b.Attributes),
+			Class:     getClass(k),
+			IconClass: getIconClass(ctx, k),
+			IconTitle: getIconTitle(k),
+			Title:     getTitle(ctx, b.Attributes),
+			Elements:  discardTrailingBlankLines(b.Elements),
+		},
+	})
+	return result.Bytes(), err
+}
+
 func renderQuoteBlock(ctx *renderer.Context, b types.DelimitedBlock) ([]byte, error) {
 	result := bytes.NewBuffer(nil)
 	err := quoteBlockTmpl.Execute(result, ContextualPipeline{
@@ -278,7 +320,7 @@ func renderQuoteBlock(ctx *renderer.Context, b types.DelimitedBlock) ([]byte, er
 			Elements    []interface{}
 		}{
 			ID:          generateID(ctx, b.Attributes),
-			Title:       getTitle(b.Attributes),
+			Title:       getTitle(ctx, b.Attributes),
 			Attribution: NewDelimitedBlockAttribution(b),
 			Elements:    b.Elements,
 		},
@@ -314,7 +356,7 @@ func renderVerseBlock(ctx *renderer.Context, b types.DelimitedBlock) ([]byte, er
 			Elements    []interface{}
 		}{
 			ID:          generateID(ctx, b.Attributes),
-			Title:       getTitle(b.Attributes),
+			Title:       getTitle(ctx, b.Attributes),
 			Attribution: NewDelimitedBlockAttribution(b),
 			Elements:    elements,
 		},
@@ -337,7 +379,50 @@ func renderSidebarBlock(ctx *renderer.Context, b types.DelimitedBlock) ([]byte,
 			Elements []interface{}
 		}{
 			ID:       generateID(ctx, b.Attributes),
-			Title:    getTitle(b.Attributes),
+			Title:    getTitle(ctx, b.Attributes),
+			Elements: discardTrailingBlankLines(b.Elements),
+		},
+	})
+	return result.Bytes(), err
+}
+
+func renderOpenBlock(ctx *renderer.Context, b types.DelimitedBlock) ([]byte, error) {
+	if k, ok := b.Attributes[types.AttrAdmonitionKind].(types.AdmonitionKind); ok {
+		return renderAdmonitionBlock(ctx, b, k)
+	}
+	var class string
+	if b.Kind == types.PartIntro {
+		class = "partintro"
+	}
+	result := bytes.NewBuffer(nil)
+	err := openBlockTmpl.Execute(result, ContextualPipeline{
+		Context: ctx,
+		Data: struct {
+			ID       string
+			Title    string
+			Class    string
+			Elements []interface{}
+		}{
+			ID:       generateID(ctx, b.Attributes),
+			Title:    getTitle(ctx, b.Attributes),
+			Class:    class,
+			Elements: discardTrailingBlankLines(b.Elements),
+		},
+	})
+	return result.Bytes(), err
+}
+
+func renderAbstractBlock(ctx *renderer.Context, b types.DelimitedBlock) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	err := abstractBlockTmpl.Execute(result, ContextualPipeline{
+		Context: ctx,
+		Data: struct {
+			ID       string
+			Title    string
+			Elements []interface{}
+		}{
+			ID:       generateID(ctx, b.Attributes),
+			Title:    getTitle(ctx, b.Attributes),
 			Elements: discardTrailingBlankLines(b.Elements),
 		},
 	})
@@ -261,6 +261,39 @@ foo
 <p>foo</p>
 </div>
 </div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("example blocks with unset and custom example caption", func() {
+			source := `:example-caption!:
+
+.first example
+====
+foo
+====
+
+:example-caption: Exhibit
+
+.second example
+====
+bar
+====`
+			expected := `<div class="exampleblock">
+<div class="title">first example</div>
+<div class="content">
+<div class="paragraph">
+<p>foo</p>
+</div>
+</div>
+</div>
+<div class="exampleblock">
+<div class="title">Exhibit 1. second example</div>
+<div class="content">
+<div class="paragraph">
+<p>bar</p>
+</div>
+</div>
 </div>`
 			Expect(source).To(RenderHTML5Element(expected))
 		})
@@ -628,6 +661,28 @@ ____`
 		})
 	})
 
+	Context("Markdown-style quote blocks", func() {
+
+		It("multi-line quote with author and title", func() {
+			source := `> some *quote*
+> content
+> -- john doe, quote title`
+			expected := `<div class="quoteblock">
+<blockquote>
+<div class="paragraph">
+<p>some <strong>quote</strong>
+content</p>
+</div>
+</blockquote>
+<div class="attribution">
+&#8212; john doe<br>
+<cite>quote title</cite>
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+	})
+
 	Context("verse blocks", func() {
 
 		It("single-line verse with author and title ", func() {
@@ -742,6 +797,162 @@ ____`
 		})
 	})
 
+	Context("open blocks", func() {
+
+		It("open block with ID, title and multiple elements", func() {
+			source := `[#open]
+.a title
+--
+some *bold* content
+
+* an item
+--`
+			expected := `<div id="open" class="openblock">
+<div class="title">a title</div>
+<div class="content">
+<div class="paragraph">
+<p>some <strong>bold</strong> content</p>
+</div>
+<div class="ulist">
+<ul>
+<li>
+<p>an item</p>
+</li>
+</ul>
+</div>
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("open block masquerading as an admonition", func() {
+			source := `[NOTE]
+--
+some content
+--`
+			expected := `<div class="admonitionblock note">
+<table>
+<tr>
+<td class="icon">
+<div class="title">Note</div>
+</td>
+<td class="content">
+<div class="paragraph">
+<p>some content</p>
+</div>
+</td>
+</tr>
+</table>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("open block masquerading as a sidebar", func() {
+			source := `[sidebar]
+--
+some content
+--`
+			expected := `<div class="sidebarblock">
+<div class="content">
+<div class="paragraph">
+<p>some content</p>
+</div>
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("open block masquerading as a listing block", func() {
+			source := `[listing]
+--
+some <content>
+--`
+			expected := `<div class="listingblock">
+<div class="content">
+<pre>some &lt;content&gt;</pre>
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("open block masquerading as a source block", func() {
+			source := `[source,go]
+--
+func foo() {}
+--`
+			expected := `<div class="listingblock">
+<div class="content">
+<pre class="highlight"><code class="language-go" data-lang="go">func foo() {}</code></pre>
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("open block masquerading as a comment block", func() {
+			source := `[comment]
+--
+some content
+--`
+			expected := ``
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("open block masquerading as an abstract", func() {
+			source := `[abstract]
+.Abstract
+--
+some content
+--`
+			expected := `<div class="quoteblock abstract">
+<div class="title">Abstract</div>
+<blockquote>
+<div class="paragraph">
+<p>some content</p>
+</div>
+</blockquote>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("open block masquerading as a part intro", func() {
+			source := `[partintro]
+--
+some content
+--`
+			expected := `<div class="openblock partintro">
+<div class="content">
+<div class="paragraph">
+<p>some content</p>
+</div>
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("open block attached to a list item", func() {
+			source := `* an item
++
+--
+some content
+--`
+			expected := `<div class="ulist">
+<ul>
+<li>
+<p>an item</p>
+<div class="openblock">
+<div class="content">
+<div class="paragraph">
+<p>some content</p>
+</div>
+</div>
+</div>
+</li>
+</ul>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+	})
+
 	Context("sidebar blocks", func() {
 
 		It("sidebar block with paragraph", func() {
@@ -26,10 +26,11 @@ func init() {
 <meta name="generator" content="{{ .Generator }}">{{ end }}
 <title>{{ escape .Title }}</title>
 </head>
-<body class="article">
+<body class="article{{ if .TocPosition }} toc2 toc-{{ .TocPosition }}{{ end }}">
 <div id="header">
 <h1>{{ .Header }}</h1>{{ if .Details }}
-{{ .Details }}{{ end }}
+{{ .Details }}{{ end }}{{ if .TableOfContents }}
+{{ .TableOfContents }}{{ end }}
 </div>
 <div id="content">
 {{ .Content }}
@@ -70,23 +71,36 @@ func renderDocument(ctx *renderer.Context, output io.Writer) (map[string]interfa
 		if err != nil {
 			return nil, errors.Wrapf(err, "unable to render full document")
 		}
+		var tableOfContents htmltemplate.HTML
+		if getTocPosition(ctx) != "" {
+			// the ToC on the left or right side of the document is rendered in the header
+			renderedTableOfContents, err := renderTableOfContentsContainer(ctx)
+			if err != nil {
+				return nil, errors.Wrapf(err, "unable to render full document")
+			}
+			tableOfContents = htmltemplate.HTML(string(renderedTableOfContents)) //nolint: gosec
+		}
 		revNumber, _ := ctx.Document.Attributes.GetAsString("revnumber")
 		err = documentTmpl.Execute(output, struct {
-			Generator   string
-			Title       string
-			Header      string
-			Content     htmltemplate.HTML
-			RevNumber   string
-			LastUpdated string
-			Details     *htmltemplate.HTML
+			Generator       string
+			Title           string
+			Header          string
+			Content         htmltemplate.HTML
+			RevNumber       string
+			LastUpdated     string
+			Details         *htmltemplate.HTML
+			TocPosition     string
+			TableOfContents htmltemplate.HTML
 		}{
-			Generator:   "libasciidoc", // TODO: externalize this value and include the lib version ?
-			Title:       string(renderedTitle),
-			Header:      string(renderedHeader),
-			Content:     htmltemplate.HTML(string(renderedElements)), //nolint: gosec
-			RevNumber:   revNumber,
-			LastUpdated: ctx.LastUpdated(),
-			Details:     documentDetails,
+			Generator:       "libasciidoc", // TODO: externalize this value and include the lib version ?
+			Title:           string(renderedTitle),
+			Header:          string(renderedHeader),
+			Content:         htmltemplate.HTML(string(renderedElements)), //nolint: gosec
+			RevNumber:       revNumber,
+			LastUpdated:     ctx.LastUpdated(),
+			Details:         documentDetails,
+			TocPosition:     getTocPosition(ctx),
+			TableOfContents: tableOfContents,
 		})
 		if err != nil {
 			return nil, errors.Wrapf(err, "unable to render full document")
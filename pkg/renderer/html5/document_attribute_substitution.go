@@ -1,30 +1,33 @@
 package html5
 
 import (
-	"bytes"
-
 	"github.com/bytesparadise/libasciidoc/pkg/renderer"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 )
 
 func processAttributeDeclaration(ctx *renderer.Context, attr types.DocumentAttributeDeclaration) []byte {
-	ctx.Document.Attributes.AddDeclaration(attr)
+	ctx.AddAttributeDeclaration(attr)
 	return []byte{}
 }
 
 func processAttributeReset(ctx *renderer.Context, attr types.DocumentAttributeReset) []byte {
-	ctx.Document.Attributes.Reset(attr)
+	ctx.ResetAttribute(attr)
 	return []byte{}
 }
 
 func renderAttributeSubstitution(ctx *renderer.Context, attr types.DocumentAttributeSubstitution) []byte {
-	result := bytes.NewBuffer(nil)
-	if value, found := ctx.Document.Attributes.GetAsString(attr.Name); found {
-		result.WriteString(value)
-	} else if value, found := predefined.GetAsString(attr.Name); found {
-		result.WriteString(value)
-	} else {
-		result.WriteString("{" + attr.Name + "}")
+	return []byte(renderer.AttributeValue(ctx, attr))
+}
+
+func renderInlineAttributeEntry(ctx *renderer.Context, entry types.InlineAttributeEntry) []byte {
+	renderer.ApplyInlineAttributeEntry(ctx, entry)
+	return []byte{}
+}
+
+func renderCounterSubstitution(ctx *renderer.Context, counter types.CounterSubstitution) []byte {
+	value := ctx.IncrementCounter(counter.Name, counter.Value)
+	if counter.Hidden {
+		return []byte{}
 	}
-	return result.Bytes()
+	return []byte(value)
 }
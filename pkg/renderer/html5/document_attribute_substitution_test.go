@@ -2,12 +2,17 @@ package html5_test
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
 	. "github.com/bytesparadise/libasciidoc/testsupport"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
+	log "github.com/sirupsen/logrus"
 )
 
 var _ = Describe("document with attributes", func() {
@@ -150,4 +155,414 @@ a {blank} here.`
 		})
 	})
 
+	Context("counters", func() {
+
+		It("numeric and alphabetic counters", func() {
+			source := `{counter:foo} {counter:foo} ({foo}) {counter:bar:A} {counter:bar} {counter2:baz:7}{counter:baz}`
+			expected := `<div class="paragraph">
+<p>1 2 (2) A B 8</p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("counter starting from an attribute value", func() {
+			source := `:foo: 41
+
+{counter:foo}`
+			expected := `<div class="paragraph">
+<p>42</p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("counters in block titles", func() {
+			source := `:version: 1.0
+
+.Step {counter:step}
+foo
+
+.Step {counter:step} of version {version}
+bar`
+			expected := `<div class="paragraph">
+<div class="doctitle">Step 1</div>
+<p>foo</p>
+</div>
+<div class="paragraph">
+<div class="doctitle">Step 2 of version 1.0</div>
+<p>bar</p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("counters in section titles with table of contents and cross-references", func() {
+			source := `= A title
+:toc:
+
+See <<first>> and <<second>>.
+
+[#first]
+== Sec {counter:sec}
+
+[#second]
+== Sec {counter:sec}
+
+{counter:sec}`
+			expected := `<div id="toc" class="toc">
+<div id="toctitle">Table of Contents</div>
+<ul class="sectlevel1">
+<li><a href="#first">Sec 1</a></li>
+<li><a href="#second">Sec 2</a></li>
+</ul>
+</div>
+<div id="preamble">
+<div class="sectionbody">
+<div class="paragraph">
+<p>See <a href="#first">Sec 1</a> and <a href="#second">Sec 2</a>.</p>
+</div>
+</div>
+</div>
+<div class="sect1">
+<h2 id="first">Sec 1</h2>
+<div class="sectionbody">
+</div>
+</div>
+<div class="sect1">
+<h2 id="second">Sec 2</h2>
+<div class="sectionbody">
+<div class="paragraph">
+<p>3</p>
+</div>
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("counters in section titles with generated IDs", func() {
+			source := `== Part {counter:part}
+
+:chapter: 4
+
+== Part {counter:part} {counter:chapter}
+
+== Part {counter:part}`
+			expected := `<div class="sect1">
+<h2 id="_part_1">Part 1</h2>
+<div class="sectionbody">
+</div>
+</div>
+<div class="sect1">
+<h2 id="_part_2_5">Part 2 5</h2>
+<div class="sectionbody">
+</div>
+</div>
+<div class="sect1">
+<h2 id="_part_3">Part 3</h2>
+<div class="sectionbody">
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+	})
+
+	Context("intrinsic attributes", func() {
+
+		BeforeEach(func() {
+			os.Setenv("SOURCE_DATE_EPOCH", "1500000000")
+		})
+
+		AfterEach(func() {
+			os.Unsetenv("SOURCE_DATE_EPOCH")
+		})
+
+		It("attributes derived from the document file", func() {
+			source := `{docname}{docfilesuffix} in {docdir}`
+			dir, err := filepath.Abs(".")
+			Expect(err).NotTo(HaveOccurred())
+			expected := `<div class="paragraph">
+<p>test.adoc in ` + dir + `</p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected, renderer.Filename("test.adoc")))
+		})
+
+		It("date and time attributes", func() {
+			source := `{docdate} {doctime} ({docyear}), {localdatetime} ({localyear})`
+			expected := `<div class="paragraph">
+<p>2017-07-14 02:40:00 UTC (2017), 2017-07-14 02:40:00 UTC (2017)</p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("backend attributes", func() {
+			source := `{backend} {basebackend} {outfilesuffix} {filetype}`
+			expected := `<div class="paragraph">
+<p>html5 html .html html</p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("version attribute", func() {
+			source := `version {libasciidoc-version}`
+			expected := `<div class="paragraph">
+<p>version 1.2.3</p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected, renderer.Version("1.2.3")))
+		})
+
+		It("safe mode attributes", func() {
+			source := `{safe-mode-name} ({safe-mode-level}){safe-mode-secure}`
+			expected := `<div class="paragraph">
+<p>secure (20)</p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected, renderer.SafeMode(types.SafeModeSecure)))
+		})
+
+		It("default safe mode attributes", func() {
+			source := `{safe-mode-name} ({safe-mode-level})`
+			expected := `<div class="paragraph">
+<p>unsafe (0)</p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("overriding intrinsic attribute", func() {
+			source := `= Title
+:docname: custom
+
+{docname}`
+			expected := `<div class="paragraph">
+<p>custom</p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected, renderer.Filename("test.adoc")))
+		})
+	})
+
+	Context("attribute overrides", func() {
+
+		It("hard overrides take precedence over declarations", func() {
+			source := `= Title
+:product: Default Product
+:edition: Community
+
+{product} {edition}
+
+:product: Other Product
+:edition!:
+
+{product} {edition}`
+			expected := `<div class="paragraph">
+<p>Acme Pro</p>
+</div>
+<div class="paragraph">
+<p>Acme Pro</p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected, renderer.Attributes(map[string]string{
+				"product": "Acme",
+				"edition": "Pro",
+			})))
+		})
+
+		It("soft overrides can be overridden by declarations", func() {
+			source := `{product} {edition}
+
+:product: Other Product
+:edition!:
+
+{product} {edition}`
+			expected := `<div class="paragraph">
+<p>Acme Pro</p>
+</div>
+<div class="paragraph">
+<p>Other Product {edition}</p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected, renderer.Attributes(map[string]string{
+				"product":  "Acme@",
+				"edition@": "Pro",
+			})))
+		})
+
+		It("hard unset takes precedence over declarations", func() {
+			source := `:product: Default Product
+
+{product}`
+			expected := `<div class="paragraph">
+<p>{product}</p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected, renderer.Attributes(map[string]string{
+				"product!": "",
+			})))
+		})
+
+		It("hard unset of a default attribute", func() {
+			source := `.A title
+image::foo.png[]`
+			expected := `<div class="imageblock">
+<div class="content">
+<img src="foo.png" alt="foo">
+</div>
+<div class="title">A title</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected, renderer.Attributes(map[string]string{
+				"figure-caption!": "",
+			})))
+		})
+
+		It("overrides in the paths of the files to include", func() {
+			source := `:includedir: unknown
+
+include::{includedir}/grandchild-include.adoc[]`
+			expected := `<div class="paragraph">
+<p>first line of grandchild</p>
+</div>
+<div class="paragraph">
+<p>last line of grandchild</p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected, renderer.Attributes(map[string]string{
+				"includedir": "../../../test/includes",
+			})))
+		})
+	})
+
+	Context("missing attributes", func() {
+
+		It("should skip reference to missing attribute by default", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			source := `a {product} b`
+			expected := `<div class="paragraph">
+<p>a {product} b</p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+			Expect(console).To(ContainMessageWithLevel(log.WarnLevel, "skipping reference to missing attribute 'product' (test.adoc:1:3)"))
+		})
+
+		It("should drop reference to missing attribute", func() {
+			source := `:attribute-missing: drop
+
+a {product} b`
+			expected := `<div class="paragraph">
+<p>a  b</p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("should drop line with reference to missing attribute", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			source := `:attribute-missing: drop-line
+
+first line
+second {product} line
+third line`
+			expected := `<div class="paragraph">
+<p>first line
+third line</p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+			Expect(console).To(ContainMessageWithLevel(log.WarnLevel, "dropping line containing reference to missing attribute 'product' (test.adoc:4:8)"))
+		})
+
+		It("should drop paragraph with single line with reference to missing attribute", func() {
+			source := `:attribute-missing: drop-line
+
+{product}
+
+after`
+			expected := `<div class="paragraph">
+<p>after</p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("should warn about reference to missing attribute", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			source := `:attribute-missing: warn
+
+a {prodcut} b`
+			expected := `<div class="paragraph">
+<p>a {prodcut} b</p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+			Expect(console).To(ContainMessageWithLevel(log.WarnLevel, "skipping reference to missing attribute 'prodcut' (test.adoc:3:3)"))
+		})
+
+		It("should report reference to missing attribute in block title at the position of the title", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			source := `:attribute-missing: warn
+
+.Title of {product}
+a paragraph`
+			expected := `<div class="paragraph">
+<div class="doctitle">Title of {product}</div>
+<p>a paragraph</p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+			Expect(console).To(ContainMessageWithLevel(log.WarnLevel, "skipping reference to missing attribute 'product' (test.adoc:3:1)"))
+		})
+
+		It("should apply attribute-missing from overrides", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			source := `a {prodcut} b`
+			expected := `<div class="paragraph">
+<p>a {prodcut} b</p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected, renderer.Attributes(map[string]string{
+				"attribute-missing": "warn",
+			})))
+			Expect(console).To(ContainMessageWithLevel(log.WarnLevel, "skipping reference to missing attribute 'prodcut' (test.adoc:1:3)"))
+		})
+
+		It("should not report reference to declared attribute", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			source := `:attribute-missing: warn
+:product: Acme
+
+a {product} b`
+			expected := `<div class="paragraph">
+<p>a Acme b</p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+			Expect(console).ToNot(ContainAnyMessageWithLevels(log.ErrorLevel, log.WarnLevel))
+		})
+	})
+
+	Context("inline attribute entries", func() {
+
+		It("should set attribute inline", func() {
+			source := `{set:product:Acme}a {product} b`
+			expected := `<div class="paragraph">
+<p>a Acme b</p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("should drop line when unsetting attribute inline by default", func() {
+			source := `:product: Acme
+
+a {product} b
+{set:product!}
+c {product} d`
+			expected := `<div class="paragraph">
+<p>a Acme b
+c {product} d</p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("should keep line when unsetting attribute inline with attribute-undefined drop", func() {
+			source := `:product: Acme
+:attribute-undefined: drop
+
+a {product} b
+{set:product!}c {product} d`
+			expected := `<div class="paragraph">
+<p>a Acme b
+c {product} d</p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+	})
 })
@@ -0,0 +1,123 @@
+package html5
+
+import (
+	"bytes"
+	"html"
+	"strconv"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	log "github.com/sirupsen/logrus"
+)
+
+// getTitle returns the title of the element with the given attributes, rendered in HTML: the document attributes
+// and the counters are substituted, the text is escaped (with the typographic replacements) and the index terms
+// are rendered with their anchor
+func getTitle(ctx *renderer.Context, attrs types.ElementAttributes) string {
+	if !attrs.Has(types.AttrTitle) {
+		return ""
+	}
+	position, _ := attrs[types.AttrTitlePosition].(types.Position)
+	if elements, ok := attrs[types.AttrTitleElements].(types.InlineElements); ok {
+		// the title was parsed along with the document, to collect its index terms
+		return substituteTitleElements(ctx, attrs.GetAsString(types.AttrID), elements, position, true)
+	}
+	return replace(html.EscapeString(substituteTitle(ctx, attrs.GetAsString(types.AttrID), attrs.GetAsString(types.AttrTitle), position)))
+}
+
+// getTitleText returns the title of the element with the given attributes, in which the document attributes
+// and the counters have been substituted
+func getTitleText(ctx *renderer.Context, attrs types.ElementAttributes) string {
+	if !attrs.Has(types.AttrTitle) {
+		return ""
+	}
+	position, _ := attrs[types.AttrTitlePosition].(types.Position)
+	return substituteTitle(ctx, attrs.GetAsString(types.AttrID), attrs.GetAsString(types.AttrTitle), position)
+}
+
+// substituteTitle returns the given title of the element with the given ID, in which the document attributes
+// and the counters have been substituted. The references to missing attributes are reported at the given position
+// of the title, since the title is parsed on its own.
+func substituteTitle(ctx *renderer.Context, id, title string, position types.Position) string {
+	title = strings.TrimSpace(title)
+	if !strings.Contains(title, "{") && !strings.Contains(title, "((") && !strings.Contains(title, "indexterm") {
+		return title
+	}
+	elements, err := parser.ParseBlockTitle(title)
+	if err != nil {
+		log.Warnf("unable to substitute attributes in title '%s': %v", title, err)
+		return title
+	}
+	return substituteTitleElements(ctx, id, elements, position, false)
+}
+
+// substituteTitleElements returns the given (parsed) title of the element with the given ID, in which the document
+// attributes and the counters have been substituted. If `escape` is true, then the text is escaped (with the
+// typographic replacements) and the index terms are rendered with their anchor, otherwise only the visible terms
+// are displayed.
+func substituteTitleElements(ctx *renderer.Context, id string, elements types.InlineElements, position types.Position, escape bool) string {
+	result := bytes.NewBuffer(nil)
+	write := func(s string) {
+		if escape {
+			s = replace(html.EscapeString(s))
+		}
+		result.WriteString(s)
+	}
+	previous := ctx.SetRenderedTitle(id)
+	defer ctx.SetRenderedTitle(previous)
+	ctx.SetDropLine(false)
+	for _, element := range elements {
+		switch element := element.(type) {
+		case types.DocumentAttributeSubstitution:
+			element.Position = position
+			write(string(renderAttributeSubstitution(ctx, element)))
+		case types.CounterSubstitution:
+			write(string(renderCounterSubstitution(ctx, element)))
+		case types.InlineAttributeEntry:
+			write(string(renderInlineAttributeEntry(ctx, element)))
+		case types.IndexTerm:
+			if escape {
+				if term, err := renderIndexTerm(ctx, element); err == nil {
+					result.Write(term)
+				}
+			} else if element.Visible {
+				result.WriteString(element.PrimaryTerm())
+			}
+		case types.StringElement:
+			write(element.Content)
+		}
+	}
+	if ctx.SetDropLine(false) {
+		return ""
+	}
+	return result.String()
+}
+
+// renderTitleElements renders the given title of the element with the given ID (eg: a section), in which the counters
+// are incremented only once, even if the title is rendered several times (eg: in the table of contents)
+func renderTitleElements(ctx *renderer.Context, id string, title types.InlineElements) ([]byte, error) {
+	previous := ctx.SetRenderedTitle(id)
+	defer ctx.SetRenderedTitle(previous)
+	return renderElement(ctx, title)
+}
+
+// getCaptionedTitle returns the title of the given element (with the given attributes), prefixed
+// with its caption (eg: `Figure 1. `), unless the caption was disabled. The number is obtained with
+// the given function, unless the caption was overridden by the `caption` attribute of the element
+func getCaptionedTitle(ctx *renderer.Context, element interface{}, attrs types.ElementAttributes, nextNumber func() int) string {
+	title := getTitle(ctx, attrs)
+	if title == "" {
+		return ""
+	}
+	label, numbered, ok := renderer.Caption(attrs, ctx.Document.Attributes, renderer.CaptionAttribute(element))
+	switch {
+	case !ok:
+		return title
+	case !numbered:
+		return html.EscapeString(label) + " " + title
+	default:
+		return html.EscapeString(label) + " " + strconv.Itoa(nextNumber()) + ". " + title
+	}
+}
@@ -1,6 +1,13 @@
 package html5_test
 
 import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
 	. "github.com/bytesparadise/libasciidoc/testsupport"
 
 	. "github.com/onsi/ginkgo"
@@ -264,10 +271,10 @@ func helloworld() {
 <div class="content">
 <pre class="highlight"><code>package includes
 
-import "fmt"
+import &#34;fmt&#34;
 
 func helloworld() {
-	fmt.Println("hello, world!")
+	fmt.Println(&#34;hello, world!&#34;)
 }</code></pre>
 </div>
 </div>`
@@ -435,6 +442,20 @@ func helloworld() {
 	fmt.Println(&#34;hello, world!&#34;)
 }</pre>
 </div>
+</div>`
+				Expect(source).To(RenderHTML5Element(expected))
+			})
+
+			It("should include multiple lines in listing block with indent", func() {
+				source := `----
+include::../../../test/includes/hello_world.go.txt[lines=5..7,indent=2]
+----`
+				expected := `<div class="listingblock">
+<div class="content">
+<pre>  func helloworld() {
+  	fmt.Println(&#34;hello, world!&#34;)
+  }</pre>
+</div>
 </div>`
 				Expect(source).To(RenderHTML5Element(expected))
 			})
@@ -443,6 +464,26 @@ func helloworld() {
 
 	Context("file inclusions with tag ranges", func() {
 
+		It("file inclusion with all tags except one", func() {
+			source := `include::../../../test/includes/tag-include.adoc[tags=**;!section]`
+			expected := `<div class="paragraph">
+<p>content</p>
+</div>
+<div class="paragraph">
+<p>end</p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("optional missing file inclusion", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			source := `include::../../../test/includes/unknown.adoc[opts=optional]`
+			expected := ``
+			Expect(source).To(RenderHTML5Element(expected))
+			Expect(console).ToNot(ContainAnyMessageWithLevels(log.ErrorLevel, log.WarnLevel))
+		})
+
 		It("file inclusion with single tag", func() {
 			source := `include::../../../test/includes/tag-include.adoc[tag=section]`
 			expected := `<div class="sect1">
@@ -564,6 +605,63 @@ last line of parent</pre>
 		})
 	})
 
+	Context("inclusion of non-asciidoc file in verbatim blocks", func() {
+
+		It("should include raw lines in listing block", func() {
+			source := "----\ninclude::../../../test/includes/verbatim.sh[]\n----"
+			expected := "<div class=\"listingblock\">\n<div class=\"content\">\n" +
+				"<pre>#!/bin/sh\n\n# a comment\n* not a list\n\techo &#34;&lt;tab&gt;&#34; &amp;&amp; exit 0  </pre>\n" +
+				"</div>\n</div>"
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("should include raw lines in source block with tabsize", func() {
+			source := "[source,sh,tabsize=4]\n----\ninclude::../../../test/includes/verbatim.sh[lines=3..5]\n----"
+			expected := "<div class=\"listingblock\">\n<div class=\"content\">\n" +
+				"<pre class=\"highlight\"><code class=\"language-sh\" data-lang=\"sh\"># a comment\n* not a list\n    echo &#34;&lt;tab&gt;&#34; &amp;&amp; exit 0  </code></pre>\n" +
+				"</div>\n</div>"
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("should include raw lines in literal block", func() {
+			source := "....\ninclude::../../../test/includes/verbatim.sh[lines=3..4]\n...."
+			expected := `<div class="literalblock">
+<div class="content">
+<pre># a comment
+* not a list</pre>
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("should include escaped raw lines in literal block", func() {
+			source := "....\ninclude::../../../test/includes/verbatim.sh[lines=5]\n...."
+			expected := "<div class=\"literalblock\">\n<div class=\"content\">\n" +
+				"<pre>\techo &#34;&lt;tab&gt;&#34; &amp;&amp; exit 0  </pre>\n" +
+				"</div>\n</div>"
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("should include escaped raw lines in fenced block", func() {
+			source := "```\ninclude::../../../test/includes/verbatim.sh[lines=5]\n```"
+			expected := "<div class=\"listingblock\">\n<div class=\"content\">\n" +
+				"<pre class=\"highlight\"><code>\techo &#34;&lt;tab&gt;&#34; &amp;&amp; exit 0  </code></pre>\n" +
+				"</div>\n</div>"
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("should include asciidoc file with a list as raw lines in listing block", func() {
+			source := "----\ninclude::../../../test/includes/list.adoc[]\n----"
+			expected := `<div class="listingblock">
+<div class="content">
+<pre>* item
+* &lt;b&gt;other&lt;/b&gt;</pre>
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+	})
+
 	Context("inclusion with attribute in path", func() {
 
 		It("should resolve path with attribute in standalone block", func() {
@@ -684,4 +782,37 @@ include::{includedir}/unknown.adoc[leveloffset=+1]
 			})
 		})
 	})
+
+	Context("remote file inclusions", func() {
+
+		fetcher := parser.FetcherFunc(func(uri string) (io.ReadCloser, error) {
+			if uri != "https://example.com/README.adoc" {
+				return nil, fmt.Errorf("not found: %s", uri)
+			}
+			return ioutil.NopCloser(strings.NewReader("*remote* content")), nil
+		})
+
+		It("should include remote file when allow-uri-read is set", func() {
+			source := `include::https://example.com/README.adoc[]`
+			expected := `<div class="paragraph">
+<p><strong>remote</strong> content</p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected,
+				renderer.Attributes(map[string]string{
+					"allow-uri-read": "",
+				}),
+				renderer.URIFetcher(fetcher)))
+		})
+
+		It("should render a link to remote file when allow-uri-read is not set", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			source := `include::https://example.com/README.adoc[]`
+			expected := `<div class="paragraph">
+<p><a href="https://example.com/README.adoc" class="bare">https://example.com/README.adoc</a></p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected, renderer.URIFetcher(fetcher)))
+			Expect(console).To(ContainMessageWithLevel(log.WarnLevel, "cannot include remote file 'https://example.com/README.adoc' unless the 'allow-uri-read' attribute is set from the API or the command line"))
+		})
+	})
 })
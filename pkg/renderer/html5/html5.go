@@ -2,8 +2,10 @@ package html5
 
 import (
 	"bytes"
+	"html"
 	"io"
 	"reflect"
+	"strings"
 
 	"github.com/bytesparadise/libasciidoc/pkg/renderer"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
@@ -91,10 +93,16 @@ func renderElement(ctx *renderer.Context, element interface{}) ([]byte, error) {
 		return renderTableOfContents(ctx, e)
 	case types.Section:
 		return renderSection(ctx, e)
+	case types.DiscreteHeading:
+		return renderDiscreteHeading(ctx, e)
 	case types.Preamble:
 		return renderPreamble(ctx, e)
 	case types.BlankLine:
 		return renderBlankLine(ctx, e)
+	case types.ThematicBreak:
+		return renderThematicBreak(ctx, e)
+	case types.PageBreak:
+		return renderPageBreak(ctx, e)
 	case types.LabeledList:
 		return renderLabeledList(ctx, e)
 	case types.OrderedList:
@@ -111,6 +119,10 @@ func renderElement(ctx *renderer.Context, element interface{}) ([]byte, error) {
 		return renderPassthrough(ctx, e)
 	case types.ImageBlock:
 		return renderImageBlock(ctx, e)
+	case types.VideoBlock:
+		return renderVideoBlock(ctx, e)
+	case types.AudioBlock:
+		return renderAudioBlock(ctx, e)
 	case types.InlineImage:
 		return renderInlineImage(ctx, e)
 	case types.DelimitedBlock:
@@ -125,14 +137,22 @@ func renderElement(ctx *renderer.Context, element interface{}) ([]byte, error) {
 		return renderLink(ctx, e)
 	case types.StringElement:
 		return renderStringElement(ctx, e)
+	case types.VerbatimLine:
+		return []byte(html.EscapeString(e.Content)), nil
 	case types.Footnote:
 		return renderFootnote(ctx, e)
+	case types.IndexTerm:
+		return renderIndexTerm(ctx, e)
 	case types.DocumentAttributeDeclaration:
 		return processAttributeDeclaration(ctx, e), nil
 	case types.DocumentAttributeReset:
 		return processAttributeReset(ctx, e), nil
 	case types.DocumentAttributeSubstitution:
 		return renderAttributeSubstitution(ctx, e), nil
+	case types.CounterSubstitution:
+		return renderCounterSubstitution(ctx, e), nil
+	case types.InlineAttributeEntry:
+		return renderInlineAttributeEntry(ctx, e), nil
 	case types.LineBreak:
 		return renderLineBreak()
 	case types.UserMacro:
@@ -161,8 +181,20 @@ func renderPlainString(ctx *renderer.Context, element interface{}) ([]byte, erro
 		return []byte("\n\n"), nil
 	case types.StringElement:
 		return []byte(element.Content), nil
+	case types.VerbatimLine:
+		return []byte(element.Content), nil
+	case types.IndexTerm:
+		if element.Visible {
+			return []byte(element.PrimaryTerm()), nil
+		}
+		return nil, nil
+	case types.Passthrough:
+		return renderPassthroughContent(ctx, element)
 	case types.Paragraph:
 		return renderLines(ctx, element.Lines, renderPlainString, false)
+	case types.LiteralBlock:
+		// eg: indented lines of a file included in a delimited block
+		return []byte(html.EscapeString(strings.Join(element.Lines, "\n"))), nil
 	case types.InlineElements:
 		return renderLine(ctx, element, renderPlainString)
 	case []types.InlineElements:
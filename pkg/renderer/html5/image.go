@@ -2,33 +2,49 @@ package html5
 
 import (
 	"bytes"
-	"fmt"
+	"encoding/base64"
 	"html"
+	"io/ioutil"
 	"net/url"
-	"path/filepath"
+	"os"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
 	texttemplate "text/template"
 
 	"github.com/bytesparadise/libasciidoc/pkg/renderer"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
 )
 
 var blockImageTmpl texttemplate.Template
 var inlineImageTmpl texttemplate.Template
+var imageTmpl texttemplate.Template
+var interactiveSVGTmpl texttemplate.Template
 
 // initializes the templates
 func init() {
-	blockImageTmpl = newTextTemplate("block image", `<div{{ if .ID }} id="{{ .ID }}"{{ end }} class="imageblock{{ if .Role }} {{ .Role }}{{ end }}">
+	blockImageTmpl = newTextTemplate("block image", `<div{{ if .ID }} id="{{ escape .ID }}"{{ end }} class="imageblock{{ if .Float }} {{ escape .Float }}{{ end }}{{ if .Align }} text-{{ escape .Align }}{{ end }}{{ if .Role }} {{ escape .Role }}{{ end }}">
 <div class="content">
-{{ if ne .Href "" }}<a class="image" href="{{ .Href }}">{{ end }}<img src="{{ .Path }}" alt="{{ .Alt }}"{{ if .Width }} width="{{ .Width }}"{{ end }}{{ if .Height }} height="{{ .Height }}"{{ end }}>{{ if ne .Href "" }}</a>{{ end }}
+{{ if .Href }}<a class="image" href="{{ escape .Href }}"{{ if .Window }} target="{{ escape .Window }}"{{ if eq .Window "_blank" }} rel="noopener"{{ end }}{{ end }}>{{ end }}{{ .Image }}{{ if .Href }}</a>{{ end }}
 </div>{{ if .Title }}
-<div class="title">{{ escape .Title }}</div>
+<div class="title">{{ .Title }}</div>
 {{ else }}
 {{ end }}</div>`,
 		texttemplate.FuncMap{
 			"escape": html.EscapeString,
 		})
-	inlineImageTmpl = newTextTemplate("inline image", `<span class="image{{ if .Role }} {{ .Role }}{{ end }}"><img src="{{ .Path }}" alt="{{ .Alt }}"{{ if .Width }} width="{{ .Width }}"{{ end }}{{ if .Height }} height="{{ .Height }}"{{ end }}{{ if .Title }} title="{{ escape .Title }}"{{ end }}></span>`,
+	inlineImageTmpl = newTextTemplate("inline image", `<span class="image{{ if .Float }} {{ escape .Float }}{{ end }}{{ if .Role }} {{ escape .Role }}{{ end }}">{{ if .Href }}<a class="image" href="{{ escape .Href }}"{{ if .Window }} target="{{ escape .Window }}"{{ if eq .Window "_blank" }} rel="noopener"{{ end }}{{ end }}>{{ end }}{{ .Image }}{{ if .Href }}</a>{{ end }}</span>`,
+		texttemplate.FuncMap{
+			"escape": html.EscapeString,
+		})
+	imageTmpl = newTextTemplate("image", `<img src="{{ escape .Src }}" alt="{{ escape .Alt }}"{{ if .Width }} width="{{ .Width }}"{{ end }}{{ if .Height }} height="{{ .Height }}"{{ end }}{{ if .Title }} title="{{ escape .Title }}"{{ end }}>`,
+		texttemplate.FuncMap{
+			"escape": html.EscapeString,
+		})
+	interactiveSVGTmpl = newTextTemplate("interactive svg", `<object type="image/svg+xml" data="{{ escape .Src }}"{{ if .Width }} width="{{ .Width }}"{{ end }}{{ if .Height }} height="{{ .Height }}"{{ end }}>{{ if .Fallback }}<img src="{{ escape .Fallback }}" alt="{{ escape .Alt }}">{{ else }}<span class="alt">{{ escape .Alt }}</span>{{ end }}</object>`,
 		texttemplate.FuncMap{
 			"escape": html.EscapeString,
 		})
@@ -36,28 +52,30 @@ func init() {
 
 func renderImageBlock(ctx *renderer.Context, img types.ImageBlock) ([]byte, error) {
 	result := bytes.NewBuffer(nil)
-	title := ""
-	if t := img.Attributes.GetAsString(types.AttrTitle); t != "" {
-		title = fmt.Sprintf("Figure %d. %s", ctx.GetAndIncrementImageCounter(), html.EscapeString(t))
+	title := getCaptionedTitle(ctx, img, img.Attributes, ctx.GetAndIncrementImageCounter)
+	// the title of a block image is rendered as its caption, not in the `title` attribute of the `<img>` element
+	image, err := renderImage(ctx, img.Path, img.Attributes, img.Position, imageDimensions(ctx, img.Path, img.Attributes, img.Position), "")
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render block image")
 	}
-	err := blockImageTmpl.Execute(result, struct {
+	err = blockImageTmpl.Execute(result, struct {
 		ID     string
 		Title  string
 		Role   string
+		Float  string
+		Align  string
 		Href   string
-		Alt    string
-		Width  string
-		Height string
-		Path   string
+		Window string
+		Image  string
 	}{
 		ID:     img.Attributes.GetAsString(types.AttrID),
 		Title:  title,
 		Role:   img.Attributes.GetAsString(types.AttrRole),
+		Float:  img.Attributes.GetAsString(types.AttrImageFloat),
+		Align:  img.Attributes.GetAsString(types.AttrImageAlign),
 		Href:   img.Attributes.GetAsString(types.AttrInlineLink),
-		Alt:    img.Attributes.GetAsString(types.AttrImageAlt),
-		Width:  img.Attributes.GetAsString(types.AttrImageWidth),
-		Height: img.Attributes.GetAsString(types.AttrImageHeight),
-		Path:   getImageHref(ctx, img.Path),
+		Window: img.Attributes.GetAsString(types.AttrImageWindow),
+		Image:  image,
 	})
 
 	if err != nil {
@@ -69,21 +87,22 @@ func renderImageBlock(ctx *renderer.Context, img types.ImageBlock) ([]byte, erro
 
 func renderInlineImage(ctx *renderer.Context, img types.InlineImage) ([]byte, error) {
 	result := bytes.NewBuffer(nil)
-	err := inlineImageTmpl.Execute(result, struct {
+	image, err := renderImage(ctx, img.Path, img.Attributes, img.Position, imageDimensions(ctx, img.Path, img.Attributes, img.Position), getTitleText(ctx, img.Attributes))
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render inline image")
+	}
+	err = inlineImageTmpl.Execute(result, struct {
 		Role   string
-		Title  string
+		Float  string
 		Href   string
-		Alt    string
-		Width  string
-		Height string
-		Path   string
+		Window string
+		Image  string
 	}{
-		Title:  getTitle(img.Attributes),
 		Role:   img.Attributes.GetAsString(types.AttrRole),
-		Alt:    img.Attributes.GetAsString(types.AttrImageAlt),
-		Width:  img.Attributes.GetAsString(types.AttrImageWidth),
-		Height: img.Attributes.GetAsString(types.AttrImageHeight),
-		Path:   getImageHref(ctx, img.Path),
+		Float:  img.Attributes.GetAsString(types.AttrImageFloat),
+		Href:   img.Attributes.GetAsString(types.AttrInlineLink),
+		Window: img.Attributes.GetAsString(types.AttrImageWindow),
+		Image:  image,
 	})
 
 	if err != nil {
@@ -93,23 +112,272 @@ func renderInlineImage(ctx *renderer.Context, img types.InlineImage) ([]byte, er
 	return result.Bytes(), nil
 }
 
-// getImageLink returns the `href` value for the image. If the given location `l` is relative,
-// then the context's `imagesdir` attribute is used (if it is set). If the location `l` is
-// absolute, then it is returned as-is
-//
-func getImageHref(ctx *renderer.Context, l string) string {
-	if _, err := url.ParseRequestURI(l); err == nil {
-		// location is a valid URL, so return it as-is
-		return l
+// renderImage renders the image at the given location: an `<img>` element by default, or the content of the SVG file
+// if the `inline` option is set, or an `<object>` element if the `interactive` option is set
+func renderImage(ctx *renderer.Context, location string, attrs types.ElementAttributes, position types.Position, dims dimensions, title string) (string, error) {
+	alt := attrs.GetAsString(types.AttrImageAlt)
+	width := dims.width
+	height := dims.height
+	if isSVG(location) {
+		// the content of the SVG file (which may contain scripts) is only inlined in the `unsafe` mode
+		if attrs.Has(types.AttrImageInline) && ctx.SafeMode() < types.SafeModeSafe {
+			return inlineSVG(ctx, location, position, alt, width, height), nil
+		}
+		if attrs.Has(types.AttrImageInteractive) {
+			result := bytes.NewBuffer(nil)
+			err := interactiveSVGTmpl.Execute(result, struct {
+				Src      string
+				Alt      string
+				Width    string
+				Height   string
+				Fallback string
+			}{
+				Src:      imageSource(ctx, location, position),
+				Alt:      alt,
+				Width:    width,
+				Height:   height,
+				Fallback: fallbackImageSource(ctx, attrs, position),
+			})
+			return result.String(), err
+		}
+	}
+	result := bytes.NewBuffer(nil)
+	err := imageTmpl.Execute(result, struct {
+		Src    string
+		Alt    string
+		Width  string
+		Height string
+		Title  string
+	}{
+		Src:    imageSource(ctx, location, position),
+		Alt:    alt,
+		Width:  width,
+		Height: height,
+		Title:  title,
+	})
+	return result.String(), err
+}
+
+// fallbackImageSource returns the source of the image to display when an interactive SVG is not supported, if any
+func fallbackImageSource(ctx *renderer.Context, attrs types.ElementAttributes, position types.Position) string {
+	if fallback := attrs.GetAsString(types.AttrImageFallback); fallback != "" {
+		return imageSource(ctx, fallback, position)
+	}
+	return ""
+}
+
+// imageSource returns the value of the `src` attribute of the image at the given location: its path (prefixed with
+// the `imagesdir` attribute) or, if the `data-uri` attribute is set and the image is local, its content as a
+// base64-encoded data URI
+func imageSource(ctx *renderer.Context, location string, position types.Position) string {
+	p := ctx.ImagePath(location)
+	if isRemoteImage(p) {
+		return p
 	}
-	if filepath.IsAbs(l) {
-		return l
+	if !ctx.Document.Attributes.Has(types.AttrDataURI) {
+		reportMissingImage(ctx, p, position)
+		return p
 	}
-	// use `imagesdir` attribute if it is set
-	if imagesdir := ctx.GetImagesDir(); imagesdir != "" {
-		return imagesdir + "/" + l
+	content, err := readImage(ctx, p)
+	if err != nil {
+		log.Warnf("unable to embed image '%s' in a data URI (%s): %v", p, position, err)
+		return p
 	}
-	// default
-	return l
+	return "data:" + mediaType(p) + ";base64," + base64.StdEncoding.EncodeToString(content)
+}
 
+// reportMissingImage logs a warning if the local image at the given path does not exist
+// (images cannot be looked up in the `secure` mode, which prevents from accessing files)
+func reportMissingImage(ctx *renderer.Context, p string, position types.Position) {
+	if ctx.SafeMode() >= types.SafeModeSecure {
+		return
+	}
+	f, err := ctx.OpenFile(localImagePath(p))
+	if err != nil {
+		if os.IsNotExist(err) {
+			log.Warnf("image '%s' not found (%s)", p, position)
+		}
+		return
+	}
+	f.Close()
+}
+
+// isRemoteImage returns true if the given path is a URL (eg: `https://example.com/foo.png`), whose content is not embedded
+func isRemoteImage(p string) bool {
+	u, err := url.Parse(p)
+	return err == nil && u.Scheme != "" && u.Scheme != "file" && len(u.Scheme) > 1 // a single letter is a Windows drive
+}
+
+// localImagePath returns the path of the local image at the given path, which may be a `file://` URL
+func localImagePath(p string) string {
+	if u, err := url.Parse(p); err == nil && u.Scheme == "file" {
+		return u.Path
+	}
+	return p
+}
+
+// readImage reads the content of the image at the given path
+func readImage(ctx *renderer.Context, p string) ([]byte, error) {
+	f, err := ctx.OpenFile(localImagePath(p))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ioutil.ReadAll(f)
+}
+
+// mediaType returns the media type of the image at the given path, based on its extension (eg: `image/png`)
+func mediaType(p string) string {
+	ext := strings.ToLower(strings.TrimPrefix(path.Ext(p), "."))
+	switch ext {
+	case "svg":
+		return "image/svg+xml"
+	case "jpg":
+		return "image/jpeg"
+	default:
+		return "image/" + ext
+	}
+}
+
+// isSVG returns true if the image at the given location is an SVG image
+func isSVG(location string) bool {
+	if u, err := url.Parse(location); err == nil {
+		location = u.Path
+	}
+	return strings.EqualFold(path.Ext(location), ".svg")
+}
+
+var svgStartTagRegexp = regexp.MustCompile(`(?s)<svg\b[^>]*>`)
+var svgDimensionAttrRegexp = regexp.MustCompile(`\s(?:width|height)=(?:"[^"]*"|'[^']*')`)
+
+// inlineSVG returns the content of the SVG image at the given location, without its XML declaration and doctype,
+// and with the given dimensions (if any) in place of its own, or the alternate text if the image cannot be read
+func inlineSVG(ctx *renderer.Context, location string, position types.Position, alt, width, height string) string {
+	p := ctx.ImagePath(location)
+	content, err := readImage(ctx, p)
+	if err != nil {
+		log.Warnf("unable to inline SVG image '%s' (%s): %v", p, position, err)
+		return `<span class="alt">` + html.EscapeString(alt) + `</span>`
+	}
+	svg := string(content)
+	start := svgStartTagRegexp.FindStringIndex(svg)
+	if start == nil {
+		log.Warnf("unable to inline SVG image '%s' (%s): no <svg> element found", p, position)
+		return `<span class="alt">` + html.EscapeString(alt) + `</span>`
+	}
+	svg = strings.TrimSpace(svg[start[0]:])
+	if width == "" && height == "" {
+		return svg
+	}
+	startTag := svg[:start[1]-start[0]]
+	dimensions := ""
+	if width != "" {
+		dimensions += ` width="` + width + `"`
+	}
+	if height != "" {
+		dimensions += ` height="` + height + `"`
+	}
+	newStartTag := svgDimensionAttrRegexp.ReplaceAllString(strings.TrimSuffix(startTag, ">"), "") + dimensions + ">"
+	return newStartTag + svg[len(startTag):]
+}
+
+// dimensions the width and height of an image, as they are rendered
+type dimensions struct {
+	width  string
+	height string
+}
+
+// imageDimensions returns the dimensions of the image at the given location: the `width` and `height` attributes,
+// completed with the intrinsic dimensions of the image (preserving its aspect ratio) if the 'ImageDimensions' Option
+// is set, and scaled with the `scale` attribute if none of these attributes was set
+// (the `scaledwidth` attribute only applies to the PDF output, so it is ignored here)
+func imageDimensions(ctx *renderer.Context, location string, attrs types.ElementAttributes, position types.Position) dimensions {
+	p := ctx.ImagePath(location)
+	width := dimensionAttribute(p, attrs, types.AttrImageWidth, position)
+	height := dimensionAttribute(p, attrs, types.AttrImageHeight, position)
+	scale := scaleAttribute(p, attrs, position)
+	readSize := (ctx.ImageDimensions() && (width == "" || height == "")) || (scale > 0 && width == "" && height == "")
+	if !readSize || isRemoteImage(p) {
+		return dimensions{width: width, height: height}
+	}
+	intrinsicWidth, intrinsicHeight, err := ctx.ImageSize(localImagePath(p))
+	if err != nil {
+		if !os.IsNotExist(err) { // missing images are reported along with their source
+			log.Warnf("unable to read the dimensions of image '%s' (%s): %v", p, position, err)
+		}
+		return dimensions{width: width, height: height}
+	}
+	if intrinsicWidth == 0 || intrinsicHeight == 0 {
+		return dimensions{width: width, height: height}
+	}
+	w, widthInPixels := pixels(width)
+	h, heightInPixels := pixels(height)
+	switch {
+	case width == "" && height == "":
+		if scale == 0 {
+			scale = 100
+		}
+		w = float64(intrinsicWidth) * scale / 100
+		h = float64(intrinsicHeight) * scale / 100
+	case widthInPixels && height == "":
+		h = w * float64(intrinsicHeight) / float64(intrinsicWidth)
+	case heightInPixels && width == "":
+		w = h * float64(intrinsicWidth) / float64(intrinsicHeight)
+	default:
+		// a percentage cannot be completed with the other intrinsic dimension
+		return dimensions{width: width, height: height}
+	}
+	return dimensions{
+		width:  strconv.Itoa(int(w + 0.5)),
+		height: strconv.Itoa(int(h + 0.5)),
+	}
+}
+
+var dimensionRegexp = regexp.MustCompile(`^\d+(?:\.\d+)?(?:px|%)?$`)
+
+// dimensionAttribute returns the value of the given dimension attribute of the image (eg: `600` or `50%`),
+// or an empty string if it is not set or if it is invalid
+func dimensionAttribute(p string, attrs types.ElementAttributes, key string, position types.Position) string {
+	value := strings.TrimSpace(attributeValue(attrs, key))
+	if value == "" {
+		return ""
+	}
+	if !dimensionRegexp.MatchString(value) {
+		log.Warnf("invalid value for the '%s' attribute of image '%s' (%s): '%s'", key, p, position, value)
+		return ""
+	}
+	return strings.TrimSuffix(value, "px")
+}
+
+// attributeValue returns the value of the given attribute, or an empty string if it is not set
+// or if it has no value (eg: `width=`)
+func attributeValue(attrs types.ElementAttributes, key string) string {
+	if attrs[key] == nil {
+		return ""
+	}
+	return attrs.GetAsString(key)
+}
+
+// scaleAttribute returns the value of the `scale` attribute of the image (eg: `50` for `50` or `50%`),
+// or `0` if it is not set or if it is invalid
+func scaleAttribute(p string, attrs types.ElementAttributes, position types.Position) float64 {
+	value := strings.TrimSpace(attributeValue(attrs, types.AttrImageScale))
+	if value == "" {
+		return 0
+	}
+	scale, err := strconv.ParseFloat(strings.TrimSuffix(value, "%"), 64)
+	if err != nil || scale <= 0 {
+		log.Warnf("invalid value for the '%s' attribute of image '%s' (%s): '%s'", types.AttrImageScale, p, position, value)
+		return 0
+	}
+	return scale
+}
+
+// pixels returns the number of pixels of the given dimension, and `true` if it is not a percentage (or empty)
+func pixels(dimension string) (float64, bool) {
+	if dimension == "" || strings.HasSuffix(dimension, "%") {
+		return 0, false
+	}
+	result, err := strconv.ParseFloat(dimension, 64)
+	return result, err == nil
 }
@@ -1,10 +1,19 @@
 package html5_test
 
 import (
+	"bytes"
+	"encoding/base64"
+	"image"
+	"image/png"
+	"testing/fstest"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
 	. "github.com/bytesparadise/libasciidoc/testsupport"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	log "github.com/sirupsen/logrus"
 )
 
 var _ = Describe("images", func() {
@@ -33,6 +42,17 @@ var _ = Describe("images", func() {
 			Expect(source).To(RenderHTML5Element(expected))
 		})
 
+		It("block image with special characters in alt", func() {
+
+			source := `image::foo.png[a"b<c&d]`
+			expected := `<div class="imageblock">
+<div class="content">
+<img src="foo.png" alt="a&#34;b&lt;c&amp;d">
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
 		It("block image with alt and dimensions", func() {
 
 			source := "image::foo.png[foo image, 600, 400]"
@@ -58,6 +78,34 @@ image::images/foo.png[the foo.png image,600,400]`
 			Expect(source).To(RenderHTML5Element(expected))
 		})
 
+		It("block image with custom figure caption", func() {
+			source := `:figure-caption: Fig.
+
+.mytitle
+image::foo.png[]`
+			expected := `<div class="imageblock">
+<div class="content">
+<img src="foo.png" alt="foo">
+</div>
+<div class="title">Fig. 1. mytitle</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("block image with unset figure caption", func() {
+			source := `:figure-caption!:
+
+.mytitle
+image::foo.png[]`
+			expected := `<div class="imageblock">
+<div class="content">
+<img src="foo.png" alt="foo">
+</div>
+<div class="title">mytitle</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
 		It("block image with role above", func() {
 			source := `.mytitle
 [#myid]
@@ -228,4 +276,408 @@ image::file:///bar/foo.png[]`
 			Expect(source).To(RenderHTML5Element(expected))
 		})
 	})
+
+	Context("image attributes", func() {
+
+		It("block image with float, align and role", func() {
+			source := `image::foo.png[foo image, float=left, align=center, role=thumb]`
+			expected := `<div class="imageblock left text-center thumb">
+<div class="content">
+<img src="foo.png" alt="foo image">
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("block image with link and window", func() {
+			source := `image::foo.png[foo image, link=https://example.com, window=_blank]`
+			expected := `<div class="imageblock">
+<div class="content">
+<a class="image" href="https://example.com" target="_blank" rel="noopener"><img src="foo.png" alt="foo image"></a>
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("inline image with link, window, float and role", func() {
+			source := `image:foo.png[foo image, link=https://example.com, window=preview, float=right, role=thumb]`
+			expected := `<div class="paragraph">
+<p><span class="image right thumb"><a class="image" href="https://example.com" target="preview"><img src="foo.png" alt="foo image"></a></span></p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("block image with special characters in the attributes", func() {
+			source := `[#fo"o]
+image::foo.png[foo image, link=https://example.com/a"b&c, window=_bl"ank, float=le"ft, align=cen"ter, role=th"umb]`
+			expected := `<div id="fo&#34;o" class="imageblock le&#34;ft text-cen&#34;ter th&#34;umb">
+<div class="content">
+<a class="image" href="https://example.com/a&#34;b&amp;c" target="_bl&#34;ank"><img src="foo.png" alt="foo image"></a>
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("inline image with special characters in the attributes", func() {
+			source := `image:foo.png[foo image, link=http://x"y, window=_bl"ank, float=le"ft, role=r"x]`
+			expected := `<div class="paragraph">
+<p><span class="image le&#34;ft r&#34;x"><a class="image" href="http://x&#34;y" target="_bl&#34;ank"><img src="foo.png" alt="foo image"></a></span></p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+	})
+
+	Context("data-uri", func() {
+
+		fsys := fstest.MapFS{
+			"images/foo.png": &fstest.MapFile{
+				Data: []byte("PNG"),
+			},
+		}
+
+		It("block image embedded in a data URI", func() {
+			source := `:data-uri:
+:imagesdir: images
+
+image::foo.png[]`
+			expected := `<div class="imageblock">
+<div class="content">
+<img src="data:image/png;base64,UE5H" alt="foo">
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected, renderer.FileSystem(fsys)))
+		})
+
+		It("inline image embedded in a data URI", func() {
+			source := `:data-uri:
+
+an image:images/foo.png[]`
+			expected := `<div class="paragraph">
+<p>an <span class="image"><img src="data:image/png;base64,UE5H" alt="foo"></span></p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected, renderer.FileSystem(fsys)))
+		})
+
+		It("remote image not embedded in a data URI", func() {
+			source := `:data-uri:
+
+image::https://example.com/foo.png[]`
+			expected := `<div class="imageblock">
+<div class="content">
+<img src="https://example.com/foo.png" alt="foo">
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected, renderer.FileSystem(fsys)))
+		})
+
+		It("missing image not embedded in a data URI", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			source := `:data-uri:
+
+image::images/unknown.png[]`
+			expected := `<div class="imageblock">
+<div class="content">
+<img src="images/unknown.png" alt="unknown">
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected, renderer.FileSystem(fsys)))
+			Expect(console).To(ContainAnyMessageWithLevels(log.WarnLevel))
+		})
+
+		It("image not embedded in a data URI in secure mode", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			source := `:data-uri:
+
+image::images/foo.png[]`
+			expected := `<div class="imageblock">
+<div class="content">
+<img src="images/foo.png" alt="foo">
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected, renderer.FileSystem(fsys), renderer.SafeMode(types.SafeModeSecure)))
+			Expect(console).To(ContainMessageWithLevel(log.WarnLevel, "unable to embed image 'images/foo.png' in a data URI (test.adoc:3:1): cannot open file 'images/foo.png' in secure mode"))
+		})
+
+		It("image outside of the directory of the document not embedded in a data URI in safe mode", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			source := `:data-uri:
+
+image::../images/foo.png[]`
+			expected := `<div class="imageblock">
+<div class="content">
+<img src="../images/foo.png" alt="foo">
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected, renderer.Filename("docs/index.adoc"), renderer.FileSystem(fsys), renderer.SafeMode(types.SafeModeSafe)))
+			Expect(console).To(ContainMessageWithLevel(log.WarnLevel, "unable to embed image '../images/foo.png' in a data URI (test.adoc:3:1): 'images/foo.png' is outside of the base directory 'docs'"))
+		})
+	})
+
+	Context("svg images", func() {
+
+		fsys := fstest.MapFS{
+			"images/circle.svg": &fstest.MapFile{
+				Data: []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE svg PUBLIC "-//W3C//DTD SVG 1.1//EN" "http://www.w3.org/Graphics/SVG/1.1/DTD/svg11.dtd">
+<svg xmlns="http://www.w3.org/2000/svg" width="100" height="100"><circle cx="50" cy="50" r="40"/></svg>
+`),
+			},
+		}
+
+		It("block image with inline svg", func() {
+			source := `image::images/circle.svg[opts=inline]`
+			expected := `<div class="imageblock">
+<div class="content">
+<svg xmlns="http://www.w3.org/2000/svg" width="100" height="100"><circle cx="50" cy="50" r="40"/></svg>
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected, renderer.FileSystem(fsys)))
+		})
+
+		It("inline image with inline svg and dimensions", func() {
+			source := `a image:images/circle.svg[circle, 50, 60, opts=inline]`
+			expected := `<div class="paragraph">
+<p>a <span class="image"><svg xmlns="http://www.w3.org/2000/svg" width="50" height="60"><circle cx="50" cy="50" r="40"/></svg></span></p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected, renderer.FileSystem(fsys)))
+		})
+
+		It("block image with inline svg in safe mode", func() {
+			source := `image::images/circle.svg[opts=inline]`
+			expected := `<div class="imageblock">
+<div class="content">
+<img src="images/circle.svg" alt="circle">
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected, renderer.FileSystem(fsys), renderer.SafeMode(types.SafeModeSafe)))
+		})
+
+		It("block image with missing inline svg", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			source := `image::images/unknown.svg[an unknown image, opts=inline]`
+			expected := `<div class="imageblock">
+<div class="content">
+<span class="alt">an unknown image</span>
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected, renderer.FileSystem(fsys)))
+			Expect(console).To(ContainAnyMessageWithLevels(log.WarnLevel))
+		})
+
+		It("block image with interactive svg", func() {
+			source := `image::images/circle.svg[circle, 100, opts=interactive]`
+			expected := `<div class="imageblock">
+<div class="content">
+<object type="image/svg+xml" data="images/circle.svg" width="100"><span class="alt">circle</span></object>
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected, renderer.FileSystem(fsys)))
+		})
+
+		It("block image with interactive svg and fallback", func() {
+			source := `:imagesdir: images
+
+image::circle.svg[circle, opts=interactive, fallback=circle.png]`
+			expected := `<div class="imageblock">
+<div class="content">
+<object type="image/svg+xml" data="images/circle.svg"><img src="images/circle.png" alt="circle"></object>
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected, renderer.FileSystem(fsys)))
+		})
+
+		It("block image with special characters in the alt of a missing inline svg", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			source := `image::images/unknown.svg[a"b<c&d, opts=inline]`
+			expected := `<div class="imageblock">
+<div class="content">
+<span class="alt">a&#34;b&lt;c&amp;d</span>
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected, renderer.FileSystem(fsys)))
+			Expect(console).To(ContainAnyMessageWithLevels(log.WarnLevel))
+		})
+
+		It("block image with special characters in the alt of an interactive svg", func() {
+			source := `image::images/circle.svg[a"b<c&d, opts=interactive]`
+			expected := `<div class="imageblock">
+<div class="content">
+<object type="image/svg+xml" data="images/circle.svg"><span class="alt">a&#34;b&lt;c&amp;d</span></object>
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected, renderer.FileSystem(fsys)))
+		})
+
+		It("block image with special characters in the alt of an interactive svg with fallback", func() {
+			source := `image::images/circle.svg[a"b<c&d, opts=interactive, fallback=images/circle.png]`
+			expected := `<div class="imageblock">
+<div class="content">
+<object type="image/svg+xml" data="images/circle.svg"><img src="images/circle.png" alt="a&#34;b&lt;c&amp;d"></object>
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected, renderer.FileSystem(fsys)))
+		})
+
+		It("block image with svg embedded in a data URI", func() {
+			source := `:data-uri:
+
+image::images/circle.svg[circle, opts=interactive]`
+			Expect(source).To(RenderHTML5Element(`<div class="imageblock">
+<div class="content">
+<object type="image/svg+xml" data="data:image/svg+xml;base64,`+base64.StdEncoding.EncodeToString(fsys["images/circle.svg"].Data)+`"><span class="alt">circle</span></object>
+</div>
+</div>`, renderer.FileSystem(fsys)))
+		})
+	})
+
+	Context("image dimensions", func() {
+
+		var fsys fstest.MapFS
+
+		BeforeEach(func() {
+			buf := bytes.NewBuffer(nil)
+			err := png.Encode(buf, image.NewRGBA(image.Rect(0, 0, 300, 200)))
+			Expect(err).ToNot(HaveOccurred())
+			fsys = fstest.MapFS{
+				"images/foo.png": &fstest.MapFile{
+					Data: buf.Bytes(),
+				},
+				"images/foo.svg": &fstest.MapFile{
+					Data: []byte(`<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 60 40"></svg>`),
+				},
+			}
+		})
+
+		It("block image with intrinsic dimensions", func() {
+			source := `image::images/foo.png[]`
+			expected := `<div class="imageblock">
+<div class="content">
+<img src="images/foo.png" alt="foo" width="300" height="200">
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected, renderer.FileSystem(fsys), renderer.ImageDimensions(true)))
+		})
+
+		It("block image without intrinsic dimensions when option is not set", func() {
+			source := `image::images/foo.png[]`
+			expected := `<div class="imageblock">
+<div class="content">
+<img src="images/foo.png" alt="foo">
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected, renderer.FileSystem(fsys)))
+		})
+
+		It("inline image with height computed from width", func() {
+			source := `:imagesdir: images
+
+an image:foo.svg[foo, 30] with imagesdir`
+			expected := `<div class="paragraph">
+<p>an <span class="image"><img src="images/foo.svg" alt="foo" width="30" height="20"></span> with imagesdir</p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected, renderer.FileSystem(fsys), renderer.ImageDimensions(true)))
+		})
+
+		It("inline image with width computed from height", func() {
+			source := `an image:images/foo.svg[foo, height=20]`
+			expected := `<div class="paragraph">
+<p>an <span class="image"><img src="images/foo.svg" alt="foo" width="30" height="20"></span></p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected, renderer.FileSystem(fsys), renderer.ImageDimensions(true)))
+		})
+
+		It("block image with percentage width", func() {
+			source := `image::images/foo.png[foo, 50%]`
+			expected := `<div class="imageblock">
+<div class="content">
+<img src="images/foo.png" alt="foo" width="50%">
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected, renderer.FileSystem(fsys), renderer.ImageDimensions(true)))
+		})
+
+		It("block image with scale", func() {
+			source := `image::images/foo.png[foo, scale=50]`
+			expected := `<div class="imageblock">
+<div class="content">
+<img src="images/foo.png" alt="foo" width="150" height="100">
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected, renderer.FileSystem(fsys)))
+		})
+
+		It("block image with scaled width", func() {
+			// the `scaledwidth` attribute only applies to the PDF output
+			source := `image::images/foo.png[foo, scaledwidth=150px]`
+			expected := `<div class="imageblock">
+<div class="content">
+<img src="images/foo.png" alt="foo" width="300" height="200">
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected, renderer.FileSystem(fsys), renderer.ImageDimensions(true)))
+		})
+
+		It("block image with invalid width", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			source := `image::images/foo.png[foo, large]`
+			expected := `<div class="imageblock">
+<div class="content">
+<img src="images/foo.png" alt="foo">
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected, renderer.FileSystem(fsys)))
+			Expect(console).To(ContainMessageWithLevel(log.WarnLevel, "invalid value for the 'width' attribute of image 'images/foo.png' (test.adoc:1:1): 'large'"))
+		})
+
+		It("missing block image", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			source := `== Images
+
+image::images/unknown.png[]`
+			expected := `<div class="sect1">
+<h2 id="_images">Images</h2>
+<div class="sectionbody">
+<div class="imageblock">
+<div class="content">
+<img src="images/unknown.png" alt="unknown">
+</div>
+</div>
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected, renderer.FileSystem(fsys)))
+			Expect(console).To(ContainMessageWithLevel(log.WarnLevel, "image 'images/unknown.png' not found (test.adoc:3:1)"))
+		})
+
+		It("missing block image with image dimensions", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			source := `image::images/unknown.png[]`
+			expected := `<div class="imageblock">
+<div class="content">
+<img src="images/unknown.png" alt="unknown">
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected, renderer.FileSystem(fsys), renderer.ImageDimensions(true)))
+			Expect(console).To(ContainMessageWithLevel(log.WarnLevel, "image 'images/unknown.png' not found (test.adoc:1:1)"))
+			Expect(console).ToNot(ContainMessageWithLevel(log.WarnLevel, "unable to read the dimensions of image 'images/unknown.png' (test.adoc:1:1): open images/unknown.png: file does not exist"))
+		})
+
+		It("missing inline image", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			source := `an image:images/unknown.png[]`
+			expected := `<div class="paragraph">
+<p>an <span class="image"><img src="images/unknown.png" alt="unknown"></span></p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected, renderer.FileSystem(fsys)))
+			Expect(console).To(ContainMessageWithLevel(log.WarnLevel, "image 'images/unknown.png' not found (test.adoc:1:4)"))
+		})
+	})
 })
@@ -0,0 +1,109 @@
+package html5
+
+import (
+	"bytes"
+	"html"
+	"strconv"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+var indexTermTmpl texttemplate.Template
+var indexCatalogTmpl texttemplate.Template
+
+// initializes the templates
+func init() {
+	indexTermTmpl = newTextTemplate("index term", `{{ if .Anchor }}<a id="{{ .Anchor }}"></a>{{ end }}{{ if .Visible }}{{ escape .Term }}{{ end }}`,
+		texttemplate.FuncMap{
+			"escape": html.EscapeString,
+		})
+	indexCatalogTmpl = newTextTemplate("index catalog", `<div class="indexcatalog">{{ $level := .Level }}{{ range $category := .Categories }}
+<div class="indexcategory">
+<h{{ $level }}>{{ escape $category.Letter }}</h{{ $level }}>
+{{ template "entries" $category.Entries }}
+</div>{{ end }}
+</div>{{ define "entries" }}<ul>{{ range $entry := . }}
+<li>{{ escape $entry.Term }}{{ range $i, $occurrence := $entry.Occurrences }}, <a href="#{{ anchor $occurrence }}">{{ number $i }}</a>{{ end }}{{ if $entry.Entries }}
+{{ template "entries" $entry.Entries }}
+{{ end }}</li>{{ end }}
+</ul>{{ end }}`,
+		texttemplate.FuncMap{
+			"escape": html.EscapeString,
+			"anchor": indexTermAnchor,
+			"number": func(i int) string {
+				return strconv.Itoa(i + 1)
+			},
+		})
+}
+
+// indexTermAnchor returns the ID of the anchor at the occurrence of the index term with the given index
+func indexTermAnchor(idx int) string {
+	return "_indexterm_" + strconv.Itoa(idx+1)
+}
+
+func renderIndexTerm(ctx *renderer.Context, t types.IndexTerm) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	var anchor string
+	if idx, ok := ctx.Document.IndexTerms.IndexOf(t); ok {
+		anchor = indexTermAnchor(idx)
+	}
+	err := indexTermTmpl.Execute(result, struct {
+		Anchor  string
+		Term    string
+		Visible bool
+	}{
+		Anchor:  anchor,
+		Term:    t.PrimaryTerm(),
+		Visible: t.Visible,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render index term")
+	}
+	return result.Bytes(), nil
+}
+
+// withoutIndexTerms returns the given elements (eg: the title of a section), in which the index terms are replaced
+// with their term if they are visible, so that their anchors are not duplicated (eg: in the table of contents)
+func withoutIndexTerms(elements types.InlineElements) types.InlineElements {
+	result := make(types.InlineElements, 0, len(elements))
+	for _, element := range elements {
+		switch e := element.(type) {
+		case types.IndexTerm:
+			if e.Visible {
+				result = append(result, types.StringElement{
+					Content: e.PrimaryTerm(),
+				})
+			}
+		case types.QuotedText:
+			e.Elements = withoutIndexTerms(e.Elements)
+			result = append(result, e)
+		default:
+			result = append(result, e)
+		}
+	}
+	return result
+}
+
+// renderIndexCatalog renders the index of the document, with its terms grouped by first letter,
+// and a link to each occurrence of the terms in the document
+func renderIndexCatalog(ctx *renderer.Context, level int) (string, error) {
+	categories := ctx.Document.IndexTerms.Catalog()
+	if len(categories) == 0 {
+		return "", nil
+	}
+	result := bytes.NewBuffer(nil)
+	err := indexCatalogTmpl.Execute(result, struct {
+		Level      int
+		Categories []types.IndexCategory
+	}{
+		Level:      level,
+		Categories: categories,
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "unable to render index")
+	}
+	return result.String(), nil
+}
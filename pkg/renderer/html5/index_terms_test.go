@@ -0,0 +1,190 @@
+package html5_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("index terms", func() {
+
+	It("visible and concealed index terms", func() {
+		source := `The ((Big cats)) and indexterm2:[lions] roar(((Big cats, wild, lions))).
+indexterm:[tigers]Tigers too.`
+		expected := `<div class="paragraph">
+<p>The <a id="_indexterm_1"></a>Big cats and <a id="_indexterm_2"></a>lions roar<a id="_indexterm_3"></a>.
+<a id="_indexterm_4"></a>Tigers too.</p>
+</div>`
+		Expect(source).To(RenderHTML5Element(expected))
+	})
+
+	It("visible index term with special characters", func() {
+		source := `some ((<html> & co))`
+		expected := `<div class="paragraph">
+<p>some <a id="_indexterm_1"></a>&lt;html&gt; &amp; co</p>
+</div>`
+		Expect(source).To(RenderHTML5Element(expected))
+	})
+
+	It("blank index terms rendered as text", func() {
+		source := `some (((,))) and ((( , ))) and indexterm:[,] here`
+		expected := `<div class="paragraph">
+<p>some (((,))) and ((( , ))) and indexterm:[,] here</p>
+</div>`
+		Expect(source).To(RenderHTML5Element(expected))
+	})
+
+	It("index section", func() {
+		source := `== Cats
+
+The ((Big cats)) and ((lions))(((Big cats, wild, lions))).
+
+* ((Big cats)) in a list
+* (((3D cats)))
+
+[index]
+== Index`
+		expected := `<div class="sect1">
+<h2 id="_cats">Cats</h2>
+<div class="sectionbody">
+<div class="paragraph">
+<p>The <a id="_indexterm_1"></a>Big cats and <a id="_indexterm_2"></a>lions<a id="_indexterm_3"></a>.</p>
+</div>
+<div class="ulist">
+<ul>
+<li>
+<p><a id="_indexterm_4"></a>Big cats in a list</p>
+</li>
+<li>
+<p><a id="_indexterm_5"></a></p>
+</li>
+</ul>
+</div>
+</div>
+</div>
+<div class="sect1">
+<h2 id="_index">Index</h2>
+<div class="sectionbody">
+<div class="indexcatalog">
+<div class="indexcategory">
+<h3>#</h3>
+<ul>
+<li>3D cats, <a href="#_indexterm_5">1</a></li>
+</ul>
+</div>
+<div class="indexcategory">
+<h3>B</h3>
+<ul>
+<li>Big cats, <a href="#_indexterm_1">1</a>, <a href="#_indexterm_4">2</a>
+<ul>
+<li>wild
+<ul>
+<li>lions, <a href="#_indexterm_3">1</a></li>
+</ul>
+</li>
+</ul>
+</li>
+</ul>
+</div>
+<div class="indexcategory">
+<h3>L</h3>
+<ul>
+<li>lions, <a href="#_indexterm_2">1</a></li>
+</ul>
+</div>
+</div>
+</div>
+</div>`
+		Expect(source).To(RenderHTML5Element(expected))
+	})
+
+	It("empty index section", func() {
+		source := `[index]
+== Index`
+		expected := `<div class="sect1">
+<h2 id="_index">Index</h2>
+<div class="sectionbody">
+</div>
+</div>`
+		Expect(source).To(RenderHTML5Element(expected))
+	})
+	It("index terms in section title with table of contents and cross-reference", func() {
+		source := `= A title
+:toc:
+
+See <<cats>>.
+
+[#cats]
+== The ((Cats)) chapter(((felines)))`
+		expected := `<div id="toc" class="toc">
+<div id="toctitle">Table of Contents</div>
+<ul class="sectlevel1">
+<li><a href="#cats">The Cats chapter</a></li>
+</ul>
+</div>
+<div id="preamble">
+<div class="sectionbody">
+<div class="paragraph">
+<p>See <a href="#cats">The Cats chapter</a>.</p>
+</div>
+</div>
+</div>
+<div class="sect1">
+<h2 id="cats">The <a id="_indexterm_1"></a>Cats chapter<a id="_indexterm_2"></a></h2>
+<div class="sectionbody">
+</div>
+</div>`
+		Expect(source).To(RenderHTML5Element(expected))
+	})
+
+	It("index terms in block title", func() {
+		source := `.The ((Cats)) and indexterm2:[lions](((felines)))
+some content`
+		expected := `<div class="paragraph">
+<div class="doctitle">The <a id="_indexterm_1"></a>Cats and <a id="_indexterm_2"></a>lions<a id="_indexterm_3"></a></div>
+<p>some content</p>
+</div>`
+		Expect(source).To(RenderHTML5Element(expected))
+	})
+
+	It("index terms in the titles of blocks and in the index section", func() {
+		source := `.A <small> ((Bird))
+image::bird.png[]
+
+.Other ((Birds))
+* a ((Bird))
+
+[index]
+== Index`
+		expected := `<div class="imageblock">
+<div class="content">
+<img src="bird.png" alt="bird">
+</div>
+<div class="title">Figure 1. A &lt;small&gt; <a id="_indexterm_1"></a>Bird</div>
+</div>
+<div class="ulist">
+<div class="title">Other <a id="_indexterm_2"></a>Birds</div>
+<ul>
+<li>
+<p>a <a id="_indexterm_3"></a>Bird</p>
+</li>
+</ul>
+</div>
+<div class="sect1">
+<h2 id="_index">Index</h2>
+<div class="sectionbody">
+<div class="indexcatalog">
+<div class="indexcategory">
+<h3>B</h3>
+<ul>
+<li>Bird, <a href="#_indexterm_1">1</a>, <a href="#_indexterm_3">2</a></li>
+<li>Birds, <a href="#_indexterm_2">1</a></li>
+</ul>
+</div>
+</div>
+</div>
+</div>`
+		Expect(source).To(RenderHTML5Element(expected))
+	})
+})
@@ -19,14 +19,23 @@ func renderLinesAsString(ctx *renderer.Context, elements []types.InlineElements,
 
 // renderLines renders all lines (i.e, all `InlineElements`` - each `InlineElements` being a slice of elements to generate a line)
 // and includes an `\n` character in-between, until the last one.
-// Trailing spaces are removed for each line.
+// Trailing spaces are removed for each line, and the lines which must be dropped (eg: because they contain a reference
+// to a missing attribute) are skipped.
 func renderLines(ctx *renderer.Context, elements []types.InlineElements, renderElementFunc rendererFunc, hardbreak bool) ([]byte, error) {
-	buff := bytes.NewBuffer(nil)
-	for i, e := range elements {
+	lines := make([][]byte, 0, len(elements))
+	for _, e := range elements {
+		ctx.SetDropLine(false)
 		renderedElement, err := renderElementFunc(ctx, e)
 		if err != nil {
 			return nil, errors.Wrap(err, "unable to render lines")
 		}
+		if ctx.SetDropLine(false) {
+			continue
+		}
+		lines = append(lines, renderedElement)
+	}
+	buff := bytes.NewBuffer(nil)
+	for i, renderedElement := range lines {
 		if len(renderedElement) > 0 {
 			_, err := buff.Write(renderedElement)
 			if err != nil {
@@ -34,7 +43,7 @@ func renderLines(ctx *renderer.Context, elements []types.InlineElements, renderE
 			}
 		}
 
-		if i < len(elements)-1 && (len(renderedElement) > 0 || ctx.WithinDelimitedBlock()) {
+		if i < len(lines)-1 && (len(renderedElement) > 0 || ctx.WithinDelimitedBlock()) {
 			// log.Debugf("rendered line is not the last one in the slice")
 			var err error
 			if hardbreak {
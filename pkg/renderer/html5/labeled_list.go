@@ -18,7 +18,7 @@ var qandaLabeledListTmpl texttemplate.Template
 func init() {
 	defaultLabeledListTmpl = newTextTemplate("labeled list with default layout",
 		`{{ $ctx := .Context }}{{ with .Data }}<div{{ if .ID }} id="{{ .ID }}"{{ end }} class="dlist{{ if .Role }} {{ .Role }}{{ end }}">
-{{ if .Title }}<div class="title">{{ escape .Title }}</div>
+{{ if .Title }}<div class="title">{{ .Title }}</div>
 {{ end }}<dl>
 {{ $items := .Items }}{{ range $itemIndex, $item := $items }}<dt class="hdlist1">{{ escape $item.Term }}</dt>{{ if $item.Elements }}
 <dd>
@@ -33,7 +33,7 @@ func init() {
 
 	horizontalLabeledListTmpl = newTextTemplate("labeled list with horizontal layout",
 		`{{ $ctx := .Context }}{{ with .Data }}<div{{ if .ID }} id="{{ .ID }}"{{ end }} class="hdlist{{ if .Role }} {{ .Role }}{{ end }}">
-{{ if .Title }}<div class="title">{{ escape .Title }}</div>
+{{ if .Title }}<div class="title">{{ .Title }}</div>
 {{ end }}<table>
 <tr>
 <td class="hdlist1">{{ $items := .Items }}{{ range $itemIndex, $item := $items }}
@@ -56,7 +56,7 @@ func init() {
 
 	qandaLabeledListTmpl = newTextTemplate("qanda labeled list",
 		`{{ $ctx := .Context }}{{ with .Data }}<div{{ if .ID }} id="{{ .ID }}"{{ end }} class="qlist qanda">
-{{ if .Title }}<div class="title">{{ escape .Title }}</div>
+{{ if .Title }}<div class="title">{{ .Title }}</div>
 {{ end }}<ol>
 {{ $items := .Items }}{{ range $itemIndex, $item := $items }}<li>
 <p><em>{{ escape $item.Term }}</em></p>
@@ -89,7 +89,7 @@ func renderLabeledList(ctx *renderer.Context, l types.LabeledList) ([]byte, erro
 			Items []types.LabeledListItem
 		}{
 			ID:    generateID(ctx, l.Attributes),
-			Title: getTitle(l.Attributes),
+			Title: getTitle(ctx, l.Attributes),
 			Role:  l.Attributes.GetAsString(types.AttrRole),
 			Items: l.Items,
 		},
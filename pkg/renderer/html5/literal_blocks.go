@@ -18,7 +18,7 @@ var literalBlockTmpl texttemplate.Template
 // initializes the templates
 func init() {
 	literalBlockTmpl = newTextTemplate("literal block", `{{ $ctx := .Context }}{{ with .Data }}<div {{ if .ID }}id="{{ .ID }}" {{ end }}class="literalblock">
-{{ if .Title }}<div class="title">{{ escape .Title }}</div>
+{{ if .Title }}<div class="title">{{ .Title }}</div>
 {{ end }}<div class="content">
 <pre>{{ $lines := .Lines }}{{ range $index, $line := $lines}}{{ $line }}{{ includeNewline $ctx $index $lines }}{{ end }}</pre>
 </div>
@@ -58,6 +58,29 @@ func renderLiteralBlock(ctx *renderer.Context, b types.LiteralBlock) ([]byte, er
 	default:
 		lines = b.Lines
 	}
+	if b.Attributes.Has(types.AttrSubstitutions) {
+		subs := substitutionsOf(b.Attributes, types.DefaultSubstitutions(types.Literal))
+		renderedLines := make([]string, 0, len(lines))
+		for _, line := range lines {
+			ctx.SetDropLine(false)
+			renderedLine, err := renderLineWithSubstitutions(ctx, line, subs)
+			if err != nil {
+				return nil, errors.Wrapf(err, "unable to render literal block")
+			}
+			if ctx.SetDropLine(false) {
+				continue
+			}
+			renderedLines = append(renderedLines, string(renderedLine))
+		}
+		lines = renderedLines
+	} else {
+		// the special characters are escaped (eg: in the raw lines of an included file)
+		escapedLines := make([]string, len(lines))
+		for i, line := range lines {
+			escapedLines[i] = html.EscapeString(line)
+		}
+		lines = escapedLines
+	}
 	result := bytes.NewBuffer(nil)
 	err := literalBlockTmpl.Execute(result, ContextualPipeline{
 		Context: ctx,
@@ -67,7 +90,7 @@ func renderLiteralBlock(ctx *renderer.Context, b types.LiteralBlock) ([]byte, er
 			Lines []string
 		}{
 			ID:    generateID(ctx, b.Attributes),
-			Title: getTitle(b.Attributes),
+			Title: getTitle(ctx, b.Attributes),
 			Lines: lines,
 		}})
 	if err != nil {
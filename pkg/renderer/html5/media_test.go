@@ -0,0 +1,208 @@
+package html5_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("videos and audios", func() {
+
+	Context("local videos", func() {
+
+		It("video alone", func() {
+			source := "video::demo.mp4[]"
+			expected := `<div class="videoblock">
+<div class="content">
+<video src="demo.mp4" controls>
+Your browser does not support the video tag.
+</video>
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("video with width, start, end and options", func() {
+			source := `video::demo.mp4[width=640,start=10,end=30,options="autoplay,loop"]`
+			expected := `<div class="videoblock">
+<div class="content">
+<video src="demo.mp4#t=10,30" width="640" autoplay controls loop>
+Your browser does not support the video tag.
+</video>
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("video with id, title, poster and imagesdir", func() {
+			source := `:imagesdir: media
+
+[#demo]
+.Product demo
+video::demo.mp4[demo.png,640,360,start=10,opts="muted,nocontrols"]`
+			expected := `<div id="demo" class="videoblock">
+<div class="title">Product demo</div>
+<div class="content">
+<video src="media/demo.mp4#t=10" width="640" height="360" poster="media/demo.png" muted>
+Your browser does not support the video tag.
+</video>
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("video with special characters in its path and poster", func() {
+			source := `video::a"onerror="alert(1).mp4[b&c<d>.png]`
+			expected := `<div class="videoblock">
+<div class="content">
+<video src="a&#34;onerror=&#34;alert(1).mp4" poster="b&amp;c&lt;d&gt;.png" controls>
+Your browser does not support the video tag.
+</video>
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("video with empty and special dimensions", func() {
+			source := `video::demo.mp4[width=,height=36"0]`
+			expected := `<div class="videoblock">
+<div class="content">
+<video src="demo.mp4" height="36&#34;0" controls>
+Your browser does not support the video tag.
+</video>
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+	})
+
+	Context("youtube videos", func() {
+
+		It("youtube video from its id", func() {
+			source := "video::rPQoq7ThGAU[youtube,640,360]"
+			expected := `<div class="videoblock">
+<div class="content">
+<iframe width="640" height="360" src="https://www.youtube.com/embed/rPQoq7ThGAU?rel=0" frameborder="0" allowfullscreen></iframe>
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("youtube video with empty dimensions", func() {
+			source := "video::rPQoq7ThGAU[youtube,width=,height=]"
+			expected := `<div class="videoblock">
+<div class="content">
+<iframe src="https://www.youtube.com/embed/rPQoq7ThGAU?rel=0" frameborder="0" allowfullscreen></iframe>
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("youtube video from its URL with start, end and options", func() {
+			source := `video::https://www.youtube.com/watch?v=rPQoq7ThGAU[start=10,end=30,opts="autoplay,loop,modest,nofullscreen"]`
+			expected := `<div class="videoblock">
+<div class="content">
+<iframe src="https://www.youtube.com/embed/rPQoq7ThGAU?rel=0&amp;start=10&amp;end=30&amp;autoplay=1&amp;loop=1&amp;playlist=rPQoq7ThGAU&amp;fs=0&amp;modestbranding=1" frameborder="0"></iframe>
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("youtube video from its short URL", func() {
+			source := "video::https://youtu.be/rPQoq7ThGAU[lang=fr]"
+			expected := `<div class="videoblock">
+<div class="content">
+<iframe src="https://www.youtube.com/embed/rPQoq7ThGAU?rel=0&amp;hl=fr" frameborder="0" allowfullscreen></iframe>
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("youtube video in a playlist", func() {
+			source := "video::rPQoq7ThGAU/PL6B3937A5D230E335[youtube]"
+			expected := `<div class="videoblock">
+<div class="content">
+<iframe src="https://www.youtube.com/embed/rPQoq7ThGAU?rel=0&amp;list=PL6B3937A5D230E335" frameborder="0" allowfullscreen></iframe>
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("youtube videos played in sequence", func() {
+			source := "video::rPQoq7ThGAU,ASwh4hjHg3A[youtube]"
+			expected := `<div class="videoblock">
+<div class="content">
+<iframe src="https://www.youtube.com/embed/rPQoq7ThGAU?rel=0&amp;playlist=ASwh4hjHg3A" frameborder="0" allowfullscreen></iframe>
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+	})
+
+	Context("vimeo videos", func() {
+
+		It("vimeo video from its id", func() {
+			source := "video::67480300[vimeo,640,360,start=60,opts=autoplay]"
+			expected := `<div class="videoblock">
+<div class="content">
+<iframe width="640" height="360" src="https://player.vimeo.com/video/67480300?autoplay=1#at=60" frameborder="0" allowfullscreen></iframe>
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("unlisted vimeo video from its URL", func() {
+			source := `:asset-uri-scheme: http
+
+video::https://vimeo.com/67480300/a1b2c3d4e5[]`
+			expected := `<div class="videoblock">
+<div class="content">
+<iframe src="http://player.vimeo.com/video/67480300?h=a1b2c3d4e5" frameborder="0" allowfullscreen></iframe>
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+	})
+
+	Context("audios", func() {
+
+		It("audio alone", func() {
+			source := "audio::clip.mp3[]"
+			expected := `<div class="audioblock">
+<div class="content">
+<audio src="clip.mp3" controls>
+Your browser does not support the audio tag.
+</audio>
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("audio with title, start and options", func() {
+			source := `.Jingle
+audio::clip.mp3[start=5,opts="autoplay,loop"]`
+			expected := `<div class="audioblock">
+<div class="title">Jingle</div>
+<div class="content">
+<audio src="clip.mp3#t=5" autoplay controls loop>
+Your browser does not support the audio tag.
+</audio>
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("audio with special characters in its path", func() {
+			source := `audio::a"onerror="alert(1).mp3[]`
+			expected := `<div class="audioblock">
+<div class="content">
+<audio src="a&#34;onerror=&#34;alert(1).mp3" controls>
+Your browser does not support the audio tag.
+</audio>
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+	})
+})
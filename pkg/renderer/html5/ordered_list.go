@@ -16,7 +16,7 @@ var orderedListTmpl texttemplate.Template
 func init() {
 	orderedListTmpl = newTextTemplate("ordered list",
 		`{{ $ctx := .Context }}{{ with .Data }}{{ $items := .Items }}<div{{ if .ID }} id="{{ .ID }}"{{ end }} class="olist {{ .NumberingStyle }}{{ if .Role }} {{ .Role }}{{ end}}">
-{{ if .Title }}<div class="title">{{ escape .Title }}</div>
+{{ if .Title }}<div class="title">{{ .Title }}</div>
 {{ end }}<ol class="{{ .NumberingStyle }}"{{ style .NumberingStyle }}{{ if .Start }} start="{{ .Start }}"{{ end }}>
 {{ range $itemIndex, $item := $items }}<li>
 {{ renderElements $ctx $item.Elements | printf "%s" }}
@@ -3,7 +3,6 @@ package html5
 import (
 	"bytes"
 	"html"
-	"strings"
 	texttemplate "text/template"
 
 	"github.com/bytesparadise/libasciidoc/pkg/renderer"
@@ -16,6 +15,7 @@ var paragraphTmpl texttemplate.Template
 var admonitionParagraphTmpl texttemplate.Template
 var listParagraphTmpl texttemplate.Template
 var sourceParagraphTmpl texttemplate.Template
+var listingParagraphTmpl texttemplate.Template
 var verseParagraphTmpl texttemplate.Template
 var quoteParagraphTmpl texttemplate.Template
 
@@ -23,7 +23,7 @@ var quoteParagraphTmpl texttemplate.Template
 func init() {
 	paragraphTmpl = newTextTemplate("paragraph",
 		`{{ $ctx := .Context }}{{ with .Data }}{{ $renderedLines := renderLines $ctx .Lines .HardBreak }}{{ if ne $renderedLines "" }}<div {{ if ne .ID "" }}id="{{ .ID }}" {{ end }}class="paragraph">{{ if ne .Title "" }}
-<div class="doctitle">{{ escape .Title }}</div>{{ end }}
+<div class="doctitle">{{ .Title }}</div>{{ end }}
 <p>{{ $renderedLines }}</p>
 </div>{{ end }}{{ end }}`,
 		texttemplate.FuncMap{
@@ -32,14 +32,14 @@ func init() {
 		})
 
 	admonitionParagraphTmpl = newTextTemplate("admonition paragraph",
-		`{{ $ctx := .Context }}{{ with .Data }}{{ $renderedLines := renderLines $ctx .Lines false }}{{ if ne $renderedLines "" }}<div {{ if .ID }}id="{{ .ID }}" {{ end }}class="admonitionblock {{ .Class }}">
+		`{{ $ctx := .Context }}{{ with .Data }}{{ $renderedLines := renderLines $ctx .Lines .HardBreak }}{{ if ne $renderedLines "" }}<div {{ if .ID }}id="{{ .ID }}" {{ end }}class="admonitionblock {{ .Class }}">
 <table>
 <tr>
 <td class="icon">
 {{ if .IconClass }}<i class="fa icon-{{ .IconClass }}" title="{{ .IconTitle }}"></i>{{ else }}<div class="title">{{ .IconTitle }}</div>{{ end }}
 </td>
 <td class="content">{{ if .Title }}
-<div class="title">{{ escape .Title }}</div>{{ end }}
+<div class="title">{{ .Title }}</div>{{ end }}
 {{ $renderedLines }}
 </td>
 </tr>
@@ -51,7 +51,7 @@ func init() {
 		})
 
 	listParagraphTmpl = newTextTemplate("list paragraph",
-		`{{ $ctx := .Context }}{{ with .Data }}<p>{{ .CheckStyle }}{{ renderLines $ctx .Lines false }}</p>{{ end }}`,
+		`{{ $ctx := .Context }}{{ with .Data }}<p>{{ .CheckStyle }}{{ renderLines $ctx .Lines .HardBreak }}</p>{{ end }}`,
 		texttemplate.FuncMap{
 			"renderLines": renderLinesAsString,
 		})
@@ -61,6 +61,18 @@ func init() {
 <div class="content">
 <pre class="highlight">{{ if .Language }}<code class="language-{{ .Language }}" data-lang="{{ .Language }}">{{ else }}<code>{{ end }}{{ renderLines $ctx .Lines | printf "%s" }}</code></pre>
 </div>
+</div>{{ end }}`,
+		texttemplate.FuncMap{
+			"renderLines": renderPlainString,
+			"escape":      html.EscapeString,
+		})
+
+	listingParagraphTmpl = newTextTemplate("listing paragraph",
+		`{{ $ctx := .Context }}{{ with .Data }}<div {{ if .ID }}id="{{ .ID }}" {{ end }}class="listingblock">{{ if .Title }}
+<div class="title">{{ .Title }}</div>{{ end }}
+<div class="content">
+<pre>{{ renderLines $ctx .Lines | printf "%s" }}</pre>
+</div>
 </div>{{ end }}`,
 		texttemplate.FuncMap{
 			"renderLines": renderPlainString,
@@ -68,7 +80,7 @@ func init() {
 		})
 
 	verseParagraphTmpl = newTextTemplate("verse block", `{{ $ctx := .Context }}{{ with .Data }}<div {{ if .ID }}id="{{ .ID }}" {{ end }}class="verseblock">{{ if .Title }}
-<div class="title">{{ escape .Title }}</div>{{ end }}
+<div class="title">{{ .Title }}</div>{{ end }}
 <pre class="content">{{ renderElements $ctx .Lines | printf "%s" }}</pre>{{ if .Attribution.First }}
 <div class="attribution">
 &#8212; {{ .Attribution.First }}{{ if .Attribution.Second }}<br>
@@ -80,9 +92,9 @@ func init() {
 			"escape":         html.EscapeString,
 		})
 	quoteParagraphTmpl = newTextTemplate("quote paragraph", `{{ $ctx := .Context }}{{ with .Data }}<div {{ if .ID }}id="{{ .ID }}" {{ end }}class="quoteblock">{{ if .Title }}
-<div class="title">{{ escape .Title }}</div>{{ end }}
+<div class="title">{{ .Title }}</div>{{ end }}
 <blockquote>
-{{ renderElements $ctx .Lines false | printf "%s" }}
+{{ renderElements $ctx .Lines .HardBreak | printf "%s" }}
 </blockquote>{{ if .Attribution.First }}
 <div class="attribution">
 &#8212; {{ .Attribution.First }}{{ if .Attribution.Second }}<br>
@@ -102,10 +114,21 @@ func renderParagraph(ctx *renderer.Context, p types.Paragraph) ([]byte, error) {
 	result := bytes.NewBuffer(nil)
 	id := generateID(ctx, p.Attributes)
 	var err error
+	if kind := p.Attributes[types.AttrKind]; p.Attributes.Has(types.AttrSubstitutions) && kind != types.Source && kind != types.Listing {
+		// (source and listing paragraphs apply their own, verbatim substitutions)
+		p.Lines, err = applySubstitutions(ctx, p.Lines, substitutionsOf(p.Attributes, types.NormalSubstitutions))
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render paragraph")
+		}
+	}
 	if _, ok := p.Attributes[types.AttrAdmonitionKind]; ok {
 		return renderAdmonitionParagraph(ctx, p)
 	} else if kind, ok := p.Attributes[types.AttrKind]; ok && kind == types.Source {
 		return renderSourceParagraph(ctx, p)
+	} else if kind, ok := p.Attributes[types.AttrKind]; ok && kind == types.Listing {
+		return renderListingParagraph(ctx, p)
+	} else if kind, ok := p.Attributes[types.AttrKind]; ok && kind == types.Comment {
+		return make([]byte, 0), nil // comment paragraphs are not rendered
 	} else if kind, ok := p.Attributes[types.AttrKind]; ok && kind == types.Verse {
 		return renderVerseParagraph(ctx, p)
 	} else if kind, ok := p.Attributes[types.AttrKind]; ok && kind == types.Quote {
@@ -123,9 +146,9 @@ func renderParagraph(ctx *renderer.Context, p types.Paragraph) ([]byte, error) {
 				HardBreak bool
 			}{
 				ID:        id,
-				Title:     getTitle(p.Attributes),
+				Title:     getTitle(ctx, p.Attributes),
 				Lines:     p.Lines,
-				HardBreak: p.Attributes.Has(types.AttrHardBreaks) || ctx.Document.Attributes.Has(types.DocumentAttrHardBreaks),
+				HardBreak: ctx.HardBreaks(p.Attributes),
 			},
 		})
 	}
@@ -152,13 +175,15 @@ func renderAdmonitionParagraph(ctx *renderer.Context, p types.Paragraph) ([]byte
 			IconTitle string
 			IconClass string
 			Lines     []types.InlineElements
+			HardBreak bool
 		}{
 			ID:        generateID(ctx, p.Attributes),
-			Title:     getTitle(p.Attributes),
+			Title:     getTitle(ctx, p.Attributes),
 			Class:     getClass(k),
 			IconTitle: getIconTitle(k),
 			IconClass: getIconClass(ctx, k),
 			Lines:     p.Lines,
+			HardBreak: ctx.HardBreaks(p.Attributes),
 		},
 	})
 	return result.Bytes(), err
@@ -166,6 +191,14 @@ func renderAdmonitionParagraph(ctx *renderer.Context, p types.Paragraph) ([]byte
 
 func renderSourceParagraph(ctx *renderer.Context, p types.Paragraph) ([]byte, error) {
 	log.Debug("rendering source paragraph...")
+	lines := p.Lines
+	if p.Attributes.Has(types.AttrSubstitutions) {
+		var err error
+		lines, err = applySubstitutions(ctx, p.Lines, substitutionsOf(p.Attributes, types.VerbatimSubstitutions))
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render source paragraph")
+		}
+	}
 	result := bytes.NewBuffer(nil)
 	err := sourceParagraphTmpl.Execute(result, ContextualPipeline{
 		Context: ctx,
@@ -176,9 +209,31 @@ func renderSourceParagraph(ctx *renderer.Context, p types.Paragraph) ([]byte, er
 			Lines    []types.InlineElements
 		}{
 			ID:       generateID(ctx, p.Attributes),
-			Title:    getTitle(p.Attributes),
+			Title:    getTitle(ctx, p.Attributes),
 			Language: p.Attributes.GetAsString(types.AttrLanguage),
-			Lines:    p.Lines,
+			Lines:    lines,
+		},
+	})
+	return result.Bytes(), err
+}
+
+func renderListingParagraph(ctx *renderer.Context, p types.Paragraph) ([]byte, error) {
+	log.Debug("rendering listing paragraph...")
+	lines, err := applySubstitutions(ctx, p.Lines, substitutionsOf(p.Attributes, types.VerbatimSubstitutions))
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render listing paragraph")
+	}
+	result := bytes.NewBuffer(nil)
+	err = listingParagraphTmpl.Execute(result, ContextualPipeline{
+		Context: ctx,
+		Data: struct {
+			ID    string
+			Title string
+			Lines []types.InlineElements
+		}{
+			ID:    generateID(ctx, p.Attributes),
+			Title: getTitle(ctx, p.Attributes),
+			Lines: lines,
 		},
 	})
 	return result.Bytes(), err
@@ -196,7 +251,7 @@ func renderVerseParagraph(ctx *renderer.Context, p types.Paragraph) ([]byte, err
 			Lines       []types.InlineElements
 		}{
 			ID:          generateID(ctx, p.Attributes),
-			Title:       getTitle(p.Attributes),
+			Title:       getTitle(ctx, p.Attributes),
 			Attribution: NewParagraphAttribution(p),
 			Lines:       p.Lines,
 		},
@@ -214,11 +269,13 @@ func renderQuoteParagraph(ctx *renderer.Context, p types.Paragraph) ([]byte, err
 			Title       string
 			Attribution Attribution
 			Lines       []types.InlineElements
+			HardBreak   bool
 		}{
 			ID:          generateID(ctx, p.Attributes),
-			Title:       getTitle(p.Attributes),
+			Title:       getTitle(ctx, p.Attributes),
 			Attribution: NewParagraphAttribution(p),
 			Lines:       p.Lines,
+			HardBreak:   ctx.HardBreaks(p.Attributes),
 		},
 	})
 	return result.Bytes(), err
@@ -234,11 +291,13 @@ func renderDelimitedBlockParagraph(ctx *renderer.Context, p types.Paragraph) ([]
 			Title      string
 			CheckStyle string
 			Lines      []types.InlineElements
+			HardBreak  bool
 		}{
 			ID:         generateID(ctx, p.Attributes),
-			Title:      getTitle(p.Attributes),
+			Title:      getTitle(ctx, p.Attributes),
 			CheckStyle: renderCheckStyle(p.Attributes[types.AttrCheckStyle]),
 			Lines:      p.Lines,
+			HardBreak:  ctx.HardBreaks(p.Attributes),
 		},
 	})
 	return result.Bytes(), err
@@ -301,10 +360,3 @@ func getIconTitle(kind types.AdmonitionKind) string {
 		return ""
 	}
 }
-
-func getTitle(attrs types.ElementAttributes) string {
-	if attrs.Has(types.AttrTitle) {
-		return strings.TrimSpace(attrs.GetAsString(types.AttrTitle))
-	}
-	return ""
-}
@@ -104,6 +104,85 @@ baz`
 <p>foo<br>
 bar<br>
 baz</p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("with paragraph options", func() {
+			source := `[options="hardbreaks"]
+foo
+bar`
+			expected := `<div class="paragraph">
+<p>foo<br>
+bar</p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("with document attribute in admonition paragraph", func() {
+			source := `:hardbreaks:
+
+NOTE: foo
+bar`
+			expected := `<div class="admonitionblock note">
+<table>
+<tr>
+<td class="icon">
+<div class="title">Note</div>
+</td>
+<td class="content">
+foo<br>
+bar
+</td>
+</tr>
+</table>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("with document attribute in quote paragraph", func() {
+			source := `:hardbreaks:
+
+[quote, john doe]
+foo
+bar`
+			expected := `<div class="quoteblock">
+<blockquote>
+foo<br>
+bar
+</blockquote>
+<div class="attribution">
+&#8212; john doe
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("with document attribute in list item and delimited block", func() {
+			source := `:hardbreaks:
+
+* foo
+bar
+
+====
+baz
+qux
+====`
+			expected := `<div class="ulist">
+<ul>
+<li>
+<p>foo<br>
+bar</p>
+</li>
+</ul>
+</div>
+<div class="exampleblock">
+<div class="content">
+<div class="paragraph">
+<p>baz<br>
+qux</p>
+</div>
+</div>
 </div>`
 			Expect(source).To(RenderHTML5Element(expected))
 		})
@@ -212,6 +291,35 @@ this is a
 		})
 	})
 
+	Context("masquerading paragraphs", func() {
+
+		It("listing paragraph", func() {
+			source := `[listing]
+.a title
+some <content>
+on 2 lines`
+			expected := `<div class="listingblock">
+<div class="title">a title</div>
+<div class="content">
+<pre>some &lt;content&gt;
+on 2 lines</pre>
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("comment paragraph", func() {
+			source := `[comment]
+some content
+
+other content`
+			expected := `<div class="paragraph">
+<p>other content</p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+	})
+
 	Context("verse paragraphs", func() {
 
 		It("paragraph as a verse with author and title", func() {
@@ -2,37 +2,72 @@ package html5
 
 import (
 	"bytes"
+	"html"
 	"html/template"
+	"strings"
 
 	"github.com/bytesparadise/libasciidoc/pkg/renderer"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
 )
 
 func renderPassthrough(ctx *renderer.Context, p types.Passthrough) ([]byte, error) {
-	renderedContent, err := renderPassthroughContent(ctx, p)
-	if err != nil {
-		return nil, errors.Wrap(err, "unable to render passthrough")
+	if p.Attributes.Has(types.AttrSubstitutions) {
+		// passthrough macro with custom substitutions (eg: `pass:q,a[...]`)
+		content, err := renderPlainString(ctx, p.Elements)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to render passthrough")
+		}
+		subs := types.NewInlineSubstitutions(p.Attributes.GetAsString(types.AttrSubstitutions))
+		if mode := ctx.SafeMode(); mode >= types.SafeModeServer && !subs.Has(types.SpecialCharactersSubstitution) {
+			if strings.ContainsAny(string(content), "<>&") {
+				log.Warnf("escaping the raw HTML of passthrough in %s mode", mode)
+			}
+			subs = append(types.Substitutions{types.SpecialCharactersSubstitution}, subs...)
+		}
+		return renderLineWithSubstitutions(ctx, string(content), subs)
 	}
 	switch p.Kind {
 	case types.SinglePlusPassthrough:
 		// rendered passthrough content is in an HTML-escaped form
+		renderedContent, err := renderPassthroughElements(ctx, p, false)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to render passthrough")
+		}
 		buf := bytes.NewBuffer(nil)
 		template.HTMLEscape(buf, renderedContent)
 		return buf.Bytes(), nil
 	default:
+		renderedContent, err := renderPassthroughContent(ctx, p)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to render passthrough")
+		}
 		return renderedContent, nil
 	}
 }
 
-// renderPassthroughMacro renders the passthrough content in ist raw from
+// renderPassthroughContent renders the passthrough content in its raw form,
+// unless in `server` mode or above, in which case the raw HTML is escaped
+// (except for the content which was already rendered, eg: after applying substitutions)
 func renderPassthroughContent(ctx *renderer.Context, p types.Passthrough) ([]byte, error) {
+	return renderPassthroughElements(ctx, p, p.Kind != types.RenderedPassthrough && ctx.SafeMode() >= types.SafeModeServer)
+}
+
+func renderPassthroughElements(ctx *renderer.Context, p types.Passthrough, escapeRaw bool) ([]byte, error) {
 	buf := bytes.NewBuffer(nil)
 	for _, element := range p.Elements {
 		switch element := element.(type) {
 		case types.StringElement:
-			// "string" elements must be rendered as-is, ie, without any HTML escaping.
-			_, err := buf.WriteString(element.Content)
+			// "string" elements must be rendered as-is, ie, without any HTML escaping (unless requested)
+			content := element.Content
+			if escapeRaw {
+				if escaped := html.EscapeString(content); escaped != content {
+					log.Warnf("escaping the raw HTML of passthrough in %s mode", ctx.SafeMode())
+					content = escaped
+				}
+			}
+			_, err := buf.WriteString(content)
 			if err != nil {
 				return nil, err
 			}
@@ -1,10 +1,13 @@
 package html5_test
 
 import (
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
 	. "github.com/bytesparadise/libasciidoc/testsupport"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	log "github.com/sirupsen/logrus"
 )
 
 var _ = Describe("passthroughs", func() {
@@ -153,4 +156,92 @@ world</p>
 			Expect(source).To(RenderHTML5Element(expected))
 		})
 	})
+
+	Context("passthroughs in safe modes", func() {
+
+		It("tripleplus passthrough with raw HTML in safe mode", func() {
+			source := `+++<del>raw</del>+++`
+			expected := `<div class="paragraph">
+<p><del>raw</del></p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected, renderer.SafeMode(types.SafeModeSafe)))
+		})
+
+		It("tripleplus passthrough with raw HTML in server mode", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			source := `+++<script>alert("hi")</script>+++`
+			expected := `<div class="paragraph">
+<p>&lt;script&gt;alert(&#34;hi&#34;)&lt;/script&gt;</p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected, renderer.SafeMode(types.SafeModeServer)))
+			Expect(console).To(ContainMessageWithLevel(log.WarnLevel, "escaping the raw HTML of passthrough in server mode"))
+		})
+
+		It("passthrough macro with raw HTML in secure mode", func() {
+			source := `pass:[<del>raw</del>]`
+			expected := `<div class="paragraph">
+<p>&lt;del&gt;raw&lt;/del&gt;</p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected, renderer.SafeMode(types.SafeModeSecure)))
+		})
+
+		It("passthrough macro with quoted text and raw HTML in server mode", func() {
+			source := `pass:q[*<del>raw</del>*]`
+			expected := `<div class="paragraph">
+<p><strong>&lt;del&gt;raw&lt;/del&gt;</strong></p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected, renderer.SafeMode(types.SafeModeServer)))
+		})
+
+		It("passthrough macro without raw HTML in server mode", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			source := `pass:[*hello*]`
+			expected := `<div class="paragraph">
+<p>*hello*</p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected, renderer.SafeMode(types.SafeModeServer)))
+			Expect(console).ToNot(ContainAnyMessageWithLevels(log.ErrorLevel, log.WarnLevel))
+		})
+
+		It("listing block with special characters in server mode", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			source := `----
+a < b && c > d
+----`
+			expected := `<div class="listingblock">
+<div class="content">
+<pre>a &lt; b &amp;&amp; c &gt; d</pre>
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected, renderer.SafeMode(types.SafeModeServer)))
+			Expect(console).ToNot(ContainAnyMessageWithLevels(log.ErrorLevel, log.WarnLevel))
+		})
+
+		It("paragraph with custom substitutions in server mode", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			source := `[subs=quotes]
+*a* < b`
+			expected := `<div class="paragraph">
+<p><strong>a</strong> < b</p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected, renderer.SafeMode(types.SafeModeServer)))
+			Expect(console).ToNot(ContainAnyMessageWithLevels(log.ErrorLevel, log.WarnLevel))
+		})
+
+		It("paragraph with special characters and quotes substitutions in secure mode", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			source := `[subs="specialchars,quotes"]
+*a < b*`
+			expected := `<div class="paragraph">
+<p><strong>a &lt; b</strong></p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected, renderer.SafeMode(types.SafeModeSecure)))
+			Expect(console).ToNot(ContainAnyMessageWithLevels(log.ErrorLevel, log.WarnLevel))
+		})
+	})
 })
@@ -15,14 +15,20 @@ var italicTextTmpl texttemplate.Template
 var monospaceTextTmpl texttemplate.Template
 var subscriptTextTmpl texttemplate.Template
 var superscriptTextTmpl texttemplate.Template
+var markedTextTmpl texttemplate.Template
+var doubleCurvedQuoteTextTmpl texttemplate.Template
+var singleCurvedQuoteTextTmpl texttemplate.Template
 
 // initializes the templates
 func init() {
-	boldTextTmpl = newTextTemplate("bold text", "<strong>{{ . }}</strong>")
-	italicTextTmpl = newTextTemplate("italic text", "<em>{{ . }}</em>")
-	monospaceTextTmpl = newTextTemplate("monospace text", "<code>{{ . }}</code>")
-	subscriptTextTmpl = newTextTemplate("subscript text", "<sub>{{ . }}</sub>")
-	superscriptTextTmpl = newTextTemplate("superscript text", "<sup>{{ . }}</sup>")
+	boldTextTmpl = newTextTemplate("bold text", `<strong{{ if .Role }} class="{{ .Role }}"{{ end }}>{{ .Content }}</strong>`)
+	italicTextTmpl = newTextTemplate("italic text", `<em{{ if .Role }} class="{{ .Role }}"{{ end }}>{{ .Content }}</em>`)
+	monospaceTextTmpl = newTextTemplate("monospace text", `<code{{ if .Role }} class="{{ .Role }}"{{ end }}>{{ .Content }}</code>`)
+	subscriptTextTmpl = newTextTemplate("subscript text", `<sub{{ if .Role }} class="{{ .Role }}"{{ end }}>{{ .Content }}</sub>`)
+	superscriptTextTmpl = newTextTemplate("superscript text", `<sup{{ if .Role }} class="{{ .Role }}"{{ end }}>{{ .Content }}</sup>`)
+	markedTextTmpl = newTextTemplate("marked text", `{{ if .Role }}<span class="{{ .Role }}">{{ .Content }}</span>{{ else }}<mark>{{ .Content }}</mark>{{ end }}`)
+	doubleCurvedQuoteTextTmpl = newTextTemplate("double curved quote text", `{{ if .Role }}<span class="{{ .Role }}">{{ end }}&#8220;{{ .Content }}&#8221;{{ if .Role }}</span>{{ end }}`)
+	singleCurvedQuoteTextTmpl = newTextTemplate("single curved quote text", `{{ if .Role }}<span class="{{ .Role }}">{{ end }}&#8216;{{ .Content }}&#8217;{{ if .Role }}</span>{{ end }}`)
 }
 
 func renderQuotedText(ctx *renderer.Context, t types.QuotedText) ([]byte, error) {
@@ -50,12 +56,24 @@ func renderQuotedText(ctx *renderer.Context, t types.QuotedText) ([]byte, error)
 		tmpl = subscriptTextTmpl
 	case types.Superscript:
 		tmpl = superscriptTextTmpl
+	case types.Marked:
+		tmpl = markedTextTmpl
+	case types.DoubleCurvedQuote:
+		tmpl = doubleCurvedQuoteTextTmpl
+	case types.SingleCurvedQuote:
+		tmpl = singleCurvedQuoteTextTmpl
 	default:
 		return nil, errors.Errorf("unsupported quoted text kind: '%v'", t.Kind)
 	}
-	err := tmpl.Execute(result, template.HTML(elementsBuffer.String())) //nolint: gosec
+	err := tmpl.Execute(result, struct {
+		Role    string
+		Content template.HTML
+	}{
+		Role:    t.Attributes.GetAsString(types.AttrRole),
+		Content: template.HTML(elementsBuffer.String()), //nolint: gosec
+	})
 	if err != nil {
-		return nil, errors.Wrapf(err, "unable to render monospaced quote")
+		return nil, errors.Wrapf(err, "unable to render quoted text")
 	}
 	// log.Debugf("rendered bold quote: %s", result.Bytes())
 	return result.Bytes(), nil
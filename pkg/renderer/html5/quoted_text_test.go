@@ -334,4 +334,57 @@ b</code></p>
 
 	})
 
+	Context("marked text and roles", func() {
+
+		It("marked text", func() {
+			source := "some #marked# and ##mark##ed content"
+			expected := `<div class="paragraph">
+<p>some <mark>marked</mark> and <mark>mark</mark>ed content</p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("marked text with roles", func() {
+			source := "[.role]#text#, [.line-through]#deleted# and [.underline]#underlined#"
+			expected := `<div class="paragraph">
+<p><span class="role">text</span>, <span class="line-through">deleted</span> and <span class="underline">underlined</span></p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("bold and italic text with roles", func() {
+			source := "[.big.red]*bold* and [.small]_italic_"
+			expected := `<div class="paragraph">
+<p><strong class="big red">bold</strong> and <em class="small">italic</em></p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("escaped marked text", func() {
+			source := `\#not marked# in C#`
+			expected := `<div class="paragraph">
+<p>#not marked# in C#</p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+	})
+
+	Context("curved quotes", func() {
+
+		It("double and single curved quotes", func() {
+			source := "\"`double *curved*`\" and '`single curved`' quotes"
+			expected := `<div class="paragraph">
+<p>&#8220;double <strong>curved</strong>&#8221; and &#8216;single curved&#8217; quotes</p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("curved quotes without quotes substitution", func() {
+			source := "[subs=\"-quotes\"]\n\"`not curved`\""
+			expected := "<div class=\"paragraph\">\n" +
+				"<p>&#34;`not curved`&#34;</p>\n" +
+				"</div>"
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+	})
 })
@@ -0,0 +1,84 @@
+package html5
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// replacement a typographic replacement, which applies on the (HTML-escaped) content of a string
+type replacement struct {
+	expression *regexp.Regexp
+	// the replacement, which may refer to the capturing groups of the expression (eg: `$1`)
+	replacement string
+	// an optional condition on the character following the match (since lookaheads are not supported by the regexp package)
+	followedBy func(rune) bool
+}
+
+func isWordCharacter(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
+
+// the typographic replacements, as defined in Asciidoctor. A match which contains a backslash
+// is escaped: it is restored without the backslash, but is not replaced.
+var replacements = []replacement{
+	// copyright, registered and trademark symbols
+	{expression: regexp.MustCompile(`\\?\(C\)`), replacement: "&#169;"},
+	{expression: regexp.MustCompile(`\\?\(R\)`), replacement: "&#174;"},
+	{expression: regexp.MustCompile(`\\?\(TM\)`), replacement: "&#8482;"},
+	// em dash surrounded by spaces
+	{expression: regexp.MustCompile(`(^|\n| |\\)--( |\n|$)`), replacement: "&#8201;&#8212;&#8201;"},
+	// em dash between words
+	{expression: regexp.MustCompile(`([\p{L}\p{N}_])\\?--`), replacement: "$1&#8212;&#8203;", followedBy: isWordCharacter},
+	// ellipsis
+	{expression: regexp.MustCompile(`\\?\.\.\.`), replacement: "&#8230;&#8203;"},
+	// right single quote
+	{expression: regexp.MustCompile("\\\\?`&#39;"), replacement: "&#8217;"},
+	// apostrophe within a word
+	{expression: regexp.MustCompile(`([\p{L}\p{N}])\\?&#39;`), replacement: "$1&#8217;", followedBy: unicode.IsLetter},
+	// arrows
+	{expression: regexp.MustCompile(`\\?-&gt;`), replacement: "&#8594;"},
+	{expression: regexp.MustCompile(`\\?=&gt;`), replacement: "&#8658;"},
+	{expression: regexp.MustCompile(`\\?&lt;-`), replacement: "&#8592;"},
+	{expression: regexp.MustCompile(`\\?&lt;=`), replacement: "&#8656;"},
+	// restore the entity references that were escaped along with the special characters
+	{expression: regexp.MustCompile(`\\?&amp;((?:[a-zA-Z][a-zA-Z]+\d{0,2}|#\d\d\d{0,4}|#x[\da-fA-F][\da-fA-F][\da-fA-F]{0,3});)`), replacement: "&$1"},
+}
+
+// replace applies the typographic replacements on the given (HTML-escaped) source
+func replace(source string) string {
+	result := source
+	for _, r := range replacements {
+		result = r.apply(result)
+	}
+	return result
+}
+
+func (r replacement) apply(source string) string {
+	matches := r.expression.FindAllStringSubmatchIndex(source, -1)
+	if len(matches) == 0 {
+		return source
+	}
+	result := strings.Builder{}
+	previous := 0
+	for _, match := range matches {
+		start, end := match[0], match[1]
+		if r.followedBy != nil {
+			next, _ := utf8.DecodeRuneInString(source[end:])
+			if next == utf8.RuneError || !r.followedBy(next) {
+				continue
+			}
+		}
+		result.WriteString(source[previous:start])
+		if m := source[start:end]; strings.Contains(m, `\`) {
+			// escaped: restore the matched text, without the backslash
+			result.WriteString(strings.Replace(m, `\`, "", 1))
+		} else {
+			result.Write(r.expression.ExpandString(nil, r.replacement, source, match))
+		}
+		previous = end
+	}
+	result.WriteString(source[previous:])
+	return result.String()
+}
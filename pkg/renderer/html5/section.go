@@ -17,6 +17,7 @@ var preambleTmpl texttemplate.Template
 var sectionHeaderTmpl texttemplate.Template
 var section1ContentTmpl texttemplate.Template
 var otherSectionContentTmpl texttemplate.Template
+var discreteHeadingTmpl texttemplate.Template
 
 // initializes the templates
 func init() {
@@ -33,7 +34,8 @@ func init() {
 		`{{ $ctx := .Context }}{{ with .Data }}<div class="{{ .Class }}">
 {{ .SectionTitle }}
 <div class="sectionbody">{{ $elements := renderElements $ctx .Elements | printf "%s" }}{{ if $elements }}
-{{ $elements }}{{ end }}
+{{ $elements }}{{ end }}{{ if .Index }}
+{{ .Index }}{{ end }}
 </div>
 </div>{{ end }}`,
 		texttemplate.FuncMap{
@@ -42,13 +44,16 @@ func init() {
 	otherSectionContentTmpl = newTextTemplate("other section",
 		`{{ $ctx := .Context }}{{ with .Data }}<div class="{{ .Class }}">
 {{ .SectionTitle }}{{ $elements := renderElements $ctx .Elements | printf "%s" }}{{ if $elements }}
-{{ $elements }}{{ end }}
+{{ $elements }}{{ end }}{{ if .Index }}
+{{ .Index }}{{ end }}
 </div>{{ end }}`,
 		texttemplate.FuncMap{
 			"renderElements": renderElements,
 		})
 	sectionHeaderTmpl = newTextTemplate("other sectionTitle",
 		`<h{{ .Level }} id="{{ .ID }}">{{ .Content }}</h{{ .Level }}>`)
+	discreteHeadingTmpl = newTextTemplate("discrete heading",
+		`<h{{ .Level }} id="{{ .ID }}" class="discrete{{ if .Role }} {{ .Role }}{{ end }}">{{ .Content }}</h{{ .Level }}>`)
 }
 
 func renderPreamble(ctx *renderer.Context, p types.Preamble) ([]byte, error) {
@@ -83,6 +88,13 @@ func renderSection(ctx *renderer.Context, s types.Section) ([]byte, error) {
 	if err != nil {
 		return nil, errors.Wrapf(err, "error while rendering section")
 	}
+	var index string
+	if s.Attributes.Has(types.AttrIndexSection) {
+		// the index of the document is generated after the content of the section
+		if index, err = renderIndexCatalog(ctx, s.Level+2); err != nil {
+			return nil, errors.Wrapf(err, "error while rendering section")
+		}
+	}
 	result := bytes.NewBuffer(nil)
 	// select the appropriate template for the section
 	var tmpl texttemplate.Template
@@ -97,10 +109,12 @@ func renderSection(ctx *renderer.Context, s types.Section) ([]byte, error) {
 			Class        string
 			SectionTitle string
 			Elements     []interface{}
+			Index        string
 		}{
 			Class:        "sect" + strconv.Itoa(s.Level),
 			SectionTitle: renderedSectionTitle,
 			Elements:     s.Elements,
+			Index:        index,
 		}})
 	if err != nil {
 		return nil, errors.Wrapf(err, "error while rendering section")
@@ -111,7 +125,7 @@ func renderSection(ctx *renderer.Context, s types.Section) ([]byte, error) {
 
 func renderSectionTitle(ctx *renderer.Context, s types.Section) (string, error) {
 	result := bytes.NewBuffer(nil)
-	renderedContent, err := renderElement(ctx, s.Title)
+	renderedContent, err := renderTitleElements(ctx, s.Attributes.GetAsString(types.AttrID), s.Title)
 	if err != nil {
 		return "", errors.Wrapf(err, "error while rendering sectionTitle content")
 	}
@@ -132,3 +146,27 @@ func renderSectionTitle(ctx *renderer.Context, s types.Section) (string, error)
 	// log.Debugf("rendered sectionTitle: %s", result.Bytes())
 	return result.String(), nil
 }
+
+func renderDiscreteHeading(ctx *renderer.Context, h types.DiscreteHeading) ([]byte, error) {
+	log.Debugf("rendering discrete heading level %d", h.Level)
+	renderedContent, err := renderTitleElements(ctx, h.Attributes.GetAsString(types.AttrID), h.Title)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while rendering discrete heading content")
+	}
+	result := bytes.NewBuffer(nil)
+	err = discreteHeadingTmpl.Execute(result, struct {
+		Level   int
+		ID      string
+		Role    string
+		Content string
+	}{
+		Level:   h.Level + 1,
+		ID:      generateID(ctx, h.Attributes),
+		Role:    h.Attributes.GetAsString(types.AttrRole),
+		Content: strings.TrimSpace(string(renderedContent)),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while rendering discrete heading")
+	}
+	return result.Bytes(), nil
+}
@@ -41,6 +41,21 @@ var _ = Describe("sections", func() {
 			Expect(source).To(RenderHTML5Element(expected))
 		})
 
+		It("Markdown-style sections level 1 and 2", func() {
+			source := `## a title
+
+### a subtitle`
+			expected := `<div class="sect1">
+<h2 id="_a_title">a title</h2>
+<div class="sectionbody">
+<div class="sect2">
+<h3 id="_a_subtitle">a subtitle</h3>
+</div>
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
 		It("section level 1 with just bold content", func() {
 			source := `==  *2 spaces and bold content*`
 			expected := `<div class="sect1">
@@ -300,6 +315,80 @@ here</p>
 <p>content here</p>
 </div>
 </div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+	})
+
+	Context("discrete headings", func() {
+
+		It("discrete headings within a section", func() {
+			source := `== section 1
+
+[discrete]
+=== a discrete heading
+
+content
+
+[#custom]
+[.role]
+[float]
+== a floating title
+
+more content`
+			expected := `<div class="sect1">
+<h2 id="_section_1">section 1</h2>
+<div class="sectionbody">
+<h3 id="_a_discrete_heading" class="discrete">a discrete heading</h3>
+<div class="paragraph">
+<p>content</p>
+</div>
+<h2 id="custom" class="discrete role">a floating title</h2>
+<div class="paragraph">
+<p>more content</p>
+</div>
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("discrete headings within a sidebar and an admonition block", func() {
+			source := `****
+[discrete]
+== a heading in a sidebar
+
+content
+****
+
+[NOTE]
+====
+[discrete]
+=== a heading in a note
+
+content
+====`
+			expected := `<div class="sidebarblock">
+<div class="content">
+<h2 id="_a_heading_in_a_sidebar" class="discrete">a heading in a sidebar</h2>
+<div class="paragraph">
+<p>content</p>
+</div>
+</div>
+</div>
+<div class="admonitionblock note">
+<table>
+<tr>
+<td class="icon">
+<div class="title">Note</div>
+</td>
+<td class="content">
+<h3 id="_a_heading_in_a_note" class="discrete">a heading in a note</h3>
+<div class="paragraph">
+<p>content</p>
+</div>
+</td>
+</tr>
+</table>
 </div>`
 			Expect(source).To(RenderHTML5Element(expected))
 		})
@@ -2,7 +2,6 @@ package html5
 
 import (
 	"bytes"
-	"strings"
 
 	"github.com/bytesparadise/libasciidoc/pkg/renderer"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
@@ -17,14 +16,10 @@ func renderStringElement(ctx *renderer.Context, str types.StringElement) ([]byte
 	if err != nil {
 		return []byte{}, errors.Wrapf(err, "unable to render string")
 	}
-	result := convert(buf.String(), ellipsis)
+	result := convert(buf.String(), replace)
 	return []byte(result), nil
 }
 
-func ellipsis(source string) string {
-	return strings.Replace(source, "...", "&#8230;&#8203;", -1)
-}
-
 type converter func(string) string
 
 func convert(source string, converters ...converter) string {
@@ -4,6 +4,7 @@ import (
 	. "github.com/bytesparadise/libasciidoc/testsupport"
 
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 )
 
@@ -21,4 +22,26 @@ var _ = Describe("strings", func() {
 			Expect(source).To(RenderHTML5Element(expected))
 		})
 	})
+
+	DescribeTable("typographic replacements",
+		func(source, expected string) {
+			Expect(source).To(RenderHTML5Element(`<div class="paragraph">
+<p>` + expected + `</p>
+</div>`))
+		},
+		Entry("copyright", `(C) 2019`, `&#169; 2019`),
+		Entry("registered", `Acme(R)`, `Acme&#174;`),
+		Entry("trademark", `Acme(TM)`, `Acme&#8482;`),
+		Entry("em dash between spaces", `a -- b`, `a&#8201;&#8212;&#8201;b`),
+		Entry("em dash between words", `a--b`, `a&#8212;&#8203;b`),
+		Entry("right arrow", `a -> b`, `a &#8594; b`),
+		Entry("right double arrow", `a => b`, `a &#8658; b`),
+		Entry("left arrow", `a <- b`, `a &#8592; b`),
+		Entry("left double arrow", `a <= b`, `a &#8656; b`),
+		Entry("apostrophe within a word", `it's`, `it&#8217;s`),
+		Entry("apostrophe not within a word", `'quoted'`, `&#39;quoted&#39;`),
+		Entry("right single quote", "the `'90s", `the &#8217;90s`),
+		Entry("entity reference", `&#169; and &copy;`, `&#169; and &copy;`),
+		Entry("escaped replacements", `\(C) \-> \... a\--b`, `(C) -&gt; ... a--b`),
+	)
 })
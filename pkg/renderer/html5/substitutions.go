@@ -0,0 +1,111 @@
+package html5
+
+import (
+	"bytes"
+	"html"
+
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// substitutionsOf returns the substitutions to apply on an element with the given attributes: the given
+// defaults, unless the element has a `subs` attribute
+func substitutionsOf(attrs types.ElementAttributes, defaults types.Substitutions) types.Substitutions {
+	if !attrs.Has(types.AttrSubstitutions) {
+		return defaults
+	}
+	return types.NewSubstitutions(attrs.GetAsString(types.AttrSubstitutions), defaults)
+}
+
+// applySubstitutions renders each of the given lines with the given substitutions. The rendered content of each line
+// is wrapped in a passthrough, so it can be rendered as-is, without further processing (eg: HTML escaping)
+func applySubstitutions(ctx *renderer.Context, lines []types.InlineElements, subs types.Substitutions) ([]types.InlineElements, error) {
+	result := make([]types.InlineElements, 0, len(lines))
+	for _, line := range lines {
+		if len(line) == 1 {
+			if _, ok := line[0].(types.SingleLineComment); ok {
+				continue // comments are not rendered
+			}
+		}
+		rawLine, err := renderPlainString(ctx, line)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to apply substitutions")
+		}
+		ctx.SetDropLine(false)
+		renderedLine, err := renderLineWithSubstitutions(ctx, string(rawLine), subs)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to apply substitutions")
+		}
+		if ctx.SetDropLine(false) {
+			continue
+		}
+		result = append(result, types.InlineElements{
+			types.Passthrough{
+				Kind: types.RenderedPassthrough,
+				Elements: types.InlineElements{
+					types.StringElement{Content: string(renderedLine)},
+				},
+			},
+		})
+	}
+	return result, nil
+}
+
+// applySubstitutionsOnElements applies the given substitutions on the lines of all paragraphs in the given elements
+func applySubstitutionsOnElements(ctx *renderer.Context, elements []interface{}, subs types.Substitutions) ([]interface{}, error) {
+	result := make([]interface{}, len(elements))
+	for i, element := range elements {
+		if p, ok := element.(types.Paragraph); ok {
+			lines, err := applySubstitutions(ctx, p.Lines, subs)
+			if err != nil {
+				return nil, err
+			}
+			p.Lines = lines
+			result[i] = p
+			continue
+		}
+		result[i] = element
+	}
+	return result, nil
+}
+
+// renderLineWithSubstitutions renders the given raw line with the given substitutions:
+// the elements of the line are parsed according to the `quotes`, `attributes`, `macros`
+// and `post_replacements` substitutions, while the `specialcharacters` and `replacements`
+// substitutions apply on the remaining text
+func renderLineWithSubstitutions(ctx *renderer.Context, line string, subs types.Substitutions) ([]byte, error) {
+	log.Debugf("rendering line with substitutions %v", subs)
+	elements := types.InlineElements{types.StringElement{Content: line}}
+	if subs.Has(types.QuotesSubstitution) || subs.Has(types.AttributesSubstitution) ||
+		subs.Has(types.MacrosSubstitution) || subs.Has(types.PostReplacementsSubstitution) {
+		var err error
+		elements, err = parser.ParseInlineElementsWithSubstitutions(line, subs)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to render line with substitutions")
+		}
+	}
+	buff := bytes.NewBuffer(nil)
+	for _, element := range elements {
+		switch element := element.(type) {
+		case types.StringElement:
+			content := element.Content
+			if subs.Has(types.SpecialCharactersSubstitution) {
+				content = html.EscapeString(content)
+			}
+			if subs.Has(types.ReplacementsSubstitution) {
+				content = replace(content)
+			}
+			buff.WriteString(content)
+		default:
+			renderedElement, err := renderElement(ctx, element)
+			if err != nil {
+				return nil, errors.Wrap(err, "unable to render line with substitutions")
+			}
+			buff.Write(renderedElement)
+		}
+	}
+	return buff.Bytes(), nil
+}
@@ -0,0 +1,208 @@
+package html5_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("substitutions", func() {
+
+	Context("paragraphs", func() {
+
+		It("paragraph without quotes substitution", func() {
+			source := `:foo: bar
+
+[subs="-quotes"]
+a *paragraph* with {foo} <and> more... +
+on 2 lines`
+			expected := `<div class="paragraph">
+<p>a *paragraph* with bar &lt;and&gt; more&#8230;&#8203;<br>
+on 2 lines</p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("paragraph with quotes substitution only", func() {
+			source := `:foo: bar
+
+[subs=quotes]
+a *paragraph* with {foo} <b>and</b> more... +
+on 2 lines`
+			expected := `<div class="paragraph">
+<p>a <strong>paragraph</strong> with {foo} <b>and</b> more... +
+on 2 lines</p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("paragraph with normal substitutions", func() {
+			source := `[subs=normal]
+a *paragraph* with a https://foo.com[link]
+// a comment
+on 2 lines`
+			expected := `<div class="paragraph">
+<p>a <strong>paragraph</strong> with a <a href="https://foo.com">link</a>
+on 2 lines</p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("paragraph without replacements substitution", func() {
+			source := `[subs="-replacements"]
+a paragraph (C) -- with <arrows> -> and it's...`
+			expected := `<div class="paragraph">
+<p>a paragraph (C) -- with &lt;arrows&gt; -&gt; and it&#39;s...</p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("paragraph without any substitution", func() {
+			source := `[subs=none]
+a *paragraph* with <b>HTML</b>`
+			expected := `<div class="paragraph">
+<p>a *paragraph* with <b>HTML</b></p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("admonition paragraph without macros substitution", func() {
+			source := `[NOTE]
+[subs="-macros"]
+a note with a https://foo.com[link]`
+			expected := `<div class="admonitionblock note">
+<table>
+<tr>
+<td class="icon">
+<div class="title">Note</div>
+</td>
+<td class="content">
+a note with a https://foo.com[link]
+</td>
+</tr>
+</table>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("admonition paragraph with quotes substitution only", func() {
+			source := `:foo: bar
+
+[subs=quotes]
+NOTE: a *note* with {foo}`
+			expected := `<div class="admonitionblock note">
+<table>
+<tr>
+<td class="icon">
+<div class="title">Note</div>
+</td>
+<td class="content">
+a <strong>note</strong> with {foo}
+</td>
+</tr>
+</table>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("list with substitutions attribute", func() {
+			source := `[subs=normal]
+* an *item*
+* another item`
+			expected := `<div class="ulist">
+<ul>
+<li>
+<p>an <strong>item</strong></p>
+</li>
+<li>
+<p>another item</p>
+</li>
+</ul>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+	})
+
+	Context("verbatim blocks", func() {
+
+		It("listing block with attributes substitution", func() {
+			source := `:version: 1.0
+
+[subs="+attributes,-callouts"]
+----
+<version> {version} </version>
+----`
+			expected := `<div class="listingblock">
+<div class="content">
+<pre>&lt;version&gt; 1.0 &lt;/version&gt;</pre>
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("source block with quotes substitution", func() {
+			source := `[source,go,subs="verbatim,quotes"]
+----
+*package* main
+
+func main() {}
+----`
+			expected := `<div class="listingblock">
+<div class="content">
+<pre class="highlight"><code class="language-go" data-lang="go"><strong>package</strong> main
+
+func main() {}</code></pre>
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("source paragraph with attributes substitution", func() {
+			source := `:name: foo
+
+[source,subs="+attributes"]
+var {name} = "<bar>"`
+			expected := `<div class="listingblock">
+<div class="content">
+<pre class="highlight"><code>var foo = &#34;&lt;bar&gt;&#34;</code></pre>
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("literal block with quotes substitution", func() {
+			source := `[subs="+quotes"]
+....
+some *literal* <content>
+....`
+			expected := `<div class="literalblock">
+<div class="content">
+<pre>some <strong>literal</strong> &lt;content&gt;</pre>
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+	})
+
+	Context("passthrough macros", func() {
+
+		It("passthrough macro with quotes and attributes substitutions", func() {
+			source := `:foo: bar
+
+pass:q,a[<u>_italic_ {foo}</u>]`
+			expected := `<div class="paragraph">
+<p><u><em>italic</em> bar</u></p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("passthrough macro with special characters substitution", func() {
+			source := `pass:c[<u>] and pass:specialchars,quotes[*<u>*]`
+			expected := `<div class="paragraph">
+<p>&lt;u&gt; and <strong>&lt;u&gt;</strong></p>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+	})
+})
@@ -17,8 +17,8 @@ import (
 var tableTmpl texttemplate.Template
 
 func init() {
-	tableTmpl = newTextTemplate("table", `{{ $ctx := .Context }}{{ with .Data }}<table class="tableblock frame-all grid-all stretch">{{ if .Lines }}
-{{ if .Title }}<caption class="title">{{ escape .Title }}</caption>
+	tableTmpl = newTextTemplate("table", `{{ $ctx := .Context }}{{ with .Data }}<table{{ if .ID }} id="{{ .ID }}"{{ end }} class="tableblock frame-all grid-all stretch">{{ if .Lines }}
+{{ if .Title }}<caption class="title">{{ .Title }}</caption>
 {{ end }}<colgroup>
 {{ $cellWidths := .CellWidths }}{{ range $index, $width := $cellWidths }}<col style="width: {{ $width }}%;">{{ includeNewline $ctx $index $cellWidths }}{{ end }}
 </colgroup>
@@ -60,18 +60,17 @@ func renderTable(ctx *renderer.Context, t types.Table) ([]byte, error) {
 		widths[n-1] = formatColumnWidth(100-total, lastColumn()) // make sure the last width as the upper rounded value
 		log.Debugf("current total width: %v -> %v", total, widths[n-1])
 	}
-	var title string
-	if titleAttr, ok := t.Attributes[types.AttrTitle].(string); ok {
-		title = fmt.Sprintf("Table %d. %s", ctx.GetAndIncrementTableCounter(), html.EscapeString(titleAttr))
-	}
+	title := getCaptionedTitle(ctx, t, t.Attributes, ctx.GetAndIncrementTableCounter)
 	err := tableTmpl.Execute(result, ContextualPipeline{
 		Context: ctx,
 		Data: struct {
+			ID         string
 			Title      string
 			CellWidths []string
 			Header     types.TableLine
 			Lines      []types.TableLine
 		}{
+			ID:         generateID(ctx, t.Attributes),
 			Title:      title,
 			CellWidths: widths,
 			Header:     t.Header,
@@ -2,6 +2,7 @@ package html5
 
 import (
 	"bytes"
+	"html"
 	"html/template"
 	"strconv"
 	"strings"
@@ -17,10 +18,13 @@ var tableOfContentTmpl texttemplate.Template
 var tableOfContentSectionSetTmpl texttemplate.Template
 
 func init() {
-	tableOfContentTmpl = newTextTemplate("toc", `<div id="toc" class="toc">
-<div id="toctitle">Table of Contents</div>
+	tableOfContentTmpl = newTextTemplate("toc", `<div id="toc" class="{{ .Class }}">
+<div id="toctitle">{{ escape .Title }}</div>
 {{ .Content }}
-</div>`)
+</div>`,
+		texttemplate.FuncMap{
+			"escape": html.EscapeString,
+		})
 	tableOfContentSectionSetTmpl = newTextTemplate("toc section", `<ul class="sectlevel{{ .Level }}">
 {{ range .Elements }}<li><a href="#{{ .Href }}">{{ .Title }}</a>{{ if .Elements }}
 {{ .Elements }}
@@ -30,6 +34,8 @@ func init() {
 
 // TableOfContents the structure of the table of contents
 type TableOfContents struct {
+	Class   string
+	Title   string
 	Content template.HTML
 }
 
@@ -48,6 +54,15 @@ type TableOfContentsSection struct {
 }
 
 func renderTableOfContents(ctx *renderer.Context, m types.TableOfContentsMacro) ([]byte, error) { //nolint:unparam
+	if ctx.IncludeHeaderFooter() && getTocPosition(ctx) != "" {
+		// the ToC is rendered in the header of the document, on the side of the content
+		return []byte{}, nil
+	}
+	return renderTableOfContentsContainer(ctx)
+}
+
+// renderTableOfContentsContainer renders the `toc` container with the sections of the document
+func renderTableOfContentsContainer(ctx *renderer.Context) ([]byte, error) {
 	log.Debug("rendering table of contents...")
 	renderedSections, err := renderTableOfContentsSections(ctx, ctx.Document.Elements, 1)
 	if err != nil {
@@ -59,6 +74,8 @@ func renderTableOfContents(ctx *renderer.Context, m types.TableOfContentsMacro)
 	}
 	result := bytes.NewBuffer(nil)
 	err = tableOfContentTmpl.Execute(result, TableOfContents{
+		Class:   getTocClass(ctx),
+		Title:   getTocTitle(ctx),
 		Content: renderedSections,
 	})
 	if err != nil {
@@ -78,11 +95,14 @@ func renderTableOfContentsSections(ctx *renderer.Context, elements []interface{}
 			if section.Level == 0 {
 				return renderTableOfContentsSections(ctx, section.Elements, currentLevel)
 			}
-			renderedTitle, err := renderElement(ctx, section.Title)
+			if excludedFromTableOfContents(section) {
+				continue
+			}
+			renderedTitle, err := renderTitleElements(ctx, section.Attributes.GetAsString(types.AttrID), withoutIndexTerms(section.Title))
 			if err != nil {
 				return template.HTML(""), errors.Wrapf(err, "error while rendering table of content section")
 			}
-			tocLevels, err := getTocLevels(ctx.Document)
+			tocLevels, err := getTocLevels(ctx)
 			if err != nil {
 				return template.HTML(""), errors.Wrapf(err, "error while rendering table of content section")
 			}
@@ -118,9 +138,46 @@ func renderTableOfContentsSections(ctx *renderer.Context, elements []interface{}
 	return template.HTML(resultBuf.String()), nil //nolint: gosec
 }
 
-func getTocLevels(doc types.Document) (int, error) {
-	if d, found := types.SearchAttributeDeclaration(doc.Elements, types.AttrTableOfContentsLevels); found {
-		return strconv.Atoi(d.Value)
+func getTocLevels(ctx *renderer.Context) (int, error) {
+	if levels, found := ctx.DeclaredAttribute(types.AttrTableOfContentsLevels); found {
+		return strconv.Atoi(levels)
 	}
 	return 2, nil
 }
+
+// getTocPosition returns the side of the document on which the ToC is rendered (`left` or `right`),
+// or an empty string if the ToC is rendered in the flow of the document
+func getTocPosition(ctx *renderer.Context) string {
+	if location, found := ctx.DeclaredAttribute(types.AttrTableOfContents); found && (location == "left" || location == "right") {
+		return location
+	}
+	return ""
+}
+
+func getTocClass(ctx *renderer.Context) string {
+	if class, found := ctx.DeclaredAttribute(types.AttrTableOfContentsClass); found && class != "" {
+		return class
+	}
+	if getTocPosition(ctx) != "" {
+		return "toc2"
+	}
+	return "toc"
+}
+
+func getTocTitle(ctx *renderer.Context) string {
+	if title, found := ctx.DeclaredAttribute(types.AttrTableOfContentsTitle); found {
+		return title
+	}
+	return "Table of Contents"
+}
+
+// excludedFromTableOfContents returns `true` if the given section has the `toc` or `notoc` role
+// (discrete headings are not sections, so they are never in the table of contents)
+func excludedFromTableOfContents(section types.Section) bool {
+	for _, role := range strings.Fields(section.Attributes.GetAsString(types.AttrRole)) {
+		if role == "toc" || role == "notoc" {
+			return true
+		}
+	}
+	return false
+}
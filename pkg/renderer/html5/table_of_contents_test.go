@@ -1,6 +1,9 @@
 package html5_test
 
 import (
+	"time"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
 	. "github.com/bytesparadise/libasciidoc/testsupport"
 
 	. "github.com/onsi/ginkgo"
@@ -187,4 +190,317 @@ level 1 sections not exists.`
 		})
 	})
 
+	Context("toc placement and attributes", func() {
+
+		It("toc at the location of the macro", func() {
+			source := `= A title
+:toc: macro
+
+A preamble...
+
+toc::[]
+
+== Section A
+
+== Section B`
+
+			expected := `<div id="preamble">
+<div class="sectionbody">
+<div class="paragraph">
+<p>A preamble&#8230;&#8203;</p>
+</div>
+<div id="toc" class="toc">
+<div id="toctitle">Table of Contents</div>
+<ul class="sectlevel1">
+<li><a href="#_section_a">Section A</a></li>
+<li><a href="#_section_b">Section B</a></li>
+</ul>
+</div>
+</div>
+</div>
+<div class="sect1">
+<h2 id="_section_a">Section A</h2>
+<div class="sectionbody">
+</div>
+</div>
+<div class="sect1">
+<h2 id="_section_b">Section B</h2>
+<div class="sectionbody">
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("toc macro ignored when the toc attribute is not set to macro", func() {
+			source := `= A title
+
+toc::[]
+
+== Section A`
+
+			expected := `<div id="preamble">
+<div class="sectionbody">
+
+</div>
+</div>
+<div class="sect1">
+<h2 id="_section_a">Section A</h2>
+<div class="sectionbody">
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("toc with custom title and class", func() {
+			source := `= A title
+:toc:
+:toc-title: Contents & more
+:toc-class: sidebar
+
+A preamble...
+
+== Section A`
+
+			expected := `<div id="toc" class="sidebar">
+<div id="toctitle">Contents &amp; more</div>
+<ul class="sectlevel1">
+<li><a href="#_section_a">Section A</a></li>
+</ul>
+</div>
+<div id="preamble">
+<div class="sectionbody">
+<div class="paragraph">
+<p>A preamble&#8230;&#8203;</p>
+</div>
+</div>
+</div>
+<div class="sect1">
+<h2 id="_section_a">Section A</h2>
+<div class="sectionbody">
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("toc excluding sections with the notoc role and discrete headings", func() {
+			source := `= A title
+:toc:
+
+A preamble...
+
+== Section A
+
+[.notoc]
+=== Section A.a
+
+[discrete]
+=== Section A.b
+
+== Section B`
+
+			expected := `<div id="toc" class="toc">
+<div id="toctitle">Table of Contents</div>
+<ul class="sectlevel1">
+<li><a href="#_section_a">Section A</a></li>
+<li><a href="#_section_b">Section B</a></li>
+</ul>
+</div>
+<div id="preamble">
+<div class="sectionbody">
+<div class="paragraph">
+<p>A preamble&#8230;&#8203;</p>
+</div>
+</div>
+</div>
+<div class="sect1">
+<h2 id="_section_a">Section A</h2>
+<div class="sectionbody">
+<div class="sect2">
+<h3 id="_section_a_a">Section A.a</h3>
+<h3 id="_section_a_b" class="discrete">Section A.b</h3>
+</div>
+</div>
+</div>
+<div class="sect1">
+<h2 id="_section_b">Section B</h2>
+<div class="sectionbody">
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("toc excluding sections with the toc role", func() {
+			source := `= A title
+:toc:
+
+A preamble...
+
+== Section A
+
+[.toc]
+=== Section A.a
+
+== Section B`
+
+			expected := `<div id="toc" class="toc">
+<div id="toctitle">Table of Contents</div>
+<ul class="sectlevel1">
+<li><a href="#_section_a">Section A</a></li>
+<li><a href="#_section_b">Section B</a></li>
+</ul>
+</div>
+<div id="preamble">
+<div class="sectionbody">
+<div class="paragraph">
+<p>A preamble&#8230;&#8203;</p>
+</div>
+</div>
+</div>
+<div class="sect1">
+<h2 id="_section_a">Section A</h2>
+<div class="sectionbody">
+<div class="sect2">
+<h3 id="_section_a_a">Section A.a</h3>
+</div>
+</div>
+</div>
+<div class="sect1">
+<h2 id="_section_b">Section B</h2>
+<div class="sectionbody">
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("toc on the left side of an embedded document", func() {
+			source := `= A title
+:toc: left
+
+A preamble...
+
+== Section A`
+
+			expected := `<div id="toc" class="toc2">
+<div id="toctitle">Table of Contents</div>
+<ul class="sectlevel1">
+<li><a href="#_section_a">Section A</a></li>
+</ul>
+</div>
+<div id="preamble">
+<div class="sectionbody">
+<div class="paragraph">
+<p>A preamble&#8230;&#8203;</p>
+</div>
+</div>
+</div>
+<div class="sect1">
+<h2 id="_section_a">Section A</h2>
+<div class="sectionbody">
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Element(expected))
+		})
+
+		It("toc on the left side of the document", func() {
+			source := `= A title
+:toc: left
+
+A preamble...
+
+== Section A`
+
+			expected := `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="UTF-8">
+<!--[if IE]><meta http-equiv="X-UA-Compatible" content="IE=edge"><![endif]-->
+<meta name="viewport" content="width=device-width, initial-scale=1.0">
+<meta name="generator" content="libasciidoc">
+<title>A title</title>
+</head>
+<body class="article toc2 toc-left">
+<div id="header">
+<h1>A title</h1>
+<div id="toc" class="toc2">
+<div id="toctitle">Table of Contents</div>
+<ul class="sectlevel1">
+<li><a href="#_section_a">Section A</a></li>
+</ul>
+</div>
+</div>
+<div id="content">
+<div id="preamble">
+<div class="sectionbody">
+<div class="paragraph">
+<p>A preamble&#8230;&#8203;</p>
+</div>
+</div>
+</div>
+<div class="sect1">
+<h2 id="_section_a">Section A</h2>
+<div class="sectionbody">
+</div>
+</div>
+</div>
+<div id="footer">
+<div id="footer-text">
+Last updated {{.LastUpdated}}
+</div>
+</div>
+</body>
+</html>`
+			Expect(source).To(RenderHTML5Element(expected, renderer.IncludeHeaderFooter(true), renderer.LastUpdated(time.Now())))
+		})
+
+		It("toc on the right side of the document", func() {
+			source := `= A title
+:toc: right
+
+A preamble...
+
+== Section A`
+
+			expected := `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="UTF-8">
+<!--[if IE]><meta http-equiv="X-UA-Compatible" content="IE=edge"><![endif]-->
+<meta name="viewport" content="width=device-width, initial-scale=1.0">
+<meta name="generator" content="libasciidoc">
+<title>A title</title>
+</head>
+<body class="article toc2 toc-right">
+<div id="header">
+<h1>A title</h1>
+<div id="toc" class="toc2">
+<div id="toctitle">Table of Contents</div>
+<ul class="sectlevel1">
+<li><a href="#_section_a">Section A</a></li>
+</ul>
+</div>
+</div>
+<div id="content">
+<div id="preamble">
+<div class="sectionbody">
+<div class="paragraph">
+<p>A preamble&#8230;&#8203;</p>
+</div>
+</div>
+</div>
+<div class="sect1">
+<h2 id="_section_a">Section A</h2>
+<div class="sectionbody">
+</div>
+</div>
+</div>
+<div id="footer">
+<div id="footer-text">
+Last updated {{.LastUpdated}}
+</div>
+</div>
+</body>
+</html>`
+			Expect(source).To(RenderHTML5Element(expected, renderer.IncludeHeaderFooter(true), renderer.LastUpdated(time.Now())))
+		})
+	})
 })
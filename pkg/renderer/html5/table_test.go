@@ -131,6 +131,46 @@ var _ = Describe("tables", func() {
 		Expect(source).To(RenderHTML5Element(expected))
 	})
 
+	It("table with custom caption and counter in title", func() {
+		source := `:table-caption: Tab
+
+.Title {counter:tables:A}
+|===
+| foo
+|===`
+		expected := `<table class="tableblock frame-all grid-all stretch">
+<caption class="title">Tab 1. Title A</caption>
+<colgroup>
+<col style="width: 100%;">
+</colgroup>
+<tbody>
+<tr>
+<td class="tableblock halign-left valign-top"><p class="tableblock">foo</p></td>
+</tr>
+</tbody>
+</table>`
+		Expect(source).To(RenderHTML5Element(expected))
+	})
+
+	It("table with typographic replacements and special characters in title", func() {
+		source := `.Title (C) it's <b> -- ok...
+|===
+| foo
+|===`
+		expected := `<table class="tableblock frame-all grid-all stretch">
+<caption class="title">Table 1. Title &#169; it&#8217;s &lt;b&gt;&#8201;&#8212;&#8201;ok&#8230;&#8203;</caption>
+<colgroup>
+<col style="width: 100%;">
+</colgroup>
+<tbody>
+<tr>
+<td class="tableblock halign-left valign-top"><p class="tableblock">foo</p></td>
+</tr>
+</tbody>
+</table>`
+		Expect(source).To(RenderHTML5Element(expected))
+	})
+
 	It("2 tables with 2 counters", func() {
 		source := `.Title 1
 |===
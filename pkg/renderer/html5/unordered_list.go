@@ -16,7 +16,7 @@ var unorderedListTmpl texttemplate.Template
 func init() {
 	unorderedListTmpl = newTextTemplate("unordered list",
 		`{{ $ctx := .Context }}{{ with .Data }}<div{{ if .ID }} id="{{ .ID }}"{{ end }} class="ulist{{ if .Checklist }} checklist{{ end }}{{ if .Role }} {{ .Role }}{{ end}}">
-{{ if .Title }}<div class="title">{{ escape .Title }}</div>
+{{ if .Title }}<div class="title">{{ .Title }}</div>
 {{ end }}<ul{{ if .Checklist }} class="checklist"{{ end }}>
 {{ $items := .Items }}{{ range $itemIndex, $item := $items }}<li>
 {{ $elements := $item.Elements }}{{ renderElements $ctx $elements | printf "%s" }}
@@ -49,7 +49,7 @@ func renderUnorderedList(ctx *renderer.Context, l types.UnorderedList) ([]byte,
 			Items     []types.UnorderedListItem
 		}{
 			ID:        generateID(ctx, l.Attributes),
-			Title:     getTitle(l.Attributes),
+			Title:     getTitle(ctx, l.Attributes),
 			Role:      l.Attributes.GetAsString(types.AttrRole),
 			Checklist: checkList,
 			Items:     l.Items,
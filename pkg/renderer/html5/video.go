@@ -0,0 +1,293 @@
+package html5
+
+import (
+	"bytes"
+	"html"
+	"net/url"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+var videoBlockTmpl texttemplate.Template
+var videoTmpl texttemplate.Template
+var embeddedVideoTmpl texttemplate.Template
+
+// initializes the templates
+func init() {
+	videoBlockTmpl = newTextTemplate("video block", `<div{{ if .ID }} id="{{ escape .ID }}"{{ end }} class="videoblock{{ if .Float }} {{ escape .Float }}{{ end }}{{ if .Align }} text-{{ escape .Align }}{{ end }}{{ if .Role }} {{ escape .Role }}{{ end }}">{{ if .Title }}
+<div class="title">{{ .Title }}</div>{{ end }}
+<div class="content">
+{{ .Video }}
+</div>
+</div>`,
+		texttemplate.FuncMap{
+			"escape": html.EscapeString,
+		})
+	videoTmpl = newTextTemplate("video", `<video src="{{ escape .Src }}"{{ if .Width }} width="{{ escape .Width }}"{{ end }}{{ if .Height }} height="{{ escape .Height }}"{{ end }}{{ if .Poster }} poster="{{ escape .Poster }}"{{ end }}{{ if .Autoplay }} autoplay{{ end }}{{ if .Controls }} controls{{ end }}{{ if .Loop }} loop{{ end }}{{ if .Muted }} muted{{ end }}>
+Your browser does not support the video tag.
+</video>`,
+		texttemplate.FuncMap{
+			"escape": html.EscapeString,
+		})
+	embeddedVideoTmpl = newTextTemplate("embedded video", `<iframe{{ if .Width }} width="{{ escape .Width }}"{{ end }}{{ if .Height }} height="{{ escape .Height }}"{{ end }} src="{{ .Src }}" frameborder="0"{{ if .AllowFullscreen }} allowfullscreen{{ end }}></iframe>`,
+		texttemplate.FuncMap{
+			"escape": html.EscapeString,
+		})
+}
+
+const (
+	youtube = "youtube"
+	vimeo   = "vimeo"
+)
+
+func renderVideoBlock(ctx *renderer.Context, v types.VideoBlock) ([]byte, error) {
+	video, err := renderVideo(ctx, v)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render video block")
+	}
+	result := bytes.NewBuffer(nil)
+	err = videoBlockTmpl.Execute(result, struct {
+		ID    string
+		Title string
+		Role  string
+		Float string
+		Align string
+		Video string
+	}{
+		ID:    v.Attributes.GetAsString(types.AttrID),
+		Title: getTitle(ctx, v.Attributes),
+		Role:  v.Attributes.GetAsString(types.AttrRole),
+		Float: v.Attributes.GetAsString(types.AttrImageFloat),
+		Align: v.Attributes.GetAsString(types.AttrImageAlign),
+		Video: video,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render video block")
+	}
+	return result.Bytes(), nil
+}
+
+// renderVideo renders the given video in an `<iframe>` element if it is hosted on YouTube or Vimeo,
+// or in a `<video>` element otherwise
+func renderVideo(ctx *renderer.Context, v types.VideoBlock) (string, error) {
+	result := bytes.NewBuffer(nil)
+	width := strings.TrimSpace(attributeValue(v.Attributes, types.AttrVideoWidth))
+	height := strings.TrimSpace(attributeValue(v.Attributes, types.AttrVideoHeight))
+	if service, id, ok := videoService(v); ok {
+		var src string
+		switch service {
+		case youtube:
+			src = youtubeEmbedURL(ctx, id, v.Attributes)
+		default:
+			src = vimeoEmbedURL(ctx, id, v.Attributes)
+		}
+		err := embeddedVideoTmpl.Execute(result, struct {
+			Width           string
+			Height          string
+			Src             string
+			AllowFullscreen bool
+		}{
+			Width:           width,
+			Height:          height,
+			Src:             src,
+			AllowFullscreen: !v.Attributes.Has(types.AttrVideoNoFullscreen),
+		})
+		return result.String(), err
+	}
+	var poster string
+	if p := v.Attributes.GetAsString(types.AttrVideoPoster); p != "" {
+		poster = ctx.ImagePath(p)
+	}
+	err := videoTmpl.Execute(result, struct {
+		Src      string
+		Width    string
+		Height   string
+		Poster   string
+		Autoplay bool
+		Controls bool
+		Loop     bool
+		Muted    bool
+	}{
+		Src:      ctx.ImagePath(v.Path) + timeAnchor(v.Attributes),
+		Width:    width,
+		Height:   height,
+		Poster:   poster,
+		Autoplay: v.Attributes.Has(types.AttrMediaAutoplay),
+		Controls: !v.Attributes.Has(types.AttrMediaNoControls),
+		Loop:     v.Attributes.Has(types.AttrMediaLoop),
+		Muted:    v.Attributes.Has(types.AttrMediaMuted),
+	})
+	return result.String(), err
+}
+
+// videoService returns the service which hosts the given video (`youtube` or `vimeo`) and the ID of the video on
+// this service, either because the `poster` attribute is the name of the service (eg: `video::rPQoq7ThGAU[youtube]`),
+// or because the target is the URL of the video on this service (eg: `video::https://youtu.be/rPQoq7ThGAU[]`)
+func videoService(v types.VideoBlock) (string, string, bool) {
+	switch poster := v.Attributes.GetAsString(types.AttrVideoPoster); poster {
+	case youtube, vimeo:
+		return poster, v.Path, true
+	}
+	u, err := url.Parse(v.Path)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return "", "", false
+	}
+	p := strings.Trim(u.Path, "/")
+	switch strings.TrimPrefix(strings.TrimPrefix(u.Host, "www."), "m.") {
+	case "youtube.com":
+		if id := u.Query().Get("v"); p == "watch" && id != "" {
+			if list := u.Query().Get("list"); list != "" {
+				return youtube, id + "/" + list, true
+			}
+			return youtube, id, true
+		}
+		if id := strings.TrimPrefix(p, "embed/"); id != p && id != "" {
+			return youtube, id, true
+		}
+	case "youtu.be":
+		if p != "" {
+			return youtube, p, true
+		}
+	case "vimeo.com":
+		if p != "" {
+			return vimeo, p, true
+		}
+	case "player.vimeo.com":
+		if id := strings.TrimPrefix(p, "video/"); id != p && id != "" {
+			if h := u.Query().Get("h"); h != "" {
+				return vimeo, id + "/" + h, true
+			}
+			return vimeo, id, true
+		}
+	}
+	return "", "", false
+}
+
+// assetURIScheme returns the scheme of the URLs of the embedded videos (`https:` by default),
+// as set in the `asset-uri-scheme` document attribute
+func assetURIScheme(ctx *renderer.Context) string {
+	scheme, found := ctx.Document.Attributes.GetAsString(types.AttrAssetURIScheme)
+	if !found {
+		return "https:"
+	}
+	if scheme == "" {
+		return ""
+	}
+	return scheme + ":"
+}
+
+// youtubeEmbedURL returns the URL to embed the YouTube video with the given ID, which may be followed by
+// the ID of a playlist (eg: `rPQoq7ThGAU/PLxyz`) or by the IDs of other videos to play (eg: `rPQoq7ThGAU,abc,def`)
+func youtubeEmbedURL(ctx *renderer.Context, id string, attrs types.ElementAttributes) string {
+	params := []string{}
+	if attrs.Has(types.AttrVideoRelated) {
+		params = append(params, "rel=1")
+	} else {
+		params = append(params, "rel=0")
+	}
+	if start := attrs.GetAsString(types.AttrMediaStart); start != "" {
+		params = append(params, "start="+url.QueryEscape(start))
+	}
+	if end := attrs.GetAsString(types.AttrMediaEnd); end != "" {
+		params = append(params, "end="+url.QueryEscape(end))
+	}
+	if attrs.Has(types.AttrMediaAutoplay) {
+		params = append(params, "autoplay=1")
+	}
+	if attrs.Has(types.AttrMediaLoop) {
+		params = append(params, "loop=1")
+	}
+	if attrs.Has(types.AttrMediaMuted) {
+		params = append(params, "mute=1")
+	}
+	if attrs.Has(types.AttrMediaNoControls) {
+		params = append(params, "controls=0")
+	}
+	id, list := splitOnce(id, "/")
+	if list == "" {
+		list = attrs.GetAsString(types.AttrVideoList)
+	}
+	if list != "" {
+		params = append(params, "list="+url.QueryEscape(list))
+	} else {
+		var playlist string
+		id, playlist = splitOnce(id, ",")
+		if playlist == "" {
+			playlist = attrs.GetAsString(types.AttrVideoPlaylist)
+		}
+		if playlist == "" && attrs.Has(types.AttrMediaLoop) {
+			// the video must be in a playlist to be played in a loop
+			playlist = id
+		}
+		if playlist != "" {
+			params = append(params, "playlist="+url.QueryEscape(playlist))
+		}
+	}
+	if attrs.Has(types.AttrVideoNoFullscreen) {
+		params = append(params, "fs=0")
+	}
+	if attrs.Has(types.AttrVideoModest) {
+		params = append(params, "modestbranding=1")
+	}
+	if theme := attrs.GetAsString(types.AttrVideoTheme); theme != "" {
+		params = append(params, "theme="+url.QueryEscape(theme))
+	}
+	if lang := attrs.GetAsString(types.AttrVideoLang); lang != "" {
+		params = append(params, "hl="+url.QueryEscape(lang))
+	}
+	return assetURIScheme(ctx) + "//www.youtube.com/embed/" + url.PathEscape(id) + "?" + strings.Join(params, "&amp;")
+}
+
+// vimeoEmbedURL returns the URL to embed the Vimeo video with the given ID, which may be followed by
+// the hash of an unlisted video (eg: `67480300/a1b2c3`)
+func vimeoEmbedURL(ctx *renderer.Context, id string, attrs types.ElementAttributes) string {
+	params := []string{}
+	id, hash := splitOnce(id, "/")
+	if hash != "" {
+		params = append(params, "h="+url.QueryEscape(hash))
+	}
+	if attrs.Has(types.AttrMediaAutoplay) {
+		params = append(params, "autoplay=1")
+	}
+	if attrs.Has(types.AttrMediaLoop) {
+		params = append(params, "loop=1")
+	}
+	if attrs.Has(types.AttrMediaMuted) {
+		params = append(params, "muted=1")
+	}
+	result := assetURIScheme(ctx) + "//player.vimeo.com/video/" + url.PathEscape(id)
+	if len(params) > 0 {
+		result += "?" + strings.Join(params, "&amp;")
+	}
+	if start := attrs.GetAsString(types.AttrMediaStart); start != "" {
+		result += "#at=" + url.QueryEscape(start)
+	}
+	return result
+}
+
+// timeAnchor returns the media fragment to play a local video or audio from the `start` time
+// and/or up to the `end` time (eg: `#t=10,30`), or an empty string if none of them is set
+func timeAnchor(attrs types.ElementAttributes) string {
+	start := attrs.GetAsString(types.AttrMediaStart)
+	end := attrs.GetAsString(types.AttrMediaEnd)
+	switch {
+	case start == "" && end == "":
+		return ""
+	case end == "":
+		return "#t=" + start
+	default:
+		return "#t=" + start + "," + end
+	}
+}
+
+// splitOnce splits the given value on the first occurrence of the given separator
+func splitOnce(value, sep string) (string, string) {
+	if i := strings.Index(value, sep); i >= 0 {
+		return value[:i], value[i+len(sep):]
+	}
+	return value, ""
+}
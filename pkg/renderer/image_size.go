@@ -0,0 +1,88 @@
+package renderer
+
+import (
+	"encoding/xml"
+	"image"
+	_ "image/gif"  // registers the GIF format to decode the size of the images
+	_ "image/jpeg" // registers the JPEG format to decode the size of the images
+	_ "image/png"  // registers the PNG format to decode the size of the images
+	"io"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ImageSize returns the intrinsic width and height (in pixels) of the local PNG, JPEG, GIF or SVG image
+// at the given path, which is opened with 'OpenFile'
+func (ctx *Context) ImageSize(p string) (int, int, error) {
+	f, err := ctx.OpenFile(p)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer f.Close()
+	if strings.EqualFold(path.Ext(p), ".svg") {
+		return svgSize(f)
+	}
+	config, _, err := image.DecodeConfig(f)
+	if err != nil {
+		return 0, 0, errors.Wrapf(err, "unable to decode the size of '%s'", p)
+	}
+	return config.Width, config.Height, nil
+}
+
+// svgSize returns the size of the SVG image, based on the `width` and `height` attributes of its root element,
+// or on its `viewBox` attribute
+func svgSize(r io.Reader) (int, int, error) {
+	decoder := xml.NewDecoder(r)
+	for {
+		t, err := decoder.Token()
+		if err != nil {
+			return 0, 0, errors.Wrap(err, "unable to find the root element of the SVG image")
+		}
+		root, ok := t.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		if root.Name.Local != "svg" {
+			return 0, 0, errors.Errorf("unexpected root element in the SVG image: '%s'", root.Name.Local)
+		}
+		var width, height, viewBoxWidth, viewBoxHeight float64
+		for _, attr := range root.Attr {
+			switch attr.Name.Local {
+			case "width":
+				width = svgLength(attr.Value)
+			case "height":
+				height = svgLength(attr.Value)
+			case "viewBox":
+				if values := strings.Fields(strings.ReplaceAll(attr.Value, ",", " ")); len(values) == 4 {
+					viewBoxWidth, _ = strconv.ParseFloat(values[2], 64)
+					viewBoxHeight, _ = strconv.ParseFloat(values[3], 64)
+				}
+			}
+		}
+		switch {
+		case width > 0 && height > 0:
+			return int(width + 0.5), int(height + 0.5), nil
+		case viewBoxWidth > 0 && viewBoxHeight > 0 && width > 0:
+			return int(width + 0.5), int(width*viewBoxHeight/viewBoxWidth + 0.5), nil
+		case viewBoxWidth > 0 && viewBoxHeight > 0 && height > 0:
+			return int(height*viewBoxWidth/viewBoxHeight + 0.5), int(height + 0.5), nil
+		case viewBoxWidth > 0 && viewBoxHeight > 0:
+			return int(viewBoxWidth + 0.5), int(viewBoxHeight + 0.5), nil
+		default:
+			return 0, 0, errors.Errorf("unable to determine the size of the SVG image")
+		}
+	}
+}
+
+// svgLength returns the value in pixels of the given SVG length (eg: `100` or `100px`),
+// or `0` if it is a relative length (eg: `100%`) or if it cannot be parsed
+func svgLength(value string) float64 {
+	result, err := strconv.ParseFloat(strings.TrimSuffix(strings.TrimSpace(value), "px"), 64)
+	if err != nil || result < 0 {
+		return 0
+	}
+	return result
+}
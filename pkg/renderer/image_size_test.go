@@ -0,0 +1,89 @@
+package renderer_test
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"testing/fstest"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("image sizes", func() {
+
+	encode := func(encoder func(*bytes.Buffer, image.Image) error) []byte {
+		buf := bytes.NewBuffer(nil)
+		if err := encoder(buf, image.NewRGBA(image.Rect(0, 0, 30, 20))); err != nil {
+			panic(err) // the fixtures are initialized before the specs are run
+		}
+		return buf.Bytes()
+	}
+
+	fsys := fstest.MapFS{
+		"images/foo.png": &fstest.MapFile{
+			Data: encode(func(buf *bytes.Buffer, img image.Image) error {
+				return png.Encode(buf, img)
+			}),
+		},
+		"images/foo.jpg": &fstest.MapFile{
+			Data: encode(func(buf *bytes.Buffer, img image.Image) error {
+				return jpeg.Encode(buf, img, nil)
+			}),
+		},
+		"images/foo.gif": &fstest.MapFile{
+			Data: encode(func(buf *bytes.Buffer, img image.Image) error {
+				return gif.Encode(buf, img, nil)
+			}),
+		},
+		"images/dimensions.svg": &fstest.MapFile{
+			Data: []byte(`<?xml version="1.0"?><svg xmlns="http://www.w3.org/2000/svg" width="30px" height="20"></svg>`),
+		},
+		"images/viewbox.svg": &fstest.MapFile{
+			Data: []byte(`<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 300 200"></svg>`),
+		},
+		"images/width-and-viewbox.svg": &fstest.MapFile{
+			Data: []byte(`<svg xmlns="http://www.w3.org/2000/svg" width="30" viewBox="0,0,300,200"></svg>`),
+		},
+		"images/relative.svg": &fstest.MapFile{
+			Data: []byte(`<svg xmlns="http://www.w3.org/2000/svg" width="100%" height="100%"></svg>`),
+		},
+		"images/invalid.png": &fstest.MapFile{
+			Data: []byte("not a PNG"),
+		},
+	}
+
+	DescribeTable("valid images",
+		func(name string, expectedWidth, expectedHeight int) {
+			ctx := renderer.Wrap(context.Background(), types.Document{}, renderer.FileSystem(fsys))
+			width, height, err := ctx.ImageSize(name)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(width).To(Equal(expectedWidth))
+			Expect(height).To(Equal(expectedHeight))
+		},
+		Entry("png", "images/foo.png", 30, 20),
+		Entry("jpeg", "images/foo.jpg", 30, 20),
+		Entry("gif", "images/foo.gif", 30, 20),
+		Entry("svg with width and height", "images/dimensions.svg", 30, 20),
+		Entry("svg with viewbox", "images/viewbox.svg", 300, 200),
+		Entry("svg with width and viewbox", "images/width-and-viewbox.svg", 30, 20),
+	)
+
+	DescribeTable("invalid images",
+		func(name string) {
+			ctx := renderer.Wrap(context.Background(), types.Document{}, renderer.FileSystem(fsys))
+			_, _, err := ctx.ImageSize(name)
+			Expect(err).To(HaveOccurred())
+		},
+		Entry("missing file", "images/unknown.png"),
+		Entry("invalid content", "images/invalid.png"),
+		Entry("svg with relative dimensions", "images/relative.svg"),
+	)
+})
@@ -0,0 +1,119 @@
+package markdown
+
+import (
+	"bytes"
+	"math"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+func renderDelimitedBlock(ctx *renderer.Context, b types.DelimitedBlock) ([]byte, error) {
+	log.Debugf("rendering delimited block of kind '%v'", b.Kind)
+	result := bytes.NewBuffer(nil)
+	switch b.Kind {
+	case types.Fenced, types.Listing, types.Source:
+		result.WriteString(renderAnchor(b.Attributes))
+		result.WriteString(renderTitle(b.Attributes))
+		result.Write(renderFencedCode(b.Attributes.GetAsString(types.AttrLanguage), verbatimLines(b.Elements)))
+	case types.Verse:
+		result.WriteString(renderAnchor(b.Attributes))
+		result.WriteString(renderTitle(b.Attributes))
+		lines := []types.InlineElements{}
+		for _, element := range b.Elements {
+			switch e := element.(type) {
+			case types.Paragraph:
+				lines = append(lines, e.Lines...)
+			case types.BlankLine:
+				lines = append(lines, types.InlineElements{})
+			}
+		}
+		content, err := renderLines(ctx, lines, true)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render verse block")
+		}
+		result.Write(renderBlockquote(content, renderAttribution(b.Attributes)))
+	case types.Quote, types.Abstract:
+		result.WriteString(renderAnchor(b.Attributes))
+		result.WriteString(renderTitle(b.Attributes))
+		content, err := renderElements(ctx, b.Elements)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render quote block")
+		}
+		result.Write(renderBlockquote(content, renderAttribution(b.Attributes)))
+	case types.Example, types.Sidebar, types.Open, types.PartIntro:
+		// no frame in Markdown, only the content is rendered (in an alert if this is an admonition)
+		result.WriteString(renderAnchor(b.Attributes))
+		result.WriteString(renderTitle(b.Attributes))
+		content, err := renderElements(ctx, b.Elements)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render %s block", b.Kind)
+		}
+		if k, ok := b.Attributes[types.AttrAdmonitionKind].(types.AdmonitionKind); ok {
+			result.Write(renderAlert(k, content))
+		} else {
+			result.Write(content)
+		}
+	case types.Comment:
+		// nothing to do
+	default:
+		return nil, errors.Errorf("unsupported kind of delimited block: '%v'", b.Kind)
+	}
+	return result.Bytes(), nil
+}
+
+// verbatimLines returns the lines of the given elements, as-is
+func verbatimLines(elements []interface{}) []string {
+	result := []string{}
+	for _, element := range elements {
+		switch e := element.(type) {
+		case types.Paragraph:
+			result = append(result, plainLines(e.Lines)...)
+		case types.BlankLine:
+			result = append(result, "")
+		}
+	}
+	return result
+}
+
+// renderFencedCode renders the given lines in a fenced code block, with an optional language
+func renderFencedCode(language string, lines []string) []byte {
+	content := strings.Join(lines, "\n")
+	fence := "```"
+	for strings.Contains(content, fence) {
+		fence += "`"
+	}
+	result := bytes.NewBuffer(nil)
+	result.WriteString(fence + language + "\n")
+	if content != "" {
+		result.WriteString(content + "\n")
+	}
+	result.WriteString(fence)
+	return result.Bytes()
+}
+
+func renderLiteralBlock(ctx *renderer.Context, b types.LiteralBlock) ([]byte, error) {
+	lines := b.Lines
+	if b.Attributes.GetAsString(types.AttrLiteralBlockType) == types.LiteralBlockWithSpacesOnFirstLine {
+		// remove as many spaces as needed on each line
+		spaceCount := math.MaxInt32
+		for _, line := range b.Lines {
+			if c := len(line) - len(strings.TrimLeft(line, " ")); c < spaceCount {
+				spaceCount = c
+			}
+		}
+		spaces := strings.Repeat(" ", spaceCount)
+		lines = make([]string, len(b.Lines))
+		for i, line := range b.Lines {
+			lines[i] = strings.TrimPrefix(line, spaces)
+		}
+	}
+	result := bytes.NewBuffer(nil)
+	result.WriteString(renderAnchor(b.Attributes))
+	result.WriteString(renderTitle(b.Attributes))
+	result.Write(renderFencedCode("", lines))
+	return result.Bytes(), nil
+}
@@ -0,0 +1,143 @@
+package markdown_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("delimited blocks", func() {
+
+	It("source block with language", func() {
+		source := `[source,go]
+----
+func main() {
+
+	fmt.Println("*hello*")
+}
+----`
+		expected := "```go" + `
+func main() {
+
+	fmt.Println("*hello*")
+}
+` + "```\n"
+		Expect(source).To(RenderMarkdown(expected))
+	})
+
+	It("listing block with fence in content", func() {
+		source := "----\n```\n----"
+		expected := "````\n```\n````\n"
+		Expect(source).To(RenderMarkdown(expected))
+	})
+
+	It("admonition block", func() {
+		source := `[WARNING]
+====
+some content
+
+* an item
+====`
+		expected := `> [!WARNING]
+> some content
+>
+> - an item
+`
+		Expect(source).To(RenderMarkdown(expected))
+	})
+
+	It("quote block", func() {
+		source := `[quote, john doe]
+____
+some *content*
+____`
+		expected := `> some **content**
+>
+> &#8212; john doe
+`
+		Expect(source).To(RenderMarkdown(expected))
+	})
+
+	It("Markdown-style quote block and thematic break", func() {
+		source := `> some *content*
+> -- john doe
+
+- - -`
+		expected := `> some **content**
+>
+> &#8212; john doe
+
+***
+`
+		Expect(source).To(RenderMarkdown(expected))
+	})
+
+	It("thematic and page breaks", func() {
+		source := `'''
+
+<<<`
+		expected := `***
+
+<div style="page-break-after: always;"></div>
+`
+		Expect(source).To(RenderMarkdown(expected))
+	})
+
+	It("open blocks", func() {
+		source := `--
+some content
+--
+
+[abstract]
+--
+an abstract
+--
+
+[listing]
+--
+some *listing*
+--`
+		expected := `some content
+
+> an abstract
+
+` + "```" + `
+some *listing*
+` + "```" + `
+`
+		Expect(source).To(RenderMarkdown(expected))
+	})
+
+	It("verse block", func() {
+		source := `[verse]
+____
+some content
+on 2 lines
+____`
+		expected := `> some content\
+> on 2 lines
+`
+		Expect(source).To(RenderMarkdown(expected))
+	})
+
+	It("comment block", func() {
+		source := `////
+a comment
+////
+content`
+		expected := `content
+`
+		Expect(source).To(RenderMarkdown(expected))
+	})
+
+	It("literal block", func() {
+		source := `   some
+    literal content`
+		expected := "```" + `
+some
+ literal content
+` + "```\n"
+		Expect(source).To(RenderMarkdown(expected))
+	})
+})
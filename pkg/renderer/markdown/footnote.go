@@ -0,0 +1,45 @@
+package markdown
+
+import (
+	"bytes"
+	"strconv"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+func renderFootnote(ctx *renderer.Context, note types.Footnote) []byte {
+	if index, ok := ctx.Document.Footnotes.IndexOf(note); ok {
+		return []byte(renderFootnoteIndex(index))
+	}
+	if noteRef, ok := ctx.Document.FootnoteReferences[note.Ref]; ok {
+		if index, ok := ctx.Document.Footnotes.IndexOf(noteRef); ok {
+			return []byte(renderFootnoteIndex(index))
+		}
+	}
+	// invalid footnote
+	dropped("reference to unknown footnote '%s'", note.Ref)
+	return nil
+}
+
+func renderFootnoteIndex(index int) string {
+	return "[^" + strconv.Itoa(index+1) + "]"
+}
+
+// renderFootnotes renders the definitions of the footnotes, which are displayed at the end of the document
+func renderFootnotes(ctx *renderer.Context, notes types.Footnotes) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	for i, note := range notes {
+		if i > 0 {
+			result.WriteString("\n")
+		}
+		content, err := renderLine(ctx, note.Elements)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render footnotes")
+		}
+		result.WriteString(renderFootnoteIndex(i) + ": ")
+		result.Write(content)
+	}
+	return result.Bytes(), nil
+}
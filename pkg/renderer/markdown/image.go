@@ -0,0 +1,35 @@
+package markdown
+
+import (
+	"html"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+)
+
+func renderImageBlock(ctx *renderer.Context, img types.ImageBlock) ([]byte, error) {
+	return []byte(renderAnchor(img.Attributes) + renderTitle(img.Attributes) + renderImage(img.Path, img.Attributes)), nil
+}
+
+func renderInlineImage(ctx *renderer.Context, img types.InlineImage) []byte {
+	return []byte(renderImage(img.Path, img.Attributes))
+}
+
+// renderImage renders the image with the Markdown syntax, or with an HTML `<img>` element
+// if a width or a height was specified
+func renderImage(path string, attrs types.ElementAttributes) string {
+	alt := attrs.GetAsString(types.AttrImageAlt)
+	width := attrs.GetAsString(types.AttrImageWidth)
+	height := attrs.GetAsString(types.AttrImageHeight)
+	if width == "" && height == "" {
+		return "![" + escape(alt) + "](" + path + ")"
+	}
+	result := `<img src="` + html.EscapeString(path) + `" alt="` + html.EscapeString(alt) + `"`
+	if width != "" {
+		result += ` width="` + html.EscapeString(width) + `"`
+	}
+	if height != "" {
+		result += ` height="` + html.EscapeString(height) + `"`
+	}
+	return result + ">"
+}
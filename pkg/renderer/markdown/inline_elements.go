@@ -0,0 +1,298 @@
+package markdown
+
+import (
+	"bytes"
+	"html"
+	"regexp"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// renderLines renders all lines, separated by a newline character,
+// or by a hard line break if `hardbreaks` is true. The lines which must
+// be dropped (eg: because they contain a reference to a missing attribute) are skipped.
+func renderLines(ctx *renderer.Context, lines []types.InlineElements, hardbreaks bool) ([]byte, error) {
+	buff := bytes.NewBuffer(nil)
+	rendered := 0
+	for _, line := range lines {
+		ctx.SetDropLine(false)
+		renderedLine, err := renderLine(ctx, line)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to render lines")
+		}
+		if ctx.SetDropLine(false) {
+			continue
+		}
+		if rendered > 0 {
+			if hardbreaks {
+				buff.WriteString(`\`)
+			}
+			buff.WriteString("\n")
+		}
+		buff.Write(escapeLineStart(bytes.TrimLeft(renderedLine, " \t")))
+		rendered++
+	}
+	return buff.Bytes(), nil
+}
+
+// renderLine renders all elements of the given line. Trailing spaces are removed.
+func renderLine(ctx *renderer.Context, elements types.InlineElements) ([]byte, error) {
+	buff := bytes.NewBuffer(nil)
+	for _, element := range elements {
+		renderedElement, err := renderInlineElement(ctx, element)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render line")
+		}
+		buff.Write(renderedElement)
+	}
+	return bytes.TrimRight(buff.Bytes(), " \t"), nil
+}
+
+// nolint: gocyclo
+func renderInlineElement(ctx *renderer.Context, element interface{}) ([]byte, error) {
+	switch e := element.(type) {
+	case types.StringElement:
+		return []byte(escape(e.Content)), nil
+	case types.VerbatimLine:
+		return []byte(e.Content), nil
+	case []interface{}:
+		return renderLine(ctx, types.InlineElements(e))
+	case types.InlineElements:
+		return renderLine(ctx, e)
+	case types.QuotedText:
+		return renderQuotedText(ctx, e)
+	case types.Passthrough:
+		// passthrough content is retained as-is (HTML is allowed in Markdown), unless in `server` mode or above
+		return renderPassthrough(ctx, e)
+	case types.InlineImage:
+		return renderInlineImage(ctx, e), nil
+	case types.InlineLink:
+		return renderLink(ctx, e)
+	case types.Footnote:
+		return renderFootnote(ctx, e), nil
+	case types.IndexTerm:
+		if e.Visible {
+			return []byte(escape(e.PrimaryTerm())), nil
+		}
+		dropped("index term '%s'", strings.Join(e.Terms, ", "))
+		return nil, nil
+	case types.CrossReference:
+		return renderCrossReference(ctx, e)
+	case types.DocumentAttributeSubstitution:
+		return []byte(renderAttributeSubstitution(ctx, e)), nil
+	case types.CounterSubstitution:
+		return []byte(renderCounterSubstitution(ctx, e)), nil
+	case types.InlineAttributeEntry:
+		renderer.ApplyInlineAttributeEntry(ctx, e)
+		return nil, nil
+	case types.ElementAttributes: // inline element ID
+		return []byte(`<a id="` + e.GetAsString(types.AttrID) + `"></a>`), nil
+	case types.LineBreak:
+		return []byte(`\`), nil
+	case types.UserMacro:
+		dropped("user macro '%s'", e.Name)
+		return nil, nil
+	case types.SingleLineComment:
+		return nil, nil // nothing to do
+	default:
+		return nil, errors.Errorf("unsupported type of inline element: %T", element)
+	}
+}
+
+// renderPlainString renders the given element without any escaping or formatting
+func renderPlainString(ctx *renderer.Context, element interface{}) ([]byte, error) {
+	switch e := element.(type) {
+	case types.StringElement:
+		return []byte(e.Content), nil
+	case types.VerbatimLine:
+		return []byte(e.Content), nil
+	case types.QuotedText:
+		return renderPlainString(ctx, e.Elements)
+	case types.InlineElements:
+		buff := bytes.NewBuffer(nil)
+		for _, element := range e {
+			renderedElement, err := renderPlainString(ctx, element)
+			if err != nil {
+				return nil, err
+			}
+			buff.Write(renderedElement)
+		}
+		return buff.Bytes(), nil
+	case types.DocumentAttributeSubstitution:
+		return []byte(renderAttributeSubstitution(ctx, e)), nil
+	case types.CounterSubstitution:
+		return []byte(renderCounterSubstitution(ctx, e)), nil
+	default:
+		return renderInlineElement(ctx, element)
+	}
+}
+
+// renderPassthrough renders the passthrough content as-is, or HTML-escaped in `server` mode or above
+func renderPassthrough(ctx *renderer.Context, p types.Passthrough) ([]byte, error) {
+	content, err := renderPlainString(ctx, p.Elements)
+	if err != nil {
+		return nil, err
+	}
+	if mode := ctx.SafeMode(); mode >= types.SafeModeServer {
+		escaped := html.EscapeString(string(content))
+		if escaped != string(content) {
+			log.Warnf("escaping the raw HTML of passthrough in %s mode", mode)
+		}
+		return []byte(escaped), nil
+	}
+	return content, nil
+}
+
+func renderQuotedText(ctx *renderer.Context, t types.QuotedText) ([]byte, error) {
+	if t.Kind == types.Monospace {
+		return renderCodeSpan(ctx, t.Elements)
+	}
+	content, err := renderLine(ctx, t.Elements)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render quoted text")
+	}
+	role := t.Attributes.GetAsString(types.AttrRole)
+	switch t.Kind {
+	case types.Bold:
+		return []byte("**" + string(content) + "**"), nil
+	case types.Italic:
+		return []byte("*" + string(content) + "*"), nil
+	case types.Subscript:
+		return []byte("<sub>" + string(content) + "</sub>"), nil
+	case types.Superscript:
+		return []byte("<sup>" + string(content) + "</sup>"), nil
+	case types.DoubleCurvedQuote:
+		return []byte("\u201c" + string(content) + "\u201d"), nil
+	case types.SingleCurvedQuote:
+		return []byte("\u2018" + string(content) + "\u2019"), nil
+	case types.Marked:
+		switch role {
+		case "":
+			return []byte("<mark>" + string(content) + "</mark>"), nil
+		case "line-through":
+			// GFM strikethrough extension
+			return []byte("~~" + string(content) + "~~"), nil
+		default:
+			return []byte(`<span class="` + role + `">` + string(content) + "</span>"), nil
+		}
+	default:
+		return nil, errors.Errorf("unsupported quoted text kind: '%v'", t.Kind)
+	}
+}
+
+// renderCodeSpan renders the given elements as a code span, delimited with as many
+// backticks as necessary to include the backticks within the content
+func renderCodeSpan(ctx *renderer.Context, elements types.InlineElements) ([]byte, error) {
+	content, err := renderPlainString(ctx, elements)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render monospace text")
+	}
+	delimiter := "`"
+	for strings.Contains(string(content), delimiter) {
+		delimiter += "`"
+	}
+	if len(delimiter) > 1 {
+		return []byte(delimiter + " " + string(content) + " " + delimiter), nil
+	}
+	return []byte(delimiter + string(content) + delimiter), nil
+}
+
+func renderLink(ctx *renderer.Context, l types.InlineLink) ([]byte, error) {
+	location := l.Location.Resolve(ctx.Document.Attributes)
+	t, ok := l.Attributes[types.AttrInlineLinkText].(types.InlineElements)
+	if !ok {
+		if hasURLScheme(location) {
+			// autolink
+			return []byte("<" + location + ">"), nil
+		}
+		return []byte("[" + escape(location) + "](" + location + ")"), nil
+	}
+	text, err := renderLine(ctx, t)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render link")
+	}
+	return []byte("[" + string(text) + "](" + location + ")"), nil
+}
+
+func hasURLScheme(location string) bool {
+	for _, scheme := range []string{"http://", "https://", "ftp://", "irc://", "mailto:"} {
+		if strings.HasPrefix(location, scheme) {
+			return true
+		}
+	}
+	return false
+}
+
+func renderCrossReference(ctx *renderer.Context, xref types.CrossReference) ([]byte, error) {
+	log.Debugf("rendering cross reference with ID: %s", xref.ID)
+	var label string
+	if xref.Label != "" {
+		label = escape(xref.Label)
+	} else if target, found := ctx.Document.ElementReferences[xref.ID]; found {
+		t, ok := target.(types.InlineElements)
+		if !ok {
+			return nil, errors.Errorf("unable to process cross-reference to element of type %T", target)
+		}
+		renderedContent, err := renderTitleLine(ctx, xref.ID, t)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render cross reference")
+		}
+		label = strings.TrimSpace(string(renderedContent))
+	} else {
+		label = `\[` + escape(xref.ID) + `\]`
+	}
+	return []byte("[" + label + "](#" + xref.ID + ")"), nil
+}
+
+func renderAttributeSubstitution(ctx *renderer.Context, attr types.DocumentAttributeSubstitution) string {
+	return renderer.AttributeValue(ctx, attr)
+}
+
+func renderCounterSubstitution(ctx *renderer.Context, counter types.CounterSubstitution) string {
+	value := ctx.IncrementCounter(counter.Name, counter.Value)
+	if counter.Hidden {
+		return ""
+	}
+	return value
+}
+
+// the characters which have a special meaning in Markdown, regardless of their position in a line
+var inlineSpecialChars = strings.NewReplacer(
+	`\`, `\\`,
+	"`", "\\`",
+	`*`, `\*`,
+	`_`, `\_`,
+	`[`, `\[`,
+	`]`, `\]`,
+	`<`, `\<`,
+)
+
+// escape escapes the characters which have a special meaning in Markdown
+func escape(s string) string {
+	return inlineSpecialChars.Replace(s)
+}
+
+// a line starting with such a sequence would be interpreted as a heading, a blockquote or a list item
+var blockStart = regexp.MustCompile(`^(#{1,6}|>|[-+]|\d+[.)])(\s|$)`)
+
+// escapeLineStart escapes the first character of the given line if it would be
+// interpreted as a block marker (eg: a heading or a list item)
+func escapeLineStart(line []byte) []byte {
+	loc := blockStart.FindSubmatchIndex(line)
+	if loc == nil {
+		return line
+	}
+	// escape the last character of the marker (eg: `1\.`), or the first for the other cases (eg: `\#`)
+	i := 0
+	if line[0] >= '0' && line[0] <= '9' {
+		i = loc[3] - 1
+	}
+	result := make([]byte, 0, len(line)+1)
+	result = append(result, line[:i]...)
+	result = append(result, '\\')
+	return append(result, line[i:]...)
+}
@@ -0,0 +1,140 @@
+package markdown
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+func renderUnorderedList(ctx *renderer.Context, l types.UnorderedList) ([]byte, error) {
+	log.Debugf("rendering unordered list with %d item(s)", len(l.Items))
+	result := bytes.NewBuffer(nil)
+	result.WriteString(renderAnchor(l.Attributes))
+	result.WriteString(renderTitle(l.Attributes))
+	for i, item := range l.Items {
+		if i > 0 {
+			result.WriteString("\n")
+		}
+		var checkbox string
+		switch item.CheckStyle {
+		case types.Checked:
+			checkbox = "[x] "
+		case types.Unchecked:
+			checkbox = "[ ] "
+		}
+		renderedItem, err := renderListItem(ctx, "- ", checkbox, item.Elements)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render unordered list")
+		}
+		result.Write(renderedItem)
+	}
+	return result.Bytes(), nil
+}
+
+func renderOrderedList(ctx *renderer.Context, l types.OrderedList) ([]byte, error) {
+	log.Debugf("rendering ordered list with %d item(s)", len(l.Items))
+	if style := numberingStyle(l); style != types.Arabic && style != types.Decimal {
+		dropped("'%s' numbering style in ordered list", style)
+	}
+	result := bytes.NewBuffer(nil)
+	result.WriteString(renderAnchor(l.Attributes))
+	result.WriteString(renderTitle(l.Attributes))
+	start := 1
+	if s, err := strconv.Atoi(l.Attributes.GetAsString(types.AttrStart)); err == nil {
+		start = s
+	}
+	for i, item := range l.Items {
+		if i > 0 {
+			result.WriteString("\n")
+		}
+		renderedItem, err := renderListItem(ctx, strconv.Itoa(start+i)+". ", "", item.Elements)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render ordered list")
+		}
+		result.Write(renderedItem)
+	}
+	return result.Bytes(), nil
+}
+
+// numberingStyle returns the numbering style set in the list attributes, or the one of the first item
+func numberingStyle(l types.OrderedList) types.NumberingStyle {
+	if style, ok := l.Attributes[types.AttrNumberingStyle].(string); ok {
+		return types.NumberingStyle(style)
+	}
+	if len(l.Items) > 0 {
+		return l.Items[0].NumberingStyle
+	}
+	return types.Arabic
+}
+
+// renderLabeledList renders the given list as an unordered list, in which each item starts with the term in bold
+func renderLabeledList(ctx *renderer.Context, l types.LabeledList) ([]byte, error) {
+	log.Debugf("rendering labeled list with %d item(s)", len(l.Items))
+	result := bytes.NewBuffer(nil)
+	result.WriteString(renderAnchor(l.Attributes))
+	result.WriteString(renderTitle(l.Attributes))
+	for i, item := range l.Items {
+		if i > 0 {
+			result.WriteString("\n")
+		}
+		term := "**" + escape(strings.TrimSpace(item.Term)) + "**"
+		if len(item.Elements) > 0 {
+			term += ": "
+		}
+		renderedItem, err := renderListItem(ctx, "- ", term, item.Elements)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render labeled list")
+		}
+		result.Write(renderedItem)
+	}
+	return result.Bytes(), nil
+}
+
+// renderListItem renders the given marker and prefix, followed by the elements of a list item.
+// The first paragraph is rendered on the same line as the marker, and all other elements are
+// indented to the width of the marker, so they belong to the same list item.
+func renderListItem(ctx *renderer.Context, marker, prefix string, elements []interface{}) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	result.WriteString(marker)
+	result.WriteString(prefix)
+	indent := strings.Repeat(" ", len(marker))
+	for i, element := range elements {
+		renderedElement, err := renderElement(ctx, element)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render list item")
+		}
+		if len(renderedElement) == 0 {
+			continue
+		}
+		switch element.(type) {
+		case types.Paragraph:
+			if i == 0 {
+				result.Write(indentLines(renderedElement, indent, false))
+				continue
+			}
+			result.WriteString("\n\n")
+		case types.UnorderedList, types.OrderedList, types.LabeledList:
+			result.WriteString("\n")
+		default:
+			result.WriteString("\n\n")
+		}
+		result.Write(indentLines(renderedElement, indent, true))
+	}
+	return result.Bytes(), nil
+}
+
+// indentLines indents all lines of the given content (except the first one if `indentFirst` is false)
+func indentLines(content []byte, indent string, indentFirst bool) []byte {
+	lines := strings.Split(string(content), "\n")
+	for i, line := range lines {
+		if (i > 0 || indentFirst) && line != "" {
+			lines[i] = indent + line
+		}
+	}
+	return []byte(strings.Join(lines, "\n"))
+}
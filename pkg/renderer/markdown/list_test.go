@@ -0,0 +1,66 @@
+package markdown_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+	log "github.com/sirupsen/logrus"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("lists", func() {
+
+	It("unordered list with checklist and nested list", func() {
+		source := `* item 1
+* [x] checked item
+* [ ] unchecked item
+** nested item`
+		expected := `- item 1
+- [x] checked item
+- [ ] unchecked item
+  - nested item
+`
+		Expect(source).To(RenderMarkdown(expected))
+	})
+
+	It("ordered list with start and continuation", func() {
+		source := `[start=3]
+. item 3
++
+----
+some code
+----
+. item 4`
+		expected := `3. item 3
+
+   ` + "```" + `
+   some code
+   ` + "```" + `
+4. item 4
+`
+		Expect(source).To(RenderMarkdown(expected))
+	})
+
+	It("ordered list with custom numbering style", func() {
+		console, reset := ConfigureLogger()
+		defer reset()
+		source := `[upperroman]
+. item 1
+. item 2`
+		expected := `1. item 1
+2. item 2
+`
+		Expect(source).To(RenderMarkdown(expected))
+		Expect(console).To(ContainMessageWithLevel(log.WarnLevel, "no Markdown equivalent for 'upperroman' numbering style in ordered list, dropping it"))
+	})
+
+	It("labeled list", func() {
+		source := `item 1:: description 1
+item 2::
+description 2`
+		expected := `- **item 1**: description 1
+- **item 2**: description 2
+`
+		Expect(source).To(RenderMarkdown(expected))
+	})
+})
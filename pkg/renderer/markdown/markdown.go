@@ -0,0 +1,136 @@
+// Package markdown renders a document in GitHub-flavored Markdown (GFM).
+// The constructs which have no equivalent in Markdown are rendered in raw HTML when possible,
+// or dropped with a warning.
+package markdown
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// Render renders the given document in Markdown and writes the result in the given `writer`
+func Render(ctx *renderer.Context, output io.Writer) error {
+	result, err := renderElements(ctx, ctx.Document.Elements)
+	if err != nil {
+		return errors.Wrapf(err, "unable to render document")
+	}
+	footnotes, err := renderFootnotes(ctx, ctx.Document.Footnotes)
+	if err != nil {
+		return errors.Wrapf(err, "unable to render document")
+	}
+	if len(footnotes) > 0 {
+		if len(result) > 0 {
+			result = append(result, "\n\n"...)
+		}
+		result = append(result, footnotes...)
+	}
+	if len(result) > 0 {
+		result = append(result, '\n')
+	}
+	_, err = output.Write(result)
+	return err
+}
+
+// renderElements renders the given elements, separated by a blank line
+func renderElements(ctx *renderer.Context, elements []interface{}) ([]byte, error) {
+	log.Debugf("rendering %d element(s)...", len(elements))
+	buff := bytes.NewBuffer(nil)
+	for _, element := range elements {
+		renderedElement, err := renderElement(ctx, element)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render an element")
+		}
+		if len(renderedElement) == 0 {
+			continue
+		}
+		if buff.Len() > 0 {
+			buff.WriteString("\n\n")
+		}
+		buff.Write(renderedElement)
+	}
+	return buff.Bytes(), nil
+}
+
+// nolint: gocyclo
+func renderElement(ctx *renderer.Context, element interface{}) ([]byte, error) {
+	switch e := element.(type) {
+	case []interface{}:
+		return renderElements(ctx, e)
+	case types.Section:
+		return renderSection(ctx, e)
+	case types.DiscreteHeading:
+		return renderDiscreteHeading(ctx, e)
+	case types.Preamble:
+		return renderElements(ctx, e.Elements)
+	case types.TableOfContentsMacro:
+		dropped("table of contents")
+		return nil, nil
+	case types.ThematicBreak:
+		return []byte("***"), nil
+	case types.PageBreak:
+		return []byte(`<div style="page-break-after: always;"></div>`), nil
+	case types.LabeledList:
+		return renderLabeledList(ctx, e)
+	case types.OrderedList:
+		return renderOrderedList(ctx, e)
+	case types.UnorderedList:
+		return renderUnorderedList(ctx, e)
+	case types.Paragraph:
+		return renderParagraph(ctx, e)
+	case types.ImageBlock:
+		return renderImageBlock(ctx, e)
+	case types.VideoBlock:
+		return renderVideoBlock(ctx, e)
+	case types.AudioBlock:
+		return renderAudioBlock(ctx, e)
+	case types.DelimitedBlock:
+		return renderDelimitedBlock(ctx, e)
+	case types.Table:
+		return renderTable(ctx, e)
+	case types.LiteralBlock:
+		return renderLiteralBlock(ctx, e)
+	case types.InlineElements:
+		return renderLine(ctx, e)
+	case types.DocumentAttributeDeclaration:
+		ctx.AddAttributeDeclaration(e)
+		return nil, nil
+	case types.DocumentAttributeReset:
+		ctx.ResetAttribute(e)
+		return nil, nil
+	case types.UserMacro:
+		dropped("user macro '%s'", e.Name)
+		return nil, nil
+	case types.BlankLine, types.SingleLineComment:
+		return nil, nil // nothing to do
+	default:
+		return nil, errors.Errorf("unsupported type of element: %T", element)
+	}
+}
+
+// dropped logs a warning about a construct which has no equivalent in Markdown
+// and which was not included in the output
+func dropped(format string, args ...interface{}) {
+	log.Warnf("no Markdown equivalent for "+format+", dropping it", args...)
+}
+
+// renderAnchor renders an HTML anchor for the custom ID of an element, if applicable.
+// The anchor is followed by a blank line, so it does not "swallow" the element in an HTML block.
+func renderAnchor(attrs types.ElementAttributes) string {
+	if id := attrs.GetAsString(types.AttrID); id != "" && attrs.GetAsBool(types.AttrCustomID) {
+		return `<a id="` + id + `"></a>` + "\n\n"
+	}
+	return ""
+}
+
+// renderTitle renders the title of a block in bold, in its own paragraph
+func renderTitle(attrs types.ElementAttributes) string {
+	if title := attrs.GetAsString(types.AttrTitle); title != "" {
+		return "**" + escape(title) + "**\n\n"
+	}
+	return ""
+}
@@ -0,0 +1,27 @@
+package markdown
+
+import (
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+)
+
+// renderVideoBlock renders a link to the video, since there is no Markdown syntax to embed a video.
+// Videos hosted on YouTube or Vimeo are linked to their page on the service.
+func renderVideoBlock(ctx *renderer.Context, v types.VideoBlock) ([]byte, error) {
+	target := v.Path
+	switch v.Attributes.GetAsString(types.AttrVideoPoster) {
+	case "youtube":
+		id := strings.SplitN(strings.SplitN(v.Path, "/", 2)[0], ",", 2)[0]
+		target = "https://www.youtube.com/watch?v=" + id
+	case "vimeo":
+		target = "https://vimeo.com/" + v.Path
+	}
+	return []byte(renderAnchor(v.Attributes) + renderTitle(v.Attributes) + "[" + escape(target) + "](" + target + ")"), nil
+}
+
+// renderAudioBlock renders a link to the audio, since there is no Markdown syntax to embed an audio.
+func renderAudioBlock(ctx *renderer.Context, a types.AudioBlock) ([]byte, error) {
+	return []byte(renderAnchor(a.Attributes) + renderTitle(a.Attributes) + "[" + escape(a.Path) + "](" + a.Path + ")"), nil
+}
@@ -0,0 +1,28 @@
+package markdown_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("videos and audios", func() {
+
+	It("youtube video", func() {
+		source := `.Product demo
+video::rPQoq7ThGAU[youtube]`
+		expected := `**Product demo**
+
+[https://www.youtube.com/watch?v=rPQoq7ThGAU](https://www.youtube.com/watch?v=rPQoq7ThGAU)
+`
+		Expect(source).To(RenderMarkdown(expected))
+	})
+
+	It("audio", func() {
+		source := `audio::clip.mp3[]`
+		expected := `[clip.mp3](clip.mp3)
+`
+		Expect(source).To(RenderMarkdown(expected))
+	})
+})
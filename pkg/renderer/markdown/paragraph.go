@@ -0,0 +1,143 @@
+package markdown
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+func renderParagraph(ctx *renderer.Context, p types.Paragraph) ([]byte, error) {
+	if len(p.Lines) == 0 {
+		return []byte{}, nil
+	}
+	result := bytes.NewBuffer(nil)
+	result.WriteString(renderAnchor(p.Attributes))
+	result.WriteString(renderTitle(p.Attributes))
+	switch p.Attributes[types.AttrKind] {
+	case types.Source, types.Listing:
+		result.Write(renderFencedCode(p.Attributes.GetAsString(types.AttrLanguage), plainLines(p.Lines)))
+		return result.Bytes(), nil
+	case types.Comment:
+		return []byte{}, nil
+	}
+	if p.Attributes.Has(types.AttrSubstitutions) {
+		lines, err := applySubstitutions(p.Lines, types.NewSubstitutions(p.Attributes.GetAsString(types.AttrSubstitutions), types.NormalSubstitutions))
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render paragraph")
+		}
+		p.Lines = lines
+	}
+	switch p.Attributes[types.AttrKind] {
+	case types.Verse:
+		lines, err := renderLines(ctx, p.Lines, true)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render verse paragraph")
+		}
+		result.Write(renderBlockquote(lines, renderAttribution(p.Attributes)))
+		return result.Bytes(), nil
+	case types.Quote:
+		lines, err := renderLines(ctx, p.Lines, ctx.HardBreaks(p.Attributes))
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render quote paragraph")
+		}
+		result.Write(renderBlockquote(lines, renderAttribution(p.Attributes)))
+		return result.Bytes(), nil
+	}
+	lines, err := renderLines(ctx, p.Lines, ctx.HardBreaks(p.Attributes))
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render paragraph")
+	}
+	if k, ok := p.Attributes[types.AttrAdmonitionKind].(types.AdmonitionKind); ok {
+		result.Write(renderAlert(k, lines))
+		return result.Bytes(), nil
+	}
+	result.Write(lines)
+	return result.Bytes(), nil
+}
+
+// applySubstitutions parses the given raw lines, retaining only the elements whose substitution is enabled
+func applySubstitutions(lines []types.InlineElements, subs types.Substitutions) ([]types.InlineElements, error) {
+	result := make([]types.InlineElements, len(lines))
+	for i, line := range lines {
+		if len(line) != 1 {
+			result[i] = line
+			continue
+		}
+		s, ok := line[0].(types.StringElement)
+		if !ok {
+			result[i] = line
+			continue
+		}
+		elements, err := parser.ParseInlineElementsWithSubstitutions(s.Content, subs)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = elements
+	}
+	return result, nil
+}
+
+// plainLines returns the content of the given lines, without any escaping
+func plainLines(lines []types.InlineElements) []string {
+	result := make([]string, len(lines))
+	for i, line := range lines {
+		buff := bytes.NewBuffer(nil)
+		for _, element := range line {
+			switch e := element.(type) {
+			case types.StringElement:
+				buff.WriteString(e.Content)
+			case types.VerbatimLine:
+				buff.WriteString(e.Content)
+			}
+		}
+		result[i] = buff.String()
+	}
+	return result
+}
+
+// renderAlert renders the given content in a GitHub alert (a blockquote starting with `[!NOTE]`, etc.)
+func renderAlert(kind types.AdmonitionKind, content []byte) []byte {
+	result := bytes.NewBuffer(nil)
+	result.WriteString("> [!" + strings.ToUpper(string(kind)) + "]\n")
+	result.Write(renderBlockquote(content, ""))
+	return result.Bytes()
+}
+
+// renderBlockquote prefixes all lines of the given content with `>`, and appends
+// the optional attribution (author and title of the quote) at the end
+func renderBlockquote(content []byte, attribution string) []byte {
+	result := bytes.NewBuffer(nil)
+	for i, line := range strings.Split(string(content), "\n") {
+		if i > 0 {
+			result.WriteString("\n")
+		}
+		if line == "" {
+			result.WriteString(">")
+		} else {
+			result.WriteString("> " + line)
+		}
+	}
+	if attribution != "" {
+		result.WriteString("\n>\n> " + attribution)
+	}
+	return result.Bytes()
+}
+
+func renderAttribution(attrs types.ElementAttributes) string {
+	author := attrs.GetAsString(types.AttrQuoteAuthor)
+	title := attrs.GetAsString(types.AttrQuoteTitle)
+	switch {
+	case author != "" && title != "":
+		return "&#8212; " + escape(author) + ", *" + escape(title) + "*"
+	case author != "":
+		return "&#8212; " + escape(author)
+	case title != "":
+		return "&#8212; *" + escape(title) + "*"
+	default:
+		return ""
+	}
+}
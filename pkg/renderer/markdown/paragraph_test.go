@@ -0,0 +1,173 @@
+package markdown_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	log "github.com/sirupsen/logrus"
+)
+
+var _ = Describe("paragraphs", func() {
+
+	It("paragraph with quoted text", func() {
+		source := `some *bold*, _italic_ and ` + "`monospace`" + ` content,
+with H~2~O and E=mc^2^.`
+		expected := `some **bold**, *italic* and ` + "`monospace`" + ` content,
+with H<sub>2</sub>O and E=mc<sup>2</sup>.
+`
+		Expect(source).To(RenderMarkdown(expected))
+	})
+
+	It("paragraph with marked text and curved quotes", func() {
+		source := `some #marked#, [.line-through]#deleted# and [.underline]#underlined# content
+with "` + "`double`" + `" and '` + "`single`" + `' curved quotes.`
+		expected := `some <mark>marked</mark>, ~~deleted~~ and <span class="underline">underlined</span> content
+with “double” and ‘single’ curved quotes.
+`
+		Expect(source).To(RenderMarkdown(expected))
+	})
+
+	It("paragraph with counters", func() {
+		source := `step {counter:step}, {counter:step} and {counter2:letter:A}{counter:letter}`
+		expected := `step 1, 2 and B
+`
+		Expect(source).To(RenderMarkdown(expected))
+	})
+
+	It("paragraph with special characters", func() {
+		source := `a * star, an _underscore and [brackets].
++ not a list item`
+		expected := `a \* star, an \_underscore and \[brackets\].
+\+ not a list item
+`
+		Expect(source).To(RenderMarkdown(expected))
+	})
+
+	It("paragraph with id, title and hard breaks", func() {
+		source := `[#foo]
+[%hardbreaks]
+.a title
+first line
+second line`
+		expected := `<a id="foo"></a>
+
+**a title**
+
+first line\
+second line
+`
+		Expect(source).To(RenderMarkdown(expected))
+	})
+
+	It("paragraph with hardbreaks option", func() {
+		source := `[opts=hardbreaks]
+foo
+bar`
+		expected := `foo\
+bar
+`
+		Expect(source).To(RenderMarkdown(expected))
+	})
+
+	It("paragraph with line break", func() {
+		source := `first line +
+second line`
+		expected := `first line\
+second line
+`
+		Expect(source).To(RenderMarkdown(expected))
+	})
+
+	It("admonition paragraph", func() {
+		source := `TIP: some content`
+		expected := `> [!TIP]
+> some content
+`
+		Expect(source).To(RenderMarkdown(expected))
+	})
+
+	It("quote paragraph", func() {
+		source := `[quote, john doe, quote title]
+some *content*`
+		expected := `> some **content**
+>
+> &#8212; john doe, *quote title*
+`
+		Expect(source).To(RenderMarkdown(expected))
+	})
+
+	It("paragraph with links, cross-references, images and attributes", func() {
+		source := `:url: https://example.com
+
+see link:foo.html[the docs], {url} or <<foo,here>> and image:foo.png[] or image:bar.png[bar, 20].`
+		expected := `see [the docs](foo.html), https://example.com or [here](#foo) and ![foo](foo.png) or <img src="bar.png" alt="bar" width="20">.
+`
+		Expect(source).To(RenderMarkdown(expected))
+	})
+
+	It("paragraph with footnotes", func() {
+		source := `a note footnote:[first note] and another footnoteref:[ref,second note]
+and again footnoteref:[ref].`
+		expected := `a note [^1] and another [^2]
+and again [^2].
+
+[^1]: first note
+[^2]: second note
+`
+		Expect(source).To(RenderMarkdown(expected))
+	})
+
+	It("paragraph with custom substitutions", func() {
+		source := `:foo: bar
+
+[subs="-quotes"]
+some *content* with {foo} +
+on 2 lines`
+		expected := `some \*content\* with bar\
+on 2 lines
+`
+		Expect(source).To(RenderMarkdown(expected))
+	})
+
+	It("paragraph with missing attributes and inline attribute entries", func() {
+		source := `:attribute-missing: drop-line
+
+first line
+{set:foo:bar}foo is {foo}
+{set:foo!}
+dropped {foo}
+last line`
+		expected := `first line
+foo is bar
+last line
+`
+		Expect(source).To(RenderMarkdown(expected))
+	})
+
+	It("paragraph with default attributes", func() {
+		source := `a {table-caption} and an {example-caption}`
+		expected := `a Table and an Example
+`
+		Expect(source).To(RenderMarkdown(expected))
+	})
+
+	It("paragraph with raw HTML passthrough in server mode", func() {
+		source := `some +++<del>raw</del>+++ content`
+		expected := `some &lt;del&gt;raw&lt;/del&gt; content
+`
+		Expect(source).To(RenderMarkdown(expected, renderer.SafeMode(types.SafeModeServer)))
+	})
+
+	It("paragraph with index terms", func() {
+		console, reset := ConfigureLogger()
+		defer reset()
+		source := `some ((big_cats)) here(((tigers, big cats)))`
+		expected := `some big\_cats here
+`
+		Expect(source).To(RenderMarkdown(expected))
+		Expect(console).To(ContainMessageWithLevel(log.WarnLevel, "no Markdown equivalent for index term 'tigers, big cats', dropping it"))
+	})
+})
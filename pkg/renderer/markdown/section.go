@@ -0,0 +1,55 @@
+package markdown
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+func renderSection(ctx *renderer.Context, s types.Section) ([]byte, error) {
+	log.Debugf("rendering section level %d", s.Level)
+	result := bytes.NewBuffer(nil)
+	result.WriteString(renderAnchor(s.Attributes))
+	title, err := renderTitleLine(ctx, s.Attributes.GetAsString(types.AttrID), s.Title)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render section title")
+	}
+	result.WriteString(strings.Repeat("#", s.Level+1))
+	result.WriteString(" ")
+	result.Write(bytes.TrimSpace(title))
+	elements, err := renderElements(ctx, s.Elements)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render section elements")
+	}
+	if len(elements) > 0 {
+		result.WriteString("\n\n")
+		result.Write(elements)
+	}
+	return result.Bytes(), nil
+}
+
+func renderDiscreteHeading(ctx *renderer.Context, h types.DiscreteHeading) ([]byte, error) {
+	log.Debugf("rendering discrete heading level %d", h.Level)
+	result := bytes.NewBuffer(nil)
+	result.WriteString(renderAnchor(h.Attributes))
+	title, err := renderTitleLine(ctx, h.Attributes.GetAsString(types.AttrID), h.Title)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render discrete heading title")
+	}
+	result.WriteString(strings.Repeat("#", h.Level+1))
+	result.WriteString(" ")
+	result.Write(bytes.TrimSpace(title))
+	return result.Bytes(), nil
+}
+
+// renderTitleLine renders the given title of the element with the given ID, in which the counters
+// are incremented only once, even if the title is rendered several times (eg: in a cross-reference)
+func renderTitleLine(ctx *renderer.Context, id string, title types.InlineElements) ([]byte, error) {
+	previous := ctx.SetRenderedTitle(id)
+	defer ctx.SetRenderedTitle(previous)
+	return renderLine(ctx, title)
+}
@@ -0,0 +1,75 @@
+package markdown_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("sections", func() {
+
+	It("document with sections", func() {
+		source := `= a document title
+John Doe
+:toc:
+
+a preamble
+
+[#custom]
+== section 1
+
+=== section *1.1*
+
+content`
+		expected := `# a document title
+
+a preamble
+
+<a id="custom"></a>
+
+## section 1
+
+### section **1.1**
+
+content
+`
+		Expect(source).To(RenderMarkdown(expected))
+	})
+
+	It("discrete headings", func() {
+		source := `== section 1
+
+[discrete]
+=== a discrete heading
+
+content
+
+****
+[discrete]
+== a heading in a sidebar
+****`
+		expected := `## section 1
+
+### a discrete heading
+
+content
+
+## a heading in a sidebar
+`
+		Expect(source).To(RenderMarkdown(expected))
+	})
+	It("cross-reference to section with counter in title", func() {
+		source := `[#first]
+== step {counter:step}
+
+See <<first>>, then {counter:step}.`
+		expected := `<a id="first"></a>
+
+## step 1
+
+See [step 1](#first), then 2.
+`
+		Expect(source).To(RenderMarkdown(expected))
+	})
+})
@@ -0,0 +1,57 @@
+package markdown
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// renderTable renders the given table in the GFM syntax. Since a header row is mandatory in GFM,
+// an empty one is rendered if the table has no header.
+func renderTable(ctx *renderer.Context, t types.Table) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	result.WriteString(renderAnchor(t.Attributes))
+	result.WriteString(renderTitle(t.Attributes))
+	columns := len(t.Header.Cells)
+	for _, line := range t.Lines {
+		if len(line.Cells) > columns {
+			columns = len(line.Cells)
+		}
+	}
+	header, err := renderTableLine(ctx, t.Header, columns)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render table header")
+	}
+	result.Write(header)
+	result.WriteString("\n|" + strings.Repeat(" --- |", columns))
+	for _, line := range t.Lines {
+		renderedLine, err := renderTableLine(ctx, line, columns)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render table")
+		}
+		result.WriteString("\n")
+		result.Write(renderedLine)
+	}
+	return result.Bytes(), nil
+}
+
+func renderTableLine(ctx *renderer.Context, l types.TableLine, columns int) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	result.WriteString("|")
+	for i := 0; i < columns; i++ {
+		result.WriteString(" ")
+		if i < len(l.Cells) {
+			renderedCell, err := renderLine(ctx, l.Cells[i])
+			if err != nil {
+				return nil, err
+			}
+			result.WriteString(strings.Replace(string(bytes.TrimSpace(renderedCell)), "|", `\|`, -1))
+			result.WriteString(" ")
+		}
+		result.WriteString("|")
+	}
+	return result.Bytes(), nil
+}
@@ -0,0 +1,51 @@
+package markdown_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("tables and images", func() {
+
+	It("table with header", func() {
+		source := `.a title
+|===
+|a | *b*
+
+|c|d
+|===`
+		expected := `**a title**
+
+| a | **b** |
+| --- | --- |
+| c | d |
+`
+		Expect(source).To(RenderMarkdown(expected))
+	})
+
+	It("table without header", func() {
+		source := `|===
+|a |b
+|c |d
+|===`
+		expected := `| | |
+| --- | --- |
+| a | b |
+| c | d |
+`
+		Expect(source).To(RenderMarkdown(expected))
+	})
+
+	It("image blocks", func() {
+		source := `image::images/foo.png[]
+
+image::images/foo.png[a foo, 100, 200]`
+		expected := `![foo](images/foo.png)
+
+<img src="images/foo.png" alt="a foo" width="100" height="200">
+`
+		Expect(source).To(RenderMarkdown(expected))
+	})
+})
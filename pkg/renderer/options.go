@@ -1,7 +1,11 @@
 package renderer
 
 import (
+	"io/fs"
 	"time"
+
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
 )
 
 //Option the options when rendering a document
@@ -15,6 +19,24 @@ const (
 	keyIncludeHeaderFooter string = "IncludeHeaderFooter"
 	//keyEntrypoint a bool value to indicate if the entrypoint to start with when parsing the document
 	keyEntrypoint string = "Entrypoint"
+	//keyFilename the key to specify the path of the document file to render
+	keyFilename string = "Filename"
+	//keyBackend the key to specify the name of the backend used to render the document
+	keyBackend string = "Backend"
+	//keyVersion the key to specify the version of libasciidoc
+	keyVersion string = "Version"
+	//keyAttributeOverrides the key to specify the document attributes set (or unset) from the API or the command line
+	keyAttributeOverrides string = "AttributeOverrides"
+	//keyURIFetcher the key to specify the fetcher of the remote files to include
+	keyURIFetcher string = "URIFetcher"
+	//keyFileSystem the key to specify the filesystem of the document and of the files that it includes or refers to
+	keyFileSystem string = "FileSystem"
+	//keySafeMode the key to specify the safe mode
+	keySafeMode string = "SafeMode"
+	//keyBaseDir the key to specify the base directory to which the file inclusions are restricted
+	keyBaseDir string = "BaseDir"
+	//keyImageDimensions the key to specify if the dimensions of the local images should be read from the image files
+	keyImageDimensions string = "ImageDimensions"
 	// LastUpdatedFormat the time format for the `last updated` document attribute
 	LastUpdatedFormat string = "2006/01/02 15:04:05 MST"
 )
@@ -40,6 +62,80 @@ func Entrypoint(entrypoint string) Option {
 	}
 }
 
+// Filename function to set the path of the document file in the renderer context,
+// from which the `docfile`, `docdir`, `docname` and `docdate` attributes are derived
+func Filename(filename string) Option {
+	return func(ctx *Context) {
+		ctx.options[keyFilename] = filename
+	}
+}
+
+// Backend function to set the name of the backend used to render the document in the renderer context (default is `html5`)
+func Backend(backend string) Option {
+	return func(ctx *Context) {
+		ctx.options[keyBackend] = backend
+	}
+}
+
+// Version function to set the version of libasciidoc in the renderer context
+func Version(version string) Option {
+	return func(ctx *Context) {
+		ctx.options[keyVersion] = version
+	}
+}
+
+// Attributes function to set (or unset) document attributes from the API or the command line.
+// These attributes cannot be overridden in the document, unless their name or value ends with `@`.
+// A name ending with `!` unsets the attribute (eg: `toc!`).
+func Attributes(attrs map[string]string) Option {
+	return func(ctx *Context) {
+		ctx.options[keyAttributeOverrides] = types.NewDocumentAttributeOverrides(attrs)
+	}
+}
+
+// URIFetcher function to set the fetcher of the remote files to include (eg: `include::https://example.com/README.adoc[]`)
+// when the `allow-uri-read` attribute is set. Default is an HTTP fetcher.
+func URIFetcher(f parser.Fetcher) Option {
+	return func(ctx *Context) {
+		ctx.options[keyURIFetcher] = f
+	}
+}
+
+// FileSystem function to set the filesystem (eg: an `embed.FS`, a zip archive or an in-memory tree)
+// from which the document file, the files to include and the images are read, instead of the OS filesystem.
+// In this case, the filename of the document is its path in the given filesystem.
+func FileSystem(fsys fs.FS) Option {
+	return func(ctx *Context) {
+		ctx.options[keyFileSystem] = fsys
+	}
+}
+
+// SafeMode function to set the safe mode, which restricts the file inclusions and the raw content (default is `types.SafeModeUnsafe`):
+// - in `safe` mode, the files to include must be in the base directory,
+// - in `server` mode, the remote files cannot be included and the raw HTML of the passthroughs is escaped,
+// - in `secure` mode, all file inclusions are replaced with links.
+func SafeMode(mode types.SafeMode) Option {
+	return func(ctx *Context) {
+		ctx.options[keySafeMode] = mode
+	}
+}
+
+// BaseDir function to set the base directory to which the file inclusions are restricted in `safe` mode and above
+// (default is the directory of the document, or the current working directory)
+func BaseDir(dir string) Option {
+	return func(ctx *Context) {
+		ctx.options[keyBaseDir] = dir
+	}
+}
+
+// ImageDimensions function to read the intrinsic width and height of the local PNG, JPEG, GIF and SVG images, when
+// they are not set in the document (default is `false`). The images which cannot be read are reported in the logs.
+func ImageDimensions(value bool) Option {
+	return func(ctx *Context) {
+		ctx.options[keyImageDimensions] = value
+	}
+}
+
 // DefineMacro defines the given template to a user macro with the given name
 func DefineMacro(name string, t MacroTemplate) Option {
 	return func(ctx *Context) {
@@ -68,3 +164,85 @@ func (ctx *Context) IncludeHeaderFooter() bool {
 	}
 	return false
 }
+
+// Filename returns the value of the 'Filename' Option if it was present,
+// otherwise it returns an empty string
+func (ctx *Context) Filename() string {
+	if filename, found := ctx.options[keyFilename].(string); found {
+		return filename
+	}
+	return ""
+}
+
+// Backend returns the value of the 'Backend' Option if it was present,
+// otherwise it returns `html5`
+func (ctx *Context) Backend() string {
+	if backend, found := ctx.options[keyBackend].(string); found {
+		return backend
+	}
+	return "html5"
+}
+
+// Version returns the value of the 'Version' Option if it was present,
+// otherwise it returns an empty string
+func (ctx *Context) Version() string {
+	if version, found := ctx.options[keyVersion].(string); found {
+		return version
+	}
+	return ""
+}
+
+// AttributeOverrides returns the value of the 'Attributes' Option if it was present,
+// otherwise it returns empty overrides
+func (ctx *Context) AttributeOverrides() types.DocumentAttributeOverrides {
+	if overrides, found := ctx.options[keyAttributeOverrides].(types.DocumentAttributeOverrides); found {
+		return overrides
+	}
+	return types.DocumentAttributeOverrides{}
+}
+
+// FileSystem returns the value of the 'FileSystem' Option and `true` if it was present,
+// otherwise it returns `nil` and `false`
+func (ctx *Context) FileSystem() (fs.FS, bool) {
+	fsys, found := ctx.options[keyFileSystem].(fs.FS)
+	return fsys, found && fsys != nil
+}
+
+// SafeMode returns the value of the 'SafeMode' Option if it was present,
+// otherwise it returns `types.SafeModeUnsafe`
+func (ctx *Context) SafeMode() types.SafeMode {
+	if mode, found := ctx.options[keySafeMode].(types.SafeMode); found {
+		return mode
+	}
+	return types.SafeModeUnsafe
+}
+
+// ImageDimensions returns the value of the 'ImageDimensions' Option if it was present,
+// otherwise it returns `false`
+func (ctx *Context) ImageDimensions() bool {
+	if value, found := ctx.options[keyImageDimensions].(bool); found {
+		return value
+	}
+	return false
+}
+
+// ParserOptions returns the options to parse the document, based on the 'Attributes', 'URIFetcher', 'FileSystem',
+// 'SafeMode' and 'BaseDir' Options
+func (ctx *Context) ParserOptions() []parser.Option {
+	opts := []parser.Option{
+		parser.AttributeOverrides(ctx.AttributeOverrides()),
+	}
+	if f, found := ctx.options[keyURIFetcher].(parser.Fetcher); found {
+		opts = append(opts, parser.URIFetcher(f))
+	}
+	if fsys, found := ctx.FileSystem(); found {
+		opts = append(opts, parser.FileSystem(fsys))
+	}
+	if mode := ctx.SafeMode(); mode != types.SafeModeUnsafe {
+		opts = append(opts, parser.SafeMode(mode))
+	}
+	if dir, found := ctx.options[keyBaseDir].(string); found {
+		opts = append(opts, parser.BaseDir(dir))
+	}
+	return opts
+}
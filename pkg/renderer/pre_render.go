@@ -9,10 +9,17 @@ import (
 // - wraps elements in a preamble
 // - generates the ToC
 // - processes the document headers (added in the document attributes)
+// - sets the document attributes overridden from the API or the command line
+// - sets the intrinsic and default document attributes
+// - numbers the elements with a caption (figures, tables, etc.)
 func Prerender(ctx *Context) error {
 	IncludePreamble(ctx)
 	IncludeTableOfContents(ctx)
 	ProcessDocumentHeader(ctx)
+	IncludeAttributeOverrides(ctx)
+	IncludeIntrinsicAttributes(ctx)
+	IncludeDefaultAttributes(ctx)
+	NumberCaptionedElements(ctx)
 	if log.IsLevelEnabled(log.DebugLevel) {
 		log.Debug("pre-rendered document:")
 		spew.Dump(ctx.Document)
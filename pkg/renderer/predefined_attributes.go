@@ -0,0 +1,189 @@
+package renderer
+
+import (
+	"io/fs"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	log "github.com/sirupsen/logrus"
+)
+
+// Predefined the predefined document attributes, which can be used in all documents
+var Predefined types.DocumentAttributes
+
+func init() {
+	Predefined = types.DocumentAttributes{
+		"sp":             " ",
+		"blank":          "",
+		"empty":          "",
+		"nbsp":           "&#160;",
+		"zwsp":           "&#8203;",
+		"wj":             "&#8288;",
+		"apos":           "&#39;",
+		"quot":           "&#34;",
+		"lsquo":          "&#8216;",
+		"rsquo":          "&#8217;",
+		"ldquo":          "&#8220;",
+		"rdquo":          "&#8221;",
+		"deg":            "&#176;",
+		"plus":           "&#43;",
+		"brvbar":         "&#166;",
+		"vbar":           "|",
+		"amp":            "&amp;",
+		"lt":             "&lt;",
+		"gt":             "&gt;",
+		"startsb":        "[",
+		"endsb":          "]",
+		"caret":          "^",
+		"asterisk":       "*",
+		"tilde":          "~",
+		"backslash":      `\`,
+		"backtick":       "`",
+		"two-colons":     "::",
+		"two-semicolons": ";",
+		"cpp":            "C++",
+	}
+}
+
+// Defaults the default values of the document attributes, which can be overridden or unset in the documents
+var Defaults = types.DocumentAttributes{
+	types.AttrFigureCaption:  "Figure",
+	types.AttrTableCaption:   "Table",
+	types.AttrExampleCaption: "Example",
+	// the behaviour on missing/undefined attributes
+	types.AttrAttributeMissing:   "skip",
+	types.AttrAttributeUndefined: "drop-line",
+}
+
+// IncludeAttributeOverrides sets (or unsets) the document attributes which were overridden from the API or the command line
+func IncludeAttributeOverrides(ctx *Context) {
+	ctx.AttributeOverrides().ApplyTo(ctx.Document.Attributes)
+}
+
+// IncludeDefaultAttributes adds the default document attributes which were not already set (or overridden)
+func IncludeDefaultAttributes(ctx *Context) {
+	overrides := ctx.AttributeOverrides()
+	for k, v := range Defaults {
+		if !ctx.Document.Attributes.Has(k) && !overrides.Has(k) {
+			ctx.Document.Attributes.Add(k, v)
+		}
+	}
+}
+
+// backend the intrinsic attributes of a backend
+type backend struct {
+	baseBackend   string
+	outFileSuffix string
+	fileType      string
+}
+
+var backends = map[string]backend{
+	"html5": {
+		baseBackend:   "html",
+		outFileSuffix: ".html",
+		fileType:      "html",
+	},
+	"markdown": {
+		baseBackend:   "markdown",
+		outFileSuffix: ".md",
+		fileType:      "md",
+	},
+}
+
+// IncludeIntrinsicAttributes adds the intrinsic document attributes which were not already set,
+// i.e., the attributes derived from the document file (`docfile`, `docdir`, `docdate`, etc.),
+// from the time of the conversion (`localdate`, `localtime`, etc.) and from the backend
+// (`backend`, `basebackend`, `outfilesuffix`, etc.) and from the safe mode (`safe-mode-name`, `safe-mode-level`, etc.)
+func IncludeIntrinsicAttributes(ctx *Context) {
+	overrides := ctx.AttributeOverrides()
+	for k, v := range intrinsicAttributes(ctx) {
+		if !ctx.Document.Attributes.Has(k) && !overrides.Has(k) {
+			ctx.Document.Attributes.Add(k, v)
+		}
+	}
+}
+
+func intrinsicAttributes(ctx *Context) types.DocumentAttributes {
+	fsys, virtual := ctx.FileSystem()
+	var result types.DocumentAttributes
+	if virtual {
+		result = types.NewVirtualFileAttributes(ctx.Filename())
+	} else {
+		result = types.NewFileAttributes(ctx.Filename())
+	}
+	// as in Asciidoctor, the `SOURCE_DATE_EPOCH` env var overrides the conversion time and the
+	// last modification time of the document file, to allow for reproducible builds
+	now, reproducible := sourceDateEpoch()
+	if !reproducible {
+		now = time.Now()
+	}
+	docTime := now
+	if filename := ctx.Filename(); filename != "" && !reproducible {
+		var info os.FileInfo
+		var err error
+		if virtual {
+			info, err = fs.Stat(fsys, strings.TrimPrefix(filename, "/"))
+		} else {
+			info, err = os.Stat(filename)
+		}
+		if err == nil {
+			docTime = info.ModTime()
+		} else {
+			log.WithError(err).Warnf("unable to retrieve the last modification time of '%s'", filename)
+		}
+	}
+	addDateTimeAttributes(result, docTime, types.AttrDocDate, types.AttrDocTime, types.AttrDocDateTime, types.AttrDocYear)
+	addDateTimeAttributes(result, now, types.AttrLocalDate, types.AttrLocalTime, types.AttrLocalDateTime, types.AttrLocalYear)
+	// backend
+	name := ctx.Backend()
+	result[types.AttrBackend] = name
+	result[types.AttrBackend+"-"+name] = ""
+	if b, found := backends[name]; found {
+		result[types.AttrBaseBackend] = b.baseBackend
+		result[types.AttrBaseBackend+"-"+b.baseBackend] = ""
+		result[types.AttrOutFileSuffix] = b.outFileSuffix
+		result[types.AttrFileType] = b.fileType
+		result[types.AttrFileType+"-"+b.fileType] = ""
+	}
+	// safe mode
+	mode := ctx.SafeMode()
+	result[types.AttrSafeModeName] = mode.String()
+	result[types.AttrSafeModeLevel] = strconv.Itoa(int(mode))
+	result["safe-mode-"+mode.String()] = ""
+	// version
+	result[types.AttrLibasciidoc] = ""
+	result.AddNonEmpty(types.AttrLibasciidocVersion, ctx.Version())
+	return result
+}
+
+func sourceDateEpoch() (time.Time, bool) {
+	value, found := os.LookupEnv("SOURCE_DATE_EPOCH")
+	if !found {
+		return time.Time{}, false
+	}
+	epoch, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		log.WithError(err).Warnf("invalid value for the SOURCE_DATE_EPOCH env var: '%s'", value)
+		return time.Time{}, false
+	}
+	return time.Unix(epoch, 0).UTC(), true
+}
+
+// addDateTimeAttributes adds the date (`2006-01-02`), time (`15:04:05 -0700`), date-time and year attributes
+// of the given time
+func addDateTimeAttributes(attrs types.DocumentAttributes, t time.Time, dateAttr, timeAttr, dateTimeAttr, yearAttr string) {
+	date := t.Format("2006-01-02")
+	var tm string
+	if _, offset := t.Zone(); offset == 0 {
+		tm = t.Format("15:04:05") + " UTC"
+	} else {
+		tm = t.Format("15:04:05 -0700")
+	}
+	attrs[dateAttr] = date
+	attrs[timeAttr] = tm
+	attrs[dateTimeAttr] = date + " " + tm
+	attrs[yearAttr] = strconv.Itoa(t.Year())
+}
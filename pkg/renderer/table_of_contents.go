@@ -6,10 +6,15 @@ import (
 )
 
 // IncludeTableOfContents includes a Table Of Contents in the document
-// if the `toc` attribute is present
+// if the `toc` attribute is present. The `toc::[]` macros of the document
+// are only retained if the `toc` attribute is set to `macro`
 func IncludeTableOfContents(ctx *Context) {
-	if d, found := types.SearchAttributeDeclaration(ctx.Document.Elements, types.AttrTableOfContents); found {
-		ctx.Document = insertTableOfContents(ctx.Document, d.Value)
+	location, found := ctx.DeclaredAttribute(types.AttrTableOfContents)
+	if !found || location != "macro" {
+		ctx.Document.Elements = removeTableOfContentsMacros(ctx.Document.Elements)
+	}
+	if found {
+		ctx.Document = insertTableOfContents(ctx.Document, location)
 	}
 }
 
@@ -17,11 +22,13 @@ func insertTableOfContents(doc types.Document, location string) types.Document {
 	log.Debugf("inserting a table of contents at location `%s`", location)
 	// insert a TableOfContentsMacro element if `toc` value is:
 	// - "auto" (or empty)
+	// - "left" or "right" (the ToC is rendered on the side of the document, but inserted at the same location as for "auto")
 	// - "preamble"
+	// if the `toc` value is "macro", the ToC is rendered at the location of the `toc::[]` macro in the document
 	log.Debugf("inserting ToC macro with placement: '%s'", location)
 	toc := types.TableOfContentsMacro{}
 	switch location {
-	case "", "auto":
+	case "", "auto", "left", "right":
 		// insert TableOfContentsMacro at first position (in section0 if it exists)
 		if header, ok := doc.Header(); ok {
 			header.Elements = append([]interface{}{toc}, header.Elements...)
@@ -40,13 +47,62 @@ func insertTableOfContents(doc types.Document, location string) types.Document {
 		} else if preambleIndex, ok := lookupPreamble(doc.Elements); ok {
 			doc.Elements = insert(doc.Elements, toc, preambleIndex)
 		}
-	// case "macro":
+	case "macro":
+		if !containsTableOfContentsMacro(doc.Elements) {
+			log.Warn("the 'toc' attribute is set to 'macro' but the document has no 'toc::[]' macro")
+		}
 	default:
 		log.Warnf("invalid or unsupported value for 'toc' attribute: '%s'", location)
 	}
 	return doc
 }
 
+// removeTableOfContentsMacros removes the `toc::[]` macros from the given elements,
+// including in their sections, preamble and delimited blocks
+func removeTableOfContentsMacros(elements []interface{}) []interface{} {
+	result := make([]interface{}, 0, len(elements))
+	for _, element := range elements {
+		switch e := element.(type) {
+		case types.TableOfContentsMacro:
+			log.Debug("ignoring 'toc::[]' macro since the 'toc' attribute is not set to 'macro'")
+			continue
+		case types.Section:
+			e.Elements = removeTableOfContentsMacros(e.Elements)
+			element = e
+		case types.Preamble:
+			e.Elements = removeTableOfContentsMacros(e.Elements)
+			element = e
+		case types.DelimitedBlock:
+			e.Elements = removeTableOfContentsMacros(e.Elements)
+			element = e
+		}
+		result = append(result, element)
+	}
+	return result
+}
+
+func containsTableOfContentsMacro(elements []interface{}) bool {
+	for _, element := range elements {
+		switch e := element.(type) {
+		case types.TableOfContentsMacro:
+			return true
+		case types.Section:
+			if containsTableOfContentsMacro(e.Elements) {
+				return true
+			}
+		case types.Preamble:
+			if containsTableOfContentsMacro(e.Elements) {
+				return true
+			}
+		case types.DelimitedBlock:
+			if containsTableOfContentsMacro(e.Elements) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func lookupPreamble(elements []interface{}) (int, bool) {
 	for i, e := range elements {
 		if _, ok := e.(types.Preamble); ok {
@@ -1,6 +1,7 @@
 package renderer_test
 
 import (
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 	. "github.com/bytesparadise/libasciidoc/testsupport"
 
@@ -286,4 +287,130 @@ var _ = Describe("table of contents", func() {
 		Expect(source).To(HaveTableOfContents(expected))
 	})
 
+	It("table of contents set from the API", func() {
+		source := types.Document{
+			Attributes:         types.DocumentAttributes{},
+			ElementReferences:  types.ElementReferences{},
+			Footnotes:          types.Footnotes{},
+			FootnoteReferences: types.FootnoteReferences{},
+			Elements: []interface{}{
+				preamble,
+				section,
+			},
+		}
+		expected := types.Document{
+			Attributes:         types.DocumentAttributes{},
+			ElementReferences:  types.ElementReferences{},
+			Footnotes:          types.Footnotes{},
+			FootnoteReferences: types.FootnoteReferences{},
+			Elements: []interface{}{
+				tableOfContents,
+				preamble,
+				section,
+			},
+		}
+		Expect(source).To(HaveTableOfContents(expected, renderer.Attributes(map[string]string{
+			"toc": "",
+		})))
+	})
+
+	It("table of contents unset from the API", func() {
+		source := types.Document{
+			Attributes:         types.DocumentAttributes{},
+			ElementReferences:  types.ElementReferences{},
+			Footnotes:          types.Footnotes{},
+			FootnoteReferences: types.FootnoteReferences{},
+			Elements: []interface{}{
+				toc,
+				preamble,
+				section,
+			},
+		}
+		Expect(source).To(HaveTableOfContents(source, renderer.Attributes(map[string]string{
+			"toc!": "",
+		})))
+	})
+
+	It("soft table of contents placement overridden in the document", func() {
+		source := types.Document{
+			Attributes:         types.DocumentAttributes{},
+			ElementReferences:  types.ElementReferences{},
+			Footnotes:          types.Footnotes{},
+			FootnoteReferences: types.FootnoteReferences{},
+			Elements: []interface{}{
+				preambletoc,
+				preamble,
+				section,
+			},
+		}
+		expected := types.Document{
+			Attributes:         types.DocumentAttributes{},
+			ElementReferences:  types.ElementReferences{},
+			Footnotes:          types.Footnotes{},
+			FootnoteReferences: types.FootnoteReferences{},
+			Elements: []interface{}{
+				preambletoc,
+				preamble,
+				tableOfContents,
+				section,
+			},
+		}
+		Expect(source).To(HaveTableOfContents(expected, renderer.Attributes(map[string]string{
+			"toc": "auto@",
+		})))
+	})
+
+	It("table of contents at the location of the macro", func() {
+		macrotoc := types.DocumentAttributeDeclaration{
+			Name:  "toc",
+			Value: "macro",
+		}
+		source := types.Document{
+			Attributes:         types.DocumentAttributes{},
+			ElementReferences:  types.ElementReferences{},
+			Footnotes:          types.Footnotes{},
+			FootnoteReferences: types.FootnoteReferences{},
+			Elements: []interface{}{
+				macrotoc,
+				preamble,
+				section,
+				tableOfContents,
+			},
+		}
+		Expect(source).To(HaveTableOfContents(source))
+	})
+
+	It("table of contents macro removed when the placement is not macro", func() {
+		source := types.Document{
+			Attributes:         types.DocumentAttributes{},
+			ElementReferences:  types.ElementReferences{},
+			Footnotes:          types.Footnotes{},
+			FootnoteReferences: types.FootnoteReferences{},
+			Elements: []interface{}{
+				preambletoc,
+				types.Preamble{
+					Elements: []interface{}{
+						tableOfContents,
+					},
+				},
+				section,
+			},
+		}
+		expected := types.Document{
+			Attributes:         types.DocumentAttributes{},
+			ElementReferences:  types.ElementReferences{},
+			Footnotes:          types.Footnotes{},
+			FootnoteReferences: types.FootnoteReferences{},
+			Elements: []interface{}{
+				preambletoc,
+				types.Preamble{
+					Elements: []interface{}{},
+				},
+				tableOfContents,
+				section,
+			},
+		}
+		Expect(source).To(HaveTableOfContents(expected))
+	})
+
 })
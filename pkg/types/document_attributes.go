@@ -1,12 +1,107 @@
 package types
 
 import (
+	"path"
+	"path/filepath"
 	"reflect"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// AttrDocFile the intrinsic document attribute which contains the absolute path of the document file
+	AttrDocFile = "docfile"
+	// AttrDocDir the intrinsic document attribute which contains the absolute path of the directory of the document file
+	AttrDocDir = "docdir"
+	// AttrDocName the intrinsic document attribute which contains the name of the document file, without its extension
+	AttrDocName = "docname"
+	// AttrDocFileSuffix the intrinsic document attribute which contains the extension of the document file (eg: `.adoc`)
+	AttrDocFileSuffix = "docfilesuffix"
+	// AttrDocDate the intrinsic document attribute which contains the last modification date of the document file
+	AttrDocDate = "docdate"
+	// AttrDocTime the intrinsic document attribute which contains the last modification time of the document file
+	AttrDocTime = "doctime"
+	// AttrDocDateTime the intrinsic document attribute which contains the last modification date and time of the document file
+	AttrDocDateTime = "docdatetime"
+	// AttrDocYear the intrinsic document attribute which contains the last modification year of the document file
+	AttrDocYear = "docyear"
+	// AttrLocalDate the intrinsic document attribute which contains the date of the conversion
+	AttrLocalDate = "localdate"
+	// AttrLocalTime the intrinsic document attribute which contains the time of the conversion
+	AttrLocalTime = "localtime"
+	// AttrLocalDateTime the intrinsic document attribute which contains the date and time of the conversion
+	AttrLocalDateTime = "localdatetime"
+	// AttrLocalYear the intrinsic document attribute which contains the year of the conversion
+	AttrLocalYear = "localyear"
+	// AttrBackend the intrinsic document attribute which contains the name of the backend used to render the document (eg: `html5`)
+	AttrBackend = "backend"
+	// AttrBaseBackend the intrinsic document attribute which contains the name of the base backend (eg: `html`)
+	AttrBaseBackend = "basebackend"
+	// AttrOutFileSuffix the intrinsic document attribute which contains the extension of the output file (eg: `.html`)
+	AttrOutFileSuffix = "outfilesuffix"
+	// AttrFileType the intrinsic document attribute which contains the type of the output file (eg: `html`)
+	AttrFileType = "filetype"
+	// AttrLibasciidoc the intrinsic document attribute which indicates that the document is rendered by libasciidoc
+	AttrLibasciidoc = "libasciidoc"
+	// AttrLibasciidocVersion the intrinsic document attribute which contains the version of libasciidoc
+	AttrLibasciidocVersion = "libasciidoc-version"
+	// AttrAttributeMissing the document attribute which specifies how a reference to a missing attribute is handled (`skip` by default)
+	AttrAttributeMissing = "attribute-missing"
+	// AttrAttributeUndefined the document attribute which specifies how an attribute declaration with an undefined value is handled (`drop-line` by default)
+	AttrAttributeUndefined = "attribute-undefined"
+	// AttrAllowURIRead the document attribute which allows the inclusion of remote files (only when set from the API or the command line)
+	AttrAllowURIRead = "allow-uri-read"
+	// AttrMaxIncludeDepth the document attribute which limits the depth of the nested file inclusions
+	AttrMaxIncludeDepth = "max-include-depth"
+	// AttrTabSize the document or block attribute which sets the number of spaces of a tab in the files included in verbatim blocks
+	AttrTabSize = "tabsize"
+	// AttrDataURI the document attribute which embeds the local images in the document, as base64-encoded data URIs
+	AttrDataURI = "data-uri"
+	// AttrAssetURIScheme the document attribute which sets the scheme of the URLs of the embedded YouTube and Vimeo videos (`https` by default)
+	AttrAssetURIScheme = "asset-uri-scheme"
 )
 
 // DocumentAttributes the document attributes
 type DocumentAttributes map[string]interface{}
 
+// NewFileAttributes returns the intrinsic document attributes which are derived from the path
+// of the document file (`docfile`, `docdir`, `docname` and `docfilesuffix`), or empty attributes
+// if the given filename is empty
+func NewFileAttributes(filename string) DocumentAttributes {
+	result := DocumentAttributes{}
+	if filename == "" {
+		return result
+	}
+	path, err := filepath.Abs(filename)
+	if err != nil {
+		path = filename
+	}
+	ext := filepath.Ext(path)
+	result[AttrDocFile] = path
+	result[AttrDocDir] = filepath.Dir(path)
+	result[AttrDocName] = strings.TrimSuffix(filepath.Base(path), ext)
+	result[AttrDocFileSuffix] = ext
+	return result
+}
+
+// NewVirtualFileAttributes returns the intrinsic document attributes which are derived from the path
+// of the document file in a virtual filesystem (eg: an `fs.FS`), where the paths are slash-separated and
+// rooted at the top of the filesystem (eg: `/docs/index.adoc`), or empty attributes if the given name is empty
+func NewVirtualFileAttributes(name string) DocumentAttributes {
+	result := DocumentAttributes{}
+	if name == "" {
+		return result
+	}
+	p := path.Join("/", name)
+	ext := path.Ext(p)
+	result[AttrDocFile] = p
+	result[AttrDocDir] = path.Dir(p)
+	result[AttrDocName] = strings.TrimSuffix(path.Base(p), ext)
+	result[AttrDocFileSuffix] = ext
+	return result
+}
+
 // Has returns the true if an entry with the given key exists
 func (a DocumentAttributes) Has(key string) bool {
 	_, ok := a[key]
@@ -79,3 +174,106 @@ func (a DocumentAttributes) GetAsString(key string) (string, bool) {
 	}
 	return "", false
 }
+
+// IncrementCounter increments the counter with the given name and returns its new value.
+// If the counter (or an attribute with the same name) does not exist yet, then it starts
+// with the given initial value (an `int` or a `rune`), or `1` if none was given.
+// Alphabetic counters are incremented to the next letter (eg: `A` -> `B`)
+func (a DocumentAttributes) IncrementCounter(name string, initial interface{}) string {
+	var value string
+	if current, found := a.GetAsString(name); found {
+		if i, err := strconv.Atoi(current); err == nil {
+			value = strconv.Itoa(i + 1)
+		} else if r, size := utf8.DecodeRuneInString(current); size == len(current) && r != utf8.RuneError {
+			value = string(r + 1)
+		} else {
+			value = "1"
+		}
+	} else {
+		switch initial := initial.(type) {
+		case int:
+			value = strconv.Itoa(initial)
+		case rune:
+			value = string(initial)
+		default:
+			value = "1"
+		}
+	}
+	a[name] = value
+	return value
+}
+
+// DocumentAttributeOverride a document attribute which was set (or unset) from the API or the command line
+type DocumentAttributeOverride struct {
+	Value string
+	// Unset true if the attribute is unset (eg: `toc!`)
+	Unset bool
+	// Soft true if the attribute can be overridden by a declaration in the document (eg: `toc@`)
+	Soft bool
+}
+
+// DocumentAttributeOverrides the document attributes which were set (or unset) from the API or the command line.
+// Unless they are soft, they take precedence over the attributes declared (or reset) in the document.
+type DocumentAttributeOverrides map[string]DocumentAttributeOverride
+
+// NewDocumentAttributeOverrides returns the overrides for the given attributes, where:
+// - a name ending with `!` (or starting with `!`) unsets the attribute (eg: `toc!`)
+// - a name or a value ending with `@` makes the attribute soft, i.e., overridable in the document (eg: `toc@` or `toc=left@`)
+func NewDocumentAttributeOverrides(attrs map[string]string) DocumentAttributeOverrides {
+	result := DocumentAttributeOverrides{}
+	for name, value := range attrs {
+		o := DocumentAttributeOverride{}
+		if strings.HasSuffix(name, "@") {
+			name = strings.TrimSuffix(name, "@")
+			o.Soft = true
+		} else if strings.HasSuffix(value, "@") {
+			value = strings.TrimSuffix(value, "@")
+			o.Soft = true
+		}
+		if strings.HasSuffix(name, "!") {
+			name = strings.TrimSuffix(name, "!")
+			o.Unset = true
+		} else if strings.HasPrefix(name, "!") {
+			name = strings.TrimPrefix(name, "!")
+			o.Unset = true
+		} else {
+			o.Value = value
+		}
+		result[name] = o
+	}
+	return result
+}
+
+// Has returns true if the attribute with the given name was set or unset
+func (o DocumentAttributeOverrides) Has(name string) bool {
+	_, found := o[name]
+	return found
+}
+
+// IsSet returns true if the attribute with the given name was set (rather than unset)
+func (o DocumentAttributeOverrides) IsSet(name string) bool {
+	override, found := o[name]
+	return found && !override.Unset
+}
+
+// Locked returns true if the attribute with the given name was set or unset with a hard override,
+// in which case its declarations and resets in the document are ignored
+func (o DocumentAttributeOverrides) Locked(name string) bool {
+	override, found := o[name]
+	return found && !override.Soft
+}
+
+// ApplyTo sets (or unsets) the overridden attributes in the given document attributes,
+// except for the soft overrides of attributes which are already set
+func (o DocumentAttributeOverrides) ApplyTo(attrs DocumentAttributes) {
+	for name, override := range o {
+		if override.Soft && attrs.Has(name) {
+			continue
+		}
+		if override.Unset {
+			delete(attrs, name)
+		} else {
+			attrs[name] = override.Value
+		}
+	}
+}
@@ -1,9 +1,12 @@
 package types_test
 
 import (
+	"path/filepath"
+
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 )
 
@@ -41,4 +44,98 @@ var _ = Describe("document attributes", func() {
 		})
 
 	})
+
+	DescribeTable("counters",
+		func(attributes types.DocumentAttributes, initial interface{}, expected string) {
+			Expect(attributes.IncrementCounter("foo", initial)).To(Equal(expected))
+			Expect(attributes["foo"]).To(Equal(expected))
+		},
+		Entry("new counter", types.DocumentAttributes{}, nil, "1"),
+		Entry("new counter with numeric initial value", types.DocumentAttributes{}, 5, "5"),
+		Entry("new counter with alphabetic initial value", types.DocumentAttributes{}, 'A', "A"),
+		Entry("existing numeric counter", types.DocumentAttributes{"foo": "2"}, 5, "3"),
+		Entry("existing alphabetic counter", types.DocumentAttributes{"foo": "b"}, 'A', "c"),
+	)
+
+	Context("file attributes", func() {
+
+		It("relative filename", func() {
+			dir, err := filepath.Abs("docs")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(types.NewFileAttributes("docs/foo.adoc")).To(Equal(types.DocumentAttributes{
+				types.AttrDocFile:       filepath.Join(dir, "foo.adoc"),
+				types.AttrDocDir:        dir,
+				types.AttrDocName:       "foo",
+				types.AttrDocFileSuffix: ".adoc",
+			}))
+		})
+
+		It("empty filename", func() {
+			Expect(types.NewFileAttributes("")).To(BeEmpty())
+		})
+
+		It("filename in virtual filesystem", func() {
+			Expect(types.NewVirtualFileAttributes("docs/index.adoc")).To(Equal(types.DocumentAttributes{
+				types.AttrDocFile:       "/docs/index.adoc",
+				types.AttrDocDir:        "/docs",
+				types.AttrDocName:       "index",
+				types.AttrDocFileSuffix: ".adoc",
+			}))
+		})
+
+		It("empty filename in virtual filesystem", func() {
+			Expect(types.NewVirtualFileAttributes("")).To(BeEmpty())
+		})
+	})
+
+	DescribeTable("attribute overrides",
+		func(attrs map[string]string, expected types.DocumentAttributeOverrides) {
+			Expect(types.NewDocumentAttributeOverrides(attrs)).To(Equal(expected))
+		},
+		Entry("hard value", map[string]string{"foo": "bar"},
+			types.DocumentAttributeOverrides{"foo": {Value: "bar"}}),
+		Entry("hard empty value", map[string]string{"foo": ""},
+			types.DocumentAttributeOverrides{"foo": {}}),
+		Entry("soft value", map[string]string{"foo": "bar@"},
+			types.DocumentAttributeOverrides{"foo": {Value: "bar", Soft: true}}),
+		Entry("soft name", map[string]string{"foo@": "bar"},
+			types.DocumentAttributeOverrides{"foo": {Value: "bar", Soft: true}}),
+		Entry("hard unset", map[string]string{"foo!": ""},
+			types.DocumentAttributeOverrides{"foo": {Unset: true}}),
+		Entry("hard unset with prefix", map[string]string{"!foo": ""},
+			types.DocumentAttributeOverrides{"foo": {Unset: true}}),
+		Entry("soft unset", map[string]string{"foo!@": ""},
+			types.DocumentAttributeOverrides{"foo": {Unset: true, Soft: true}}),
+	)
+
+	It("apply attribute overrides", func() {
+		attrs := types.DocumentAttributes{
+			"soft": "document",
+			"hard": "document",
+			"gone": "document",
+		}
+		types.NewDocumentAttributeOverrides(map[string]string{
+			"soft":  "api@",
+			"hard":  "api",
+			"gone!": "",
+			"new@":  "api",
+		}).ApplyTo(attrs)
+		Expect(attrs).To(Equal(types.DocumentAttributes{
+			"soft": "document",
+			"hard": "api",
+			"new":  "api",
+		}))
+	})
+
+	It("check attribute overrides which are set", func() {
+		overrides := types.NewDocumentAttributeOverrides(map[string]string{
+			"hard":  "",
+			"soft@": "",
+			"gone!": "",
+		})
+		Expect(overrides.IsSet("hard")).To(BeTrue())
+		Expect(overrides.IsSet("soft")).To(BeTrue())
+		Expect(overrides.IsSet("gone")).To(BeFalse())
+		Expect(overrides.IsSet("unknown")).To(BeFalse())
+	})
 })
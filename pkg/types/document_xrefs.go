@@ -3,4 +3,12 @@ package types
 // ElementReferences the element references in the document
 type ElementReferences map[string]interface{}
 
+// CaptionReference the reference to a block with a caption (eg: `Figure 1. A title`)
+type CaptionReference struct {
+	Label         string // eg: `Figure`
+	Number        int    // `0` if the caption is not numbered
+	Title         string
+	TitlePosition Position // the position of the title in the document, if it is known
+}
+
 // ElementReferencesCollector the visitor that traverses the whole document structure in search for elements with an ID
@@ -21,6 +21,11 @@ const (
 	AttrIDPrefix string = "idprefix"
 	// AttrTitle the key to retrieve the title in the element attributes
 	AttrTitle string = "title"
+	// AttrTitlePosition the key to retrieve the position of the title in the document, if it is known
+	AttrTitlePosition string = "titlePosition"
+	// AttrTitleElements the key to retrieve the elements of the title, once parsed along with the document
+	// (ie, when the title contains index terms)
+	AttrTitleElements string = "titleElements"
 	// AttrAuthors the key to the authors declared after the section level 0 (at the beginning of the doc)
 	AttrAuthors string = "authors"
 	// AttrRevision the key to the revision declared after the section level 0 (at the beginning of the doc)
@@ -29,6 +34,22 @@ const (
 	AttrTableOfContents = "toc"
 	// AttrTableOfContentsLevels the document attribute which specifies the number of levels to display in the ToC
 	AttrTableOfContentsLevels = "toclevels"
+	// AttrTableOfContentsTitle the document attribute which specifies the title of the ToC (`Table of Contents` by default)
+	AttrTableOfContentsTitle = "toc-title"
+	// AttrTableOfContentsClass the document attribute which specifies the CSS class of the ToC (`toc`, or `toc2` for a ToC on the left or right side)
+	AttrTableOfContentsClass = "toc-class"
+	// AttrFigureCaption the document attribute which specifies the caption label of the images with a title (`Figure` by default)
+	AttrFigureCaption = "figure-caption"
+	// AttrTableCaption the document attribute which specifies the caption label of the tables with a title (`Table` by default)
+	AttrTableCaption = "table-caption"
+	// AttrExampleCaption the document attribute which specifies the caption label of the example blocks with a title (`Example` by default)
+	AttrExampleCaption = "example-caption"
+	// AttrListingCaption the document attribute which specifies the caption label of the listing and source blocks with a title (unset by default)
+	AttrListingCaption = "listing-caption"
+	// AttrCaption the attribute which overrides the caption of a block (an empty value disables the caption)
+	AttrCaption = "caption"
+	// AttrXRefStyle the document attribute which specifies how the cross-references to blocks with a caption are rendered (`full`, `short` or `basic`)
+	AttrXRefStyle = "xrefstyle"
 	// AttrRole the key to retrieve the role in the element attributes
 	AttrRole string = "role"
 	// AttrInlineLink the key to retrieve the link in the element attributes
@@ -57,6 +78,18 @@ const (
 	AttrLineRanges = "lines"
 	// AttrTagRanges the `tag`/`tags` attribute used in file inclusions
 	AttrTagRanges = "tags"
+	// AttrIncludeDepth the `depth` attribute used in file inclusions, to limit the depth of the nested file inclusions
+	AttrIncludeDepth = "depth"
+	// AttrIncludeIndent the `indent` attribute used in file inclusions, to re-indent the included lines
+	AttrIncludeIndent = "indent"
+	// AttrIncludeEncoding the `encoding` attribute used in file inclusions, to read files which are not UTF-8 encoded
+	AttrIncludeEncoding = "encoding"
+	// AttrOptions the `options` attribute, whose values are converted into `%<option>` attributes
+	AttrOptions = "options"
+	// AttrOpts the `opts` attribute, an alias for `options`
+	AttrOpts = "opts"
+	// AttrSubstitutions the `subs` attribute, which customizes the substitutions applied on the content of a block
+	AttrSubstitutions = "subs"
 )
 
 // ElementWithAttributes an element on which attributes can be added/set
@@ -79,12 +112,17 @@ func NewInlineElementID(id string) (ElementAttributes, error) {
 	return ElementAttributes{AttrID: id}, nil
 }
 
-// NewElementTitle initializes a new attribute map with a single entry for the title using the given value
-func NewElementTitle(title string) (ElementAttributes, error) {
+// NewElementTitle initializes a new attribute map with an entry for the title using the given value,
+// and an entry for the position of the title in the document, if it is known
+func NewElementTitle(title string, position Position) (ElementAttributes, error) {
 	log.Debugf("initializing a new ElementTitle with content=%s", title)
-	return ElementAttributes{
+	result := ElementAttributes{
 		AttrTitle: title,
-	}, nil
+	}
+	if position.Line > 0 {
+		result[AttrTitlePosition] = position
+	}
+	return result, nil
 }
 
 // NewElementRole initializes a new attribute map with a single entry for the title using the given value
@@ -109,6 +147,10 @@ func NewAttributeGroup(attributes []interface{}) (ElementAttributes, error) {
 		if a, ok := a.(ElementAttributes); ok {
 			for k, v := range a {
 				// log.Debugf("adding attribute %v='%v'", k, v)
+				if k == AttrOptions || k == AttrOpts {
+					result.addOptions(v)
+					continue
+				}
 				result[k] = v
 			}
 		} else {
@@ -119,6 +161,20 @@ func NewAttributeGroup(attributes []interface{}) (ElementAttributes, error) {
 	return result, nil
 }
 
+// addOptions adds an `%<option>` attribute for each value of the given `options` attribute (eg: `options="hardbreaks"`)
+// so that they can be processed the same way as the shorthand syntax (eg: `[%hardbreaks]`)
+func (a ElementAttributes) addOptions(value interface{}) {
+	options, ok := value.(string)
+	if !ok {
+		return
+	}
+	for _, option := range strings.Split(options, ",") {
+		if option = strings.TrimSpace(option); option != "" {
+			a["%"+option] = nil
+		}
+	}
+}
+
 // NewGenericAttribute initializes a new ElementAttribute from the given key and optional value
 func NewGenericAttribute(key string, value interface{}) (ElementAttributes, error) {
 	result := make(map[string]interface{})
@@ -168,16 +224,24 @@ func NewQuoteAttributes(kind string, author, title interface{}) (map[string]inte
 	return result, nil
 }
 
+// NewBlockStyleAttribute initializes a new attribute map with a single entry for the given kind of block
+func NewBlockStyleAttribute(kind string) (ElementAttributes, error) {
+	return ElementAttributes{AttrKind: BlockKind(kind)}, nil
+}
+
 // NewLiteralAttribute initializes a new attribute map with a single entry for the literal kind of block
 func NewLiteralAttribute() (ElementAttributes, error) {
 	return ElementAttributes{AttrKind: Literal}, nil
 }
 
-// NewSourceAttributes initializes a new attribute map with two entries, one for the kind of element ("source") and another optional one for the language of the source code
-func NewSourceAttributes(language interface{}) (ElementAttributes, error) {
-	result := ElementAttributes{
-		AttrKind: Source,
+// NewSourceAttributes initializes a new attribute map with two entries, one for the kind of element ("source") and another optional one for the language of the source code,
+// along with the other (optional) attributes (eg: `[source,go,subs="+quotes"]`)
+func NewSourceAttributes(language interface{}, others []interface{}) (ElementAttributes, error) {
+	result, err := NewAttributeGroup(others)
+	if err != nil {
+		return result, errors.Wrapf(err, "failed to initialize source attributes")
 	}
+	result[AttrKind] = Source
 	if language, ok := language.(string); ok {
 		result[AttrLanguage] = strings.TrimSpace(language)
 	}
@@ -0,0 +1,239 @@
+package types
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// IndexTerms the index terms of a document, in their order of occurrence. Index terms are "collected"
+// during the parsing phase and displayed in the section with the `index` style during the rendering.
+type IndexTerms []IndexTerm
+
+// IndexOf returns the index of the given term in the index terms.
+func (t IndexTerms) IndexOf(term IndexTerm) (int, bool) {
+	for i, it := range t {
+		if it.ID == term.ID {
+			return i, true
+		}
+	}
+	return -1, false
+}
+
+// TitleParser the function which parses the (raw) title of a block
+type TitleParser func(title string) (InlineElements, error)
+
+// IndexTermsCollector the visitor that traverses the inline elements in search for index terms
+type IndexTermsCollector struct {
+	IndexTerms IndexTerms
+	parseTitle TitleParser
+}
+
+var _ Visitor = &IndexTermsCollector{}
+
+// NewIndexTermsCollector initializes a new IndexTermsCollector, which uses the given parser (if not nil)
+// to collect the index terms in the titles of the blocks
+func NewIndexTermsCollector(parseTitle TitleParser) *IndexTermsCollector {
+	return &IndexTermsCollector{
+		parseTitle: parseTitle,
+	}
+}
+
+// Visit Implements Visitable#Visit()
+func (c *IndexTermsCollector) Visit(element Visitable) error {
+	if term, ok := element.(IndexTerm); ok {
+		c.IndexTerms = append(c.IndexTerms, term)
+		log.Debugf("indexed term %v at %d", term.Terms, len(c.IndexTerms))
+	}
+	return nil
+}
+
+// CollectIndexTerms collects the index terms of the given block, including in its title
+// and in its nested blocks (eg: in the items of a list or in the elements of a delimited block)
+func (c *IndexTermsCollector) CollectIndexTerms(element interface{}) error {
+	if err := c.collectInTitle(element); err != nil {
+		return err
+	}
+	switch e := element.(type) {
+	case Section:
+		if err := e.Title.AcceptVisitor(c); err != nil {
+			return err
+		}
+		return c.collectAll(e.Elements)
+	case DiscreteHeading:
+		return e.Title.AcceptVisitor(c)
+	case Preamble:
+		return c.collectAll(e.Elements)
+	case Paragraph:
+		return e.AcceptVisitor(c)
+	case DelimitedBlock:
+		return c.collectAll(e.Elements)
+	case OrderedList:
+		for _, item := range e.Items {
+			if err := c.collectAll(item.Elements); err != nil {
+				return err
+			}
+		}
+	case UnorderedList:
+		for _, item := range e.Items {
+			if err := c.collectAll(item.Elements); err != nil {
+				return err
+			}
+		}
+	case LabeledList:
+		for _, item := range e.Items {
+			if err := c.collectAll(item.Elements); err != nil {
+				return err
+			}
+		}
+	case Table:
+		for _, line := range append([]TableLine{e.Header}, e.Lines...) {
+			for _, cell := range line.Cells {
+				if err := cell.AcceptVisitor(c); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// collectInTitle collects the index terms in the title of the given block, which is parsed and retained
+// in the attributes of the block, so that the same index terms are rendered along with the block
+func (c *IndexTermsCollector) collectInTitle(element interface{}) error {
+	attrs := blockAttributes(element)
+	title := strings.TrimSpace(attrs.GetAsString(AttrTitle))
+	if c.parseTitle == nil || (!strings.Contains(title, "((") && !strings.Contains(title, "indexterm")) {
+		return nil
+	}
+	elements, err := c.parseTitle(title)
+	if err != nil {
+		return errors.Wrapf(err, "unable to parse block title '%s'", title)
+	}
+	attrs[AttrTitleElements] = elements
+	return elements.AcceptVisitor(c)
+}
+
+// blockAttributes returns the attributes of the given block, or `nil` if it is not a block with a title
+func blockAttributes(element interface{}) ElementAttributes {
+	switch e := element.(type) {
+	case Paragraph:
+		return e.Attributes
+	case DelimitedBlock:
+		return e.Attributes
+	case LiteralBlock:
+		return e.Attributes
+	case ImageBlock:
+		return e.Attributes
+	case VideoBlock:
+		return e.Attributes
+	case AudioBlock:
+		return e.Attributes
+	case Table:
+		return e.Attributes
+	case OrderedList:
+		return e.Attributes
+	case UnorderedList:
+		return e.Attributes
+	case LabeledList:
+		return e.Attributes
+	default:
+		return nil
+	}
+}
+
+func (c *IndexTermsCollector) collectAll(elements []interface{}) error {
+	for _, element := range elements {
+		if err := c.CollectIndexTerms(element); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexCategory the entries of the index whose primary term starts with the same letter
+type IndexCategory struct {
+	Letter  string // the uppercase letter, or `#` for the terms which do not start with a letter
+	Entries []IndexEntry
+}
+
+// IndexEntry an entry of the index, with the occurrences of its term in the document
+// and its sub-entries (ie, the secondary or tertiary terms)
+type IndexEntry struct {
+	Term string
+	// Occurrences the indexes of the occurrences of the term in the index terms of the document
+	Occurrences []int
+	Entries     []IndexEntry
+}
+
+// Catalog returns the index terms grouped by primary, secondary and tertiary terms,
+// sorted alphabetically (ignoring the case) and split in categories by first letter
+func (t IndexTerms) Catalog() []IndexCategory {
+	root := IndexEntry{}
+	for i, term := range t {
+		if len(term.Terms) == 0 {
+			continue
+		}
+		entry := &root
+		for _, s := range term.Terms {
+			entry = entry.subEntry(s)
+		}
+		entry.Occurrences = append(entry.Occurrences, i)
+	}
+	root.sort()
+	categories := map[string][]IndexEntry{}
+	letters := []string{}
+	for _, entry := range root.Entries {
+		letter := indexLetter(entry.Term)
+		if _, found := categories[letter]; !found {
+			letters = append(letters, letter)
+		}
+		categories[letter] = append(categories[letter], entry)
+	}
+	sort.Strings(letters) // `#` comes first
+	result := make([]IndexCategory, len(letters))
+	for i, letter := range letters {
+		result[i] = IndexCategory{
+			Letter:  letter,
+			Entries: categories[letter],
+		}
+	}
+	return result
+}
+
+// subEntry returns the sub-entry with the given term, which is created if it did not exist yet
+func (e *IndexEntry) subEntry(term string) *IndexEntry {
+	for i := range e.Entries {
+		if e.Entries[i].Term == term {
+			return &e.Entries[i]
+		}
+	}
+	e.Entries = append(e.Entries, IndexEntry{
+		Term: term,
+	})
+	return &e.Entries[len(e.Entries)-1]
+}
+
+func (e *IndexEntry) sort() {
+	sort.SliceStable(e.Entries, func(i, j int) bool {
+		a, b := strings.ToLower(e.Entries[i].Term), strings.ToLower(e.Entries[j].Term)
+		if a == b {
+			return e.Entries[i].Term < e.Entries[j].Term
+		}
+		return a < b
+	})
+	for i := range e.Entries {
+		e.Entries[i].sort()
+	}
+}
+
+func indexLetter(term string) string {
+	if r, _ := utf8.DecodeRuneInString(term); unicode.IsLetter(r) {
+		return string(unicode.ToUpper(r))
+	}
+	return "#"
+}
@@ -0,0 +1,160 @@
+package types_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("index terms", func() {
+
+	It("collect index terms in nested blocks", func() {
+		// given
+		term1 := types.IndexTerm{ID: 0, Terms: []string{"cats"}, Visible: true}
+		term2 := types.IndexTerm{ID: 1, Terms: []string{"lions"}}
+		term3 := types.IndexTerm{ID: 2, Terms: []string{"tigers"}}
+		section := types.Section{
+			Title: types.InlineElements{},
+			Elements: []interface{}{
+				types.Paragraph{
+					Lines: []types.InlineElements{
+						{
+							types.StringElement{Content: "some "},
+							types.QuotedText{
+								Kind:     types.Bold,
+								Elements: types.InlineElements{term1},
+							},
+						},
+					},
+				},
+				types.DelimitedBlock{
+					Kind: types.Example,
+					Elements: []interface{}{
+						types.OrderedList{
+							Items: []types.OrderedListItem{
+								{
+									Elements: []interface{}{
+										types.Paragraph{
+											Lines: []types.InlineElements{{term2}},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				types.Table{
+					Lines: []types.TableLine{
+						{
+							Cells: []types.InlineElements{{term3}},
+						},
+					},
+				},
+			},
+		}
+		c := types.NewIndexTermsCollector(nil)
+		// when
+		err := c.CollectIndexTerms(section)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(c.IndexTerms).To(Equal(types.IndexTerms{term1, term2, term3}))
+	})
+
+	It("collect index terms in the title of a block", func() {
+		// given
+		term1 := types.IndexTerm{ID: 0, Terms: []string{"birds"}, Visible: true}
+		term2 := types.IndexTerm{ID: 1, Terms: []string{"eagles"}}
+		title := types.InlineElements{
+			types.StringElement{Content: "some "},
+			term1,
+		}
+		image := types.ImageBlock{
+			Attributes: types.ElementAttributes{
+				types.AttrTitle: "some ((birds))",
+			},
+		}
+		list := types.UnorderedList{
+			Items: []types.UnorderedListItem{
+				{
+					Elements: []interface{}{
+						types.Paragraph{
+							Lines: []types.InlineElements{{term2}},
+						},
+					},
+				},
+			},
+		}
+		c := types.NewIndexTermsCollector(func(t string) (types.InlineElements, error) {
+			Expect(t).To(Equal("some ((birds))"))
+			return title, nil
+		})
+		// when
+		err := c.CollectIndexTerms(image)
+		Expect(err).ToNot(HaveOccurred())
+		err = c.CollectIndexTerms(list)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(c.IndexTerms).To(Equal(types.IndexTerms{term1, term2}))
+		Expect(image.Attributes[types.AttrTitleElements]).To(Equal(title))
+	})
+
+	It("catalog of index terms", func() {
+		// given
+		terms := types.IndexTerms{
+			{ID: 0, Terms: []string{"lions"}},
+			{ID: 1, Terms: []string{"Big cats", "wild", "tigers"}},
+			{ID: 2, Terms: []string{"big dogs"}},
+			{ID: 3, Terms: []string{"Big cats"}},
+			{ID: 4, Terms: []string{"lions"}},
+			{ID: 5, Terms: []string{"Big cats", "domestic"}},
+			{ID: 6, Terms: []string{"3D cats"}},
+		}
+		// when
+		catalog := terms.Catalog()
+		// then
+		Expect(catalog).To(Equal([]types.IndexCategory{
+			{
+				Letter: "#",
+				Entries: []types.IndexEntry{
+					{Term: "3D cats", Occurrences: []int{6}},
+				},
+			},
+			{
+				Letter: "B",
+				Entries: []types.IndexEntry{
+					{
+						Term:        "Big cats",
+						Occurrences: []int{3},
+						Entries: []types.IndexEntry{
+							{Term: "domestic", Occurrences: []int{5}},
+							{
+								Term: "wild",
+								Entries: []types.IndexEntry{
+									{Term: "tigers", Occurrences: []int{1}},
+								},
+							},
+						},
+					},
+					{Term: "big dogs", Occurrences: []int{2}},
+				},
+			},
+			{
+				Letter: "L",
+				Entries: []types.IndexEntry{
+					{Term: "lions", Occurrences: []int{0, 4}},
+				},
+			},
+		}))
+	})
+
+	It("no catalog without index terms", func() {
+		Expect(types.IndexTerms{}.Catalog()).To(BeEmpty())
+	})
+
+	It("index term without any term", func() {
+		term := types.IndexTerm{ID: 0, Terms: []string{}}
+		Expect(term.PrimaryTerm()).To(BeEmpty())
+		Expect(types.IndexTerms{term}.Catalog()).To(BeEmpty())
+	})
+})
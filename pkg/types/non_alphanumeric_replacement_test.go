@@ -58,4 +58,22 @@ var _ = Describe("normalizing string", func() {
 		}
 		Expect(source).To(EqualWithoutNonAlphanumeric("a_section_title_with_bold_content"))
 	})
+
+	It("content with counters", func() {
+		// == Part {counter2:part} {counter:part} {counter:chapter:A}
+		source := types.InlineElements{
+			types.StringElement{Content: "Part "},
+			types.CounterSubstitution{Name: "part", Hidden: true},
+			types.StringElement{Content: " "},
+			types.CounterSubstitution{Name: "part"},
+			types.StringElement{Content: " "},
+			types.CounterSubstitution{Name: "chapter", Value: 'A'},
+		}
+		counters := types.DocumentAttributes{}
+		Expect(types.ReplaceNonAlphanumericsWithCounters(source, "_", counters)).To(Equal("part_2_a"))
+		Expect(counters).To(Equal(types.DocumentAttributes{
+			"part":    "2",
+			"chapter": "A",
+		}))
+	})
 })
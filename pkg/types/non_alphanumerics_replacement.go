@@ -18,11 +18,24 @@ func ReplaceNonAlphanumerics(source InlineElements, replacement string) (string,
 	return v.normalizedContent(), nil
 }
 
+// ReplaceNonAlphanumericsWithCounters replace all non alpha numeric characters with the given `replacement`,
+// after substituting the counters with their values. The counters are incremented in the given attributes
+func ReplaceNonAlphanumericsWithCounters(source InlineElements, replacement string, counters DocumentAttributes) (string, error) {
+	v := newReplaceNonAlphanumericsVisitor(replacement)
+	v.counters = counters
+	err := source.AcceptVisitor(v)
+	if err != nil {
+		return "", err
+	}
+	return v.normalizedContent(), nil
+}
+
 //ReplaceNonAlphanumericsVisitor a visitor that builds a string representation of the visited elements,
 // in which all non-alphanumeric characters have been replaced with a "_"
 type ReplaceNonAlphanumericsVisitor struct {
 	buf         bytes.Buffer
 	replacement string
+	counters    DocumentAttributes // the attributes in which the counters are incremented, or nil if they are ignored
 }
 
 var _ Visitor = &ReplaceNonAlphanumericsVisitor{}
@@ -39,21 +52,43 @@ func newReplaceNonAlphanumericsVisitor(replacement string) *ReplaceNonAlphanumer
 // Visit method called when an element is visited
 func (v *ReplaceNonAlphanumericsVisitor) Visit(element Visitable) error {
 	// log.Debugf("visiting element of type '%T'", element)
-	if element, ok := element.(StringElement); ok {
-		if v.buf.Len() > 0 {
-			v.buf.WriteString("_")
+	switch element := element.(type) {
+	case StringElement:
+		return v.write(element.Content)
+	case IndexTerm:
+		// the term is part of the content, unless it is concealed
+		if element.Visible {
+			return v.write(element.PrimaryTerm())
 		}
-		normalized, err := v.normalize(element.Content)
-		if err != nil {
-			return errors.Wrapf(err, "error while normalizing String Element")
+	case CounterSubstitution:
+		if v.counters != nil {
+			value := v.counters.IncrementCounter(element.Name, element.Value)
+			if !element.Hidden {
+				return v.write(value)
+			}
 		}
-		v.buf.WriteString(normalized)
-		return nil
 	}
 	// other types are ignored
 	return nil
 }
 
+// write appends the normalized content to the buffer
+func (v *ReplaceNonAlphanumericsVisitor) write(content string) error {
+	normalized, err := v.normalize(content)
+	if err != nil {
+		return errors.Wrapf(err, "error while normalizing String Element")
+	}
+	if normalized == "" {
+		// eg: the space between 2 counters
+		return nil
+	}
+	if v.buf.Len() > 0 {
+		v.buf.WriteString("_")
+	}
+	v.buf.WriteString(normalized)
+	return nil
+}
+
 // normalize returns the normalized content
 func (v *ReplaceNonAlphanumericsVisitor) normalize(source string) (string, error) {
 	buf := bytes.NewBuffer(nil)
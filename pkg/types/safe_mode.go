@@ -0,0 +1,55 @@
+package types
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// SafeMode the security level of the conversion, which restricts the access to the files and remote resources,
+// and the output of raw content (in the same spirit as Asciidoctor's safe modes)
+type SafeMode int
+
+const (
+	// SafeModeUnsafe no restrictions (default)
+	SafeModeUnsafe SafeMode = 0
+	// SafeModeSafe restricts the file inclusions to the base directory
+	SafeModeSafe SafeMode = 1
+	// SafeModeServer restricts the file inclusions to the base directory, blocks the remote file inclusions (even if the
+	// `allow-uri-read` attribute is set) and escapes the raw HTML of the passthroughs
+	SafeModeServer SafeMode = 10
+	// SafeModeSecure same as SafeModeServer, but all file inclusions are replaced with links
+	SafeModeSecure SafeMode = 20
+)
+
+// AttrSafeModeName the intrinsic document attribute which contains the name of the safe mode (eg: `secure`)
+const AttrSafeModeName = "safe-mode-name"
+
+// AttrSafeModeLevel the intrinsic document attribute which contains the level of the safe mode (eg: `20`)
+const AttrSafeModeLevel = "safe-mode-level"
+
+var safeModeNames = map[SafeMode]string{
+	SafeModeUnsafe: "unsafe",
+	SafeModeSafe:   "safe",
+	SafeModeServer: "server",
+	SafeModeSecure: "secure",
+}
+
+// NewSafeMode returns the safe mode with the given name (`unsafe`, `safe`, `server` or `secure`),
+// or an error if the name is unknown
+func NewSafeMode(name string) (SafeMode, error) {
+	for mode, n := range safeModeNames {
+		if strings.EqualFold(name, n) {
+			return mode, nil
+		}
+	}
+	return SafeModeUnsafe, errors.Errorf("unknown safe mode: '%s' (expected 'unsafe', 'safe', 'server' or 'secure')", name)
+}
+
+// String returns the name of the safe mode
+func (m SafeMode) String() string {
+	if name, found := safeModeNames[m]; found {
+		return name
+	}
+	return "unknown"
+}
@@ -0,0 +1,31 @@
+package types_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("safe modes", func() {
+
+	DescribeTable("valid safe modes",
+		func(name string, expected types.SafeMode, expectedName string) {
+			mode, err := types.NewSafeMode(name)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(mode).To(Equal(expected))
+			Expect(mode.String()).To(Equal(expectedName))
+		},
+		Entry("unsafe", "unsafe", types.SafeModeUnsafe, "unsafe"),
+		Entry("safe", "safe", types.SafeModeSafe, "safe"),
+		Entry("server", "server", types.SafeModeServer, "server"),
+		Entry("secure", "secure", types.SafeModeSecure, "secure"),
+		Entry("secure in uppercase", "SECURE", types.SafeModeSecure, "secure"),
+	)
+
+	It("invalid safe mode", func() {
+		_, err := types.NewSafeMode("paranoid")
+		Expect(err).To(HaveOccurred())
+	})
+})
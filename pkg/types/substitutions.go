@@ -0,0 +1,191 @@
+package types
+
+import (
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// ------------------------------------------
+// Substitutions
+// ------------------------------------------
+
+// SubstitutionKind a kind of substitution (ie, a step of the substitution pipeline) that applies on the content of a block or an inline passthrough
+type SubstitutionKind string
+
+const (
+	// SpecialCharactersSubstitution the substitution which escapes the `<`, `>` and `&` characters
+	SpecialCharactersSubstitution SubstitutionKind = "specialcharacters"
+	// QuotesSubstitution the substitution which applies the text formatting (bold, italic, monospace, etc.)
+	QuotesSubstitution SubstitutionKind = "quotes"
+	// AttributesSubstitution the substitution which replaces the references to document attributes with their value
+	AttributesSubstitution SubstitutionKind = "attributes"
+	// ReplacementsSubstitution the substitution which replaces textual characters (eg: `...`) with their typographic form
+	ReplacementsSubstitution SubstitutionKind = "replacements"
+	// MacrosSubstitution the substitution which processes the inline macros (links, images, footnotes, passthroughs, etc.)
+	MacrosSubstitution SubstitutionKind = "macros"
+	// PostReplacementsSubstitution the substitution which processes the line breaks (` +` at the end of a line)
+	PostReplacementsSubstitution SubstitutionKind = "post_replacements"
+	// CalloutsSubstitution the substitution which processes the callouts in verbatim blocks
+	CalloutsSubstitution SubstitutionKind = "callouts"
+)
+
+// Substitutions the (ordered) substitutions to apply
+type Substitutions []SubstitutionKind
+
+// Has returns `true` if the given kind of substitution is part of this set of substitutions
+func (s Substitutions) Has(kind SubstitutionKind) bool {
+	for _, k := range s {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NormalSubstitutions the substitutions applied by default on paragraphs and most blocks
+	NormalSubstitutions = Substitutions{
+		SpecialCharactersSubstitution,
+		QuotesSubstitution,
+		AttributesSubstitution,
+		ReplacementsSubstitution,
+		MacrosSubstitution,
+		PostReplacementsSubstitution,
+	}
+	// VerbatimSubstitutions the substitutions applied by default on listing, source and literal blocks
+	VerbatimSubstitutions = Substitutions{
+		SpecialCharactersSubstitution,
+		CalloutsSubstitution,
+	}
+	// NoSubstitutions no substitution at all, as applied by default on passthrough macros and comments
+	NoSubstitutions = Substitutions{}
+)
+
+// the substitution groups and the aliases, which can be used in the `subs` attribute
+var substitutionGroups = map[string]Substitutions{
+	"none":         NoSubstitutions,
+	"normal":       NormalSubstitutions,
+	"verbatim":     VerbatimSubstitutions,
+	"specialchars": {SpecialCharactersSubstitution},
+}
+
+// all valid kinds of substitutions
+var substitutionKinds = Substitutions{
+	SpecialCharactersSubstitution,
+	QuotesSubstitution,
+	AttributesSubstitution,
+	ReplacementsSubstitution,
+	MacrosSubstitution,
+	PostReplacementsSubstitution,
+	CalloutsSubstitution,
+}
+
+// the single-letter shorthands, which can be used on inline passthrough macros (eg: `pass:q,a[...]`)
+var substitutionHints = map[string]string{
+	"a": string(AttributesSubstitution),
+	"c": string(SpecialCharactersSubstitution),
+	"m": string(MacrosSubstitution),
+	"n": "normal",
+	"p": string(PostReplacementsSubstitution),
+	"q": string(QuotesSubstitution),
+	"r": string(ReplacementsSubstitution),
+	"v": "verbatim",
+}
+
+// DefaultSubstitutions returns the substitutions which apply by default on the content of a block of the given kind
+func DefaultSubstitutions(kind BlockKind) Substitutions {
+	switch kind {
+	case Listing, Source, Fenced, Literal:
+		return VerbatimSubstitutions
+	case Comment:
+		return NoSubstitutions
+	default:
+		return NormalSubstitutions
+	}
+}
+
+// NewSubstitutions returns the substitutions described by the given value of a `subs` attribute, which can be:
+// - a list of substitutions and/or groups (eg: `subs="quotes,macros"` or `subs=normal`), which replaces the default substitutions
+// - a list of modifiers (eg: `subs="+attributes,-callouts"`), which append (`+attributes`), prepend (`attributes+`)
+// or remove (`-callouts`) substitutions to/from the given defaults
+// Unknown substitutions are ignored.
+func NewSubstitutions(value string, defaults Substitutions) Substitutions {
+	return newSubstitutions(value, defaults, false)
+}
+
+// NewInlineSubstitutions returns the substitutions described by the given value of an inline passthrough macro,
+// in which the single-letter shorthands are also allowed (eg: `pass:q,a[...]`).
+// By default, no substitution applies on the content of the macro
+func NewInlineSubstitutions(value string) Substitutions {
+	return newSubstitutions(value, NoSubstitutions, true)
+}
+
+func newSubstitutions(value string, defaults Substitutions, inline bool) Substitutions {
+	value = strings.Replace(value, " ", "", -1)
+	if value == "" {
+		return defaults
+	}
+	modifiers := strings.ContainsAny(value, "+-")
+	var candidates Substitutions
+	for _, key := range strings.Split(value, ",") {
+		operation := ""
+		if modifiers {
+			switch {
+			case strings.HasPrefix(key, "+"):
+				operation = "append"
+				key = strings.TrimPrefix(key, "+")
+			case strings.HasPrefix(key, "-"):
+				operation = "remove"
+				key = strings.TrimPrefix(key, "-")
+			case strings.HasSuffix(key, "+"):
+				operation = "prepend"
+				key = strings.TrimSuffix(key, "+")
+			}
+		}
+		if hint, ok := substitutionHints[key]; ok && inline {
+			key = hint
+		}
+		var kinds Substitutions
+		if inline && key == "verbatim" {
+			// callouts do not apply on inline content
+			kinds = Substitutions{SpecialCharactersSubstitution}
+		} else if group, ok := substitutionGroups[key]; ok {
+			kinds = group
+		} else {
+			kinds = Substitutions{SubstitutionKind(key)}
+		}
+		if candidates == nil {
+			candidates = Substitutions{}
+			if operation != "" {
+				candidates = append(candidates, defaults...)
+			}
+		}
+		switch operation {
+		case "prepend":
+			candidates = append(append(Substitutions{}, kinds...), candidates...)
+		case "remove":
+			remaining := Substitutions{}
+			for _, c := range candidates {
+				if !kinds.Has(c) {
+					remaining = append(remaining, c)
+				}
+			}
+			candidates = remaining
+		default:
+			candidates = append(candidates, kinds...)
+		}
+	}
+	// retain valid substitutions, without duplicates
+	result := Substitutions{}
+	for _, c := range candidates {
+		if !substitutionKinds.Has(c) {
+			log.Warnf("invalid substitution: '%s'", c)
+			continue
+		}
+		if !result.Has(c) {
+			result = append(result, c)
+		}
+	}
+	return result
+}
@@ -0,0 +1,80 @@
+package types_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("substitutions", func() {
+
+	DescribeTable("block substitutions",
+		func(value string, defaults, expected types.Substitutions) {
+			Expect(types.NewSubstitutions(value, defaults)).To(Equal(expected))
+		},
+		Entry("no value", "", types.VerbatimSubstitutions, types.VerbatimSubstitutions),
+		Entry("none", "none", types.NormalSubstitutions, types.Substitutions{}),
+		Entry("normal", "normal", types.VerbatimSubstitutions, types.NormalSubstitutions),
+		Entry("verbatim with quotes", "verbatim,quotes", types.NormalSubstitutions,
+			types.Substitutions{
+				types.SpecialCharactersSubstitution,
+				types.CalloutsSubstitution,
+				types.QuotesSubstitution,
+			}),
+		Entry("append and remove", "+attributes,-callouts", types.VerbatimSubstitutions,
+			types.Substitutions{
+				types.SpecialCharactersSubstitution,
+				types.AttributesSubstitution,
+			}),
+		Entry("prepend", "quotes+", types.VerbatimSubstitutions,
+			types.Substitutions{
+				types.QuotesSubstitution,
+				types.SpecialCharactersSubstitution,
+				types.CalloutsSubstitution,
+			}),
+		Entry("remove group", "-verbatim", types.NormalSubstitutions,
+			types.Substitutions{
+				types.QuotesSubstitution,
+				types.AttributesSubstitution,
+				types.ReplacementsSubstitution,
+				types.MacrosSubstitution,
+				types.PostReplacementsSubstitution,
+			}),
+		Entry("specialchars alias with spaces", "specialchars, macros", types.NormalSubstitutions,
+			types.Substitutions{
+				types.SpecialCharactersSubstitution,
+				types.MacrosSubstitution,
+			}),
+		Entry("duplicate and unknown substitutions", "quotes,unknown,quotes", types.NormalSubstitutions,
+			types.Substitutions{
+				types.QuotesSubstitution,
+			}),
+	)
+
+	DescribeTable("inline substitutions",
+		func(value string, expected types.Substitutions) {
+			Expect(types.NewInlineSubstitutions(value)).To(Equal(expected))
+		},
+		Entry("no value", "", types.Substitutions{}),
+		Entry("shorthands", "q,a", types.Substitutions{
+			types.QuotesSubstitution,
+			types.AttributesSubstitution,
+		}),
+		Entry("verbatim without callouts", "v", types.Substitutions{
+			types.SpecialCharactersSubstitution,
+		}),
+		Entry("full names", "quotes,macros", types.Substitutions{
+			types.QuotesSubstitution,
+			types.MacrosSubstitution,
+		}),
+	)
+
+	It("default substitutions", func() {
+		Expect(types.DefaultSubstitutions(types.Listing)).To(Equal(types.VerbatimSubstitutions))
+		Expect(types.DefaultSubstitutions(types.Literal)).To(Equal(types.VerbatimSubstitutions))
+		Expect(types.DefaultSubstitutions(types.Comment)).To(Equal(types.NoSubstitutions))
+		Expect(types.DefaultSubstitutions(types.Example)).To(Equal(types.NormalSubstitutions))
+	})
+})
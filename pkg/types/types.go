@@ -73,6 +73,7 @@ type Document struct {
 	ElementReferences  ElementReferences
 	Footnotes          Footnotes
 	FootnoteReferences FootnoteReferences
+	IndexTerms         IndexTerms
 }
 
 // Title retrieves the document title in its metadata, or empty section title if the title was not specified
@@ -252,13 +253,83 @@ func NewDocumentAttributeReset(attrName string) (DocumentAttributeReset, error)
 
 // DocumentAttributeSubstitution the type for DocumentAttributeSubstitution
 type DocumentAttributeSubstitution struct {
-	Name string
+	Name     string
+	Position Position // the position of the substitution in the source document, used to report missing attributes
 }
 
 // NewDocumentAttributeSubstitution initializes a new Document Attribute Substitutions
-func NewDocumentAttributeSubstitution(attrName string) (DocumentAttributeSubstitution, error) {
+func NewDocumentAttributeSubstitution(attrName string, position Position) (DocumentAttributeSubstitution, error) {
 	log.Debugf("initialized a new DocumentAttributeSubstitution: '%s'", attrName)
-	return DocumentAttributeSubstitution{Name: attrName}, nil
+	return DocumentAttributeSubstitution{
+		Name:     attrName,
+		Position: position,
+	}, nil
+}
+
+// Position the position of an element in a source document. The position is unknown (i.e., its line is `0`)
+// when the element was not parsed from a document (eg: the content of a title, parsed during the rendering)
+type Position struct {
+	Filename string
+	Line     int
+	Column   int
+}
+
+func (p Position) String() string {
+	switch {
+	case p.Line == 0:
+		return "unknown position"
+	case p.Filename == "":
+		return fmt.Sprintf("line %d, column %d", p.Line, p.Column)
+	default:
+		return fmt.Sprintf("%s:%d:%d", p.Filename, p.Line, p.Column)
+	}
+}
+
+// InlineAttributeEntry the type for an attribute entry within a line (eg: `{set:name:value}`, `{set:name}` or `{set:name!}`)
+type InlineAttributeEntry struct {
+	Name  string
+	Value string
+	Unset bool // `true` if the attribute is unset (ie, `{set:name!}`)
+}
+
+// NewInlineAttributeEntry initializes a new Inline Attribute Entry
+func NewInlineAttributeEntry(name string, value interface{}, unset bool) (InlineAttributeEntry, error) {
+	log.Debugf("initialized a new InlineAttributeEntry: '%s'", name)
+	result := InlineAttributeEntry{
+		Name:  name,
+		Unset: unset,
+	}
+	if value, ok := value.(string); ok {
+		result.Value = value
+	}
+	return result, nil
+}
+
+// CounterSubstitution the type for a counter (eg: `{counter:name}`, `{counter2:name}` or `{counter:name:A}`),
+// whose value is incremented each time it is referenced
+type CounterSubstitution struct {
+	Name   string
+	Hidden bool        // `true` if the value of the counter should not be displayed (ie, `{counter2:name}`)
+	Value  interface{} // the optional initial value of the counter, as an `int` or a `rune`
+}
+
+// NewCounterSubstitution initializes a new Counter Substitution
+func NewCounterSubstitution(name string, hidden bool, value interface{}) (CounterSubstitution, error) {
+	log.Debugf("initialized a new CounterSubstitution: '%s'", name)
+	return CounterSubstitution{
+		Name:   name,
+		Hidden: hidden,
+		Value:  value,
+	}, nil
+}
+
+// AcceptVisitor implements Visitable#AcceptVisitor(Visitor)
+func (c CounterSubstitution) AcceptVisitor(v Visitor) error {
+	err := v.Visit(c)
+	if err != nil {
+		return errors.Wrapf(err, "error while visiting counter substitution")
+	}
+	return nil
 }
 
 // ------------------------------------------
@@ -289,6 +360,12 @@ const (
 	Literal BlockKind = "literal"
 	// Source a source block
 	Source BlockKind = "source"
+	// Open an open block
+	Open BlockKind = "open"
+	// Abstract an abstract block (an open block masquerading as an abstract)
+	Abstract BlockKind = "abstract"
+	// PartIntro a part intro block (an open block masquerading as a part intro)
+	PartIntro BlockKind = "partintro"
 )
 
 // ------------------------------------------
@@ -299,6 +376,11 @@ const (
 type TableOfContentsMacro struct {
 }
 
+// NewTableOfContentsMacro returns a new TableOfContentsMacro, ie, the location of the ToC when the `toc` attribute is set to `macro`
+func NewTableOfContentsMacro() (TableOfContentsMacro, error) {
+	return TableOfContentsMacro{}, nil
+}
+
 // ------------------------------------------
 // User Macro
 // ------------------------------------------
@@ -377,6 +459,15 @@ func NewYamlFrontMatter(content string) (FrontMatter, error) {
 // Sections
 // ------------------------------------------
 
+// AttrIndexSection the attribute set on the section in which the index of the document is generated (ie, `[index]`)
+const AttrIndexSection = "index"
+
+// AttrDiscrete the attribute set on a heading which is not part of the sections hierarchy (ie, `[discrete]`)
+const AttrDiscrete = "discrete"
+
+// AttrFloatingTitle the legacy alternative to the `discrete` attribute (ie, `[float]`)
+const AttrFloatingTitle = "float"
+
 // Section the structure for a section
 type Section struct {
 	Level      int
@@ -450,6 +541,36 @@ func (s *Section) AddAttributes(attributes ElementAttributes) {
 	s.Attributes.AddAll(attributes)
 }
 
+// DiscreteHeading a heading which is not part of the sections hierarchy (ie, with the `[discrete]` or `[float]` style):
+// it does not contain the elements which follow it, and it is not listed in the table of contents
+type DiscreteHeading struct {
+	Level      int
+	Attributes ElementAttributes
+	Title      InlineElements
+}
+
+// NewDiscreteHeading initializes a new `DiscreteHeading` from the given title
+func NewDiscreteHeading(level int, title InlineElements, ids []interface{}, attributes interface{}) (DiscreteHeading, error) {
+	// same attributes (and default ID) as for a section
+	section, err := NewSection(level, title, ids, attributes)
+	if err != nil {
+		return DiscreteHeading{}, errors.Wrapf(err, "unable to initialize a new DiscreteHeading element")
+	}
+	log.Debugf("initialized a new DiscreteHeading level %d", level)
+	return DiscreteHeading{
+		Level:      level,
+		Attributes: section.Attributes,
+		Title:      title,
+	}, nil
+}
+
+// Footnotes returns the footnotes found in the title of this heading
+func (h DiscreteHeading) Footnotes() (Footnotes, FootnoteReferences, error) {
+	v := NewFootnotesCollector()
+	err := h.Title.AcceptVisitor(v)
+	return v.Footnotes, v.FootnoteReferences, err
+}
+
 // AcceptSubstitutor implements Substituable#AcceptSubstitutor(Substitutor)
 // in a section, the substitutor only cares about the elements for now.
 func (s *Section) AcceptSubstitutor(v Substitutor) (interface{}, error) {
@@ -1139,16 +1260,31 @@ const (
 	AttrImageHeight string = "height"
 	// AttrImageTitle the image `title` attribute
 	AttrImageTitle string = "title"
+	// AttrImageScale the image `scale` attribute, ie, the percentage of the intrinsic dimensions of the image (eg: `50`)
+	AttrImageScale string = "scale"
+	// AttrImageFloat the image `float` attribute (`left` or `right`)
+	AttrImageFloat string = "float"
+	// AttrImageAlign the block image `align` attribute (`left`, `center` or `right`)
+	AttrImageAlign string = "align"
+	// AttrImageWindow the image `window` attribute, ie, the target of the image link (eg: `_blank`)
+	AttrImageWindow string = "window"
+	// AttrImageFallback the image `fallback` attribute, ie, the image to display when an interactive SVG is not supported
+	AttrImageFallback string = "fallback"
+	// AttrImageInline the `inline` option, to embed the content of an SVG image in the document
+	AttrImageInline string = "%inline"
+	// AttrImageInteractive the `interactive` option, to render an SVG image in an `<object>` element
+	AttrImageInteractive string = "%interactive"
 )
 
 // ImageBlock the structure for the block images
 type ImageBlock struct {
 	Path       string
 	Attributes ElementAttributes
+	Position   Position // the position of the image in the source document, used to report missing images
 }
 
 // NewImageBlock initializes a new `ImageBlock`
-func NewImageBlock(path string, inlineAttributes ElementAttributes, attributes interface{}) (ImageBlock, error) {
+func NewImageBlock(path string, inlineAttributes ElementAttributes, attributes interface{}, position Position) (ImageBlock, error) {
 	attrs := ElementAttributes{}
 	if attributes, ok := attributes.(ElementAttributes); ok {
 		attrs.AddAll(attributes)
@@ -1167,6 +1303,7 @@ func NewImageBlock(path string, inlineAttributes ElementAttributes, attributes i
 	return ImageBlock{
 		Path:       path,
 		Attributes: attrs,
+		Position:   position,
 	}, nil
 }
 
@@ -1179,10 +1316,11 @@ func (i ImageBlock) AddAttributes(attributes ElementAttributes) {
 type InlineImage struct {
 	Path       string
 	Attributes ElementAttributes
+	Position   Position // the position of the image in the source document, used to report missing images
 }
 
 // NewInlineImage initializes a new `InlineImage` (similar to ImageBlock, but without attributes)
-func NewInlineImage(path string, attributes ElementAttributes) (InlineImage, error) {
+func NewInlineImage(path string, attributes ElementAttributes, position Position) (InlineImage, error) {
 	if alt, found := attributes[AttrImageAlt]; !found || alt == "" {
 		_, filename := filepath.Split(path)
 		log.Debugf("adding alt based on filename '%s'", filename)
@@ -1196,6 +1334,7 @@ func NewInlineImage(path string, attributes ElementAttributes) (InlineImage, err
 	return InlineImage{
 		Path:       path,
 		Attributes: attributes,
+		Position:   position,
 	}, nil
 }
 
@@ -1219,17 +1358,140 @@ func NewImageAttributes(alt, width, height interface{}, otherattrs []interface{}
 		}
 	}
 	result[AttrImageAlt] = altStr
+	result.addOtherAttributes(otherattrs)
+	return result, nil
+}
+
+// addOtherAttributes adds the given (non-positional) attributes of an image, video or audio macro,
+// with an `%<option>` attribute for each value of the `options` attribute
+func (a ElementAttributes) addOtherAttributes(otherattrs []interface{}) {
 	for _, otherAttr := range otherattrs {
 		if otherAttr, ok := otherAttr.(ElementAttributes); ok {
 			for k, v := range otherAttr {
-				result[k] = v
+				if k == AttrOptions || k == AttrOpts {
+					a.addOptions(v)
+					continue
+				}
+				a[k] = v
 				if k == AttrID {
 					// mark custom_id flag to `true`
-					result[AttrCustomID] = true
+					a[AttrCustomID] = true
 				}
 			}
 		}
 	}
+}
+
+// ------------------------------------------
+// Videos and audios
+// ------------------------------------------
+
+const (
+	// AttrVideoPoster the video `poster` attribute, ie, the image to show until the video is played,
+	// or the service which hosts the video (`youtube` or `vimeo`)
+	AttrVideoPoster string = "poster"
+	// AttrVideoWidth the video `width` attribute
+	AttrVideoWidth string = "width"
+	// AttrVideoHeight the video `height` attribute
+	AttrVideoHeight string = "height"
+	// AttrMediaStart the video and audio `start` attribute, ie, the time (in seconds) at which the media starts playing
+	AttrMediaStart string = "start"
+	// AttrMediaEnd the video and audio `end` attribute, ie, the time (in seconds) at which the media stops playing
+	AttrMediaEnd string = "end"
+	// AttrVideoTheme the `theme` attribute of the YouTube videos
+	AttrVideoTheme string = "theme"
+	// AttrVideoLang the `lang` attribute of the YouTube videos (ie, the language of the player)
+	AttrVideoLang string = "lang"
+	// AttrVideoList the `list` attribute of the YouTube videos, ie, the ID of a playlist
+	AttrVideoList string = "list"
+	// AttrVideoPlaylist the `playlist` attribute of the YouTube videos, ie, the IDs of the videos to play next
+	AttrVideoPlaylist string = "playlist"
+	// AttrMediaAutoplay the `autoplay` option of videos and audios
+	AttrMediaAutoplay string = "%autoplay"
+	// AttrMediaLoop the `loop` option of videos and audios
+	AttrMediaLoop string = "%loop"
+	// AttrMediaMuted the `muted` option of videos and audios
+	AttrMediaMuted string = "%muted"
+	// AttrMediaNoControls the `nocontrols` option of videos and audios
+	AttrMediaNoControls string = "%nocontrols"
+	// AttrVideoNoFullscreen the `nofullscreen` option of the YouTube and Vimeo videos
+	AttrVideoNoFullscreen string = "%nofullscreen"
+	// AttrVideoModest the `modest` option of the YouTube videos (ie, with a modest branding)
+	AttrVideoModest string = "%modest"
+	// AttrVideoRelated the `related` option of the YouTube videos (ie, showing related videos at the end)
+	AttrVideoRelated string = "%related"
+)
+
+// VideoBlock the structure for the video block macros (eg: `video::intro.mp4[]` or `video::rPQoq7ThGAU[youtube]`)
+type VideoBlock struct {
+	Path       string
+	Attributes ElementAttributes
+}
+
+// NewVideoBlock initializes a new `VideoBlock`
+func NewVideoBlock(path string, inlineAttributes ElementAttributes, attributes interface{}) (VideoBlock, error) {
+	attrs := ElementAttributes{}
+	if attributes, ok := attributes.(ElementAttributes); ok {
+		attrs.AddAll(attributes)
+	}
+	attrs.AddAll(inlineAttributes)
+	return VideoBlock{
+		Path:       path,
+		Attributes: attrs,
+	}, nil
+}
+
+// AddAttributes adds all given attributes to the current set of attribute of the element
+func (v VideoBlock) AddAttributes(attributes ElementAttributes) {
+	v.Attributes.AddAll(attributes)
+}
+
+// NewVideoAttributes returns a map of video attributes, some of which have implicit keys (`poster`, `width` and `height`)
+func NewVideoAttributes(poster, width, height interface{}, otherattrs []interface{}) (ElementAttributes, error) {
+	result := ElementAttributes{}
+	for k, v := range map[string]interface{}{
+		AttrVideoPoster: poster,
+		AttrVideoWidth:  width,
+		AttrVideoHeight: height,
+	} {
+		if v, ok := v.(string); ok {
+			if v = strings.TrimSpace(v); v != "" {
+				result[k] = v
+			}
+		}
+	}
+	result.addOtherAttributes(otherattrs)
+	return result, nil
+}
+
+// AudioBlock the structure for the audio block macros (eg: `audio::podcast.mp3[]`)
+type AudioBlock struct {
+	Path       string
+	Attributes ElementAttributes
+}
+
+// NewAudioBlock initializes a new `AudioBlock`
+func NewAudioBlock(path string, inlineAttributes ElementAttributes, attributes interface{}) (AudioBlock, error) {
+	attrs := ElementAttributes{}
+	if attributes, ok := attributes.(ElementAttributes); ok {
+		attrs.AddAll(attributes)
+	}
+	attrs.AddAll(inlineAttributes)
+	return AudioBlock{
+		Path:       path,
+		Attributes: attrs,
+	}, nil
+}
+
+// AddAttributes adds all given attributes to the current set of attribute of the element
+func (a AudioBlock) AddAttributes(attributes ElementAttributes) {
+	a.Attributes.AddAll(attributes)
+}
+
+// NewAudioAttributes returns a map of audio attributes
+func NewAudioAttributes(attrs []interface{}) (ElementAttributes, error) {
+	result := ElementAttributes{}
+	result.addOtherAttributes(attrs)
 	return result, nil
 }
 
@@ -1276,6 +1538,86 @@ func (f Footnote) AcceptVisitor(v Visitor) error {
 	return nil
 }
 
+// ------------------------------------------
+// Index terms
+// ------------------------------------------
+
+var indexTermSequence int
+
+// ResetIndexTermSequence resets the index term sequence (for test purpose only)
+func ResetIndexTermSequence() {
+	indexTermSequence = 0
+}
+
+// IndexTerm an index term, which is either visible in the text (eg: `((term))` or `indexterm2:[term]`),
+// or concealed (eg: `(((primary,secondary)))` or `indexterm:[primary,secondary]`)
+type IndexTerm struct {
+	ID int
+	// Terms the primary term, followed by the optional secondary and tertiary terms
+	Terms []string
+	// Visible true if the (primary) term is also displayed in the text
+	Visible bool
+}
+
+// NewIndexTerm returns a new IndexTerm with the given primary term, displayed in the text
+func NewIndexTerm(term string) (IndexTerm, error) {
+	return newIndexTerm([]string{strings.TrimSpace(term)}, true), nil
+}
+
+// NewConcealedIndexTerm returns a new IndexTerm with the given comma-separated terms, which is not displayed in the text
+func NewConcealedIndexTerm(terms string) (IndexTerm, error) {
+	t := []string{}
+	for _, term := range strings.Split(terms, ",") {
+		if term = strings.TrimSpace(term); term != "" {
+			t = append(t, term)
+		}
+	}
+	if len(t) > 3 {
+		log.Warnf("ignoring the index terms after the tertiary term in '%s'", terms)
+		t = t[:3]
+	}
+	return newIndexTerm(t, false), nil
+}
+
+// HasIndexTerms returns true if the given comma-separated terms contain at least one non-blank term
+// (eg: `(((,)))` or `indexterm:[ ]` are not index terms)
+func HasIndexTerms(terms string) bool {
+	for _, term := range strings.Split(terms, ",") {
+		if strings.TrimSpace(term) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func newIndexTerm(terms []string, visible bool) IndexTerm {
+	defer func() {
+		indexTermSequence++
+	}()
+	return IndexTerm{
+		ID:      indexTermSequence,
+		Terms:   terms,
+		Visible: visible,
+	}
+}
+
+// PrimaryTerm returns the primary term, or an empty string if there is no term at all
+func (t IndexTerm) PrimaryTerm() string {
+	if len(t.Terms) == 0 {
+		return ""
+	}
+	return t.Terms[0]
+}
+
+// AcceptVisitor implements Visitable#AcceptVisitor(Visitor)
+func (t IndexTerm) AcceptVisitor(v Visitor) error {
+	err := v.Visit(t)
+	if err != nil {
+		return errors.Wrapf(err, "error while visiting index term")
+	}
+	return nil
+}
+
 // ------------------------------------------
 // Delimited blocks
 // ------------------------------------------
@@ -1331,6 +1673,19 @@ func NewDelimitedBlock(kind BlockKind, content []interface{}, substitution Subst
 	}, nil
 }
 
+// NewMarkdownQuoteBlock initializes a new `DelimitedBlock` of kind `Quote` from the content
+// of a Markdown-style quote block, with the given (optional) attribution
+func NewMarkdownQuoteBlock(content []interface{}, attribution interface{}, attributes interface{}) (DelimitedBlock, error) {
+	attrs := ElementAttributes{}
+	if attributes, ok := attributes.(ElementAttributes); ok {
+		attrs.AddAll(attributes)
+	}
+	if attribution, ok := attribution.(map[string]interface{}); ok {
+		attrs.AddAll(attribution)
+	}
+	return NewDelimitedBlock(Quote, content, None, attrs)
+}
+
 // AddAttributes adds all given attributes to the current set of attribute of the element
 func (b *DelimitedBlock) AddAttributes(attributes ElementAttributes) {
 	b.Attributes.AddAll(attributes)
@@ -1492,6 +1847,30 @@ func (l BlankLine) AddAttributes(attributes ElementAttributes) {
 	// TODO: raise a warning?
 }
 
+// ------------------------------------------
+// Thematic breaks
+// ------------------------------------------
+
+// ThematicBreak the structure for the thematic breaks (aka horizontal rules)
+type ThematicBreak struct{}
+
+// NewThematicBreak returns a new thematic break
+func NewThematicBreak() (ThematicBreak, error) {
+	return ThematicBreak{}, nil
+}
+
+// ------------------------------------------
+// Page breaks
+// ------------------------------------------
+
+// PageBreak the structure for the page breaks
+type PageBreak struct{}
+
+// NewPageBreak returns a new page break
+func NewPageBreak() (PageBreak, error) {
+	return PageBreak{}, nil
+}
+
 // ------------------------------------------
 // Comments
 // ------------------------------------------
@@ -1536,6 +1915,29 @@ func (s StringElement) String() string {
 	return s.Content
 }
 
+// ------------------------------------------
+// VerbatimLine
+// ------------------------------------------
+
+// VerbatimLine the raw content of a line of a (non-AsciiDoc) file included in a listing, source or literal block,
+// which is rendered as-is, including its trailing spaces
+type VerbatimLine struct {
+	Content string
+}
+
+// AcceptVisitor implements Visitable#AcceptVisitor(Visitor)
+func (l VerbatimLine) AcceptVisitor(v Visitor) error {
+	err := v.Visit(l)
+	if err != nil {
+		return errors.Wrapf(err, "error while visiting verbatim line")
+	}
+	return nil
+}
+
+func (l VerbatimLine) String() string {
+	return l.Content
+}
+
 // ------------------------------------------
 // Explicit line breaks
 // ------------------------------------------
@@ -1554,8 +1956,9 @@ func NewLineBreak() (LineBreak, error) {
 
 // QuotedText the structure for quoted text
 type QuotedText struct {
-	Kind     QuotedTextKind
-	Elements InlineElements
+	Kind       QuotedTextKind
+	Attributes ElementAttributes
+	Elements   InlineElements
 }
 
 // QuotedTextKind the type for
@@ -1572,6 +1975,12 @@ const (
 	Subscript
 	// Superscript superscript quoted text (wrapped with '^' or '^^')
 	Superscript
+	// Marked marked (or highlighted) quoted text (wrapped with '#' or '##')
+	Marked
+	// DoubleCurvedQuote text wrapped with curved double quotes ('"`' and '`"')
+	DoubleCurvedQuote
+	// SingleCurvedQuote text wrapped with curved single quotes ("'`" and "`'")
+	SingleCurvedQuote
 )
 
 // NewQuotedText initializes a new `QuotedText` from the given kind and content
@@ -1583,6 +1992,17 @@ func NewQuotedText(kind QuotedTextKind, content ...interface{}) (QuotedText, err
 	}, nil
 }
 
+// NewQuotedTextWithRole sets the given role (if any) on the given quoted text. Multiple roles
+// are separated by a dot (eg: `big.red`)
+func NewQuotedTextWithRole(t QuotedText, role interface{}) (QuotedText, error) {
+	if role, ok := role.(string); ok {
+		t.Attributes = ElementAttributes{
+			AttrRole: strings.Replace(role, ".", " ", -1),
+		}
+	}
+	return t, nil
+}
+
 // AcceptVisitor implements Visitable#AcceptVisitor(Visitor)
 func (t QuotedText) AcceptVisitor(v Visitor) error {
 	err := v.Visit(t)
@@ -1635,8 +2055,9 @@ func NewEscapedQuotedText(backslashes string, punctuation string, content interf
 
 // Passthrough the structure for Passthroughs
 type Passthrough struct {
-	Kind     PassthroughKind
-	Elements InlineElements
+	Kind       PassthroughKind
+	Attributes ElementAttributes
+	Elements   InlineElements
 }
 
 // PassthroughKind the kind of passthrough
@@ -1649,6 +2070,9 @@ const (
 	TriplePlusPassthrough
 	// PassthroughMacro a passthrough with the `pass:[]` macro
 	PassthroughMacro
+	// RenderedPassthrough a passthrough whose content was already rendered by the renderer (eg: after applying
+	// the substitutions of a block), and which is never escaped
+	RenderedPassthrough
 )
 
 // NewPassthrough returns a new passthrough
@@ -1660,6 +2084,17 @@ func NewPassthrough(kind PassthroughKind, elements []interface{}) (Passthrough,
 
 }
 
+// NewPassthroughMacroWithSubstitutions returns a new passthrough macro on which the given substitutions apply (eg: `pass:q,a[...]`)
+func NewPassthroughMacroWithSubstitutions(subs string, elements []interface{}) (Passthrough, error) {
+	return Passthrough{
+		Kind: PassthroughMacro,
+		Attributes: ElementAttributes{
+			AttrSubstitutions: subs,
+		},
+		Elements: mergeElements(elements...),
+	}, nil
+}
+
 // ------------------------------------------
 // Inline Links
 // ------------------------------------------
@@ -1695,6 +2130,10 @@ func NewInlineLinkAttributes(text interface{}, otherattrs []interface{}) (Elemen
 	for _, otherAttr := range otherattrs {
 		if otherAttr, ok := otherAttr.(ElementAttributes); ok {
 			for k, v := range otherAttr {
+				if k == AttrOptions || k == AttrOpts {
+					result.addOptions(v)
+					continue
+				}
 				result[k] = v
 			}
 		}
@@ -1761,6 +2200,19 @@ func (f *FileInclusion) TagRanges() (TagRanges, bool) {
 	return TagRanges{}, false // default tag ranges: include all content
 }
 
+// IsOptional returns true if the file inclusion has the `optional` option (eg: `opts=optional`),
+// in which case a missing file is silently skipped
+func (f *FileInclusion) IsOptional() bool {
+	for _, key := range []string{AttrOpts, AttrOptions} {
+		for _, option := range strings.Split(f.Attributes.GetAsString(key), ",") {
+			if strings.TrimSpace(option) == "optional" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // -------------------------------------------------------------------------------------
 // LineRanges: one or more ranges of lines to limit the content of a file to include
 // -------------------------------------------------------------------------------------
@@ -1860,7 +2312,9 @@ func NewTagRangesAttribute(ranges interface{}) (ElementAttributes, error) {
 	}
 }
 
-// TagRanges the ranges of tags of the child doc to include in the master doc
+// TagRanges the ranges of tags of the child doc to include in the master doc.
+// A tag can be negated with a `!` prefix (eg: `!debug`), and the `**` and `*` wildcards
+// respectively select all the lines and all the lines within a tag.
 type TagRanges []string
 
 // TagRange a tag range found while processing a document. When the 'start' tag is found,
@@ -1883,14 +2337,79 @@ func NewTagRanges(ranges ...interface{}) TagRanges {
 	return result
 }
 
-// Match checks if the given tag matches one of the range
-func (r TagRanges) Match(line int, ranges map[string]*TagRange) bool {
+// Names returns the names of the selected tags, ie, without the negated tags and the wildcards
+func (r TagRanges) Names() []string {
+	result := []string{}
 	for _, t := range r {
-		if r, found := ranges[t]; found && r.StartLine <= line && r.EndLine == -1 {
-			return true
+		if !strings.HasPrefix(t, "!") && t != "*" && t != "**" {
+			result = append(result, t)
 		}
 	}
-	return false
+	return result
+}
+
+// TagSelection the selection of the lines of a file to include, depending on the tags which enclose them
+type TagSelection struct {
+	tags     map[string]bool // the selected or negated tags
+	wildcard *bool           // the selection of the lines within the other tags, if set with `*` or `!*`
+	base     bool            // the selection of the lines outside of any tag
+}
+
+// Selection returns the selection of lines corresponding to the tag ranges, following the same rules as Asciidoctor:
+// - the `**` wildcard selects (or, if negated, excludes) the lines outside of any tag,
+// - the `*` wildcard selects (or, if negated, excludes) the lines within any tag which is not explicitly set,
+// - when no wildcard is set, the lines outside of any tag are only selected if all tags are negated.
+func (r TagRanges) Selection() TagSelection {
+	result := TagSelection{
+		tags: map[string]bool{},
+	}
+	var outside, inside *bool
+	for i, t := range r {
+		selected := !strings.HasPrefix(t, "!")
+		name := strings.TrimPrefix(t, "!")
+		switch name {
+		case "**":
+			outside = &selected
+		case "*":
+			inside = &selected
+			if i == 0 {
+				// the `*` wildcard comes first, so the lines outside of any tag get the opposite selection
+				result.base = !selected
+			}
+		default:
+			result.tags[name] = selected
+		}
+	}
+	result.wildcard = inside
+	if outside != nil {
+		result.base = *outside
+	} else if inside == nil {
+		result.base = true
+		for _, selected := range result.tags {
+			if selected {
+				result.base = false
+				break
+			}
+		}
+	}
+	return result
+}
+
+// Match checks if a line enclosed by the given tags (from the outermost to the innermost) is selected
+func (s TagSelection) Match(openTags []string) bool {
+	if len(openTags) == 0 {
+		return s.base
+	}
+	// the innermost tag which is explicitly set determines the selection
+	for i := len(openTags) - 1; i >= 0; i-- {
+		if selected, found := s.tags[openTags[i]]; found {
+			return selected
+		}
+	}
+	if s.wildcard != nil {
+		return *s.wildcard
+	}
+	return s.base
 }
 
 // -------------------------------------------------------------------------------------
@@ -0,0 +1,5 @@
+#!/bin/sh
+
+# a comment
+* not a list
+	echo "<tab>" && exit 0  
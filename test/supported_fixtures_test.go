@@ -39,6 +39,9 @@ var _ = Describe("fixtures", func() {
 
 	// verifies that all files in the `supported` subfolder match their sibling golden file
 	DescribeTable("supported", compare, entries("fixtures/supported/*.adoc")...)
+
+	// verifies that formatting the formatted content of all files in the `supported` subfolder does not change it
+	DescribeTable("formatting round-trip", roundtrip, entries("fixtures/supported/*.adoc")...)
 })
 
 func compare(file string) {
@@ -65,6 +68,17 @@ func compare(file string) {
 	Expect(actual).To(Equal(expected))
 }
 
+func roundtrip(file string) {
+	// format the file, then parse and format the result
+	formatted := bytes.NewBuffer(nil)
+	err := libasciidoc.ConvertFileToAsciidoc(context.Background(), file, formatted)
+	Expect(err).ShouldNot(HaveOccurred())
+	reformatted := bytes.NewBuffer(nil)
+	err = libasciidoc.ConvertToAsciidoc(context.Background(), strings.NewReader(formatted.String()), reformatted)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(reformatted.String()).To(Equal(formatted.String()))
+}
+
 const adocExt = ".adoc"
 
 func entries(pattern string) []TableEntry {
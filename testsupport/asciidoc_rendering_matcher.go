@@ -0,0 +1,54 @@
+package testsupport
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+
+	gomegatypes "github.com/onsi/gomega/types"
+	"github.com/pkg/errors"
+)
+
+// --------------------
+// Render Asciidoc
+// --------------------
+
+// RenderAsciidoc a custom matcher to verify that a document is formatted as the expectation
+func RenderAsciidoc(expected string, opts ...renderer.Option) gomegatypes.GomegaMatcher {
+	return &asciidocMatcher{
+		expected: expected,
+		opts:     opts,
+	}
+}
+
+type asciidocMatcher struct {
+	expected string
+	actual   string
+	opts     []renderer.Option
+}
+
+func (m *asciidocMatcher) Match(actual interface{}) (success bool, err error) {
+	content, ok := actual.(string)
+	if !ok {
+		return false, errors.Errorf("RenderAsciidoc matcher expects a string (actual: %T)", actual)
+	}
+	resultWriter := bytes.NewBuffer(nil)
+	err = libasciidoc.ConvertToAsciidoc(context.Background(), strings.NewReader(content), resultWriter, m.opts...)
+	if err != nil {
+		return false, err
+	}
+	m.actual = resultWriter.String()
+	return m.expected == m.actual, nil
+}
+
+func (m *asciidocMatcher) FailureMessage(actual interface{}) (message string) {
+	return fmt.Sprintf("expected Asciidoc documents to match:\n\texpected: '%v'\n\tactual:   '%v'", m.expected, m.actual)
+}
+
+func (m *asciidocMatcher) NegatedFailureMessage(actual interface{}) (message string) {
+	return fmt.Sprintf("expected Asciidoc documents not to match:\n\texpected: '%v'\n\tactual:   '%v'", m.expected, m.actual)
+}
@@ -44,9 +44,19 @@ func ContainMessageWithLevel(level log.Level, msg string) types.GomegaMatcher {
 	}
 }
 
+// ContainMessageWithLevelAndError a custom Matcher to verify that a message with at a given level and with the given error was logged
+func ContainMessageWithLevelAndError(level log.Level, msg, err string) types.GomegaMatcher {
+	return &containMessageMatcher{
+		level: level,
+		msg:   msg,
+		err:   err,
+	}
+}
+
 type containMessageMatcher struct {
 	level log.Level
 	msg   string
+	err   string // optional
 }
 
 func (m *containMessageMatcher) Match(actual interface{}) (success bool, err error) {
@@ -67,6 +77,9 @@ func (m *containMessageMatcher) Match(actual interface{}) (success bool, err err
 		if msg, ok := out["msg"].(string); !ok || msg != m.msg {
 			continue
 		}
+		if e, _ := out[log.ErrorKey].(string); m.err != "" && e != m.err {
+			continue
+		}
 		// match found
 		return true, nil
 	}
@@ -75,10 +88,16 @@ func (m *containMessageMatcher) Match(actual interface{}) (success bool, err err
 }
 
 func (m *containMessageMatcher) FailureMessage(actual interface{}) (message string) {
+	if m.err != "" {
+		return fmt.Sprintf("expected console to contain message '%s' with level '%v' and error '%s'", m.msg, m.level, m.err)
+	}
 	return fmt.Sprintf("expected console to contain message '%s' with level '%v'", m.msg, m.level)
 }
 
 func (m *containMessageMatcher) NegatedFailureMessage(actual interface{}) (message string) {
+	if m.err != "" {
+		return fmt.Sprintf("expected console not to contain message '%s' with level '%v' and error '%s'", m.msg, m.level, m.err)
+	}
 	return fmt.Sprintf("expected console not to contain message '%s' with level '%v'", m.msg, m.level)
 }
 
@@ -13,15 +13,17 @@ import (
 )
 
 // HaveTableOfContents a custom matcher to verify that a document matches the expectation
-func HaveTableOfContents(expected types.Document) gomegatypes.GomegaMatcher {
+func HaveTableOfContents(expected types.Document, opts ...renderer.Option) gomegatypes.GomegaMatcher {
 	return &tocMatcher{
 		expected: expected,
+		opts:     opts,
 	}
 }
 
 type tocMatcher struct {
 	expected types.Document
 	actual   types.Document
+	opts     []renderer.Option
 }
 
 func (m *tocMatcher) Match(actual interface{}) (success bool, err error) {
@@ -29,7 +31,7 @@ func (m *tocMatcher) Match(actual interface{}) (success bool, err error) {
 	if !ok {
 		return false, errors.Errorf("HaveTableOfContents matcher expects a Document (actual: %T)", actual)
 	}
-	ctx := renderer.Wrap(context.Background(), doc)
+	ctx := renderer.Wrap(context.Background(), doc, m.opts...)
 	renderer.IncludeTableOfContents(ctx)
 	m.actual = ctx.Document
 	return reflect.DeepEqual(m.expected, m.actual), nil
@@ -43,12 +43,13 @@ func (m *html5ElementMatcher) Match(actual interface{}) (success bool, err error
 		return false, errors.Errorf("RenderHTML5Element matcher expects a string (actual: %T)", actual)
 	}
 	r := strings.NewReader(content)
-	doc, err := parser.ParseDocument("test.adoc", r)
+	rendererCtx := renderer.Wrap(context.Background(), types.Document{}, m.opts...)
+	doc, err := parser.ParseDocument("test.adoc", r, rendererCtx.ParserOptions()...)
 	if err != nil {
 		return false, err
 	}
+	rendererCtx.Document = doc
 	buff := bytes.NewBuffer(nil)
-	rendererCtx := renderer.Wrap(context.Background(), doc, m.opts...)
 	// insert tables of contents, preamble and process file inclusions
 	err = renderer.Prerender(rendererCtx)
 	if err != nil {
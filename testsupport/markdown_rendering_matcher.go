@@ -0,0 +1,54 @@
+package testsupport
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+
+	gomegatypes "github.com/onsi/gomega/types"
+	"github.com/pkg/errors"
+)
+
+// --------------------
+// Render Markdown
+// --------------------
+
+// RenderMarkdown a custom matcher to verify that a document renders as the expectation in Markdown
+func RenderMarkdown(expected string, opts ...renderer.Option) gomegatypes.GomegaMatcher {
+	return &markdownMatcher{
+		expected: expected,
+		opts:     opts,
+	}
+}
+
+type markdownMatcher struct {
+	expected string
+	actual   string
+	opts     []renderer.Option
+}
+
+func (m *markdownMatcher) Match(actual interface{}) (success bool, err error) {
+	content, ok := actual.(string)
+	if !ok {
+		return false, errors.Errorf("RenderMarkdown matcher expects a string (actual: %T)", actual)
+	}
+	resultWriter := bytes.NewBuffer(nil)
+	err = libasciidoc.ConvertToMarkdown(context.Background(), strings.NewReader(content), resultWriter, m.opts...)
+	if err != nil {
+		return false, err
+	}
+	m.actual = resultWriter.String()
+	return m.expected == m.actual, nil
+}
+
+func (m *markdownMatcher) FailureMessage(actual interface{}) (message string) {
+	return fmt.Sprintf("expected Markdown documents to match:\n\texpected: '%v'\n\tactual:   '%v'", m.expected, m.actual)
+}
+
+func (m *markdownMatcher) NegatedFailureMessage(actual interface{}) (message string) {
+	return fmt.Sprintf("expected Markdown documents not to match:\n\texpected: '%v'\n\tactual:   '%v'", m.expected, m.actual)
+}
@@ -0,0 +1,52 @@
+package testsupport_test
+
+import (
+	"fmt"
+
+	"github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("markdown rendering assertions", func() {
+
+	expected := "hello, **world**!\n"
+
+	It("should match", func() {
+		// given
+		matcher := testsupport.RenderMarkdown(expected)
+		actual := "hello, *world*!"
+		// when
+		result, err := matcher.Match(actual)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(BeTrue())
+	})
+
+	It("should not match", func() {
+		// given
+		matcher := testsupport.RenderMarkdown(expected)
+		actual := "foo"
+		// when
+		result, err := matcher.Match(actual)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(BeFalse())
+		// also verify the messages
+		obtained := "foo\n"
+		Expect(matcher.FailureMessage(actual)).To(Equal(fmt.Sprintf("expected Markdown documents to match:\n\texpected: '%v'\n\tactual:   '%v'", expected, obtained)))
+		Expect(matcher.NegatedFailureMessage(actual)).To(Equal(fmt.Sprintf("expected Markdown documents not to match:\n\texpected: '%v'\n\tactual:   '%v'", expected, obtained)))
+	})
+
+	It("should return error when invalid type is input", func() {
+		// given
+		matcher := testsupport.RenderMarkdown("")
+		// when
+		result, err := matcher.Match(1) // not a string
+		// then
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(Equal("RenderMarkdown matcher expects a string (actual: int)"))
+		Expect(result).To(BeFalse())
+	})
+})
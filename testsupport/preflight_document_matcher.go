@@ -12,10 +12,11 @@ import (
 )
 
 // BecomePreflightDocument a custom matcher to verify that a preflight document matches the expectation
-func BecomePreflightDocument(expected interface{}) types.GomegaMatcher {
+func BecomePreflightDocument(expected interface{}, opts ...parser.Option) types.GomegaMatcher {
 	return &preflightDocumentMatcher{
 		expected:      expected,
 		preprocessing: true,
+		opts:          opts,
 	}
 }
 
@@ -31,6 +32,7 @@ type preflightDocumentMatcher struct {
 	expected      interface{}
 	actual        interface{}
 	preprocessing bool
+	opts          []parser.Option
 }
 
 func (m *preflightDocumentMatcher) Match(actual interface{}) (success bool, err error) {
@@ -43,7 +45,7 @@ func (m *preflightDocumentMatcher) Match(actual interface{}) (success bool, err
 		m.actual, err = parser.ParseReader("", r, parser.Entrypoint("PreflightDocument"))
 
 	} else {
-		m.actual, err = parser.ParsePreflightDocument("test.adoc", r)
+		m.actual, err = parser.ParsePreflightDocument("test.adoc", r, m.opts...)
 	}
 	if err != nil {
 		return false, err